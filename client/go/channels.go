@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/stats"
+	"github.com/olekukonko/tablewriter"
+	"google.golang.org/grpc"
+)
+
+// channelMode is one way of spreading the workers of benchmark-channels
+// across channels, with the results of running them that way
+type channelMode struct {
+	name     string
+	channels int
+	samples  [][]float64 // by call, in milliseconds
+	failed   []int
+	warmup   time.Duration
+	elapsed  time.Duration
+}
+
+// run opens the mode's channels, pre-warms them if asked, then has --workers
+// workers make --iterations rounds of the calls at once, worker i on
+// channel i modulo the number of channels
+func (m *channelMode) run(ctx context.Context, calls []unaryCall) error {
+	target, opts := dialTarget()
+	conns := make([]*grpc.ClientConn, m.channels)
+	for i := range conns {
+		conn, err := grpc.DialContext(ctx, target, opts...)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		conns[i] = conn
+	}
+	if *prewarm {
+		start := time.Now()
+		for _, conn := range conns {
+			if err := prewarmChannel(ctx, conn); err != nil {
+				return fmt.Errorf("pre-warming channel: %w", err)
+			}
+		}
+		m.warmup = time.Since(start)
+	}
+
+	type result struct {
+		samples [][]float64
+		failed  []int
+	}
+	results := make([]result, *workers)
+	var wg sync.WaitGroup
+	start := time.Now()
+	for w := range results {
+		client := proto.NewBenchmarkServiceClient(conns[w%len(conns)])
+		r := &results[w]
+		r.samples, r.failed = make([][]float64, len(calls)), make([]int, len(calls))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < int(*iterations); i++ {
+				for j, call := range calls {
+					timeCall(&r.samples[j], &r.failed[j], func() error { return call.call(client) })
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	m.elapsed = time.Since(start)
+
+	m.samples, m.failed = make([][]float64, len(calls)), make([]int, len(calls))
+	for _, r := range results {
+		for j := range calls {
+			m.samples[j] = append(m.samples[j], r.samples[j]...)
+			m.failed[j] += r.failed[j]
+		}
+	}
+	return nil
+}
+
+// prewarmChannel connects a channel and waits until it is ready, which
+// includes TCP, TLS and the HTTP/2 preface and settings exchange, then
+// with --prewarm-ping makes a call the server answers locally, so the first
+// timed call on the channel also finds its stream and header state warm
+func prewarmChannel(ctx context.Context, conn *grpc.ClientConn) error {
+	if err := waitReady(ctx, conn); err != nil {
+		return err
+	}
+	if *prewarmPing {
+		_, err := proto.NewBenchmarkServiceClient(conn).GetCapabilities(ctx, &proto.CapabilitiesRequest{})
+		return err
+	}
+	return nil
+}
+
+// runBenchmarkChannels runs the same concurrent workers over one shared
+// channel and over one channel per worker, plus --channels channels if set,
+// since calls multiplexed on one HTTP/2 connection contend for its writer
+// and flow control windows at high concurrency
+func runBenchmarkChannels(ctx context.Context, client proto.BenchmarkServiceClient) {
+	calls := unaryCalls(ctx)
+	if *workers < 2 {
+		fatal("--workers must be at least 2 to compare shared and per-worker channels")
+	}
+	modes := []*channelMode{
+		{name: "shared", channels: 1},
+		{name: "per worker", channels: int(*workers)},
+	}
+	if *channels > 1 && *channels != *workers {
+		modes = append(modes, &channelMode{name: fmt.Sprintf("%d channels", *channels), channels: int(*channels)})
+	}
+
+	// Make each call once, untimed, so no mode is the first to reach a cold
+	// upstream
+	for _, call := range calls {
+		call.call(client)
+	}
+
+	fmt.Printf("Benchmarking %d workers x %d rounds via %s (pre-warm: %t, ping: %t)...\n\n", *workers, *iterations, *serverAddr, *prewarm, *prewarm && *prewarmPing)
+	for _, mode := range modes {
+		if err := mode.run(ctx, calls); err != nil {
+			fatalf("Error running %s channels: %v", mode.name, err)
+		}
+	}
+
+	fmt.Println("Channel Sharing Results (client-observed):")
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Mode", "Channels", "Category", "Calls/s", "Avg (ms)", "P50 (ms)", "P99 (ms)", "Max (ms)", "Successful", "Failed"})
+	for _, mode := range modes {
+		for j, call := range calls {
+			summary := stats.Summarize(mode.samples[j])
+			table.Append([]string{
+				mode.name,
+				fmt.Sprintf("%d", mode.channels),
+				call.name,
+				fmt.Sprintf("%.1f", float64(len(mode.samples[j]))/mode.elapsed.Seconds()),
+				fmt.Sprintf("%.2f", summary.Mean),
+				fmt.Sprintf("%.2f", summary.P50),
+				fmt.Sprintf("%.2f", summary.P99),
+				fmt.Sprintf("%.2f", summary.Max),
+				fmt.Sprintf("%d", len(mode.samples[j])),
+				fmt.Sprintf("%d", mode.failed[j]),
+			})
+		}
+	}
+	table.Render()
+	fmt.Println()
+
+	fmt.Println("Channel Setup:")
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Mode", "Channels", "Pre-warm (ms)", "Run (ms)"})
+	for _, mode := range modes {
+		warmup := "-"
+		if *prewarm {
+			warmup = fmt.Sprintf("%.2f", float64(mode.warmup.Microseconds())/1000)
+		}
+		table.Append([]string{mode.name, fmt.Sprintf("%d", mode.channels), warmup, fmt.Sprintf("%.2f", float64(mode.elapsed.Microseconds())/1000)})
+	}
+	table.Render()
+	fmt.Println()
+
+	shared, perWorker := modes[0], modes[1]
+	fmt.Println("Shared vs Per-Worker Channels (Welch's t-test, shared minus per worker):")
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Category", "Ratio", "Mean Difference (ms)", "p-value", "Significant"})
+	for j, call := range calls {
+		sharedMean, perWorkerMean := stats.Summarize(shared.samples[j]).Mean, stats.Summarize(perWorker.samples[j]).Mean
+		if sharedMean == 0 || perWorkerMean == 0 {
+			continue
+		}
+		row := []string{call.name, fmt.Sprintf("%.2fx", sharedMean/perWorkerMean), fmt.Sprintf("%.2f", sharedMean-perWorkerMean), "-", "-"}
+		if test, err := stats.WelchTTest(perWorker.samples[j], shared.samples[j]); err == nil {
+			row[3] = fmt.Sprintf("%.4f", test.PValue)
+			row[4] = fmt.Sprintf("%t", test.Significant())
+		}
+		table.Append(row)
+	}
+	table.Render()
+}
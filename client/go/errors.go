@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Exit codes by failure class, so scripts can tell failures apart
+const (
+	exitError      = 1 // anything not classified below
+	exitUsage      = 2 // invalid flags or arguments, including INVALID_ARGUMENT
+	exitConnection = 3 // the server could not be reached
+	exitUpstream   = 4 // the server or its Solana upstream failed
+	exitTimeout    = 5 // the call timed out or was cancelled
+	exitNotFound   = 6 // the requested job, session or history does not exist
+	exitRejected   = 7 // the server refused the call: limits, auth or a disabled feature
+)
+
+// failureClasses names the exit codes in machine-readable errors
+var failureClasses = map[int]string{
+	exitError:      "error",
+	exitUsage:      "invalid_argument",
+	exitConnection: "connection",
+	exitUpstream:   "upstream",
+	exitTimeout:    "timeout",
+	exitNotFound:   "not_found",
+	exitRejected:   "rejected",
+}
+
+// exitCodes maps gRPC status codes to exit codes
+var exitCodes = map[codes.Code]int{
+	codes.InvalidArgument:    exitUsage,
+	codes.OutOfRange:         exitUsage,
+	codes.FailedPrecondition: exitUsage,
+	codes.Unavailable:        exitConnection,
+	codes.Internal:           exitUpstream,
+	codes.Unknown:            exitUpstream,
+	codes.DataLoss:           exitUpstream,
+	codes.Aborted:            exitUpstream,
+	codes.DeadlineExceeded:   exitTimeout,
+	codes.Canceled:           exitTimeout,
+	codes.NotFound:           exitNotFound,
+	codes.ResourceExhausted:  exitRejected,
+	codes.PermissionDenied:   exitRejected,
+	codes.Unauthenticated:    exitRejected,
+	codes.Unimplemented:      exitRejected,
+}
+
+// setupOutput applies --quiet and checks --format
+func setupOutput() {
+	if *errFormat != "text" && *errFormat != "json" {
+		fail(exitUsage, nil, "Unknown format: %s", *errFormat)
+	}
+	if *quiet {
+		log.SetOutput(io.Discard)
+	}
+}
+
+// fatalf reports a failure and exits with the code of its class. The class is
+// taken from the gRPC status of an error argument; a failure without one, or
+// with a client-side error such as a malformed pubkey, is a usage error.
+func fatalf(format string, args ...interface{}) {
+	code := exitUsage
+	var cause error
+	for _, arg := range args {
+		err, ok := arg.(error)
+		if !ok {
+			continue
+		}
+		cause = err
+		if st, ok := status.FromError(err); ok {
+			code = exitError
+			if c, ok := exitCodes[st.Code()]; ok {
+				code = c
+			}
+		}
+	}
+	fail(code, cause, format, args...)
+}
+
+// fatal is fatalf without formatting
+func fatal(msg string) {
+	fatalf("%s", msg)
+}
+
+// fail reports a failure of a known class and exits with its code. With
+// --format json the failure is written to stderr as a JSON object, even when
+// --quiet suppresses everything else. A stream command's session summary is
+// printed first.
+func fail(code int, cause error, format string, args ...interface{}) {
+	printSessionSummary()
+	message := fmt.Sprintf(format, args...)
+	if *errFormat != "json" {
+		fmt.Fprintln(os.Stderr, message)
+		os.Exit(code)
+	}
+
+	out := struct {
+		Class    string `json:"class"`
+		ExitCode int    `json:"exit_code"`
+		Status   string `json:"status,omitempty"`
+		Message  string `json:"message"`
+	}{Class: failureClasses[code], ExitCode: code, Message: message}
+	var st interface{ GRPCStatus() *status.Status }
+	if errors.As(cause, &st) {
+		out.Status = st.GRPCStatus().Code().String()
+	}
+	data, _ := json.Marshal(out)
+	fmt.Fprintln(os.Stderr, string(data))
+	os.Exit(code)
+}
@@ -2,75 +2,354 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
 	"os"
+	"os/signal"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/flatblock"
+	"github.com/i-tozer/solana-grpc-exploration/server/stats"
 	"github.com/olekukonko/tablewriter"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 var (
-	serverAddr = flag.String("server", "localhost:50051", "The server address in the format host:port")
-	command    = flag.String("command", "benchmark", "Command to run: benchmark, account, transaction, block, stream-accounts, stream-transactions, stream-blocks")
-	pubkey     = flag.String("pubkey", "", "Solana account public key")
-	signature  = flag.String("signature", "", "Solana transaction signature")
-	slot       = flag.Uint64("slot", 0, "Solana block slot")
-	iterations = flag.Uint("iterations", 10, "Number of iterations for benchmark")
+	serverAddr  = flag.String("server", "localhost:50051", "The server address: host:port, a comma-separated list of host:port, or a gRPC target such as dns:///host:port")
+	lbPolicy    = flag.String("lb-policy", "pick_first", "Client load-balancing policy: pick_first or round_robin")
+	command     = flag.String("command", "benchmark", "Command to run: benchmark, benchmark-e2e, benchmark-result, benchmark-cancel, benchmark-serialization, encoded-block, benchmark-quic, benchmark-channels, stream-benchmark, benchmark-dual-serve, account, account-history, benchmarks, upstream-health, current-slot, stream-stats, slo-status, capabilities, nonce-account, read-consistent, token-account, nfts, asset, assets, asset-proof, find-pda, create-with-seed, ata, verify-signature, decode-transaction, build-transfer, build-token-transfer, transaction, block, block-time, slot-at-time, block-production, cluster-nodes, stake-activation, inflation-reward, owned-accounts, stream-accounts, stream-accounts-ack, stream-program-accounts, commitment-latency, stream-transactions, stream-blocks, stream-balance-changes, stream-alerts, stream-fee-stats, stream-address-history, stream-block-production, stream-epoch-events, stream-owned-accounts, stream-slo-events")
+	pubkey      = flag.String("pubkey", "", "Solana account public key; the validator identity for block-production and stream-block-production")
+	signature   = flag.String("signature", "", "Solana transaction signature, or the signature to check for verify-signature")
+	slot        = flag.Uint64("slot", 0, "Solana block slot")
+	iterations  = flag.Uint("iterations", 10, "Number of iterations for benchmark")
+	sessionID   = flag.String("session", "", "Session ID to resume for stream-accounts-ack")
+	ackCursor   = flag.Uint64("ack-cursor", 0, "Last processed cursor when resuming a stream-accounts-ack session")
+	snapshot    = flag.Bool("snapshot", false, "Send the current state of each account before live updates when streaming accounts or owned accounts")
+	programID   = flag.String("program", "", "Solana program ID for stream-program-accounts and find-pda; the owner for owned-accounts and stream-owned-accounts; the owner for create-with-seed; the token program for ata (default SPL Token)")
+	chunkSize   = flag.Uint("chunk-size", 100, "Accounts per chunk for stream-program-accounts")
+	live        = flag.Bool("live", false, "Continue with live updates after the stream-program-accounts snapshot or the stream-address-history history")
+	commitment  = flag.String("commitment", "finalized", "Commitment level for stream-blocks, stream-transactions, stream-balance-changes, stream-alerts, stream-fee-stats, stream-address-history, block-production, stream-block-production, owned-accounts and stream-owned-accounts: confirmed or finalized")
+	duration    = flag.Uint("duration", 30, "Measurement duration in seconds for commitment-latency and stream-benchmark")
+	owner       = flag.String("owner", "", "Owner program ID whose accounts to stream, instead of or in addition to --pubkey; wallet whose NFTs or assets to list for nfts and assets")
+	outliers    = flag.String("outliers", "none", "Outlier handling for benchmark statistics: none, trim, or winsorize")
+	outlierPct  = flag.Float64("outlier-fraction", 0.01, "Fraction of benchmark samples to trim or winsorize in each tail")
+	compression = flag.String("compression", "", "Compression for the streamed responses of a stream command: gzip, none, or empty for the channel default")
+	channelComp = flag.String("channel-compression", "", "Default compression for the channel: gzip, or empty for none")
+	runtimeStat = flag.Bool("runtime-stats", false, "Report server allocations, GC and CPU time per benchmark (benchmarks then run one at a time)")
+	noCache     = flag.Bool("no-cache", false, "Run the benchmark even if the server has cached results for an identical request")
+	workSeed    = flag.Uint64("workload-seed", 0, "Seed shuffling the order benchmark targets are requested in, so runs with the same seed are comparable (0 for the request order)")
+	region      = flag.String("region", "", "Region the client runs in, recorded with benchmark results")
+	ndjson      = flag.Bool("ndjson", false, "Stream commands: write each update to stdout as one line of JSON, with progress messages on stderr")
+	quiet       = flag.Bool("quiet", false, "Suppress log output, leaving results and errors")
+	errFormat   = flag.String("format", "text", "Format of errors on stderr: text or json")
+	atTime      = flag.String("time", "", "Time for slot-at-time: RFC 3339, such as 2024-06-01T12:00:00Z, or Unix seconds")
+	untilSig    = flag.String("until", "", "stream-address-history: start after this signature, such as the cursor of a previous run")
+	beforeSig   = flag.String("before", "", "stream-address-history: end before this signature instead of at the newest transaction")
+	jobID       = flag.String("job", "", "Benchmark job ID for benchmark-result and benchmark-cancel")
+	scenario    = flag.String("scenario", "", "Scheduled benchmark scenario whose runs benchmarks lists (all if empty)")
+	minTransfer = flag.Uint64("alert-min-transfer", 0, "stream-alerts: alert on transfers of more than this many lamports (into or out of --pubkey, if set)")
+	balanceAt   = flag.Uint64("alert-balance", 0, "stream-alerts: alert when the lamport balance of --pubkey crosses this value")
+	alertProg   = flag.String("alert-program", "", "stream-alerts: alert when this program is invoked")
+	feeWindow   = flag.Uint("fee-window", 1, "Slots aggregated per update for stream-fee-stats")
+	apiKey      = flag.String("api-key", "", "API key sent as x-api-key metadata, selecting the server's benchmark and stream limits for it")
+	fromSlot    = flag.Uint64("from-slot", 0, "First slot of the account-history or block-production range (block-production: 0 for the start of the current epoch)")
+	toSlot      = flag.Uint64("to-slot", 0, "Last slot of the account-history or block-production range (0 for the latest)")
+	rpcEndpoint = flag.String("rpc-endpoint", "https://api.mainnet-beta.solana.com", "Solana RPC endpoint benchmark-e2e calls directly for JSON-RPC; use the server's upstream for a fair comparison")
+	page        = flag.Uint("page", 1, "Page of assets to list for assets")
+	limit       = flag.Uint("limit", 0, "Assets per page for assets (0 for the upstream default); transactions of history for stream-address-history (0 for the server default); validators listed for block-production and stream-block-production, nodes for cluster-nodes (0 for all); accounts per page for owned-accounts, runs for benchmarks (0 for the server default)")
+	seeds       = flag.String("seeds", "", "Comma-separated seeds for find-pda: UTF-8 text, or hex:<bytes> or pubkey:<base58 key>")
+	seed        = flag.String("seed", "", "Seed string for create-with-seed")
+	mint        = flag.String("mint", "", "Token mint for ata and build-token-transfer")
+	recipient   = flag.String("to", "", "Recipient wallet for build-transfer and build-token-transfer")
+	amount      = flag.Uint64("amount", 0, "Amount to transfer: lamports for build-transfer, base units for build-token-transfer")
+	feePayer    = flag.String("fee-payer", "", "Fee payer of a built transaction (defaults to the sender)")
+	cuLimit     = flag.Uint("cu-limit", 0, "Compute unit limit of a built transaction (0 for the server default)")
+	cuPrice     = flag.Uint64("cu-price", 0, "Compute unit price of a built transaction, in micro-lamports")
+	rawTx       = flag.String("tx", "", "Serialized transaction for decode-transaction")
+	txEncoding  = flag.String("encoding", "base64", "Encoding of --tx: base64 or base58")
+	message     = flag.String("message", "", "Base64-encoded message for verify-signature")
+	createATA   = flag.Bool("create-account", false, "build-token-transfer: create the recipient's associated token account if missing")
+	batchMax    = flag.Uint("batch-max-messages", 0, "Live updates coalesced per stream-program-accounts chunk or stream-accounts batch (0 to send each on its own)")
+	batchWait   = flag.Uint("batch-max-wait-ms", 0, "Longest a live update is held for its stream-program-accounts or stream-accounts batch to fill (0 for the server default)")
+	pollEvery   = flag.Uint("poll-interval-ms", 0, "Poll interval of stream-program-accounts --live and commitment-latency when the server has no WebSocket upstream (0 for the server default)")
+	changeCheck = flag.String("change-detection", "hash", "How polled stream-program-accounts updates are found: hash sends accounts whose state changed, slot sends all accounts whenever the slot advances")
+	blockDetail = flag.String("block-details", "", "Comma-separated getBlock detail tiers (full, accounts, signatures, none): benchmark runs its block benchmarks at each, block fetches at the first (full if empty)")
+	inclFailed  = flag.Bool("include-failed", false, "stream-transactions: also stream failed transactions, with why they failed")
+	sampleRate  = flag.Uint("sample-rate", 0, "Stream a 1-in-N sample of the transactions for stream-transactions (0 for all)")
+	fields      = flag.String("fields", "", "Comma-separated response fields to return for account, transaction, block, stream-accounts, stream-transactions and stream-blocks, such as lamports (all if empty)")
+	qos         = flag.String("qos", "realtime", "QoS class of a stream command: realtime, or bulk for backfills that may be batched and yield to realtime streams")
+	rate        = flag.Float64("rate", 0, "Load-test mode: requests per second per transport and category, measured from each request's intended start (0 to send back to back)")
+	nodeVersion = flag.String("node-version", "", "cluster-nodes: only nodes running this version or a release of it, such as 1.18")
+	featureSet  = flag.Uint("feature-set", 0, "cluster-nodes: only nodes with this feature set (0 for all)")
+	nodesBench  = flag.Bool("cluster-nodes", false, "benchmark and benchmark-e2e: also time getClusterNodes, a medium-size payload")
+	rewardAddrs = flag.String("reward-addresses", "", "Comma-separated stake or vote accounts whose rewards to report: for every epoch that ends with stream-epoch-events, for --epoch through --to-epoch with inflation-reward")
+	epoch       = flag.Uint64("epoch", 0, "Epoch for stake-activation (0 for the current epoch); first epoch for inflation-reward (0 for the previous epoch)")
+	toEpoch     = flag.Uint64("to-epoch", 0, "Last epoch for inflation-reward (0 for --epoch alone)")
+	closedOnly  = flag.Bool("closed-only", false, "stream-owned-accounts: only report accounts that close, with the rent they reclaim")
+	signedBy    = flag.String("verify-signer", "", "Public key of the gateway responses must be signed by; an unsigned or mis-signed response fails the command (unchecked if empty)")
+	tlsOn       = flag.Bool("tls", false, "Connect to the server over TLS, verifying its certificate against --ca-cert or the system roots")
+	caCert      = flag.String("ca-cert", "", "PEM CAs the server's certificate is verified against (system roots if empty); implies --tls")
+	clientCert  = flag.String("client-cert", "", "PEM certificate presented to servers that require client certificates, with --client-key; implies --tls")
+	clientKey   = flag.String("client-key", "", "PEM private key of --client-cert")
+	tlsName     = flag.String("tls-server-name", "", "Name the server's certificate is verified for (the host of --server if empty)")
+	workers     = flag.Uint("workers", 8, "benchmark-channels: concurrent workers, each making --iterations rounds of calls")
+	channels    = flag.Uint("channels", 0, "benchmark-channels: also run with the workers spread across this many channels, besides one shared channel and one channel per worker (0 for just those)")
+	prewarm     = flag.Bool("prewarm", true, "benchmark-channels: connect every channel and wait until it is ready, finishing the HTTP/2 settings exchange, before timing calls")
+	prewarmPing = flag.Bool("prewarm-ping", false, "benchmark-channels: also make a GetCapabilities call on each channel while pre-warming, which the server answers without the upstream")
+	streamKinds = flag.String("streams", "", "Comma-separated updates stream-benchmark subscribes to: account (of --pubkey), slot, block (slot and block, and account if --pubkey is set, if empty)")
+	quicServer  = flag.String("quic-server", "", "benchmark-quic: the server's QUIC address, as set by its --quic-addr, such as localhost:50052")
 )
 
 func main() {
 	flag.Parse()
+	setupOutput()
 
 	// Set up a connection to the server
-	conn, err := grpc.Dial(*serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	target, opts := dialTarget()
+	conn, err := grpc.Dial(target, opts...)
 	if err != nil {
-		log.Fatalf("did not connect: %v", err)
+		fail(exitConnection, err, "did not connect: %v", err)
 	}
 	defer conn.Close()
+	if sessionStats != nil {
+		sessionStats.watch(conn)
+		defer printSessionSummary()
+	}
 
-	// Create a client
+	// Create the clients
 	client := proto.NewBenchmarkServiceClient(conn)
+	utils := proto.NewUtilsServiceClient(conn)
+
+	// Identify the caller for the server's per-key benchmark limits
+	base := context.Background()
+	if *apiKey != "" {
+		base = metadata.AppendToOutgoingContext(base, "x-api-key", *apiKey)
+	}
 
 	// Create a context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	ctx, cancel := context.WithTimeout(base, 5*time.Minute)
 	defer cancel()
 
 	// Execute the requested command
 	switch *command {
 	case "benchmark":
-		runBenchmark(ctx, client)
+		// The job runs on the server and reports progress, so waiting for it
+		// is not bound by the command timeout
+		runBenchmark(base, client)
+	case "benchmark-e2e":
+		runBenchmarkE2E(ctx, client)
+	case "benchmark-result":
+		getBenchmarkResult(ctx, client)
+	case "benchmark-cancel":
+		cancelBenchmark(ctx, client)
+	case "benchmark-serialization":
+		benchmarkSerialization(ctx, client)
+	case "encoded-block":
+		getEncodedBlock(ctx, client)
+	case "benchmark-quic":
+		runBenchmarkQUIC(ctx, client)
+	case "benchmark-channels":
+		runBenchmarkChannels(ctx, client)
+	case "benchmark-dual-serve":
+		benchmarkDualServe(ctx, client)
+	case "stream-benchmark":
+		// Runs for --duration after waiting its turn on the server
+		runStreamBenchmark(base, client)
+	case "benchmarks":
+		listBenchmarks(ctx, client)
 	case "account":
 		getAccountInfo(ctx, client)
+	case "account-history":
+		getAccountHistory(ctx, client)
+	case "upstream-health":
+		getUpstreamHealth(ctx, client)
+	case "current-slot":
+		getCurrentSlot(ctx, client)
+	case "stream-stats":
+		getStreamStats(ctx, client)
+	case "slo-status":
+		getSLOStatus(ctx, client)
+	case "capabilities":
+		getCapabilities(ctx, client)
+	case "nonce-account":
+		getNonceAccount(ctx, client)
+	case "read-consistent":
+		readConsistent(ctx, client)
+	case "token-account":
+		getTokenAccount(ctx, client)
+	case "nfts":
+		getNFTsByOwner(ctx, client)
+	case "asset":
+		getAsset(ctx, client)
+	case "assets":
+		getAssetsByOwner(ctx, client)
+	case "asset-proof":
+		getAssetProof(ctx, client)
+	case "find-pda":
+		findProgramAddress(ctx, utils)
+	case "create-with-seed":
+		createWithSeed(ctx, utils)
+	case "ata":
+		getAssociatedTokenAddress(ctx, utils)
+	case "verify-signature":
+		verifySignature(ctx, utils)
+	case "decode-transaction":
+		decodeTransaction(ctx, utils)
+	case "build-transfer":
+		buildTransfer(ctx, client)
+	case "build-token-transfer":
+		buildTokenTransfer(ctx, client)
 	case "transaction":
 		getTransaction(ctx, client)
 	case "block":
 		getBlock(ctx, client)
+	case "block-time":
+		getBlockTime(ctx, client)
+	case "slot-at-time":
+		estimateSlotAtTime(ctx, client)
+	case "block-production":
+		getBlockProduction(ctx, client)
+	case "cluster-nodes":
+		getClusterNodes(ctx, client)
+	case "stake-activation":
+		getStakeActivation(ctx, client)
+	case "inflation-reward":
+		getInflationReward(ctx, client)
+	case "owned-accounts":
+		listOwnedAccounts(ctx, client)
 	case "stream-accounts":
 		streamAccounts(ctx, client)
+	case "stream-accounts-ack":
+		streamAccountsWithAck(ctx, client)
+	case "stream-program-accounts":
+		streamProgramAccounts(ctx, client)
+	case "commitment-latency":
+		measureCommitmentLatency(ctx, client)
 	case "stream-transactions":
 		streamTransactions(ctx, client)
 	case "stream-blocks":
 		streamBlocks(ctx, client)
+	case "stream-balance-changes":
+		streamBalanceChanges(ctx, client)
+	case "stream-alerts":
+		streamAlerts(ctx, client)
+	case "stream-fee-stats":
+		streamFeeStats(ctx, client)
+	case "stream-block-production":
+		streamBlockProduction(ctx, client)
+	case "stream-epoch-events":
+		streamEpochEvents(ctx, client)
+	case "stream-address-history":
+		streamAddressHistory(ctx, client)
+	case "stream-owned-accounts":
+		streamOwnedAccounts(ctx, client)
+	case "stream-slo-events":
+		streamSLOEvents(ctx, client)
+	default:
+		fatalf("Unknown command: %s", *command)
+	}
+}
+
+// dialTarget builds the dial target and options from the --server and
+// --lb-policy flags. A comma-separated address list is resolved statically so
+// the load-balancing policy can spread calls across every listed server.
+func dialTarget() (string, []grpc.DialOption) {
+	if *lbPolicy != "pick_first" && *lbPolicy != "round_robin" {
+		fatalf("Unknown load-balancing policy: %s", *lbPolicy)
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCredentials()),
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingConfig": [{"%s": {}}]}`, *lbPolicy)),
+	}
+
+	// Requests are compressed with the channel default, and the server answers
+	// in kind unless a stream asks for something else
+	switch *channelComp {
+	case "":
+	case gzip.Name:
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
 	default:
-		log.Fatalf("Unknown command: %s", *command)
+		fatalf("Unknown channel compression: %s", *channelComp)
+	}
+
+	// Stream commands summarize their session when they end
+	if isStreamCommand(*command) {
+		sessionStats = &streamStats{}
+		opts = append(opts, grpc.WithChainStreamInterceptor(sessionStats.intercept))
+	}
+
+	if *signedBy != "" {
+		signer := signerKey()
+		opts = append(opts, grpc.WithChainUnaryInterceptor(verifyUnary(signer)), grpc.WithChainStreamInterceptor(verifyStream(signer)))
+	}
+
+	if !strings.Contains(*serverAddr, ",") {
+		return *serverAddr, opts
+	}
+
+	var addrs []resolver.Address
+	for _, addr := range strings.Split(*serverAddr, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, resolver.Address{Addr: addr})
+		}
 	}
+	r := manual.NewBuilderWithScheme("static")
+	r.InitialState(resolver.State{Addresses: addrs})
+	return r.Scheme() + ":///servers", append(opts, grpc.WithResolvers(r))
 }
 
 func runBenchmark(ctx context.Context, client proto.BenchmarkServiceClient) {
-	if *pubkey == "" && *signature == "" && *slot == 0 {
-		log.Fatal("At least one of --pubkey, --signature, or --slot must be specified")
+	if *pubkey == "" && *signature == "" && *slot == 0 && !*nodesBench {
+		fatal("At least one of --pubkey, --signature, --slot, or --cluster-nodes must be specified")
 	}
 
 	// Prepare benchmark request
 	req := &proto.BenchmarkRequest{
-		Iterations:      uint32(*iterations),
-		RunGrpcTests:    true,
-		RunJsonrpcTests: true,
+		Iterations:          uint32(*iterations),
+		RunGrpcTests:        true,
+		RunJsonrpcTests:     true,
+		OutlierFraction:     *outlierPct,
+		TargetRate:          *rate,
+		CollectRuntimeStats: *runtimeStat,
+		BypassCache:         *noCache,
+		Seed:                *workSeed,
+		ClientOs:            runtime.GOOS + "/" + runtime.GOARCH,
+		ClientRegion:        *region,
+		BlockDetails:        blockDetails(),
+		RunClusterNodes:     *nodesBench,
+	}
+
+	switch *outliers {
+	case "none":
+	case "trim":
+		req.OutlierHandling = proto.OutlierHandling_OUTLIER_HANDLING_TRIM
+	case "winsorize":
+		req.OutlierHandling = proto.OutlierHandling_OUTLIER_HANDLING_WINSORIZE
+	default:
+		fatalf("Invalid --outliers: %s", *outliers)
 	}
 
 	// Add test accounts if provided
@@ -88,17 +367,543 @@ func runBenchmark(ctx context.Context, client proto.BenchmarkServiceClient) {
 		req.TestSlots = []uint64{*slot}
 	}
 
-	// Run benchmark
-	fmt.Println("Running benchmark...")
+	// Start the benchmark as a background job on the server
 	startTime := time.Now()
-	resp, err := client.RunBenchmark(ctx, req)
+	job, err := client.StartBenchmark(ctx, req)
 	if err != nil {
-		log.Fatalf("Error running benchmark: %v", err)
+		fatalf("Error starting benchmark: %v", err)
+	}
+	fmt.Printf("Started benchmark job %s\n", job.JobId)
+
+	// Cancel the job on interrupt; the results gathered so far are still printed
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	go func() {
+		if _, ok := <-interrupt; ok {
+			fmt.Println("\nCancelling benchmark...")
+			if _, err := client.CancelBenchmark(ctx, &proto.BenchmarkJobRequest{JobId: job.JobId}); err != nil {
+				log.Printf("Error cancelling benchmark: %v", err)
+			}
+		}
+	}()
+
+	watchBenchmark(ctx, client, job.JobId)
+
+	result, err := client.GetBenchmarkResult(ctx, &proto.BenchmarkJobRequest{JobId: job.JobId})
+	if err != nil {
+		fatalf("Error getting benchmark result: %v", err)
 	}
 	duration := time.Since(startTime)
 
-	// Print results
-	fmt.Printf("\nBenchmark completed in %v\n\n", duration)
+	fmt.Printf("\nBenchmark %s in %v\n\n", jobStateName(result.State), duration)
+	printCached(result.Results)
+	printTruncated(result.Results)
+	printEnvironment(result.Results)
+	printBenchmarkResults(ctx, client, req, result.Results, true)
+}
+
+// e2eCategory holds the client-observed latencies of one benchmark category
+// on both transports
+type e2eCategory struct {
+	name          string
+	grpc, jsonrpc []float64
+	grpcFailed    int
+	jsonrpcFailed int
+	grpcCall      func() error
+	jsonrpcCall   func() error
+}
+
+// measure times one call on each transport, alternating which goes first so
+// neither consistently benefits from the other warming the upstream
+func (c *e2eCategory) measure(grpcFirst bool) {
+	if grpcFirst {
+		timeCall(&c.grpc, &c.grpcFailed, c.grpcCall)
+		timeCall(&c.jsonrpc, &c.jsonrpcFailed, c.jsonrpcCall)
+		return
+	}
+	timeCall(&c.jsonrpc, &c.jsonrpcFailed, c.jsonrpcCall)
+	timeCall(&c.grpc, &c.grpcFailed, c.grpcCall)
+}
+
+// timeCall records the latency of a successful call in milliseconds, or counts
+// a failure
+func timeCall(samples *[]float64, failed *int, call func() error) {
+	start := time.Now()
+	if err := call(); err != nil {
+		*failed++
+		return
+	}
+	*samples = append(*samples, float64(time.Since(start).Microseconds())/1000)
+}
+
+// runBenchmarkE2E times gRPC calls through the server against JSON-RPC calls
+// made directly to the upstream from this machine, so the gRPC hop itself is
+// part of what is measured
+func runBenchmarkE2E(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" && *signature == "" && *slot == 0 && !*nodesBench {
+		fatal("At least one of --pubkey, --signature, --slot, or --cluster-nodes must be specified")
+	}
+	rpcClient := rpc.New(*rpcEndpoint)
+
+	var categories []*e2eCategory
+	if *pubkey != "" {
+		account, err := solana.PublicKeyFromBase58(*pubkey)
+		if err != nil {
+			fatalf("Invalid --pubkey: %v", err)
+		}
+		categories = append(categories, &e2eCategory{
+			name: "accounts",
+			grpcCall: func() error {
+				_, err := client.GetAccountInfo(ctx, &proto.AccountInfoRequest{Pubkey: *pubkey, Commitment: "finalized", EncodingBinary: true})
+				return err
+			},
+			jsonrpcCall: func() error {
+				_, err := rpcClient.GetAccountInfo(ctx, account)
+				return err
+			},
+		})
+	}
+	if *signature != "" {
+		sig, err := solana.SignatureFromBase58(*signature)
+		if err != nil {
+			fatalf("Invalid --signature: %v", err)
+		}
+		categories = append(categories, &e2eCategory{
+			name: "transactions",
+			grpcCall: func() error {
+				_, err := client.GetTransaction(ctx, &proto.TransactionRequest{Signature: *signature, Commitment: "finalized"})
+				return err
+			},
+			jsonrpcCall: func() error {
+				_, err := rpcClient.GetTransaction(ctx, sig, &rpc.GetTransactionOpts{})
+				return err
+			},
+		})
+	}
+	if *slot != 0 {
+		categories = append(categories, &e2eCategory{
+			name: "blocks",
+			grpcCall: func() error {
+				_, err := client.GetBlock(ctx, &proto.BlockRequest{Slot: *slot, Commitment: "finalized"})
+				return err
+			},
+			jsonrpcCall: func() error {
+				_, err := rpcClient.GetBlock(ctx, *slot)
+				return err
+			},
+		})
+	}
+	if *nodesBench {
+		categories = append(categories, &e2eCategory{
+			name: "cluster nodes",
+			grpcCall: func() error {
+				_, err := client.GetClusterNodes(ctx, &proto.ClusterNodesRequest{})
+				return err
+			},
+			jsonrpcCall: func() error {
+				_, err := rpcClient.GetClusterNodes(ctx)
+				return err
+			},
+		})
+	}
+
+	// Time setting up new connections on their own, then open both
+	// connections before timing calls, so connection setup is not counted
+	// against the first request of either transport
+	setups := measureConnectionSetup(ctx)
+	for _, category := range categories {
+		category.grpcCall()
+		category.jsonrpcCall()
+	}
+
+	fmt.Printf("Benchmarking gRPC via %s against JSON-RPC via %s...\n\n", *serverAddr, *rpcEndpoint)
+	for i := 0; i < int(*iterations); i++ {
+		for _, category := range categories {
+			category.measure(i%2 == 0)
+		}
+	}
+
+	fmt.Println("End-to-End Benchmark Results (client-observed):")
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Category", "Transport", "Avg (ms)", "Min (ms)", "Max (ms)", "Successful", "Failed"})
+	for _, category := range categories {
+		for _, transport := range []struct {
+			name    string
+			samples []float64
+			failed  int
+		}{
+			{"gRPC", category.grpc, category.grpcFailed},
+			{"JSON-RPC", category.jsonrpc, category.jsonrpcFailed},
+		} {
+			summary := stats.Summarize(transport.samples)
+			table.Append([]string{
+				category.name,
+				transport.name,
+				fmt.Sprintf("%.2f", summary.Mean),
+				fmt.Sprintf("%.2f", summary.Min),
+				fmt.Sprintf("%.2f", summary.Max),
+				fmt.Sprintf("%d", len(transport.samples)),
+				fmt.Sprintf("%d", transport.failed),
+			})
+		}
+	}
+	table.Render()
+	fmt.Println()
+	printConnectionSetup(setups)
+
+	fmt.Println("gRPC vs JSON-RPC Speedup (Welch's t-test, JSON-RPC minus gRPC):")
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Category", "Speedup", "Mean Difference (ms)", "p-value", "Significant"})
+	for _, category := range categories {
+		grpcMean, jsonrpcMean := stats.Summarize(category.grpc).Mean, stats.Summarize(category.jsonrpc).Mean
+		if grpcMean == 0 || jsonrpcMean == 0 {
+			continue
+		}
+		row := []string{category.name, fmt.Sprintf("%.2fx", jsonrpcMean/grpcMean), fmt.Sprintf("%.2f", jsonrpcMean-grpcMean), "-", "-"}
+		if test, err := stats.WelchTTest(category.grpc, category.jsonrpc); err == nil {
+			row[3] = fmt.Sprintf("%.4f", test.PValue)
+			row[4] = fmt.Sprintf("%t", test.Significant())
+		}
+		table.Append(row)
+	}
+	table.Render()
+}
+
+// unaryCall is a call timed by the benchmarks that compare ways of reaching
+// the server
+type unaryCall struct {
+	name string
+	call func(proto.BenchmarkServiceClient) error
+}
+
+// unaryCalls returns the calls of the categories selected by --pubkey,
+// --signature, --slot and --cluster-nodes
+func unaryCalls(ctx context.Context) []unaryCall {
+	if *pubkey == "" && *signature == "" && *slot == 0 && !*nodesBench {
+		fatal("At least one of --pubkey, --signature, --slot, or --cluster-nodes must be specified")
+	}
+	var calls []unaryCall
+	if *pubkey != "" {
+		calls = append(calls, unaryCall{"accounts", func(c proto.BenchmarkServiceClient) error {
+			_, err := c.GetAccountInfo(ctx, &proto.AccountInfoRequest{Pubkey: *pubkey, Commitment: "finalized", EncodingBinary: true})
+			return err
+		}})
+	}
+	if *signature != "" {
+		calls = append(calls, unaryCall{"transactions", func(c proto.BenchmarkServiceClient) error {
+			_, err := c.GetTransaction(ctx, &proto.TransactionRequest{Signature: *signature, Commitment: "finalized"})
+			return err
+		}})
+	}
+	if *slot != 0 {
+		calls = append(calls, unaryCall{"blocks", func(c proto.BenchmarkServiceClient) error {
+			_, err := c.GetBlock(ctx, &proto.BlockRequest{Slot: *slot, Commitment: "finalized"})
+			return err
+		}})
+	}
+	if *nodesBench {
+		calls = append(calls, unaryCall{"cluster nodes", func(c proto.BenchmarkServiceClient) error {
+			_, err := c.GetClusterNodes(ctx, &proto.ClusterNodesRequest{})
+			return err
+		}})
+	}
+	return calls
+}
+
+// connectionSetupSamples is the number of new connections benchmark-e2e
+// opens on each transport to time connection setup
+const connectionSetupSamples = 5
+
+// connectionSetup holds the setup times of new connections on one transport,
+// in milliseconds
+type connectionSetup struct {
+	name       string
+	tls        bool
+	connect    []float64
+	handshakes []float64
+	failed     int
+}
+
+// measureConnectionSetup opens new connections to the server and to the
+// JSON-RPC endpoint, alternating between them, and times each until it is
+// ready for a request: DNS, TCP and the TLS handshake, plus the HTTP/2
+// preface for gRPC. TLS handshakes are also timed on their own.
+func measureConnectionSetup(ctx context.Context) []*connectionSetup {
+	endpoint, err := url.Parse(*rpcEndpoint)
+	if err != nil {
+		fatalf("Invalid --rpc-endpoint: %v", err)
+	}
+	grpcSetup := &connectionSetup{name: "gRPC", tls: useTLS()}
+	jsonrpcSetup := &connectionSetup{name: "JSON-RPC", tls: endpoint.Scheme == "https"}
+
+	for i := 0; i < connectionSetupSamples; i++ {
+		connect, handshake, err := connectGRPC(ctx)
+		grpcSetup.record(connect, handshake, err)
+		connect, handshake, err = connectJSONRPC(ctx)
+		jsonrpcSetup.record(connect, handshake, err)
+	}
+	return []*connectionSetup{grpcSetup, jsonrpcSetup}
+}
+
+func (c *connectionSetup) record(connect, handshake time.Duration, err error) {
+	if err != nil {
+		c.failed++
+		return
+	}
+	c.connect = append(c.connect, float64(connect.Microseconds())/1000)
+	if c.tls {
+		c.handshakes = append(c.handshakes, float64(handshake.Microseconds())/1000)
+	}
+}
+
+// connectGRPC dials a new connection to the server with the command's
+// options and waits until it is ready
+func connectGRPC(ctx context.Context) (connect, handshake time.Duration, err error) {
+	timer := &handshakeTimer{TransportCredentials: transportCredentials()}
+	target, opts := dialTarget()
+	start := time.Now()
+	conn, err := grpc.DialContext(ctx, target, append(opts, grpc.WithTransportCredentials(timer))...)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer conn.Close()
+
+	if err := waitReady(ctx, conn); err != nil {
+		return 0, 0, err
+	}
+	return time.Since(start), timer.last(), nil
+}
+
+// waitReady connects a channel and waits until it is ready for calls
+func waitReady(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+	for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+		if state == connectivity.TransientFailure || state == connectivity.Shutdown {
+			return fmt.Errorf("connection failed")
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// connectJSONRPC opens a new connection to the JSON-RPC endpoint with a
+// getHealth call, timing until the connection is obtained
+func connectJSONRPC(ctx context.Context) (connect, handshake time.Duration, err error) {
+	var start, handshakeStart time.Time
+	trace := &httptrace.ClientTrace{
+		TLSHandshakeStart: func() { handshakeStart = time.Now() },
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			handshake = time.Since(handshakeStart)
+		},
+		GotConn: func(httptrace.GotConnInfo) { connect = time.Since(start) },
+	}
+	body := strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"getHealth"}`)
+	req, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, trace), http.MethodPost, *rpcEndpoint, body)
+	if err != nil {
+		return 0, 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, ForceAttemptHTTP2: true}
+	defer transport.CloseIdleConnections()
+	start = time.Now()
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return connect, handshake, nil
+}
+
+// printConnectionSetup prints the setup times of new connections
+func printConnectionSetup(setups []*connectionSetup) {
+	fmt.Println("Connection Setup (client-observed, new connection each):")
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Transport", "TLS", "Connect Avg (ms)", "Connect Min (ms)", "Connect Max (ms)", "TLS Handshake Avg (ms)", "Failed"})
+	for _, setup := range setups {
+		connect := stats.Summarize(setup.connect)
+		handshake := "-"
+		if setup.tls && len(setup.handshakes) > 0 {
+			handshake = fmt.Sprintf("%.2f", stats.Summarize(setup.handshakes).Mean)
+		}
+		table.Append([]string{
+			setup.name,
+			fmt.Sprintf("%t", setup.tls),
+			fmt.Sprintf("%.2f", connect.Mean),
+			fmt.Sprintf("%.2f", connect.Min),
+			fmt.Sprintf("%.2f", connect.Max),
+			handshake,
+			fmt.Sprintf("%d", setup.failed),
+		})
+	}
+	table.Render()
+	fmt.Println()
+}
+
+// watchBenchmark prints the progress of a benchmark job until it finishes
+func watchBenchmark(ctx context.Context, client proto.BenchmarkServiceClient, jobID string) {
+	stream, err := client.StreamBenchmarkProgress(ctx, &proto.BenchmarkJobRequest{JobId: jobID})
+	if err != nil {
+		fatalf("Error streaming benchmark progress: %v", err)
+	}
+
+	for {
+		progress, err := stream.Recv()
+		if err == io.EOF {
+			fmt.Println()
+			return
+		}
+		if err != nil {
+			fatalf("Error receiving benchmark progress: %v", err)
+		}
+		if progress.Benchmark == "" {
+			fmt.Printf("\r%s: %d/%d requests", jobStateName(progress.State), progress.CompletedRequests, progress.TotalRequests)
+			continue
+		}
+		fmt.Printf("\r%s: %d/%d requests (%s iteration %d: %d ms)    ", jobStateName(progress.State), progress.CompletedRequests, progress.TotalRequests, progress.Benchmark, progress.Iteration, progress.ResponseTimeMs)
+	}
+}
+
+// getBenchmarkResult prints the state and results of an existing benchmark job
+func getBenchmarkResult(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *jobID == "" {
+		fatal("--job is required")
+	}
+
+	result, err := client.GetBenchmarkResult(ctx, &proto.BenchmarkJobRequest{JobId: *jobID})
+	if err != nil {
+		fatalf("Error getting benchmark result: %v", err)
+	}
+
+	fmt.Printf("Benchmark job %s: %s\n\n", result.JobId, jobStateName(result.State))
+	printCached(result.Results)
+	printTruncated(result.Results)
+	printEnvironment(result.Results)
+	if result.Results != nil {
+		printBenchmarkResults(ctx, client, result.Request, result.Results, false)
+	}
+}
+
+// listBenchmarks lists the scheduled benchmark scenarios and their latest runs
+func listBenchmarks(ctx context.Context, client proto.BenchmarkServiceClient) {
+	resp, err := client.ListBenchmarks(ctx, &proto.ListBenchmarksRequest{
+		Scenario: *scenario,
+		PageSize: uint32(*limit),
+	})
+	if err != nil {
+		fatalf("Error listing benchmarks: %v", err)
+	}
+
+	fmt.Println("Scheduled scenarios:")
+	for _, scenario := range resp.Scenarios {
+		next := "running job " + scenario.RunningJobId
+		if scenario.NextRunUnixMs != 0 {
+			next = "next at " + time.UnixMilli(scenario.NextRunUnixMs).UTC().Format(time.RFC3339)
+		}
+		fmt.Printf("  %s (%s): %s\n", scenario.Name, scenario.Schedule, next)
+	}
+	fmt.Println()
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Run", "Scenario", "State", "Started (UTC)", "Duration", "Account gRPC (ms)", "Account JSON-RPC (ms)", "Overall Speedup"})
+	for _, run := range resp.Runs {
+		results := run.Results
+		table.Append([]string{
+			fmt.Sprintf("%d", run.RunId),
+			run.Scenario,
+			jobStateName(run.State),
+			time.UnixMilli(run.StartedAtUnixMs).UTC().Format(time.DateTime),
+			(time.Duration(run.FinishedAtUnixMs-run.StartedAtUnixMs) * time.Millisecond).Round(time.Second).String(),
+			fmt.Sprintf("%d", results.GetAccountGrpc().GetAvgResponseTimeMs()),
+			fmt.Sprintf("%d", results.GetAccountJsonrpc().GetAvgResponseTimeMs()),
+			fmt.Sprintf("%.2fx", results.GetSummary().GetOverallSpeedup()),
+		})
+	}
+	table.Render()
+	if resp.NextPageToken != "" {
+		fmt.Println("Older runs not shown; raise --limit to list more")
+	}
+}
+
+// cancelBenchmark cancels an existing benchmark job
+func cancelBenchmark(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *jobID == "" {
+		fatal("--job is required")
+	}
+
+	job, err := client.CancelBenchmark(ctx, &proto.BenchmarkJobRequest{JobId: *jobID})
+	if err != nil {
+		fatalf("Error cancelling benchmark: %v", err)
+	}
+	fmt.Printf("Benchmark job %s: %s\n", job.JobId, jobStateName(job.State))
+}
+
+// printCached notes when results were served from the server's result cache
+func printCached(results *proto.BenchmarkResults) {
+	if results.GetCached() {
+		fmt.Printf("Cached results from %s; use --no-cache to run the benchmark again\n\n", time.Unix(int64(results.CachedAt), 0).Format(time.RFC3339))
+	}
+}
+
+// printEnvironment prints what a benchmark ran on
+func printEnvironment(results *proto.BenchmarkResults) {
+	env := results.GetEnvironment()
+	if env == nil {
+		return
+	}
+	fmt.Printf("Server %s (%s) built with %s on %s", orUnknown(env.ServerVersion), orUnknown(env.ServerCommit), env.GoVersion, env.ServerOs)
+	if env.ServerRegion != "" {
+		fmt.Printf(" in %s", env.ServerRegion)
+	}
+	fmt.Printf("\nClient on %s", orUnknown(env.ClientOs))
+	if env.ClientRegion != "" {
+		fmt.Printf(" in %s", env.ClientRegion)
+	}
+	fmt.Printf("\nUpstream %s", env.UpstreamEndpoint)
+	if env.UpstreamRttProbes > 0 {
+		fmt.Printf(", RTT %.2f ms (best of %d probes)", env.UpstreamRttMs, env.UpstreamRttProbes)
+	}
+	fmt.Printf("\n\n")
+}
+
+// orUnknown returns s, or "unknown" if it is empty
+func orUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
+
+// printTruncated notes when a run stopped before making every request
+func printTruncated(results *proto.BenchmarkResults) {
+	if results.GetTruncated() {
+		fmt.Printf("Results are partial: the run was cancelled or exceeded its maximum duration\n\n")
+	}
+}
+
+// jobStateName returns a human-readable benchmark job state
+func jobStateName(state proto.BenchmarkJobState) string {
+	switch state {
+	case proto.BenchmarkJobState_BENCHMARK_JOB_STATE_QUEUED:
+		return "queued"
+	case proto.BenchmarkJobState_BENCHMARK_JOB_STATE_RUNNING:
+		return "running"
+	case proto.BenchmarkJobState_BENCHMARK_JOB_STATE_SUCCEEDED:
+		return "completed"
+	case proto.BenchmarkJobState_BENCHMARK_JOB_STATE_CANCELLED:
+		return "cancelled"
+	default:
+		return state.String()
+	}
+}
+
+// printBenchmarkResults prints the tables for the benchmarks of a request,
+// optionally measuring the client-observed latency split as well
+func printBenchmarkResults(ctx context.Context, client proto.BenchmarkServiceClient, req *proto.BenchmarkRequest, resp *proto.BenchmarkResults, measureSplit bool) {
 
 	// Print account benchmark results if available
 	if len(req.TestAccounts) > 0 {
@@ -110,6 +915,10 @@ func runBenchmark(ctx context.Context, client proto.BenchmarkServiceClient) {
 		table.Append([]string{"Max Response Time (ms)", fmt.Sprintf("%d", resp.AccountGrpc.MaxResponseTimeMs), fmt.Sprintf("%d", resp.AccountJsonrpc.MaxResponseTimeMs)})
 		table.Append([]string{"Successful Requests", fmt.Sprintf("%d", resp.AccountGrpc.SuccessfulRequests), fmt.Sprintf("%d", resp.AccountJsonrpc.SuccessfulRequests)})
 		table.Append([]string{"Failed Requests", fmt.Sprintf("%d", resp.AccountGrpc.FailedRequests), fmt.Sprintf("%d", resp.AccountJsonrpc.FailedRequests)})
+		table.Append([]string{"Avg Payload (bytes)", fmt.Sprintf("%d", resp.AccountGrpc.AvgPayloadBytes), fmt.Sprintf("%d", resp.AccountJsonrpc.AvgPayloadBytes)})
+		appendDistribution(table, resp.AccountGrpc.Distribution, resp.AccountJsonrpc.Distribution)
+		appendAdjusted(table, resp.AccountGrpc.Adjusted, resp.AccountJsonrpc.Adjusted)
+		appendRuntime(table, resp.AccountGrpc.Runtime, resp.AccountJsonrpc.Runtime)
 		table.Render()
 		fmt.Println()
 	}
@@ -124,6 +933,10 @@ func runBenchmark(ctx context.Context, client proto.BenchmarkServiceClient) {
 		table.Append([]string{"Max Response Time (ms)", fmt.Sprintf("%d", resp.TransactionGrpc.MaxResponseTimeMs), fmt.Sprintf("%d", resp.TransactionJsonrpc.MaxResponseTimeMs)})
 		table.Append([]string{"Successful Requests", fmt.Sprintf("%d", resp.TransactionGrpc.SuccessfulRequests), fmt.Sprintf("%d", resp.TransactionJsonrpc.SuccessfulRequests)})
 		table.Append([]string{"Failed Requests", fmt.Sprintf("%d", resp.TransactionGrpc.FailedRequests), fmt.Sprintf("%d", resp.TransactionJsonrpc.FailedRequests)})
+		table.Append([]string{"Avg Payload (bytes)", fmt.Sprintf("%d", resp.TransactionGrpc.AvgPayloadBytes), fmt.Sprintf("%d", resp.TransactionJsonrpc.AvgPayloadBytes)})
+		appendDistribution(table, resp.TransactionGrpc.Distribution, resp.TransactionJsonrpc.Distribution)
+		appendAdjusted(table, resp.TransactionGrpc.Adjusted, resp.TransactionJsonrpc.Adjusted)
+		appendRuntime(table, resp.TransactionGrpc.Runtime, resp.TransactionJsonrpc.Runtime)
 		table.Render()
 		fmt.Println()
 	}
@@ -138,31 +951,306 @@ func runBenchmark(ctx context.Context, client proto.BenchmarkServiceClient) {
 		table.Append([]string{"Max Response Time (ms)", fmt.Sprintf("%d", resp.BlockGrpc.MaxResponseTimeMs), fmt.Sprintf("%d", resp.BlockJsonrpc.MaxResponseTimeMs)})
 		table.Append([]string{"Successful Requests", fmt.Sprintf("%d", resp.BlockGrpc.SuccessfulRequests), fmt.Sprintf("%d", resp.BlockJsonrpc.SuccessfulRequests)})
 		table.Append([]string{"Failed Requests", fmt.Sprintf("%d", resp.BlockGrpc.FailedRequests), fmt.Sprintf("%d", resp.BlockJsonrpc.FailedRequests)})
+		table.Append([]string{"Avg Payload (bytes)", fmt.Sprintf("%d", resp.BlockGrpc.AvgPayloadBytes), fmt.Sprintf("%d", resp.BlockJsonrpc.AvgPayloadBytes)})
+		appendDistribution(table, resp.BlockGrpc.Distribution, resp.BlockJsonrpc.Distribution)
+		appendAdjusted(table, resp.BlockGrpc.Adjusted, resp.BlockJsonrpc.Adjusted)
+		appendRuntime(table, resp.BlockGrpc.Runtime, resp.BlockJsonrpc.Runtime)
+		table.Render()
+		fmt.Println()
+	}
+
+	// Print cluster nodes benchmark results if requested
+	if req.RunClusterNodes {
+		fmt.Println("Cluster Nodes Benchmark Results:")
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Metric", "gRPC", "JSON-RPC"})
+		table.Append([]string{"Avg Response Time (ms)", fmt.Sprintf("%d", resp.ClusterNodesGrpc.AvgResponseTimeMs), fmt.Sprintf("%d", resp.ClusterNodesJsonrpc.AvgResponseTimeMs)})
+		table.Append([]string{"Min Response Time (ms)", fmt.Sprintf("%d", resp.ClusterNodesGrpc.MinResponseTimeMs), fmt.Sprintf("%d", resp.ClusterNodesJsonrpc.MinResponseTimeMs)})
+		table.Append([]string{"Max Response Time (ms)", fmt.Sprintf("%d", resp.ClusterNodesGrpc.MaxResponseTimeMs), fmt.Sprintf("%d", resp.ClusterNodesJsonrpc.MaxResponseTimeMs)})
+		table.Append([]string{"Successful Requests", fmt.Sprintf("%d", resp.ClusterNodesGrpc.SuccessfulRequests), fmt.Sprintf("%d", resp.ClusterNodesJsonrpc.SuccessfulRequests)})
+		table.Append([]string{"Failed Requests", fmt.Sprintf("%d", resp.ClusterNodesGrpc.FailedRequests), fmt.Sprintf("%d", resp.ClusterNodesJsonrpc.FailedRequests)})
+		table.Append([]string{"Avg Payload (bytes)", fmt.Sprintf("%d", resp.ClusterNodesGrpc.AvgPayloadBytes), fmt.Sprintf("%d", resp.ClusterNodesJsonrpc.AvgPayloadBytes)})
+		appendDistribution(table, resp.ClusterNodesGrpc.Distribution, resp.ClusterNodesJsonrpc.Distribution)
+		appendAdjusted(table, resp.ClusterNodesGrpc.Adjusted, resp.ClusterNodesJsonrpc.Adjusted)
+		appendRuntime(table, resp.ClusterNodesGrpc.Runtime, resp.ClusterNodesJsonrpc.Runtime)
+		table.Render()
+		fmt.Println()
+	}
+
+	// Print block benchmark results per detail tier if requested
+	if len(resp.BlockTiers) > 0 {
+		fmt.Println("Block Benchmark Results by Detail Tier:")
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Tier", "gRPC Avg (ms)", "JSON-RPC Avg (ms)", "gRPC P99 (ms)", "JSON-RPC P99 (ms)", "gRPC Payload (bytes)", "JSON-RPC Payload (bytes)", "Speedup"})
+		for _, tier := range resp.BlockTiers {
+			table.Append([]string{
+				blockDetailName(tier.Detail),
+				fmt.Sprintf("%d", tier.Grpc.AvgResponseTimeMs),
+				fmt.Sprintf("%d", tier.Jsonrpc.AvgResponseTimeMs),
+				fmt.Sprintf("%.0f", tier.Grpc.Distribution.GetP99ResponseTimeMs()),
+				fmt.Sprintf("%.0f", tier.Jsonrpc.Distribution.GetP99ResponseTimeMs()),
+				fmt.Sprintf("%d", tier.Grpc.AvgPayloadBytes),
+				fmt.Sprintf("%d", tier.Jsonrpc.AvgPayloadBytes),
+				fmt.Sprintf("%.2fx", tier.Speedup),
+			})
+		}
+		table.Render()
+		fmt.Println()
+	}
+
+	// Split the gRPC response time into upstream time and overhead
+	if measureSplit {
+		printLatencySplit(ctx, client, req)
+	}
+
+	// Print significance tests if available
+	if len(resp.Summary.Significance) > 0 {
+		fmt.Println("Significance (Welch's t-test, JSON-RPC minus gRPC):")
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Category", "Mean Difference (ms)", "Confidence Interval (ms)", "p-value", "Significant"})
+		for _, test := range resp.Summary.Significance {
+			table.Append([]string{
+				test.Category,
+				fmt.Sprintf("%.2f", test.MeanDifferenceMs),
+				fmt.Sprintf("%.0f%%: [%.2f, %.2f]", test.ConfidenceLevel*100, test.CiLowMs, test.CiHighMs),
+				fmt.Sprintf("%.4f", test.PValue),
+				fmt.Sprintf("%t", test.Significant),
+			})
+		}
 		table.Render()
 		fmt.Println()
 	}
 
 	// Print summary
 	fmt.Printf("Summary: %s\n", resp.Summary.Conclusion)
-	fmt.Printf("gRPC vs JSON-RPC Speedup: %.2fx\n", resp.Summary.GrpcVsJsonrpcSpeedup)
+	if resp.Summary.OverallSpeedup > 0 {
+		fmt.Println("gRPC vs JSON-RPC Speedup:")
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Category", "Speedup"})
+		for _, category := range []struct {
+			name    string
+			speedup float64
+		}{
+			{"Accounts", resp.Summary.AccountSpeedup},
+			{"Transactions", resp.Summary.TransactionSpeedup},
+			{"Blocks", resp.Summary.BlockSpeedup},
+			{"Cluster Nodes", resp.Summary.ClusterNodesSpeedup},
+			{"Overall (weighted)", resp.Summary.OverallSpeedup},
+		} {
+			if category.speedup > 0 {
+				table.Append([]string{category.name, fmt.Sprintf("%.2fx", category.speedup)})
+			}
+		}
+		table.Render()
+	}
+	if resp.Summary.GeometricMeanSpeedup > 0 {
+		fmt.Printf("Composite Score (geometric mean of speedups): %.2fx\n", resp.Summary.GeometricMeanSpeedup)
+	}
+	if resp.Summary.NormalizedScore > 0 {
+		fmt.Printf("Normalized Score (per payload byte): %.2fx\n", resp.Summary.NormalizedScore)
+	}
 	fmt.Printf("Total Benchmark Duration: %d ms\n", resp.Summary.TotalDurationMs)
 }
 
+// latencySplit accumulates client-observed round trips alongside the
+// upstream time the server reports for the same calls
+type latencySplit struct {
+	category  string
+	roundTrip time.Duration
+	upstream  time.Duration
+	samples   int
+	failed    int
+	// interval spaces the category's calls at --rate; next is when the next
+	// call is due
+	interval time.Duration
+	next     time.Time
+}
+
+func newLatencySplit(category string) *latencySplit {
+	split := &latencySplit{category: category}
+	if *rate > 0 {
+		split.interval = time.Duration(float64(time.Second) / *rate)
+	}
+	return split
+}
+
+// measure waits until the next call is due, then times call, which returns
+// the upstream time the server reported
+func (l *latencySplit) measure(ctx context.Context, call func() (uint64, error)) {
+	if l.interval > 0 {
+		if delay := time.Until(l.next); delay > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(delay):
+			}
+		}
+		l.next = time.Now().Add(l.interval)
+	}
+
+	start := time.Now()
+	upstreamMs, err := call()
+	if err != nil {
+		l.failed++
+		return
+	}
+	l.roundTrip += time.Since(start)
+	l.upstream += time.Duration(upstreamMs) * time.Millisecond
+	l.samples++
+}
+
+// printLatencySplit repeats the benchmark's gRPC calls from the client, timing
+// each round trip, and prints it next to the server-reported upstream time.
+// The difference is what the client pays for the network hop to the server
+// and serialization. The calls are paced at --rate like the benchmark's, so
+// a load test is not followed by an unpaced burst.
+func printLatencySplit(ctx context.Context, client proto.BenchmarkServiceClient, req *proto.BenchmarkRequest) {
+	accounts := newLatencySplit("accounts")
+	transactions := newLatencySplit("transactions")
+	blocks := newLatencySplit("blocks")
+	nodes := newLatencySplit("cluster nodes")
+
+	// The split covers the tier the block results are reported for
+	var detail proto.BlockDetail
+	if len(req.BlockDetails) > 0 {
+		detail = req.BlockDetails[0]
+	}
+	for i := 0; i < int(req.Iterations) && ctx.Err() == nil; i++ {
+		for _, account := range req.TestAccounts {
+			accounts.measure(ctx, func() (uint64, error) {
+				resp, err := client.GetAccountInfo(ctx, &proto.AccountInfoRequest{Pubkey: account, Commitment: "finalized", EncodingBinary: true})
+				return resp.GetResponseTimeMs(), err
+			})
+		}
+		for _, sig := range req.TestSignatures {
+			transactions.measure(ctx, func() (uint64, error) {
+				resp, err := client.GetTransaction(ctx, &proto.TransactionRequest{Signature: sig, Commitment: "finalized"})
+				return resp.GetResponseTimeMs(), err
+			})
+		}
+		for _, s := range req.TestSlots {
+			blocks.measure(ctx, func() (uint64, error) {
+				resp, err := client.GetBlock(ctx, &proto.BlockRequest{Slot: s, Commitment: "finalized", Detail: detail})
+				return resp.GetResponseTimeMs(), err
+			})
+		}
+		if req.RunClusterNodes {
+			nodes.measure(ctx, func() (uint64, error) {
+				resp, err := client.GetClusterNodes(ctx, &proto.ClusterNodesRequest{})
+				return resp.GetResponseTimeMs(), err
+			})
+		}
+	}
+
+	fmt.Println("gRPC Latency Split (client-observed vs server-observed):")
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Category", "Client Round Trip (ms)", "Server Upstream (ms)", "Network/Serialization Overhead (ms)", "Samples", "Failed"})
+	for _, split := range []*latencySplit{accounts, transactions, blocks, nodes} {
+		switch {
+		case split.samples > 0:
+			roundTrip := float64(split.roundTrip.Microseconds()) / 1000 / float64(split.samples)
+			upstream := float64(split.upstream.Microseconds()) / 1000 / float64(split.samples)
+			table.Append([]string{
+				split.category,
+				fmt.Sprintf("%.2f", roundTrip),
+				fmt.Sprintf("%.2f", upstream),
+				fmt.Sprintf("%.2f", roundTrip-upstream),
+				fmt.Sprintf("%d", split.samples),
+				fmt.Sprintf("%d", split.failed),
+			})
+		case split.failed > 0:
+			table.Append([]string{split.category, "-", "-", "-", "0", fmt.Sprintf("%d", split.failed)})
+		}
+	}
+	table.Render()
+	fmt.Println()
+}
+
+// printRoundTrip prints the client-observed round trip of a single call next
+// to the upstream time reported by the server
+func printRoundTrip(roundTrip time.Duration, upstreamMs uint64) {
+	roundTripMs := float64(roundTrip.Microseconds()) / 1000
+	fmt.Printf("Client Round Trip: %.2f ms\n", roundTripMs)
+	fmt.Printf("Network/Serialization Overhead: %.2f ms\n", roundTripMs-float64(upstreamMs))
+}
+
+// printContentHash prints the content hash of a block or transaction, which
+// matches between replicas serving the same data
+func printContentHash(hash []byte) {
+	if len(hash) > 0 {
+		fmt.Printf("Content Hash: %x\n", hash)
+	}
+}
+
+// printTiming prints the server's timing breakdown of a response or update
+func printTiming(timing *proto.TimingBreakdown) {
+	if timing == nil {
+		return
+	}
+	fmt.Printf("Server Timing: upstream %.2f ms, decode %.2f ms, serialize %.2f ms, queue %.2f ms\n",
+		timing.UpstreamRpcMs, timing.DecodeMs, timing.SerializeMs, timing.QueueMs)
+}
+
+// appendRuntime adds the server resource usage of each benchmark to a table
+func appendRuntime(table *tablewriter.Table, grpcStats, jsonrpcStats *proto.RuntimeStats) {
+	if grpcStats == nil && jsonrpcStats == nil {
+		return
+	}
+
+	table.Append([]string{"Server Allocated (KB)", fmt.Sprintf("%.1f", float64(grpcStats.GetAllocatedBytes())/1024), fmt.Sprintf("%.1f", float64(jsonrpcStats.GetAllocatedBytes())/1024)})
+	table.Append([]string{"Server Allocations", fmt.Sprintf("%d", grpcStats.GetAllocations()), fmt.Sprintf("%d", jsonrpcStats.GetAllocations())})
+	table.Append([]string{"Server GC Cycles", fmt.Sprintf("%d", grpcStats.GetGcCycles()), fmt.Sprintf("%d", jsonrpcStats.GetGcCycles())})
+	table.Append([]string{"Server GC Pause (ms)", fmt.Sprintf("%.3f", grpcStats.GetGcPauseMs()), fmt.Sprintf("%.3f", jsonrpcStats.GetGcPauseMs())})
+	table.Append([]string{"Server CPU Time (ms)", fmt.Sprintf("%.2f", grpcStats.GetCpuTimeMs()), fmt.Sprintf("%.2f", jsonrpcStats.GetCpuTimeMs())})
+}
+
+// appendDistribution adds the percentiles and standard deviation of the
+// response times to a benchmark table
+func appendDistribution(table *tablewriter.Table, grpcStats, jsonrpcStats *proto.LatencyStats) {
+	if grpcStats == nil && jsonrpcStats == nil {
+		return
+	}
+
+	table.Append([]string{"P50 Response Time (ms)", fmt.Sprintf("%.0f", grpcStats.GetP50ResponseTimeMs()), fmt.Sprintf("%.0f", jsonrpcStats.GetP50ResponseTimeMs())})
+	table.Append([]string{"P90 Response Time (ms)", fmt.Sprintf("%.0f", grpcStats.GetP90ResponseTimeMs()), fmt.Sprintf("%.0f", jsonrpcStats.GetP90ResponseTimeMs())})
+	table.Append([]string{"P95 Response Time (ms)", fmt.Sprintf("%.0f", grpcStats.GetP95ResponseTimeMs()), fmt.Sprintf("%.0f", jsonrpcStats.GetP95ResponseTimeMs())})
+	table.Append([]string{"P99 Response Time (ms)", fmt.Sprintf("%.0f", grpcStats.GetP99ResponseTimeMs()), fmt.Sprintf("%.0f", jsonrpcStats.GetP99ResponseTimeMs())})
+	table.Append([]string{"Std Dev (ms)", fmt.Sprintf("%.2f", grpcStats.GetStddevResponseTimeMs()), fmt.Sprintf("%.2f", jsonrpcStats.GetStddevResponseTimeMs())})
+}
+
+// appendAdjusted adds the statistics after outlier handling to a benchmark table
+func appendAdjusted(table *tablewriter.Table, grpcStats, jsonrpcStats *proto.LatencyStats) {
+	if grpcStats == nil && jsonrpcStats == nil {
+		return
+	}
+
+	label := "Trimmed"
+	if *outliers == "winsorize" {
+		label = "Winsorized"
+	}
+	table.Append([]string{label + " Avg Response Time (ms)", fmt.Sprintf("%.2f", grpcStats.GetAvgResponseTimeMs()), fmt.Sprintf("%.2f", jsonrpcStats.GetAvgResponseTimeMs())})
+	table.Append([]string{label + " Min Response Time (ms)", fmt.Sprintf("%.2f", grpcStats.GetMinResponseTimeMs()), fmt.Sprintf("%.2f", jsonrpcStats.GetMinResponseTimeMs())})
+	table.Append([]string{label + " Max Response Time (ms)", fmt.Sprintf("%.2f", grpcStats.GetMaxResponseTimeMs()), fmt.Sprintf("%.2f", jsonrpcStats.GetMaxResponseTimeMs())})
+	table.Append([]string{label + " Samples", fmt.Sprintf("%d", grpcStats.GetSamples()), fmt.Sprintf("%d", jsonrpcStats.GetSamples())})
+}
+
 func getAccountInfo(ctx context.Context, client proto.BenchmarkServiceClient) {
 	if *pubkey == "" {
-		log.Fatal("--pubkey is required")
+		fatal("--pubkey is required")
 	}
 
 	// Get account info
 	fmt.Printf("Getting account info for %s...\n", *pubkey)
+	start := time.Now()
 	resp, err := client.GetAccountInfo(ctx, &proto.AccountInfoRequest{
 		Pubkey:         *pubkey,
 		Commitment:     "finalized",
 		EncodingBinary: true,
+		FieldMask:      fieldMask(),
 	})
 	if err != nil {
-		log.Fatalf("Error getting account info: %v", err)
+		fatalf("Error getting account info: %v", err)
 	}
+	roundTrip := time.Since(start)
 
 	// Print results
 	fmt.Printf("\nAccount Info:\n")
@@ -173,106 +1261,1361 @@ func getAccountInfo(ctx context.Context, client proto.BenchmarkServiceClient) {
 	fmt.Printf("Rent Epoch: %d\n", resp.RentEpoch)
 	fmt.Printf("Data Length: %d bytes\n", len(resp.Data))
 	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+	printRoundTrip(roundTrip, resp.ResponseTimeMs)
+	printTiming(resp.Timing)
 }
 
-func getTransaction(ctx context.Context, client proto.BenchmarkServiceClient) {
-	if *signature == "" {
-		log.Fatal("--signature is required")
+func getNonceAccount(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" {
+		fatal("--pubkey is required")
 	}
 
-	// Get transaction
-	fmt.Printf("Getting transaction %s...\n", *signature)
-	resp, err := client.GetTransaction(ctx, &proto.TransactionRequest{
-		Signature:  *signature,
-		Commitment: "finalized",
+	// Get nonce account
+	fmt.Printf("Getting nonce account %s...\n", *pubkey)
+	resp, err := client.GetNonceAccount(ctx, &proto.NonceAccountRequest{
+		Pubkey:     *pubkey,
+		Commitment: *commitment,
 	})
 	if err != nil {
-		log.Fatalf("Error getting transaction: %v", err)
+		fatalf("Error getting nonce account: %v", err)
 	}
 
 	// Print results
-	fmt.Printf("\nTransaction Info:\n")
-	fmt.Printf("Signature: %s\n", resp.Signature)
+	fmt.Printf("\nNonce Account:\n")
+	fmt.Printf("Pubkey: %s\n", resp.Pubkey)
+	fmt.Printf("Authority: %s\n", resp.Authority)
+	fmt.Printf("Nonce: %s\n", resp.Nonce)
+	fmt.Printf("Lamports Per Signature: %d\n", resp.LamportsPerSignature)
+	fmt.Printf("Lamports: %d\n", resp.Lamports)
+	fmt.Printf("Slot: %d\n", resp.Slot)
+	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+}
+
+// readConsistent reads the --pubkey accounts as of one slot
+func readConsistent(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" {
+		fatal("--pubkey is required")
+	}
+
+	resp, err := client.ReadConsistent(ctx, &proto.ConsistentReadRequest{
+		Pubkeys:    strings.Split(*pubkey, ","),
+		Commitment: *commitment,
+	})
+	if err != nil {
+		fatalf("Error reading accounts: %v", err)
+	}
+
+	fmt.Printf("Accounts at slot %d (%d attempts, %d ms):\n", resp.Slot, resp.Attempts, resp.ResponseTimeMs)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Pubkey", "Owner", "Lamports", "Data Length"})
+	for _, account := range resp.Accounts {
+		if !account.Exists {
+			table.Append([]string{account.Pubkey, "-", "-", "not found"})
+			continue
+		}
+		table.Append([]string{account.Pubkey, account.Owner, fmt.Sprintf("%d", account.Lamports), fmt.Sprintf("%d bytes", len(account.Data))})
+	}
+	table.Render()
+}
+
+// getTokenAccount prints a decoded mint or token account with its extensions
+func getTokenAccount(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" {
+		fatal("--pubkey is required")
+	}
+
+	resp, err := client.GetTokenAccount(ctx, &proto.TokenAccountRequest{
+		Pubkey:     *pubkey,
+		Commitment: *commitment,
+	})
+	if err != nil {
+		fatalf("Error getting token account: %v", err)
+	}
+
+	fmt.Printf("Program: %s\n", resp.ProgramId)
+	if mint := resp.Mint; mint != nil {
+		fmt.Printf("\nMint %s:\n", resp.Pubkey)
+		fmt.Printf("Supply: %d\n", mint.Supply)
+		fmt.Printf("Decimals: %d\n", mint.Decimals)
+		fmt.Printf("Mint Authority: %s\n", orNone(mint.MintAuthority))
+		fmt.Printf("Freeze Authority: %s\n", orNone(mint.FreezeAuthority))
+	}
+	if account := resp.Account; account != nil {
+		fmt.Printf("\nToken Account %s:\n", resp.Pubkey)
+		fmt.Printf("Mint: %s\n", account.Mint)
+		fmt.Printf("Owner: %s\n", account.Owner)
+		fmt.Printf("Amount: %d\n", account.Amount)
+		fmt.Printf("Status: %s\n", strings.ToLower(strings.TrimPrefix(account.Status.String(), "TOKEN_ACCOUNT_STATUS_")))
+		fmt.Printf("Delegate: %s (%d)\n", orNone(account.Delegate), account.DelegatedAmount)
+		if account.IsNative {
+			fmt.Printf("Native Reserve: %d lamports\n", account.NativeReserve)
+		}
+	}
+
+	if ext := resp.Extensions; ext != nil {
+		fmt.Println("\nExtensions:")
+		if fee := ext.TransferFeeConfig; fee != nil {
+			fmt.Printf("Transfer Fee: %d bps, max %d (from epoch %d; previously %d bps, max %d)\n",
+				fee.NewerTransferFee.GetBasisPoints(), fee.NewerTransferFee.GetMaximumFee(), fee.NewerTransferFee.GetEpoch(),
+				fee.OlderTransferFee.GetBasisPoints(), fee.OlderTransferFee.GetMaximumFee())
+			fmt.Printf("Transfer Fee Authority: %s, withheld %d\n", orNone(fee.ConfigAuthority), fee.WithheldAmount)
+		}
+		if fee := ext.TransferFeeAmount; fee != nil {
+			fmt.Printf("Withheld Transfer Fees: %d\n", fee.WithheldAmount)
+		}
+		if interest := ext.InterestBearingConfig; interest != nil {
+			fmt.Printf("Interest Rate: %d bps (average before last update %d bps, updated %s)\n",
+				interest.CurrentRate, interest.PreUpdateAverageRate, time.Unix(interest.LastUpdateTimestamp, 0).Format(time.RFC3339))
+		}
+		if pointer := ext.MetadataPointer; pointer != nil {
+			fmt.Printf("Metadata Address: %s (authority %s)\n", orNone(pointer.MetadataAddress), orNone(pointer.Authority))
+		}
+		for _, kind := range ext.OtherTypes {
+			fmt.Printf("Extension type %d (not decoded)\n", kind)
+		}
+	}
+	fmt.Printf("\nSlot: %d\n", resp.Slot)
+	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+}
+
+// getNFTsByOwner lists the NFTs held by the --owner wallet
+func getNFTsByOwner(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *owner == "" {
+		fatal("--owner is required")
+	}
+
+	resp, err := client.GetNFTsByOwner(ctx, &proto.NFTsByOwnerRequest{
+		Owner:      *owner,
+		Commitment: *commitment,
+	})
+	if err != nil {
+		fatalf("Error getting NFTs: %v", err)
+	}
+
+	fmt.Printf("NFTs held by %s (%d ms):\n", resp.Owner, resp.ResponseTimeMs)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Mint", "Name", "Symbol", "Collection", "URI"})
+	for _, nft := range resp.Nfts {
+		metadata := nft.Metadata
+		if metadata == nil {
+			table.Append([]string{nft.Mint, "-", "-", "-", "-"})
+			continue
+		}
+		collection := orNone(metadata.Collection)
+		if metadata.Collection != "" && !metadata.CollectionVerified {
+			collection += " (unverified)"
+		}
+		table.Append([]string{nft.Mint, metadata.Name, metadata.Symbol, collection, metadata.Uri})
+	}
+	table.Render()
+	if resp.Truncated {
+		fmt.Println("More NFTs are held than a request resolves; the list is incomplete")
+	}
+}
+
+func getAsset(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" {
+		fatal("--pubkey is required")
+	}
+
+	resp, err := client.GetAsset(ctx, &proto.AssetRequest{Id: *pubkey})
+	if err != nil {
+		fatalf("Error getting asset: %v", err)
+	}
+
+	asset := resp.Asset
+	fmt.Printf("Asset %s (%d ms):\n", asset.Id, resp.ResponseTimeMs)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Field", "Value"})
+	table.Append([]string{"Interface", asset.Interface})
+	table.Append([]string{"Name", asset.Name})
+	table.Append([]string{"Symbol", asset.Symbol})
+	table.Append([]string{"URI", asset.JsonUri})
+	table.Append([]string{"Owner", asset.Owner})
+	table.Append([]string{"Collection", orNone(asset.Collection)})
+	table.Append([]string{"Mutable", fmt.Sprintf("%t", asset.Mutable)})
+	table.Append([]string{"Burnt", fmt.Sprintf("%t", asset.Burnt)})
+	table.Append([]string{"Compressed", fmt.Sprintf("%t", asset.Compressed)})
+	if asset.Compressed {
+		table.Append([]string{"Tree", asset.Tree})
+		table.Append([]string{"Leaf ID", fmt.Sprintf("%d", asset.LeafId)})
+	}
+	table.Render()
+}
+
+func getAssetsByOwner(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *owner == "" {
+		fatal("--owner is required")
+	}
+
+	resp, err := client.GetAssetsByOwner(ctx, &proto.AssetsByOwnerRequest{
+		Owner: *owner,
+		Page:  uint32(*page),
+		Limit: uint32(*limit),
+	})
+	if err != nil {
+		fatalf("Error getting assets: %v", err)
+	}
+
+	fmt.Printf("Assets held by %s, page %d (%d of %d, %d ms):\n", *owner, resp.Page, len(resp.Assets), resp.Total, resp.ResponseTimeMs)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Interface", "Name", "Collection", "Compressed"})
+	for _, asset := range resp.Assets {
+		table.Append([]string{
+			asset.Id,
+			asset.Interface,
+			asset.Name,
+			orNone(asset.Collection),
+			fmt.Sprintf("%t", asset.Compressed),
+		})
+	}
+	table.Render()
+}
+
+func getAssetProof(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" {
+		fatal("--pubkey is required")
+	}
+
+	resp, err := client.GetAssetProof(ctx, &proto.AssetProofRequest{Id: *pubkey})
+	if err != nil {
+		fatalf("Error getting asset proof: %v", err)
+	}
+
+	fmt.Printf("Proof of asset %s (%d ms):\n", resp.Id, resp.ResponseTimeMs)
+	fmt.Printf("  Tree:       %s\n", resp.Tree)
+	fmt.Printf("  Root:       %s\n", solana.HashFromBytes(resp.Root))
+	fmt.Printf("  Leaf:       %s\n", solana.HashFromBytes(resp.Leaf))
+	fmt.Printf("  Leaf index: %d (node %d, depth %d)\n", resp.LeafIndex, resp.NodeIndex, resp.Depth)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Level", "Sibling"})
+	for i, node := range resp.Proof {
+		table.Append([]string{fmt.Sprintf("%d", i), solana.HashFromBytes(node).String()})
+	}
+	table.Render()
+}
+
+func findProgramAddress(ctx context.Context, utils proto.UtilsServiceClient) {
+	if *programID == "" {
+		fatal("--program is required")
+	}
+	var seedBytes [][]byte
+	if *seeds != "" {
+		for _, value := range strings.Split(*seeds, ",") {
+			seedBytes = append(seedBytes, parseSeed(value))
+		}
+	}
+
+	resp, err := utils.FindProgramAddress(ctx, &proto.FindProgramAddressRequest{
+		ProgramId: *programID,
+		Seeds:     seedBytes,
+	})
+	if err != nil {
+		fatalf("Error finding program address: %v", err)
+	}
+	fmt.Printf("Program address: %s (bump %d)\n", resp.Address, resp.Bump)
+}
+
+// parseSeed decodes a find-pda seed: hex:<bytes>, pubkey:<base58 key>, or
+// otherwise UTF-8 text
+func parseSeed(value string) []byte {
+	switch {
+	case strings.HasPrefix(value, "hex:"):
+		seed, err := hex.DecodeString(strings.TrimPrefix(value, "hex:"))
+		if err != nil {
+			fatalf("Invalid hex seed %q: %v", value, err)
+		}
+		return seed
+	case strings.HasPrefix(value, "pubkey:"):
+		key, err := solana.PublicKeyFromBase58(strings.TrimPrefix(value, "pubkey:"))
+		if err != nil {
+			fatalf("Invalid pubkey seed %q: %v", value, err)
+		}
+		return key.Bytes()
+	default:
+		return []byte(value)
+	}
+}
+
+func createWithSeed(ctx context.Context, utils proto.UtilsServiceClient) {
+	if *pubkey == "" || *programID == "" {
+		fatal("--pubkey (the base) and --program (the owner) are required")
+	}
+
+	resp, err := utils.CreateWithSeed(ctx, &proto.CreateWithSeedRequest{
+		Base:  *pubkey,
+		Seed:  *seed,
+		Owner: *programID,
+	})
+	if err != nil {
+		fatalf("Error creating address with seed: %v", err)
+	}
+	fmt.Printf("Address: %s\n", resp.Address)
+}
+
+func getAssociatedTokenAddress(ctx context.Context, utils proto.UtilsServiceClient) {
+	if *owner == "" || *mint == "" {
+		fatal("--owner (the wallet) and --mint are required")
+	}
+
+	resp, err := utils.GetAssociatedTokenAddress(ctx, &proto.AssociatedTokenAddressRequest{
+		Wallet:         *owner,
+		Mint:           *mint,
+		TokenProgramId: *programID,
+	})
+	if err != nil {
+		fatalf("Error getting associated token address: %v", err)
+	}
+	fmt.Printf("Associated token address: %s (bump %d)\n", resp.Address, resp.Bump)
+}
+
+func buildTransfer(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" || *recipient == "" || *amount == 0 {
+		fatal("--pubkey (the sender), --to and --amount are required")
+	}
+
+	resp, err := client.BuildTransferTransaction(ctx, &proto.TransferTransactionRequest{
+		From:          *pubkey,
+		To:            *recipient,
+		Lamports:      *amount,
+		FeePayer:      *feePayer,
+		ComputeBudget: computeBudget(),
+		Commitment:    *commitment,
+	})
+	if err != nil {
+		fatalf("Error building transfer: %v", err)
+	}
+	printBuiltTransaction(resp)
+}
+
+func buildTokenTransfer(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *owner == "" || *recipient == "" || *mint == "" || *amount == 0 {
+		fatal("--owner, --to, --mint and --amount are required")
+	}
+
+	resp, err := client.BuildTokenTransferTransaction(ctx, &proto.TokenTransferTransactionRequest{
+		Owner:                  *owner,
+		Recipient:              *recipient,
+		Mint:                   *mint,
+		Amount:                 *amount,
+		FeePayer:               *feePayer,
+		CreateRecipientAccount: *createATA,
+		ComputeBudget:          computeBudget(),
+		Commitment:             *commitment,
+	})
+	if err != nil {
+		fatalf("Error building token transfer: %v", err)
+	}
+	printBuiltTransaction(resp)
+}
+
+func verifySignature(ctx context.Context, utils proto.UtilsServiceClient) {
+	if *pubkey == "" || *signature == "" {
+		fatal("--pubkey and --signature are required")
+	}
+	msg, err := base64.StdEncoding.DecodeString(*message)
+	if err != nil {
+		fatalf("Invalid --message: %v", err)
+	}
+
+	resp, err := utils.VerifySignature(ctx, &proto.VerifySignatureRequest{
+		Pubkey:    *pubkey,
+		Signature: *signature,
+		Message:   msg,
+	})
+	if err != nil {
+		fatalf("Error verifying signature: %v", err)
+	}
+	fmt.Printf("Signature valid: %t\n", resp.Valid)
+}
+
+// fieldMask returns the field mask selected by --fields, or nil for all fields
+func fieldMask() *fieldmaskpb.FieldMask {
+	if *fields == "" {
+		return nil
+	}
+	return &fieldmaskpb.FieldMask{Paths: strings.Split(*fields, ",")}
+}
+
+// streamQoS returns the QoS class selected by --qos
+func streamQoS() proto.StreamQoS {
+	switch *qos {
+	case "realtime":
+		return proto.StreamQoS_STREAM_QOS_REALTIME
+	case "bulk":
+		return proto.StreamQoS_STREAM_QOS_BULK
+	}
+	fatalf("Unknown QoS class: %s", *qos)
+	return 0
+}
+
+// blockDetails parses --block-details
+func blockDetails() []proto.BlockDetail {
+	if *blockDetail == "" {
+		return nil
+	}
+	var details []proto.BlockDetail
+	for _, name := range strings.Split(*blockDetail, ",") {
+		value, ok := proto.BlockDetail_value["BLOCK_DETAIL_"+strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			fatalf("Unknown block detail: %s", name)
+		}
+		details = append(details, proto.BlockDetail(value))
+	}
+	return details
+}
+
+func blockDetailName(detail proto.BlockDetail) string {
+	return strings.ToLower(strings.TrimPrefix(detail.String(), "BLOCK_DETAIL_"))
+}
+
+func changeDetection() proto.PollChangeDetection {
+	switch *changeCheck {
+	case "hash":
+		return proto.PollChangeDetection_POLL_CHANGE_DETECTION_DATA_HASH
+	case "slot":
+		return proto.PollChangeDetection_POLL_CHANGE_DETECTION_SLOT
+	}
+	fatalf("Unknown change detection: %s", *changeCheck)
+	return 0
+}
+
+func decodeTransaction(ctx context.Context, utils proto.UtilsServiceClient) {
+	if *rawTx == "" {
+		fatal("--tx is required")
+	}
+	encoding := proto.TransactionEncoding_TRANSACTION_ENCODING_BASE64
+	switch *txEncoding {
+	case "base64":
+	case "base58":
+		encoding = proto.TransactionEncoding_TRANSACTION_ENCODING_BASE58
+	default:
+		fatalf("Unknown encoding: %s", *txEncoding)
+	}
+
+	resp, err := utils.DecodeTransaction(ctx, &proto.DecodeTransactionRequest{
+		Transaction: *rawTx,
+		Encoding:    encoding,
+	})
+	if err != nil {
+		fatalf("Error decoding transaction: %v", err)
+	}
+
+	fmt.Printf("Version %s transaction, blockhash %s, signatures valid: %t\n", resp.Version, resp.RecentBlockhash, resp.SignaturesValid)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Signer", "Signature", "Valid"})
+	for _, sig := range resp.Signatures {
+		valid := fmt.Sprintf("%t", sig.Valid)
+		if !sig.Signed {
+			valid = "unsigned"
+		}
+		table.Append([]string{sig.Signer, sig.Signature, valid})
+	}
+	table.Render()
+
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Index", "Account", "Signer", "Writable"})
+	for i, key := range resp.AccountKeys {
+		table.Append([]string{fmt.Sprintf("%d", i), key.Pubkey, fmt.Sprintf("%t", key.Signer), fmt.Sprintf("%t", key.Writable)})
+	}
+	table.Render()
+
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Program", "Accounts", "Data (hex)"})
+	for _, instruction := range resp.Instructions {
+		indexes := make([]string, len(instruction.AccountIndexes))
+		for i, index := range instruction.AccountIndexes {
+			indexes[i] = fmt.Sprintf("%d", index)
+		}
+		table.Append([]string{instruction.ProgramId, strings.Join(indexes, ","), hex.EncodeToString(instruction.Data)})
+	}
+	table.Render()
+
+	for _, lookup := range resp.AddressTableLookups {
+		fmt.Printf("Lookup table %s: writable %v, read-only %v\n", lookup.AccountKey, lookup.WritableIndexes, lookup.ReadonlyIndexes)
+	}
+}
+
+// computeBudget builds the compute budget of a built transaction from the flags
+func computeBudget() *proto.ComputeBudget {
+	return &proto.ComputeBudget{
+		UnitLimit:              uint32(*cuLimit),
+		UnitPriceMicroLamports: *cuPrice,
+	}
+}
+
+// printBuiltTransaction prints an unsigned transaction for signing elsewhere
+func printBuiltTransaction(resp *proto.BuiltTransaction) {
+	fmt.Printf("Unsigned transaction (%d ms):\n", resp.ResponseTimeMs)
+	fmt.Printf("  Signers:                 %s\n", strings.Join(resp.Signers, ", "))
+	fmt.Printf("  Blockhash:               %s\n", resp.Blockhash)
+	fmt.Printf("  Last valid block height: %d\n", resp.LastValidBlockHeight)
+	fmt.Printf("  Compute unit limit:      %d\n", resp.ComputeUnitLimit)
+	fmt.Printf("  Transaction (base64):    %s\n", base64.StdEncoding.EncodeToString(resp.Transaction))
+}
+
+// orNone returns value, or "none" if it is empty
+func orNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
+
+func getUpstreamHealth(ctx context.Context, client proto.BenchmarkServiceClient) {
+	resp, err := client.GetUpstreamHealth(ctx, &proto.UpstreamHealthRequest{})
+	if err != nil {
+		fatalf("Error getting upstream health: %v", err)
+	}
+
+	ws := resp.Websocket
+	if ws.State == proto.WebSocketState_WEBSOCKET_STATE_UNMONITORED {
+		fmt.Println("The server does not monitor its upstream WebSocket connection")
+		return
+	}
+	since := time.UnixMilli(ws.StateSinceUnixMs)
+	fmt.Printf("Upstream WebSocket: %s for %v\n", strings.TrimPrefix(ws.State.String(), "WEBSOCKET_STATE_"), time.Since(since).Round(time.Second))
+	fmt.Printf("  Connects:    %d\n", ws.Connects)
+	fmt.Printf("  Disconnects: %d\n", ws.Disconnects)
+	if ws.LastNotificationUnixMs != 0 {
+		fmt.Printf("  Last slot:   %d (%v ago)\n", ws.LastSlot, time.Since(time.UnixMilli(ws.LastNotificationUnixMs)).Round(time.Millisecond))
+	}
+	if ws.State == proto.WebSocketState_WEBSOCKET_STATE_DISCONNECTED {
+		fmt.Printf("  Reconnect:   after %v\n", time.Duration(ws.ReconnectDelayMs)*time.Millisecond)
+	}
+	if ws.LastError != "" {
+		fmt.Printf("  Last error:  %s\n", ws.LastError)
+	}
+}
+
+func getCurrentSlot(ctx context.Context, client proto.BenchmarkServiceClient) {
+	resp, err := client.GetCurrentSlot(ctx, &proto.CurrentSlotRequest{})
+	if err != nil {
+		fatalf("Error getting current slot: %v", err)
+	}
+	source := "slot clock"
+	if !resp.FromSlotClock {
+		source = "upstream"
+	}
+	fmt.Printf("Current slot: %d (from the %s, %v ago)\n", resp.Slot, source, time.Since(time.UnixMilli(resp.ObservedAtUnixMs)).Round(time.Millisecond))
+}
+
+func getSLOStatus(ctx context.Context, client proto.BenchmarkServiceClient) {
+	resp, err := client.GetSLOStatus(ctx, &proto.SLOStatusRequest{})
+	if err != nil {
+		fatalf("Error getting SLO status: %v", err)
+	}
+	if len(resp.Objectives) == 0 {
+		fmt.Println("The server evaluates no latency objectives")
+		return
+	}
+	for _, objective := range resp.Objectives {
+		printSLOStatus(objective)
+	}
+}
+
+// printSLOStatus prints the state of a latency objective
+func printSLOStatus(objective *proto.SLOStatus) {
+	state := "met"
+	if !objective.Met {
+		state = "VIOLATED"
+	}
+	since := time.Since(time.UnixMilli(objective.SinceUnixMs)).Round(time.Second)
+	fmt.Printf("%s: %s for %v\n", objective.Name, state, since)
+	fmt.Printf("  p%g latency: %.1fms of %dms over %v (%d calls)\n",
+		objective.Percentile, objective.LatencyMs, objective.ThresholdMs, time.Duration(objective.WindowMs)*time.Millisecond, objective.Samples)
+}
+
+func getStreamStats(ctx context.Context, client proto.BenchmarkServiceClient) {
+	resp, err := client.GetStreamStats(ctx, &proto.StreamStatsRequest{})
+	if err != nil {
+		fatalf("Error getting stream stats: %v", err)
+	}
+	if resp.MaxStreams > 0 {
+		fmt.Printf("Open streams: %d of %d\n", resp.OpenStreams, resp.MaxStreams)
+	} else {
+		fmt.Printf("Open streams: %d\n", resp.OpenStreams)
+	}
+	fmt.Printf("Reaped streams: %d stalled, %d idle\n", resp.ReapedStalled, resp.ReapedIdle)
+	if len(resp.Topics) == 0 {
+		fmt.Println("No streams are subscribed")
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Hub", "Topic", "Subscribers", "Queued", "Max Queued", "Capacity", "Published", "Dropped"})
+	for _, topic := range resp.Topics {
+		table.Append([]string{
+			topic.Hub,
+			topic.Kind + ":" + topic.Key,
+			fmt.Sprintf("%d", topic.Subscribers),
+			fmt.Sprintf("%d", topic.Queued),
+			fmt.Sprintf("%d", topic.MaxQueued),
+			fmt.Sprintf("%d", topic.Capacity),
+			fmt.Sprintf("%d", topic.Published),
+			fmt.Sprintf("%d", topic.Dropped),
+		})
+	}
+	table.Render()
+}
+
+func getCapabilities(ctx context.Context, client proto.BenchmarkServiceClient) {
+	resp, err := client.GetCapabilities(ctx, &proto.CapabilitiesRequest{})
+	if err != nil {
+		fatalf("Error getting capabilities: %v", err)
+	}
+	fmt.Printf("Upstream:        %s\n", resp.UpstreamType)
+	fmt.Printf("Yellowstone:     %v\n", resp.Yellowstone)
+	fmt.Printf("WebSocket:       %v\n", resp.Websocket)
+	if resp.Degraded {
+		fmt.Printf("Degraded:        polling every %v\n", time.Duration(resp.FallbackPollIntervalMs)*time.Millisecond)
+	}
+	if resp.BenchmarkCache {
+		fmt.Printf("Benchmark cache: %v\n", time.Duration(resp.BenchmarkCacheTtlMs)*time.Millisecond)
+	} else {
+		fmt.Printf("Benchmark cache: disabled\n")
+	}
+	fmt.Printf("Archive routing: %v\n", resp.ArchiveRouting)
+	fmt.Printf("Account history: %v\n", resp.AccountHistory)
+	for _, feature := range resp.Features {
+		state := "enabled"
+		if !feature.Enabled {
+			state = "disabled"
+		}
+		fmt.Printf("Feature %s: %s\n", feature.Name, state)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Stream", "Source"})
+	for _, stream := range resp.Streams {
+		table.Append([]string{
+			stream.Method[strings.LastIndex(stream.Method, "/")+1:],
+			strings.ToLower(strings.TrimPrefix(stream.Source.String(), "STREAM_SOURCE_")),
+		})
+	}
+	table.Render()
+}
+
+func getAccountHistory(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" {
+		fatal("--pubkey is required")
+	}
+
+	resp, err := client.GetAccountHistory(ctx, &proto.AccountHistoryRequest{
+		Pubkey:    *pubkey,
+		StartSlot: *fromSlot,
+		EndSlot:   *toSlot,
+	})
+	if err != nil {
+		fatalf("Error getting account history: %v", err)
+	}
+
+	fmt.Printf("\nAccount History for %s:\n", *pubkey)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Version", "Slot", "Lamports", "Owner", "Data Length"})
+	for _, state := range resp.States {
+		table.Append([]string{
+			fmt.Sprintf("%d", state.WriteVersion),
+			fmt.Sprintf("%d", state.Slot),
+			fmt.Sprintf("%d", state.Lamports),
+			state.Owner,
+			fmt.Sprintf("%d bytes", len(state.Data)),
+		})
+	}
+	table.Render()
+	if resp.Truncated {
+		fmt.Println("More states fall in the range; narrow it with --from-slot and --to-slot")
+	}
+}
+
+func getTransaction(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *signature == "" {
+		fatal("--signature is required")
+	}
+
+	// Get transaction
+	fmt.Printf("Getting transaction %s...\n", *signature)
+	start := time.Now()
+	resp, err := client.GetTransaction(ctx, &proto.TransactionRequest{
+		Signature:  *signature,
+		Commitment: "finalized",
+		FieldMask:  fieldMask(),
+	})
+	if err != nil {
+		fatalf("Error getting transaction: %v", err)
+	}
+	roundTrip := time.Since(start)
+
+	// Print results
+	fmt.Printf("\nTransaction Info:\n")
+	fmt.Printf("Signature: %s\n", resp.Signature)
 	fmt.Printf("Slot: %d\n", resp.Slot)
 	fmt.Printf("Success: %t\n", resp.Success)
 	fmt.Printf("Transaction Data Length: %d bytes\n", len(resp.Transaction))
+	printContentHash(resp.ContentHash)
+	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+	printRoundTrip(roundTrip, resp.ResponseTimeMs)
+	printTiming(resp.Timing)
+}
+
+func getBlock(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *slot == 0 {
+		fatal("--slot is required")
+	}
+
+	// Get block
+	fmt.Printf("Getting block at slot %d...\n", *slot)
+	start := time.Now()
+	var detail proto.BlockDetail
+	if details := blockDetails(); len(details) > 0 {
+		detail = details[0]
+	}
+	resp, err := client.GetBlock(ctx, &proto.BlockRequest{
+		Slot:       *slot,
+		Commitment: "finalized",
+		FieldMask:  fieldMask(),
+		Detail:     detail,
+	})
+	if err != nil {
+		fatalf("Error getting block: %v", err)
+	}
+	roundTrip := time.Since(start)
+
+	// Print results
+	fmt.Printf("\nBlock Info:\n")
+	fmt.Printf("Slot: %d\n", resp.Slot)
+	fmt.Printf("Blockhash: %s\n", resp.Blockhash)
+	fmt.Printf("Previous Blockhash: %s\n", resp.PreviousBlockhash)
+	fmt.Printf("Parent Slot: %d\n", resp.ParentSlot)
+	fmt.Printf("Transactions: %d\n", len(resp.Transactions))
+	fmt.Printf("Rewards: %d\n", resp.Rewards)
+	printContentHash(resp.ContentHash)
+	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+	printRoundTrip(roundTrip, resp.ResponseTimeMs)
+	printTiming(resp.Timing)
+}
+
+// benchmarkSerialization compares the server's cost of encoding and decoding
+// a block as protobuf, as JSON and as FlatBuffers
+func benchmarkSerialization(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *slot == 0 {
+		fatal("--slot is required")
+	}
+
+	fmt.Printf("Benchmarking serialization of block %d over %d iterations...\n", *slot, *iterations)
+	resp, err := client.BenchmarkSerialization(ctx, &proto.SerializationBenchmarkRequest{
+		Slot:       *slot,
+		Iterations: uint32(*iterations),
+	})
+	if err != nil {
+		fatalf("Error benchmarking serialization: %v", err)
+	}
+
+	fmt.Printf("\nBlock %d with %d transactions, %d iterations:\n", resp.Slot, resp.Transactions, resp.Iterations)
+	n := float64(resp.Iterations)
+	costs := []*proto.SerializationCost{resp.Protobuf, resp.Json, resp.Flatbuffers}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Metric", "Protobuf", "JSON", "FlatBuffers"})
+	for _, metric := range []struct {
+		name  string
+		value func(*proto.SerializationCost) string
+	}{
+		{"Encoded Size (bytes)", func(c *proto.SerializationCost) string { return fmt.Sprintf("%d", c.EncodedBytes) }},
+		{"Encode (us/op)", func(c *proto.SerializationCost) string { return fmt.Sprintf("%.1f", c.EncodeUs) }},
+		{"Decode (us/op)", func(c *proto.SerializationCost) string { return fmt.Sprintf("%.1f", c.DecodeUs) }},
+		{"Encode Allocations (/op)", func(c *proto.SerializationCost) string {
+			return fmt.Sprintf("%.0f", float64(c.EncodeRuntime.GetAllocations())/n)
+		}},
+		{"Decode Allocations (/op)", func(c *proto.SerializationCost) string {
+			return fmt.Sprintf("%.0f", float64(c.DecodeRuntime.GetAllocations())/n)
+		}},
+		{"Encode Allocated (KB/op)", func(c *proto.SerializationCost) string {
+			return fmt.Sprintf("%.1f", float64(c.EncodeRuntime.GetAllocatedBytes())/1024/n)
+		}},
+		{"Decode Allocated (KB/op)", func(c *proto.SerializationCost) string {
+			return fmt.Sprintf("%.1f", float64(c.DecodeRuntime.GetAllocatedBytes())/1024/n)
+		}},
+		{"Encode CPU Time (ms)", func(c *proto.SerializationCost) string {
+			return fmt.Sprintf("%.2f", c.EncodeRuntime.GetCpuTimeMs())
+		}},
+		{"Decode CPU Time (ms)", func(c *proto.SerializationCost) string {
+			return fmt.Sprintf("%.2f", c.DecodeRuntime.GetCpuTimeMs())
+		}},
+		{"GC Cycles", func(c *proto.SerializationCost) string {
+			return fmt.Sprintf("%d", c.EncodeRuntime.GetGcCycles()+c.DecodeRuntime.GetGcCycles())
+		}},
+	} {
+		row := []string{metric.name}
+		for _, cost := range costs {
+			if cost == nil {
+				// Servers that predate a format leave it unset
+				row = append(row, "-")
+				continue
+			}
+			row = append(row, metric.value(cost))
+		}
+		table.Append(row)
+	}
+	table.Render()
+}
+
+// getEncodedBlock fetches a block as protobuf and as FlatBuffers, then reads
+// each payload in full iterations times, comparing what the encodings cost
+// the client as well as the wire
+func getEncodedBlock(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *slot == 0 {
+		fatal("--slot is required")
+	}
+	n := max(int(*iterations), 1)
+
+	fmt.Printf("Getting block %d in each encoding, reading each %d times...\n", *slot, n)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Encoding", "Payload (bytes)", "Round Trip (ms)", "Server Encode (us)", "Client Read (us/op)"})
+	for _, encoding := range []struct {
+		name     string
+		encoding proto.BlockEncoding
+		read     func([]byte) error
+	}{
+		{"Protobuf", proto.BlockEncoding_BLOCK_ENCODING_PROTOBUF, func(data []byte) error {
+			return protobuf.Unmarshal(data, &proto.SerializedBlock{})
+		}},
+		{"FlatBuffers", proto.BlockEncoding_BLOCK_ENCODING_FLATBUFFERS, func(data []byte) error {
+			_, err := flatblock.Walk(data)
+			return err
+		}},
+	} {
+		start := time.Now()
+		resp, err := client.GetEncodedBlock(ctx, &proto.EncodedBlockRequest{Slot: *slot, Encoding: encoding.encoding})
+		if err != nil {
+			fatalf("Error getting %s block: %v", encoding.name, err)
+		}
+		roundTrip := time.Since(start)
+
+		start = time.Now()
+		for i := 0; i < n; i++ {
+			if err := encoding.read(resp.Payload); err != nil {
+				fatalf("Error reading %s block: %v", encoding.name, err)
+			}
+		}
+		read := time.Since(start)
+
+		table.Append([]string{
+			encoding.name,
+			fmt.Sprintf("%d", len(resp.Payload)),
+			fmt.Sprintf("%.1f", float64(roundTrip)/float64(time.Millisecond)),
+			fmt.Sprintf("%.1f", resp.EncodeUs),
+			fmt.Sprintf("%.1f", float64(read)/float64(n)/float64(time.Microsecond)),
+		})
+	}
+	table.Render()
+}
+
+// benchmarkDualServe compares gRPC with JSON over HTTP, both served by the
+// server from the same data without upstream calls
+func benchmarkDualServe(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" && *signature == "" && *slot == 0 {
+		fatal("At least one of --pubkey, --signature, or --slot must be specified")
+	}
+
+	req := &proto.DualServeBenchmarkRequest{Iterations: uint32(*iterations)}
+	if *pubkey != "" {
+		req.TestAccounts = []string{*pubkey}
+	}
+	if *signature != "" {
+		req.TestSignatures = []string{*signature}
+	}
+	if *slot != 0 {
+		req.TestSlots = []uint64{*slot}
+	}
+
+	fmt.Printf("Benchmarking gRPC against JSON over HTTP served in-process over %d iterations...\n", *iterations)
+	resp, err := client.BenchmarkDualServe(ctx, req)
+	if err != nil {
+		fatalf("Error running dual-serve benchmark: %v", err)
+	}
+
+	categories := []struct {
+		name   string
+		result *proto.DualServeCategory
+	}{
+		{"Account", resp.Account},
+		{"Transaction", resp.Transaction},
+		{"Block", resp.Block},
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Category", "Protocol", "Avg (us)", "Min (us)", "Max (us)", "Payload (bytes)", "Calls"})
+	for _, category := range categories {
+		if category.result == nil {
+			continue
+		}
+		for _, protocol := range []struct {
+			name   string
+			result *proto.DualServeProtocol
+		}{
+			{"gRPC", category.result.Grpc},
+			{"JSON", category.result.Json},
+		} {
+			table.Append([]string{
+				category.name,
+				protocol.name,
+				fmt.Sprintf("%.1f", protocol.result.AvgUs),
+				fmt.Sprintf("%.1f", protocol.result.MinUs),
+				fmt.Sprintf("%.1f", protocol.result.MaxUs),
+				fmt.Sprintf("%d", protocol.result.AvgPayloadBytes),
+				fmt.Sprintf("%d", protocol.result.Calls),
+			})
+		}
+	}
+	table.Render()
+
+	for _, category := range categories {
+		if category.result != nil && category.result.Speedup > 0 {
+			fmt.Printf("%s: gRPC is %.2fx faster than JSON over HTTP\n", category.name, category.result.Speedup)
+		}
+	}
+}
+
+// runStreamBenchmark has the server subscribe to the same updates through its
+// gRPC streams and a WebSocket connection to its upstream for --duration, and
+// prints what each transport delivered
+func runStreamBenchmark(ctx context.Context, client proto.BenchmarkServiceClient) {
+	req := &proto.StreamBenchmarkRequest{Pubkey: *pubkey, DurationSeconds: uint32(*duration)}
+	if *streamKinds != "" {
+		for _, name := range strings.Split(*streamKinds, ",") {
+			switch strings.TrimSpace(name) {
+			case "account":
+				if *pubkey == "" {
+					fatal("--pubkey is required to benchmark account streams")
+				}
+				req.Kinds = append(req.Kinds, proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_ACCOUNT)
+			case "slot":
+				req.Kinds = append(req.Kinds, proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_SLOT)
+			case "block":
+				req.Kinds = append(req.Kinds, proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_BLOCK)
+			default:
+				fatalf("Unknown --streams kind: %s", name)
+			}
+		}
+	}
+	// The server follows confirmed updates unless asked otherwise
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "commitment" {
+			req.Commitment = *commitment
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(*duration)*time.Second+5*time.Minute)
+	defer cancel()
+	fmt.Printf("Subscribing through gRPC streams and WebSocket subscriptions for %d seconds...\n\n", *duration)
+	resp, err := client.StreamBenchmark(ctx, req)
+	if err != nil {
+		fatalf("Error running stream benchmark: %v", err)
+	}
+
+	fmt.Printf("Stream Benchmark Results (%s commitment, slots at processed, %d s):\n", resp.Commitment, resp.DurationSeconds)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Updates", "Transport", "Method", "Messages", "Msgs/s", "Latency Avg (ms)", "Latency P50 (ms)", "Latency P99 (ms)", "Latency Max (ms)", "Dropped", "Error"})
+	for _, category := range resp.Categories {
+		for _, transport := range []struct {
+			name   string
+			result *proto.StreamBenchmarkTransport
+		}{
+			{"gRPC", category.Grpc},
+			{"WebSocket", category.Websocket},
+		} {
+			result := transport.result
+			latency := []string{"-", "-", "-", "-"}
+			if result.LatencySamples > 0 {
+				latency = []string{
+					fmt.Sprintf("%.1f", result.AvgLatencyMs),
+					fmt.Sprintf("%.1f", result.P50LatencyMs),
+					fmt.Sprintf("%.1f", result.P99LatencyMs),
+					fmt.Sprintf("%.1f", result.MaxLatencyMs),
+				}
+			}
+			errText := result.Error
+			if errText == "" {
+				errText = "-"
+			}
+			row := []string{
+				strings.ToLower(strings.TrimPrefix(category.Kind.String(), "STREAM_BENCHMARK_KIND_")),
+				transport.name,
+				result.Method,
+				fmt.Sprintf("%d", result.Messages),
+				fmt.Sprintf("%.2f", result.MessagesPerSecond),
+			}
+			row = append(row, latency...)
+			table.Append(append(row, fmt.Sprintf("%d", result.Dropped), errText))
+		}
+	}
+	table.Render()
+	fmt.Println("Latency runs from when the server's reference slot subscription saw each update's slot processed.")
+}
+
+func getBlockTime(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *slot == 0 {
+		fatal("--slot is required")
+	}
+
+	resp, err := client.GetBlockTime(ctx, &proto.BlockTimeRequest{Slot: *slot})
+	if err != nil {
+		fatalf("Error getting block time: %v", err)
+	}
+	fmt.Printf("Slot %d was produced at %s\n", resp.Slot, time.Unix(resp.BlockTime, 0).UTC().Format(time.RFC3339))
 	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
 }
 
-func getBlock(ctx context.Context, client proto.BenchmarkServiceClient) {
-	if *slot == 0 {
-		log.Fatal("--slot is required")
+func estimateSlotAtTime(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *atTime == "" {
+		fatal("--time is required")
+	}
+	at, err := time.Parse(time.RFC3339, *atTime)
+	if err != nil {
+		seconds, perr := strconv.ParseInt(*atTime, 10, 64)
+		if perr != nil {
+			fatalf("Invalid --time: %v", err)
+		}
+		at = time.Unix(seconds, 0)
+	}
+
+	resp, err := client.EstimateSlotAtTime(ctx, &proto.SlotAtTimeRequest{UnixTime: at.Unix()})
+	if err != nil {
+		fatalf("Error estimating slot: %v", err)
+	}
+	fmt.Printf("Estimated slot at %s: %d\n", at.UTC().Format(time.RFC3339), resp.Slot)
+	fmt.Printf("Epoch: %d (slot %d of %d)\n", resp.Epoch, resp.SlotIndex, resp.SlotsInEpoch)
+	fmt.Printf("Slot Time: %.1f ms\n", resp.MsPerSlot)
+	fmt.Printf("Reference: slot %d at %s\n", resp.ReferenceSlot, time.Unix(resp.ReferenceTime, 0).UTC().Format(time.RFC3339))
+	switch {
+	case resp.Future:
+		fmt.Println("The time is after the latest finalized slot, so the slot is extrapolated")
+	case resp.Calibrated:
+		fmt.Println("Calibrated against recorded block times")
+	}
+	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+}
+
+func streamAccounts(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" && *owner == "" {
+		fatal("--pubkey or --owner is required")
+	}
+
+	// Batched updates come from their own method
+	if *batchMax > 0 {
+		streamAccountBatches(ctx, client)
+		return
+	}
+
+	// Stream account updates
+	streamLogf("Streaming account updates for %s...\n", accountSelection())
+	stream, err := client.StreamAccountUpdates(ctx, accountSubscription())
+	if err != nil {
+		fatalf("Error streaming account updates: %v", err)
+	}
+
+	// Receive updates
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			fatalf("Error receiving account update: %v", err)
+		}
+		printAccountUpdate(update)
+	}
+}
+
+// streamAccountBatches streams account updates coalesced into batches of up
+// to --batch-max-messages
+func streamAccountBatches(ctx context.Context, client proto.BenchmarkServiceClient) {
+	req := accountSubscription()
+	req.BatchMaxMessages = uint32(*batchMax)
+	req.BatchMaxWaitMs = uint32(*batchWait)
+
+	streamLogf("Streaming batched account updates for %s...\n", accountSelection())
+	stream, err := client.StreamAccountUpdateBatches(ctx, req)
+	if err != nil {
+		fatalf("Error streaming account updates: %v", err)
+	}
+
+	for {
+		batch, err := stream.Recv()
+		if err != nil {
+			fatalf("Error receiving account updates: %v", err)
+		}
+		streamLogf("\nBatch of %d updates\n", len(batch.Updates))
+		for _, update := range batch.Updates {
+			printAccountUpdate(update)
+		}
+	}
+}
+
+// printAccountUpdate prints an account update, or emits it with --ndjson
+func printAccountUpdate(update *proto.AccountUpdate) {
+	if emit(update) {
+		return
+	}
+
+	kind := "Account Update"
+	if update.Snapshot {
+		kind = "Account Snapshot"
+	}
+	fmt.Printf("\n%s at %s:\n", kind, time.Unix(int64(update.Timestamp), 0).Format(time.RFC3339))
+	fmt.Printf("Pubkey: %s\n", update.Pubkey)
+	fmt.Printf("Owner: %s\n", update.Owner)
+	fmt.Printf("Lamports: %d\n", update.Lamports)
+	fmt.Printf("Slot: %d\n", update.Slot)
+	fmt.Printf("Write Version: %d\n", update.WriteVersion)
+	fmt.Printf("Data Length: %d bytes\n", len(update.Data))
+	printTiming(update.Timing)
+}
+
+// accountSubscription builds an account stream request from the --pubkey and --owner flags
+func accountSubscription() *proto.AccountStreamRequest {
+	req := &proto.AccountStreamRequest{
+		Commitment:      "finalized",
+		IncludeSnapshot: *snapshot,
+		Compression:     *compression,
+		Qos:             streamQoS(),
+		FieldMask:       fieldMask(),
+	}
+	if *pubkey != "" {
+		req.Pubkeys = []string{*pubkey}
+	}
+	if *owner != "" {
+		req.Owners = []string{*owner}
+	}
+	return req
+}
+
+// accountSelection describes the accounts selected by the --pubkey and --owner flags
+func accountSelection() string {
+	switch {
+	case *pubkey != "" && *owner != "":
+		return fmt.Sprintf("%s and accounts owned by %s", *pubkey, *owner)
+	case *owner != "":
+		return fmt.Sprintf("accounts owned by %s", *owner)
+	default:
+		return *pubkey
+	}
+}
+
+func streamAccountsWithAck(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" && *owner == "" && *sessionID == "" {
+		fatal("--pubkey, --owner or --session is required")
+	}
+
+	stream, err := client.StreamAccountUpdatesWithAck(ctx)
+	if err != nil {
+		fatalf("Error streaming account updates: %v", err)
+	}
+
+	// Start a new session or resume an existing one
+	first := &proto.AccountAckStreamRequest{
+		SessionId:   *sessionID,
+		AckCursor:   *ackCursor,
+		Compression: *compression,
+	}
+	if *sessionID == "" {
+		first.Subscription = accountSubscription()
+		streamLogf("Streaming acknowledged account updates for %s...\n", accountSelection())
+	} else {
+		streamLogf("Resuming session %s from cursor %d...\n", *sessionID, *ackCursor)
+	}
+	if err := stream.Send(first); err != nil {
+		fatalf("Error starting session: %v", err)
+	}
+
+	// Receive updates and acknowledge each one once printed
+	for {
+		acked, err := stream.Recv()
+		if err != nil {
+			fatalf("Error receiving account update: %v", err)
+		}
+
+		if !emit(acked) {
+			update := acked.Update
+			kind := "Account Update"
+			if update.Snapshot {
+				kind = "Account Snapshot"
+			}
+			fmt.Printf("\n%s at %s (session %s, cursor %d):\n", kind, time.Unix(int64(update.Timestamp), 0).Format(time.RFC3339), acked.SessionId, acked.Cursor)
+			fmt.Printf("Pubkey: %s\n", update.Pubkey)
+			fmt.Printf("Owner: %s\n", update.Owner)
+			fmt.Printf("Lamports: %d\n", update.Lamports)
+			fmt.Printf("Slot: %d\n", update.Slot)
+			fmt.Printf("Write Version: %d\n", update.WriteVersion)
+			fmt.Printf("Data Length: %d bytes\n", len(update.Data))
+		}
+
+		if err := stream.Send(&proto.AccountAckStreamRequest{AckCursor: acked.Cursor}); err != nil {
+			fatalf("Error acknowledging cursor %d: %v", acked.Cursor, err)
+		}
+	}
+}
+
+func streamProgramAccounts(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *programID == "" {
+		fatal("--program is required")
 	}
 
-	// Get block
-	fmt.Printf("Getting block at slot %d...\n", *slot)
-	resp, err := client.GetBlock(ctx, &proto.BlockRequest{
-		Slot:       *slot,
-		Commitment: "finalized",
+	// Stream the program accounts snapshot
+	streamLogf("Streaming accounts owned by %s...\n", *programID)
+	stream, err := client.StreamProgramAccountsSnapshot(ctx, &proto.ProgramAccountsSnapshotRequest{
+		ProgramId:        *programID,
+		Commitment:       "finalized",
+		ChunkSize:        uint32(*chunkSize),
+		ContinueLive:     *live,
+		Compression:      *compression,
+		Qos:              streamQoS(),
+		BatchMaxMessages: uint32(*batchMax),
+		BatchMaxWaitMs:   uint32(*batchWait),
+		PollIntervalMs:   uint32(*pollEvery),
+		ChangeDetection:  changeDetection(),
 	})
 	if err != nil {
-		log.Fatalf("Error getting block: %v", err)
+		fatalf("Error streaming program accounts: %v", err)
 	}
 
-	// Print results
-	fmt.Printf("\nBlock Info:\n")
-	fmt.Printf("Slot: %d\n", resp.Slot)
-	fmt.Printf("Blockhash: %s\n", resp.Blockhash)
-	fmt.Printf("Previous Blockhash: %s\n", resp.PreviousBlockhash)
-	fmt.Printf("Parent Slot: %d\n", resp.ParentSlot)
-	fmt.Printf("Transactions: %d\n", len(resp.Transactions))
-	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+	// Receive chunks
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			fatalf("Error receiving program accounts: %v", err)
+		}
+		if emit(chunk) {
+			continue
+		}
+
+		if chunk.GapRepair {
+			fmt.Printf("Upstream resubscribed; %d accounts changed while it was down:\n", len(chunk.Accounts))
+		}
+		if chunk.PollIntervalMs > 0 && len(chunk.Accounts) > 0 {
+			fmt.Printf("Polled every %d ms; %d accounts changed:\n", chunk.PollIntervalMs, len(chunk.Accounts))
+		}
+		for _, account := range chunk.Accounts {
+			fmt.Printf("%s lamports=%d slot=%d data=%d bytes\n", account.Pubkey, account.Lamports, account.Slot, len(account.Data))
+		}
+
+		if chunk.Live {
+			continue
+		}
+		fmt.Printf("Snapshot progress: %d/%d accounts\n", chunk.SentAccounts, chunk.TotalAccounts)
+		if chunk.SnapshotComplete {
+			fmt.Println("Snapshot complete")
+		}
+	}
 }
 
-func streamAccounts(ctx context.Context, client proto.BenchmarkServiceClient) {
-	if *pubkey == "" {
-		log.Fatal("--pubkey is required")
+func measureCommitmentLatency(ctx context.Context, client proto.BenchmarkServiceClient) {
+	target := "the Clock sysvar"
+	if *pubkey != "" {
+		target = *pubkey
 	}
 
-	// Stream account updates
-	fmt.Printf("Streaming account updates for %s...\n", *pubkey)
-	stream, err := client.StreamAccountUpdates(ctx, &proto.AccountStreamRequest{
-		Pubkeys:    []string{*pubkey},
-		Commitment: "finalized",
+	// Measure commitment transitions
+	streamLogf("Measuring commitment latency for %s over %d seconds...\n", target, *duration)
+	stream, err := client.MeasureCommitmentLatency(ctx, &proto.CommitmentLatencyRequest{
+		Pubkey:          *pubkey,
+		DurationSeconds: uint32(*duration),
+		Compression:     *compression,
+		PollIntervalMs:  uint32(*pollEvery),
 	})
 	if err != nil {
-		log.Fatalf("Error streaming account updates: %v", err)
+		fatalf("Error measuring commitment latency: %v", err)
 	}
 
-	// Receive updates
+	// Receive samples until the measurement ends
+	var samples []*proto.CommitmentLatencySample
 	for {
-		update, err := stream.Recv()
+		sample, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
 		if err != nil {
-			log.Fatalf("Error receiving account update: %v", err)
+			fatalf("Error receiving commitment latency sample: %v", err)
 		}
 
-		// Print update
-		fmt.Printf("\nAccount Update at %s:\n", time.Unix(int64(update.Timestamp), 0).Format(time.RFC3339))
-		fmt.Printf("Pubkey: %s\n", update.Pubkey)
-		fmt.Printf("Owner: %s\n", update.Owner)
-		fmt.Printf("Lamports: %d\n", update.Lamports)
-		fmt.Printf("Slot: %d\n", update.Slot)
-		fmt.Printf("Data Length: %d bytes\n", len(update.Data))
+		samples = append(samples, sample)
+		if emit(sample) {
+			continue
+		}
+		fmt.Printf("Slot %d: processed->confirmed %d ms, confirmed->finalized %d ms, processed->finalized %d ms",
+			sample.Slot, sample.ProcessedToConfirmedMs, sample.ConfirmedToFinalizedMs, sample.ProcessedToFinalizedMs)
+		if sample.PollIntervalMs > 0 {
+			fmt.Printf(" (polled every %d ms)", sample.PollIntervalMs)
+		}
+		fmt.Println()
+	}
+
+	// The summary is derived from the samples an NDJSON consumer already has
+	if *ndjson {
+		return
 	}
+	if len(samples) == 0 {
+		fmt.Println("\nNo slots were observed at every commitment level")
+		return
+	}
+
+	// Print summary
+	fmt.Printf("\nCommitment Latency Results (%d slots):\n", len(samples))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Transition", "Avg (ms)", "Min (ms)", "Max (ms)"})
+	transitions := []struct {
+		name  string
+		value func(*proto.CommitmentLatencySample) uint64
+	}{
+		{"Processed -> Confirmed", func(s *proto.CommitmentLatencySample) uint64 { return s.ProcessedToConfirmedMs }},
+		{"Confirmed -> Finalized", func(s *proto.CommitmentLatencySample) uint64 { return s.ConfirmedToFinalizedMs }},
+		{"Processed -> Finalized", func(s *proto.CommitmentLatencySample) uint64 { return s.ProcessedToFinalizedMs }},
+	}
+	for _, transition := range transitions {
+		var total, min, max uint64
+		for i, sample := range samples {
+			v := transition.value(sample)
+			total += v
+			if i == 0 || v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+		avg := total / uint64(len(samples))
+		table.Append([]string{transition.name, fmt.Sprintf("%d", avg), fmt.Sprintf("%d", min), fmt.Sprintf("%d", max)})
+	}
+	table.Render()
 }
 
 func streamTransactions(ctx context.Context, client proto.BenchmarkServiceClient) {
 	// Stream transaction updates
-	fmt.Println("Streaming transaction updates...")
-	stream, err := client.StreamTransactions(ctx, &proto.TransactionStreamRequest{
-		Accounts:      []string{},
-		IncludeFailed: false,
-		Commitment:    "finalized",
-	})
+	streamLogf("Streaming transaction updates...\n")
+	req := &proto.TransactionStreamRequest{
+		IncludeFailed: *inclFailed,
+		Commitment:    *commitment,
+		Compression:   *compression,
+		Qos:           streamQoS(),
+		SampleRate:    uint32(*sampleRate),
+		FieldMask:     fieldMask(),
+	}
+	if *pubkey != "" {
+		req.Accounts = strings.Split(*pubkey, ",")
+	}
+	stream, err := client.StreamTransactions(ctx, req)
 	if err != nil {
-		log.Fatalf("Error streaming transaction updates: %v", err)
+		fatalf("Error streaming transaction updates: %v", err)
 	}
 
 	// Receive updates
 	for {
 		update, err := stream.Recv()
 		if err != nil {
-			log.Fatalf("Error receiving transaction update: %v", err)
+			fatalf("Error receiving transaction update: %v", err)
+		}
+		if emit(update) {
+			continue
 		}
 
 		// Print update
@@ -280,32 +2623,609 @@ func streamTransactions(ctx context.Context, client proto.BenchmarkServiceClient
 		fmt.Printf("Signature: %s\n", update.Signature)
 		fmt.Printf("Slot: %d\n", update.Slot)
 		fmt.Printf("Success: %t\n", update.Success)
+		if failure := update.Failure; failure != nil {
+			kind := strings.ToLower(strings.TrimPrefix(failure.Kind.String(), "TRANSACTION_FAILURE_KIND_"))
+			fmt.Printf("Failure: %s (%s", kind, failure.Error)
+			if failure.Error == "Custom" {
+				fmt.Printf(" %d", failure.CustomCode)
+			}
+			if failure.ProgramId != "" {
+				fmt.Printf(" from %s", failure.ProgramId)
+			}
+			fmt.Println(")")
+		}
 		fmt.Printf("Transaction Data Length: %d bytes\n", len(update.Transaction))
+		for _, transfer := range update.TokenTransfers {
+			fmt.Printf("Token Transfer: %d of %s from %s to %s (%d decimals)\n",
+				transfer.Amount, transfer.Mint, transfer.Source, transfer.Destination, transfer.Decimals)
+		}
+		for _, swap := range update.Swaps {
+			fmt.Printf("Swap on %s pool %s: %d of %s for %d of %s\n",
+				swap.Dex, swap.Pool, swap.AmountIn, swap.MintIn, swap.AmountOut, swap.MintOut)
+		}
+		printContentHash(update.ContentHash)
+		printTiming(update.Timing)
 	}
 }
 
 func streamBlocks(ctx context.Context, client proto.BenchmarkServiceClient) {
 	// Stream block updates
-	fmt.Println("Streaming block updates...")
+	streamLogf("Streaming block updates...\n")
 	stream, err := client.StreamBlocks(ctx, &proto.BlockStreamRequest{
-		Commitment: "finalized",
+		Commitment:  *commitment,
+		Compression: *compression,
+		Qos:         streamQoS(),
+		FieldMask:   fieldMask(),
 	})
 	if err != nil {
-		log.Fatalf("Error streaming block updates: %v", err)
+		fatalf("Error streaming block updates: %v", err)
 	}
 
 	// Receive updates
 	for {
 		update, err := stream.Recv()
 		if err != nil {
-			log.Fatalf("Error receiving block update: %v", err)
+			fatalf("Error receiving block update: %v", err)
+		}
+		if emit(update) {
+			continue
+		}
+
+		// Print fork switches
+		if update.Reorg != nil {
+			fmt.Printf("\nReorg: abandoned slots %v, common ancestor %d, new chain %v\n",
+				update.Reorg.AbandonedSlots, update.Reorg.CommonAncestorSlot, update.Reorg.NewChainSlots)
+			continue
+		}
+
+		// Print slots without a block
+		if update.Skipped {
+			fmt.Printf("\nSlot %d skipped\n", update.Slot)
+			continue
+		}
+
+		// Print status changes of previously streamed slots
+		if update.StatusChanged {
+			fmt.Printf("\nSlot %d is now %s\n", update.Slot, slotStatusName(update.Status))
+			continue
 		}
 
 		// Print update
 		fmt.Printf("\nBlock Update at %s:\n", time.Unix(int64(update.Timestamp), 0).Format(time.RFC3339))
 		fmt.Printf("Slot: %d\n", update.Slot)
+		fmt.Printf("Status: %s\n", slotStatusName(update.Status))
 		fmt.Printf("Blockhash: %s\n", update.Blockhash)
 		fmt.Printf("Previous Blockhash: %s\n", update.PreviousBlockhash)
 		fmt.Printf("Parent Slot: %d\n", update.ParentSlot)
+		printContentHash(update.ContentHash)
+		printTiming(update.Timing)
+	}
+}
+
+// slotStatusName returns a readable name for a slot status
+func streamBalanceChanges(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" {
+		fatal("--pubkey is required")
+	}
+
+	// Stream balance changes
+	streamLogf("Streaming balance changes for %s...\n", *pubkey)
+	stream, err := client.StreamBalanceChanges(ctx, &proto.BalanceChangeStreamRequest{
+		Accounts:    strings.Split(*pubkey, ","),
+		Commitment:  *commitment,
+		Compression: *compression,
+		Qos:         streamQoS(),
+	})
+	if err != nil {
+		fatalf("Error streaming balance changes: %v", err)
+	}
+
+	// Receive updates
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			fatalf("Error receiving balance changes: %v", err)
+		}
+		if emit(update) {
+			continue
+		}
+
+		fmt.Printf("\nBalance Changes in slot %d (%s):\n", update.Slot, slotStatusName(update.Status))
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Account", "Mint", "Pre", "Post", "Delta", "Transactions"})
+		for _, change := range update.Changes {
+			mint := change.Mint
+			if mint == "" {
+				mint = "SOL (lamports)"
+			}
+			table.Append([]string{
+				change.Account,
+				mint,
+				fmt.Sprintf("%d", change.PreBalance),
+				fmt.Sprintf("%d", change.PostBalance),
+				fmt.Sprintf("%+d", change.Delta),
+				fmt.Sprintf("%d", change.Transactions),
+			})
+		}
+		table.Render()
+	}
+}
+
+func streamAlerts(ctx context.Context, client proto.BenchmarkServiceClient) {
+	var conditions []*proto.AlertCondition
+	if *minTransfer > 0 {
+		conditions = append(conditions, &proto.AlertCondition{
+			Name:        "transfer",
+			Type:        proto.AlertConditionType_ALERT_CONDITION_LAMPORT_TRANSFER,
+			MinLamports: *minTransfer,
+			Account:     *pubkey,
+		})
+	}
+	if *balanceAt > 0 {
+		if *pubkey == "" {
+			fatal("--pubkey is required with --alert-balance")
+		}
+		conditions = append(conditions, &proto.AlertCondition{
+			Name:              "balance",
+			Type:              proto.AlertConditionType_ALERT_CONDITION_BALANCE_CROSSES,
+			Account:           *pubkey,
+			ThresholdLamports: *balanceAt,
+		})
+	}
+	if *alertProg != "" {
+		conditions = append(conditions, &proto.AlertCondition{
+			Name:      "program",
+			Type:      proto.AlertConditionType_ALERT_CONDITION_PROGRAM_INVOKED,
+			ProgramId: *alertProg,
+		})
+	}
+	if len(conditions) == 0 {
+		fatal("at least one of --alert-min-transfer, --alert-balance or --alert-program is required")
+	}
+
+	// Stream alerts
+	streamLogf("Streaming alerts for %d conditions...\n", len(conditions))
+	stream, err := client.StreamAlerts(ctx, &proto.AlertStreamRequest{
+		Conditions:  conditions,
+		Commitment:  *commitment,
+		Compression: *compression,
+		Qos:         streamQoS(),
+	})
+	if err != nil {
+		fatalf("Error streaming alerts: %v", err)
+	}
+
+	// Receive alerts
+	for {
+		alert, err := stream.Recv()
+		if err != nil {
+			fatalf("Error receiving alert: %v", err)
+		}
+		if emit(alert) {
+			continue
+		}
+
+		fmt.Printf("\n[%s] slot %d, transaction %s\n", alert.Condition, alert.Slot, alert.Signature)
+		if alert.ProgramId != "" {
+			fmt.Printf("Invoked program %s\n", alert.ProgramId)
+		} else {
+			fmt.Printf("Account %s: %d -> %d lamports (%+d)\n", alert.Account, alert.PreBalance, alert.PostBalance, alert.LamportsDelta)
+		}
+	}
+}
+
+func streamFeeStats(ctx context.Context, client proto.BenchmarkServiceClient) {
+	// Stream fee statistics
+	streamLogf("Streaming fee stats per %d slots...\n", *feeWindow)
+	stream, err := client.StreamFeeStats(ctx, &proto.FeeStatsRequest{
+		WindowSlots: uint32(*feeWindow),
+		Commitment:  *commitment,
+		Compression: *compression,
+		Qos:         streamQoS(),
+	})
+	if err != nil {
+		fatalf("Error streaming fee stats: %v", err)
+	}
+
+	// Receive updates
+	for {
+		stats, err := stream.Recv()
+		if err != nil {
+			fatalf("Error receiving fee stats: %v", err)
+		}
+		if emit(stats) {
+			continue
+		}
+
+		fmt.Printf("\nSlots %d-%d: %d blocks, %d transactions, %d with a priority fee\n",
+			stats.StartSlot, stats.EndSlot, stats.Blocks, stats.Transactions, stats.PrioritizedTransactions)
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Metric", "Min", "P25", "P50", "P75", "P90", "P99", "Max"})
+		for _, row := range []struct {
+			name        string
+			percentiles *proto.FeePercentiles
+		}{
+			{"Priority Fee (lamports)", stats.PriorityFeeLamports},
+			{"CU Price (micro-lamports)", stats.ComputeUnitPrice},
+		} {
+			p := row.percentiles
+			table.Append([]string{
+				row.name,
+				fmt.Sprintf("%d", p.Min), fmt.Sprintf("%d", p.P25), fmt.Sprintf("%d", p.P50), fmt.Sprintf("%d", p.P75),
+				fmt.Sprintf("%d", p.P90), fmt.Sprintf("%d", p.P99), fmt.Sprintf("%d", p.Max),
+			})
+		}
+		table.Render()
+	}
+}
+
+func getBlockProduction(ctx context.Context, client proto.BenchmarkServiceClient) {
+	resp, err := client.GetBlockProduction(ctx, &proto.BlockProductionRequest{
+		FirstSlot:  *fromSlot,
+		LastSlot:   *toSlot,
+		Identity:   *pubkey,
+		Commitment: *commitment,
+	})
+	if err != nil {
+		fatalf("Error getting block production: %v", err)
+	}
+	printBlockProduction(resp)
+	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+}
+
+func getClusterNodes(ctx context.Context, client proto.BenchmarkServiceClient) {
+	resp, err := client.GetClusterNodes(ctx, &proto.ClusterNodesRequest{
+		Version:    *nodeVersion,
+		FeatureSet: uint32(*featureSet),
+	})
+	if err != nil {
+		fatalf("Error getting cluster nodes: %v", err)
+	}
+	fmt.Printf("%d of %d cluster nodes match\n", len(resp.Nodes), resp.TotalNodes)
+
+	versions := make([]string, 0, len(resp.Versions))
+	for version := range resp.Versions {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return resp.Versions[versions[i]] > resp.Versions[versions[j]] })
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Version", "Nodes"})
+	for _, version := range versions {
+		table.Append([]string{version, fmt.Sprintf("%d", resp.Versions[version])})
+	}
+	table.Render()
+
+	nodes := resp.Nodes
+	if *limit > 0 && len(nodes) > int(*limit) {
+		nodes = nodes[:*limit]
+	}
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Pubkey", "Version", "Feature Set", "Gossip", "RPC"})
+	for _, node := range nodes {
+		table.Append([]string{node.Pubkey, node.Version, fmt.Sprintf("%d", node.FeatureSet), node.Gossip, node.Rpc})
+	}
+	table.Render()
+	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+}
+
+func getStakeActivation(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" {
+		fatal("--pubkey is required")
+	}
+	resp, err := client.GetStakeActivation(ctx, &proto.StakeActivationRequest{Pubkey: *pubkey, Epoch: *epoch})
+	if err != nil {
+		fatalf("Error getting stake activation: %v", err)
+	}
+	fmt.Printf("Stake account %s is %s\n", resp.Pubkey, strings.ToLower(strings.TrimPrefix(resp.State.String(), "STAKE_ACTIVATION_STATE_")))
+	fmt.Printf("  Active:   %d lamports\n", resp.ActiveLamports)
+	fmt.Printf("  Inactive: %d lamports\n", resp.InactiveLamports)
+	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+}
+
+func getInflationReward(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *rewardAddrs == "" {
+		fatal("--reward-addresses is required")
+	}
+	resp, err := client.GetInflationReward(ctx, &proto.InflationRewardRequest{
+		Addresses:  strings.Split(*rewardAddrs, ","),
+		FirstEpoch: *epoch,
+		LastEpoch:  *toEpoch,
+	})
+	if err != nil {
+		fatalf("Error getting inflation rewards: %v", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Epoch", "Address", "Reward (lamports)", "Post Balance", "Slot", "Commission"})
+	for _, rewards := range resp.Epochs {
+		for _, reward := range rewards.Rewards {
+			if !reward.Credited {
+				table.Append([]string{fmt.Sprintf("%d", rewards.Epoch), reward.Address, "none", "", "", ""})
+				continue
+			}
+			table.Append([]string{
+				fmt.Sprintf("%d", rewards.Epoch),
+				reward.Address,
+				fmt.Sprintf("%d", reward.AmountLamports),
+				fmt.Sprintf("%d", reward.PostBalanceLamports),
+				fmt.Sprintf("%d", reward.EffectiveSlot),
+				fmt.Sprintf("%d%%", reward.Commission),
+			})
+		}
+	}
+	table.Render()
+	fmt.Printf("Response Time: %d ms\n", resp.ResponseTimeMs)
+}
+
+func listOwnedAccounts(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *programID == "" {
+		fatal("--program is required")
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Pubkey", "Lamports", "Data (bytes)", "Slot"})
+	var resp *proto.ListOwnedAccountsResponse
+	token := ""
+	for {
+		var err error
+		resp, err = client.ListOwnedAccounts(ctx, &proto.ListOwnedAccountsRequest{
+			Owner:      *programID,
+			Commitment: *commitment,
+			PageSize:   uint32(*limit),
+			PageToken:  token,
+		})
+		if err != nil {
+			fatalf("Error listing owned accounts: %v", err)
+		}
+		for _, account := range resp.Accounts {
+			table.Append([]string{
+				account.Pubkey,
+				fmt.Sprintf("%d", account.Lamports),
+				fmt.Sprintf("%d", len(account.Data)),
+				fmt.Sprintf("%d", account.Slot),
+			})
+		}
+		if token = resp.NextPageToken; token == "" {
+			break
+		}
+	}
+	table.Render()
+	fmt.Printf("Accounts: %d (as of slot %d)\n", resp.TotalAccounts, resp.IndexSlot)
+	if resp.PollIntervalMs > 0 {
+		fmt.Printf("Kept current by polling every %d ms\n", resp.PollIntervalMs)
+	}
+}
+
+func streamBlockProduction(ctx context.Context, client proto.BenchmarkServiceClient) {
+	streamLogf("Streaming block production...\n")
+	stream, err := client.StreamBlockProduction(ctx, &proto.BlockProductionStreamRequest{
+		Identity:    *pubkey,
+		Commitment:  *commitment,
+		Compression: *compression,
+	})
+	if err != nil {
+		fatalf("Error streaming block production: %v", err)
+	}
+
+	for {
+		production, err := stream.Recv()
+		if err != nil {
+			fatalf("Error receiving block production: %v", err)
+		}
+		if emit(production) {
+			continue
+		}
+		fmt.Println()
+		printBlockProduction(production)
+	}
+}
+
+// printBlockProduction prints the totals of a block production range and its
+// validators, the --limit with the most leader slots if set
+func printBlockProduction(production *proto.BlockProduction) {
+	label := "so far"
+	if production.EpochComplete {
+		label = "complete"
+	}
+	fmt.Printf("Epoch %d, slots %d-%d (%s): %d leader slots, %d blocks, %.2f%% skipped\n",
+		production.Epoch, production.FirstSlot, production.LastSlot, label,
+		production.LeaderSlots, production.BlocksProduced, production.SkipRate*100)
+
+	validators := production.Validators
+	if *limit > 0 && len(validators) > int(*limit) {
+		validators = validators[:*limit]
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Identity", "Leader Slots", "Blocks", "Skipped", "Skip Rate"})
+	for _, v := range validators {
+		table.Append([]string{
+			v.Identity,
+			fmt.Sprintf("%d", v.LeaderSlots),
+			fmt.Sprintf("%d", v.BlocksProduced),
+			fmt.Sprintf("%d", v.SkippedSlots),
+			fmt.Sprintf("%.2f%%", v.SkipRate*100),
+		})
+	}
+	table.Render()
+}
+
+func streamEpochEvents(ctx context.Context, client proto.BenchmarkServiceClient) {
+	var addresses []string
+	if *rewardAddrs != "" {
+		addresses = strings.Split(*rewardAddrs, ",")
+	}
+	streamLogf("Streaming epoch events...\n")
+	stream, err := client.StreamEpochEvents(ctx, &proto.EpochEventsRequest{
+		IncludeCurrent:  true,
+		RewardAddresses: addresses,
+		Compression:     *compression,
+	})
+	if err != nil {
+		fatalf("Error streaming epoch events: %v", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			fatalf("Error receiving epoch event: %v", err)
+		}
+		if emit(event) {
+			continue
+		}
+
+		switch event.Type {
+		case proto.EpochEventType_EPOCH_EVENT_TYPE_CURRENT:
+			fmt.Printf("\nEpoch %d in progress (slots %d-%d, at slot %d)\n", event.Epoch, event.FirstSlot, event.LastSlot, event.ObservedSlot)
+		case proto.EpochEventType_EPOCH_EVENT_TYPE_STARTED:
+			fmt.Printf("\nEpoch %d started (slots %d-%d, seen at slot %d)\n", event.Epoch, event.FirstSlot, event.LastSlot, event.ObservedSlot)
+		case proto.EpochEventType_EPOCH_EVENT_TYPE_INFLATION:
+			fmt.Printf("\nEpoch %d inflation reported\n", event.Epoch)
+		case proto.EpochEventType_EPOCH_EVENT_TYPE_REWARDS:
+			fmt.Printf("\nEpoch %d rewards:\n", event.Epoch)
+			for _, reward := range event.Rewards {
+				if !reward.Credited {
+					fmt.Printf("  %s: not credited\n", reward.Address)
+					continue
+				}
+				fmt.Printf("  %s: %d lamports at slot %d (balance %d)\n", reward.Address, reward.AmountLamports, reward.EffectiveSlot, reward.PostBalanceLamports)
+			}
+		}
+		if inflation := event.Inflation; inflation != nil {
+			fmt.Printf("  Inflation: %.4f%% total, %.4f%% to validators, %.4f%% to the foundation\n",
+				inflation.Total*100, inflation.Validator*100, inflation.Foundation*100)
+		}
+	}
+}
+
+func streamOwnedAccounts(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *programID == "" {
+		fatal("--program is required")
+	}
+
+	streamLogf("Streaming changes to accounts owned by %s...\n", *programID)
+	stream, err := client.StreamOwnedAccountChanges(ctx, &proto.OwnedAccountChangesRequest{
+		Owner:           *programID,
+		Commitment:      *commitment,
+		IncludeSnapshot: *snapshot,
+		Compression:     *compression,
+		ClosedOnly:      *closedOnly,
+	})
+	if err != nil {
+		fatalf("Error streaming owned account changes: %v", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			fatalf("Error receiving owned account change: %v", err)
+		}
+		if emit(event) {
+			continue
+		}
+
+		if event.Type == proto.OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_SNAPSHOT_COMPLETE {
+			fmt.Printf("Snapshot complete: %d accounts\n", event.TotalAccounts)
+			continue
+		}
+		kind := strings.ToLower(strings.TrimPrefix(event.Type.String(), "OWNED_ACCOUNT_EVENT_TYPE_"))
+		if event.Resync {
+			kind += " (resync)"
+		}
+		account := event.Account
+		if closure := event.Closure; closure != nil {
+			reason := strings.ToLower(strings.TrimPrefix(closure.Reason.String(), "ACCOUNT_CLOSURE_REASON_"))
+			fmt.Printf("%s %s slot=%d %s, reclaimed %d of %d lamports, %d accounts\n",
+				kind, account.Pubkey, account.Slot, reason, closure.ReclaimedLamports, closure.PreviousLamports, event.TotalAccounts)
+			continue
+		}
+		fmt.Printf("%s %s lamports=%d slot=%d data=%d bytes, %d accounts\n",
+			kind, account.Pubkey, account.Lamports, account.Slot, len(account.Data), event.TotalAccounts)
+	}
+}
+
+func streamAddressHistory(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *pubkey == "" {
+		fatal("--pubkey is required")
+	}
+
+	streamLogf("Streaming transaction history of %s...\n", *pubkey)
+	stream, err := client.StreamAddressHistory(ctx, &proto.AddressHistoryRequest{
+		Address:      *pubkey,
+		Until:        *untilSig,
+		Before:       *beforeSig,
+		Limit:        uint32(*limit),
+		ContinueLive: *live,
+		Commitment:   *commitment,
+		Compression:  *compression,
+		Qos:          streamQoS(),
+	})
+	if err != nil {
+		fatalf("Error streaming address history: %v", err)
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			fatalf("Error receiving address history: %v", err)
+		}
+		if emit(update) {
+			continue
+		}
+
+		if update.HistoryComplete {
+			if update.Truncated {
+				fmt.Println("\nHistory reached the limit; older transactions may exist")
+			}
+			fmt.Printf("\nHistory complete, cursor %s\n", update.Cursor)
+			continue
+		}
+		tx := update.Transaction
+		kind := "History"
+		if update.Live {
+			kind = "Live"
+		}
+		fmt.Printf("[%s] slot %d %s success=%t transfers=%d swaps=%d\n", kind, tx.Slot, tx.Signature, tx.Success, len(tx.TokenTransfers), len(tx.Swaps))
+	}
+}
+
+func slotStatusName(status proto.SlotStatus) string {
+	switch status {
+	case proto.SlotStatus_SLOT_STATUS_FINALIZED:
+		return "finalized"
+	case proto.SlotStatus_SLOT_STATUS_CONFIRMED:
+		return "confirmed"
+	default:
+		return "processed"
+	}
+}
+
+func streamSLOEvents(ctx context.Context, client proto.BenchmarkServiceClient) {
+	streamLogf("Streaming SLO events...\n")
+	stream, err := client.StreamSLOEvents(ctx, &proto.SLOEventsRequest{IncludeCurrent: true})
+	if err != nil {
+		fatalf("Error streaming SLO events: %v", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			fatalf("Error receiving SLO event: %v", err)
+		}
+		if emit(event) {
+			continue
+		}
+
+		switch event.Type {
+		case proto.SLOEventType_SLO_EVENT_TYPE_VIOLATED:
+			fmt.Printf("\nViolated at %s\n", time.Unix(int64(event.Timestamp), 0).Format(time.TimeOnly))
+		case proto.SLOEventType_SLO_EVENT_TYPE_RECOVERED:
+			fmt.Printf("\nRecovered at %s\n", time.Unix(int64(event.Timestamp), 0).Format(time.TimeOnly))
+		default:
+			fmt.Println()
+		}
+		printSLOStatus(event.Status)
 	}
 }
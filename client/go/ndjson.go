@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ndjsonOptions marshals stream updates with their proto field names, as the
+// server's documentation uses them
+var ndjsonOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+// emit writes a stream update to stdout as one line of JSON under --ndjson,
+// reporting whether it did; otherwise the caller prints the update itself
+func emit(update protobuf.Message) bool {
+	if !*ndjson {
+		return false
+	}
+	data, err := ndjsonOptions.Marshal(update)
+	if err != nil {
+		fatalf("Error encoding update: %v", err)
+	}
+	if _, err := fmt.Fprintf(os.Stdout, "%s\n", data); err != nil {
+		// The reader went away, e.g. a pipe into head closed
+		os.Exit(0)
+	}
+	return true
+}
+
+// streamLogf prints a progress message of a stream command. Under --ndjson it
+// goes to stderr, keeping stdout to updates.
+func streamLogf(format string, args ...interface{}) {
+	if *ndjson {
+		log.Printf(format, args...)
+		return
+	}
+	fmt.Printf(format, args...)
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/quicconn"
+	"github.com/i-tozer/solana-grpc-exploration/server/stats"
+	"github.com/olekukonko/tablewriter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// dialQUIC dials the server's experimental QUIC listener at --quic-server
+// with the command's options. QUIC always runs TLS, verified as it would be
+// with --tls; dialed reports how long each QUIC handshake took, if not nil.
+func dialQUIC(ctx context.Context, dialed func(time.Duration)) (*grpc.ClientConn, error) {
+	tlsConfig := clientTLSConfig()
+	dialer := func(ctx context.Context, addr string) (net.Conn, error) {
+		start := time.Now()
+		conn, err := quicconn.Dial(ctx, addr, tlsConfig)
+		if err == nil && dialed != nil {
+			dialed(time.Since(start))
+		}
+		return conn, err
+	}
+	_, opts := dialTarget()
+	opts = append(opts,
+		grpc.WithTransportCredentials(quicconn.Credentials(credentials.NewTLS(tlsConfig))),
+		grpc.WithContextDialer(dialer),
+	)
+	return grpc.DialContext(ctx, *quicServer, opts...)
+}
+
+// connectQUIC dials a new QUIC connection to the server and waits until it
+// is ready. The handshake is QUIC's, which sets up the transport and TLS
+// together.
+func connectQUIC(ctx context.Context) (connect, handshake time.Duration, err error) {
+	start := time.Now()
+	conn, err := dialQUIC(ctx, func(d time.Duration) { handshake = d })
+	if err != nil {
+		return 0, 0, err
+	}
+	defer conn.Close()
+
+	if err := waitReady(ctx, conn); err != nil {
+		return 0, 0, err
+	}
+	return time.Since(start), handshake, nil
+}
+
+// quicCategory holds the latencies of one call over gRPC's HTTP/2 transport
+// and over QUIC
+type quicCategory struct {
+	name        string
+	http2, quic []float64
+	http2Failed int
+	quicFailed  int
+	http2Call   func() error
+	quicCall    func() error
+}
+
+// measure times one call on each transport, alternating which goes first
+func (c *quicCategory) measure(http2First bool) {
+	if http2First {
+		timeCall(&c.http2, &c.http2Failed, c.http2Call)
+		timeCall(&c.quic, &c.quicFailed, c.quicCall)
+		return
+	}
+	timeCall(&c.quic, &c.quicFailed, c.quicCall)
+	timeCall(&c.http2, &c.http2Failed, c.http2Call)
+}
+
+// runBenchmarkQUIC times the same calls to the server over HTTP/2 at
+// --server and over QUIC at --quic-server, after timing new connections on
+// each, so the transports are compared with the server and upstream alike
+func runBenchmarkQUIC(ctx context.Context, client proto.BenchmarkServiceClient) {
+	if *quicServer == "" {
+		fatal("--quic-server is required")
+	}
+	calls := unaryCalls(ctx)
+
+	conn, err := dialQUIC(ctx, nil)
+	if err != nil {
+		fatalf("Error dialing QUIC: %v", err)
+	}
+	defer conn.Close()
+	quicClient := proto.NewBenchmarkServiceClient(conn)
+
+	categories := make([]*quicCategory, len(calls))
+	for i, call := range calls {
+		categories[i] = &quicCategory{
+			name:      call.name,
+			http2Call: func() error { return call.call(client) },
+			quicCall:  func() error { return call.call(quicClient) },
+		}
+	}
+
+	// Time new connections on each transport, then warm both channels up
+	http2Setup := &connectionSetup{name: "HTTP/2", tls: useTLS()}
+	quicSetup := &connectionSetup{name: "QUIC", tls: true}
+	for i := 0; i < connectionSetupSamples; i++ {
+		connect, handshake, err := connectGRPC(ctx)
+		http2Setup.record(connect, handshake, err)
+		connect, handshake, err = connectQUIC(ctx)
+		quicSetup.record(connect, handshake, err)
+	}
+	for _, category := range categories {
+		category.http2Call()
+		category.quicCall()
+	}
+
+	fmt.Printf("Benchmarking gRPC over HTTP/2 via %s against QUIC via %s...\n\n", *serverAddr, *quicServer)
+	for i := 0; i < int(*iterations); i++ {
+		for _, category := range categories {
+			category.measure(i%2 == 0)
+		}
+	}
+
+	fmt.Println("QUIC Benchmark Results (client-observed):")
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Category", "Transport", "Avg (ms)", "P50 (ms)", "P99 (ms)", "Max (ms)", "Successful", "Failed"})
+	for _, category := range categories {
+		for _, transport := range []struct {
+			name    string
+			samples []float64
+			failed  int
+		}{
+			{"HTTP/2", category.http2, category.http2Failed},
+			{"QUIC", category.quic, category.quicFailed},
+		} {
+			summary := stats.Summarize(transport.samples)
+			table.Append([]string{
+				category.name,
+				transport.name,
+				fmt.Sprintf("%.2f", summary.Mean),
+				fmt.Sprintf("%.2f", summary.P50),
+				fmt.Sprintf("%.2f", summary.P99),
+				fmt.Sprintf("%.2f", summary.Max),
+				fmt.Sprintf("%d", len(transport.samples)),
+				fmt.Sprintf("%d", transport.failed),
+			})
+		}
+	}
+	table.Render()
+	fmt.Println()
+	printConnectionSetup([]*connectionSetup{http2Setup, quicSetup})
+
+	fmt.Println("QUIC vs HTTP/2 Speedup (Welch's t-test, HTTP/2 minus QUIC):")
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Category", "Speedup", "Mean Difference (ms)", "p-value", "Significant"})
+	for _, category := range categories {
+		http2Mean, quicMean := stats.Summarize(category.http2).Mean, stats.Summarize(category.quic).Mean
+		if http2Mean == 0 || quicMean == 0 {
+			continue
+		}
+		row := []string{category.name, fmt.Sprintf("%.2fx", http2Mean/quicMean), fmt.Sprintf("%.2f", http2Mean-quicMean), "-", "-"}
+		if test, err := stats.WelchTTest(category.quic, category.http2); err == nil {
+			row[3] = fmt.Sprintf("%.4f", test.PValue)
+			row[4] = fmt.Sprintf("%t", test.Significant())
+		}
+		table.Append(row)
+	}
+	table.Render()
+}
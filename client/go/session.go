@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// streamStats measures the session of a stream command, summarized when the
+// stream ends, fails or is interrupted
+type streamStats struct {
+	mu         sync.Mutex
+	opened     bool
+	start      time.Time
+	last       time.Time
+	messages   uint64
+	bytes      uint64
+	gaps       time.Duration
+	maxLag     int64
+	reconnects int
+	printed    bool
+}
+
+// sessionStats is set while a stream command runs
+var sessionStats *streamStats
+
+// isStreamCommand reports whether a command streams until it is stopped or
+// the server ends the stream
+func isStreamCommand(command string) bool {
+	if command == "stream-benchmark" {
+		return false
+	}
+	return strings.HasPrefix(command, "stream-") || command == "commitment-latency"
+}
+
+// intercept measures the messages received on streams
+func (s *streamStats) intercept(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	cs, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	if !s.opened {
+		s.opened = true
+		s.start = time.Now()
+	}
+	s.mu.Unlock()
+	return &measuredStream{ClientStream: cs, stats: s}, nil
+}
+
+// watch counts the times conn reconnects and prints the summary when the
+// command is interrupted
+func (s *streamStats) watch(conn *grpc.ClientConn) {
+	go func() {
+		ready := false
+		for state := conn.GetState(); ; state = conn.GetState() {
+			if state == connectivity.Ready {
+				if ready {
+					s.mu.Lock()
+					s.reconnects++
+					s.mu.Unlock()
+				}
+				ready = true
+			}
+			if !conn.WaitForStateChange(context.Background(), state) {
+				return
+			}
+		}
+	}()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		printSessionSummary()
+		os.Exit(130)
+	}()
+}
+
+// measuredStream records every message received
+type measuredStream struct {
+	grpc.ClientStream
+	stats *streamStats
+}
+
+func (m *measuredStream) RecvMsg(msg interface{}) error {
+	if err := m.ClientStream.RecvMsg(msg); err != nil {
+		return err
+	}
+	now := time.Now()
+	size := 0
+	lag, hasLag := int64(0), false
+	if message, ok := msg.(protobuf.Message); ok {
+		size = protobuf.Size(message)
+		if sent, ok := messageTime(message); ok {
+			lag, hasLag = now.Unix()-sent, true
+		}
+	}
+
+	s := m.stats
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.messages > 0 {
+		s.gaps += now.Sub(s.last)
+	}
+	s.last = now
+	s.messages++
+	s.bytes += uint64(size)
+	if hasLag && lag > s.maxLag {
+		s.maxLag = lag
+	}
+	return nil
+}
+
+// messageTime returns the Unix second a message was built at by the server,
+// from its timestamp field or that of the update it wraps
+func messageTime(m protobuf.Message) (int64, bool) {
+	msg := m.ProtoReflect()
+	if fd := msg.Descriptor().Fields().ByName("update"); fd != nil && fd.Message() != nil && msg.Has(fd) {
+		msg = msg.Get(fd).Message()
+	}
+	fd := msg.Descriptor().Fields().ByName("timestamp")
+	if fd == nil || fd.Kind() != protoreflect.Uint64Kind {
+		return 0, false
+	}
+	sent := msg.Get(fd).Uint()
+	return int64(sent), sent > 0
+}
+
+// printSessionSummary prints the summary of the stream session once, if a
+// stream was opened. With --format json it is written to stderr as a JSON
+// object, like failures.
+func printSessionSummary() {
+	s := sessionStats
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.opened || s.printed {
+		return
+	}
+	s.printed = true
+
+	duration := time.Since(s.start)
+	var avgGap time.Duration
+	if s.messages > 1 {
+		avgGap = s.gaps / time.Duration(s.messages-1)
+	}
+
+	if *errFormat == "json" {
+		data, _ := json.Marshal(struct {
+			DurationMs int64  `json:"duration_ms"`
+			Messages   uint64 `json:"messages"`
+			Bytes      uint64 `json:"bytes"`
+			AvgGapMs   int64  `json:"avg_gap_ms"`
+			MaxLagS    int64  `json:"max_lag_s"`
+			Reconnects int    `json:"reconnects"`
+		}{duration.Milliseconds(), s.messages, s.bytes, avgGap.Milliseconds(), s.maxLag, s.reconnects})
+		fmt.Fprintln(os.Stderr, string(data))
+		return
+	}
+
+	streamLogf("\nSession Summary:\n")
+	streamLogf("  Duration:        %v\n", duration.Round(time.Millisecond))
+	streamLogf("  Messages:        %d\n", s.messages)
+	streamLogf("  Bytes:           %d\n", s.bytes)
+	streamLogf("  Avg Message Gap: %v\n", avgGap.Round(time.Microsecond))
+	streamLogf("  Max Lag:         %ds\n", s.maxLag)
+	streamLogf("  Reconnects:      %d\n", s.reconnects)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/i-tozer/solana-grpc-exploration/server/signing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// signerKey parses --verify-signer, the gateway responses must be signed by
+func signerKey() solana.PublicKey {
+	signer, err := solana.PublicKeyFromBase58(*signedBy)
+	if err != nil {
+		fatalf("Invalid --verify-signer: %v", err)
+	}
+	return signer
+}
+
+// verifyUnary fails the client unless every unary response is signed by the
+// expected gateway
+func verifyUnary(signer solana.PublicKey) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var trailer metadata.MD
+		if err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Trailer(&trailer))...); err != nil {
+			return err
+		}
+		message, ok := reply.(protobuf.Message)
+		if !ok {
+			return nil
+		}
+		hash, err := signing.Hash(message)
+		if err == nil {
+			err = signing.Verify(trailer, hash, signer)
+		}
+		if err != nil {
+			fail(exitUpstream, nil, "Unverified response from %s: %v", method, err)
+		}
+		return nil
+	}
+}
+
+// verifyStream fails the client unless a stream that ends normally carries a
+// signature by the expected gateway over the messages received
+func verifyStream(signer solana.PublicKey) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &verifiedStream{ClientStream: cs, method: method, signer: signer}, nil
+	}
+}
+
+// verifiedStream chains the hashes of the messages received, as the server
+// does those it sends, and checks the chain's signature at the end
+type verifiedStream struct {
+	grpc.ClientStream
+	method string
+	signer solana.PublicKey
+	chain  [32]byte
+}
+
+func (s *verifiedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if errors.Is(err, io.EOF) {
+		if verifyErr := signing.Verify(s.Trailer(), s.chain, s.signer); verifyErr != nil {
+			fail(exitUpstream, nil, "Unverified stream from %s: %v", s.method, verifyErr)
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if message, ok := m.(protobuf.Message); ok {
+		hash, hashErr := signing.Hash(message)
+		if hashErr != nil {
+			fail(exitUpstream, nil, "Unverified stream from %s: %v", s.method, hashErr)
+		}
+		s.chain = signing.Chain(s.chain, hash)
+	}
+	return nil
+}
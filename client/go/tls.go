@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// useTLS reports whether the server is dialed over TLS: with --tls, or when
+// a CA or client certificate is given
+func useTLS() bool {
+	return *tlsOn || *caCert != "" || *clientCert != ""
+}
+
+// transportCredentials returns the credentials the server is dialed with:
+// TLS verified against --ca-cert or the system roots, presenting
+// --client-cert to servers that require one, or plaintext
+func transportCredentials() credentials.TransportCredentials {
+	if !useTLS() {
+		return insecure.NewCredentials()
+	}
+	return credentials.NewTLS(clientTLSConfig())
+}
+
+// clientTLSConfig returns the TLS configuration of connections to the server,
+// from --ca-cert, --client-cert and --tls-server-name
+func clientTLSConfig() *tls.Config {
+	cfg := &tls.Config{ServerName: *tlsName, MinVersion: tls.VersionTLS12}
+	if *caCert != "" {
+		data, err := os.ReadFile(*caCert)
+		if err != nil {
+			fatalf("Invalid --ca-cert: %v", err)
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(data) {
+			fatalf("Invalid --ca-cert: no certificates found in %s", *caCert)
+		}
+	}
+	if *clientCert != "" || *clientKey != "" {
+		if *clientCert == "" || *clientKey == "" {
+			fatal("--client-cert and --client-key must be given together")
+		}
+		cert, err := tls.LoadX509KeyPair(*clientCert, *clientKey)
+		if err != nil {
+			fatalf("Invalid --client-cert: %v", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg
+}
+
+// handshakeTimer wraps transport credentials to record how long each client
+// handshake took
+type handshakeTimer struct {
+	credentials.TransportCredentials
+	mu         sync.Mutex
+	handshakes []time.Duration
+}
+
+func (h *handshakeTimer) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	start := time.Now()
+	conn, info, err := h.TransportCredentials.ClientHandshake(ctx, authority, conn)
+	if err == nil {
+		h.mu.Lock()
+		h.handshakes = append(h.handshakes, time.Since(start))
+		h.mu.Unlock()
+	}
+	return conn, info, err
+}
+
+func (h *handshakeTimer) Clone() credentials.TransportCredentials {
+	return &handshakeTimer{TransportCredentials: h.TransportCredentials.Clone()}
+}
+
+// last returns the duration of the latest handshake
+func (h *handshakeTimer) last() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.handshakes) == 0 {
+		return 0
+	}
+	return h.handshakes[len(h.handshakes)-1]
+}
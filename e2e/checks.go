@@ -0,0 +1,884 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+)
+
+// checkTimeout bounds each individual check
+const checkTimeout = 60 * time.Second
+
+// check exercises one RPC or stream against the validator
+type check struct {
+	name string
+	run  func(ctx context.Context, env *environment) error
+}
+
+var checks = []check{
+	{"GetAccountInfo", checkAccountInfo},
+	{"GetTransaction", checkTransaction},
+	{"GetBlock", checkBlock},
+	{"StreamAccountUpdates", checkStreamAccounts},
+	{"StreamAccountUpdateBatches", checkStreamAccountBatches},
+	{"StreamAccountUpdatesWithAck", checkStreamAccountsAck},
+	{"StreamProgramAccountsSnapshot", checkProgramAccounts},
+	{"MeasureCommitmentLatency", checkCommitmentLatency},
+	{"StreamTransactions", checkStreamTransactions},
+	{"StreamBlocks", checkStreamBlocks},
+	{"StreamBalanceChanges", checkBalanceChanges},
+	{"StreamAlerts", checkAlerts},
+	{"StreamFeeStats", checkFeeStats},
+	{"StreamAddressHistory", checkAddressHistory},
+	{"ReadConsistent", checkReadConsistent},
+	{"BuildTransferTransaction", checkBuildTransfer},
+	{"GetCurrentSlot", checkCurrentSlot},
+	{"GetBlockTime", checkBlockTime},
+	{"EstimateSlotAtTime", checkSlotAtTime},
+	{"GetEncodedBlock", checkEncodedBlock},
+	{"BenchmarkSerialization", checkSerialization},
+	{"GetCapabilities", checkCapabilities},
+	{"GetStreamStats", checkStreamStats},
+	{"GetUpstreamHealth", checkUpstreamHealth},
+	{"GetClusterNodes", checkClusterNodes},
+	{"GetBlockProduction", checkBlockProduction},
+	{"StreamBlockProduction", checkStreamBlockProduction},
+	{"StreamEpochEvents", checkEpochEvents},
+	{"GetInflationReward", checkInflationReward},
+	{"ListOwnedAccounts", checkListOwnedAccounts},
+	{"StreamOwnedAccountChanges", checkOwnedAccountChanges},
+	{"StartBenchmark", checkBenchmark},
+	{"RunBenchmark", checkRunBenchmark},
+	{"CancelBenchmark", checkCancelBenchmark},
+	{"BenchmarkDualServe", checkDualServe},
+	{"StreamBenchmark", checkStreamBenchmark},
+	{"FindProgramAddress", checkFindProgramAddress},
+	{"CreateWithSeed", checkCreateWithSeed},
+	{"GetAssociatedTokenAddress", checkAssociatedTokenAddress},
+	{"VerifySignature", checkVerifySignature},
+	{"DecodeTransaction", checkDecodeTransaction},
+}
+
+// runChecks runs every check, logging each result, and returns the number that failed
+func runChecks(ctx context.Context, env *environment) int {
+	failed := 0
+	for _, c := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		start := time.Now()
+		err := c.run(checkCtx, env)
+		cancel()
+
+		if err != nil {
+			failed++
+			log.Printf("FAIL %s: %v", c.name, err)
+			continue
+		}
+		log.Printf("PASS %s (%v)", c.name, time.Since(start).Round(time.Millisecond))
+	}
+	return failed
+}
+
+func checkAccountInfo(ctx context.Context, env *environment) error {
+	resp, err := env.client.GetAccountInfo(ctx, &proto.AccountInfoRequest{Pubkey: env.payer.PublicKey().String()})
+	if err != nil {
+		return err
+	}
+	if resp.Lamports == 0 {
+		return fmt.Errorf("payer has no lamports")
+	}
+	if resp.Owner != solana.SystemProgramID.String() {
+		return fmt.Errorf("payer owner is %s, want the system program", resp.Owner)
+	}
+	return nil
+}
+
+func checkTransaction(ctx context.Context, env *environment) error {
+	resp, err := env.client.GetTransaction(ctx, &proto.TransactionRequest{Signature: env.signature.String()})
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("transfer reported as failed")
+	}
+	if resp.Slot != env.slot {
+		return fmt.Errorf("transfer slot is %d, want %d", resp.Slot, env.slot)
+	}
+	return nil
+}
+
+func checkBlock(ctx context.Context, env *environment) error {
+	resp, err := env.client.GetBlock(ctx, &proto.BlockRequest{Slot: env.slot})
+	if err != nil {
+		return err
+	}
+	for _, sig := range resp.Transactions {
+		if sig == env.signature.String() {
+			return nil
+		}
+	}
+	return fmt.Errorf("block %d does not contain transfer %s", env.slot, env.signature)
+}
+
+// checkStreamAccounts expects the recipient snapshot followed by an update
+// caused by a new transfer
+func checkStreamAccounts(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamAccountUpdates(ctx, &proto.AccountStreamRequest{
+		Pubkeys:         []string{env.recipient.String()},
+		Commitment:      "confirmed",
+		IncludeSnapshot: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("no snapshot: %v", err)
+	}
+	if !snapshot.Snapshot {
+		return fmt.Errorf("first update is not a snapshot")
+	}
+
+	if _, err := env.transfer(ctx); err != nil {
+		return err
+	}
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("no update after transfer: %v", err)
+		}
+		if update.Lamports > snapshot.Lamports {
+			return nil
+		}
+	}
+}
+
+// checkStreamAccountBatches expects the snapshots of the payer and recipient
+// coalesced into one batch
+func checkStreamAccountBatches(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamAccountUpdateBatches(ctx, &proto.AccountStreamRequest{
+		Pubkeys:          []string{env.payer.PublicKey().String(), env.recipient.String()},
+		Commitment:       "confirmed",
+		IncludeSnapshot:  true,
+		BatchMaxMessages: 2,
+		BatchMaxWaitMs:   5000,
+	})
+	if err != nil {
+		return err
+	}
+
+	batch, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("no batch: %v", err)
+	}
+	if len(batch.Updates) != 2 {
+		return fmt.Errorf("batch has %d updates, want 2", len(batch.Updates))
+	}
+	for _, update := range batch.Updates {
+		if !update.Snapshot {
+			return fmt.Errorf("update of %s is not a snapshot", update.Pubkey)
+		}
+	}
+	return nil
+}
+
+// checkStreamAccountsAck expects a snapshot delivered with a session and cursor
+func checkStreamAccountsAck(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamAccountUpdatesWithAck(ctx)
+	if err != nil {
+		return err
+	}
+	err = stream.Send(&proto.AccountAckStreamRequest{
+		Subscription: &proto.AccountStreamRequest{
+			Pubkeys:         []string{env.payer.PublicKey().String()},
+			Commitment:      "confirmed",
+			IncludeSnapshot: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	update, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("no snapshot: %v", err)
+	}
+	if update.SessionId == "" || update.Cursor == 0 {
+		return fmt.Errorf("update has no session or cursor")
+	}
+	if err := stream.Send(&proto.AccountAckStreamRequest{AckCursor: update.Cursor}); err != nil {
+		return err
+	}
+	return stream.CloseSend()
+}
+
+// checkProgramAccounts expects the system program snapshot to include the payer
+func checkProgramAccounts(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamProgramAccountsSnapshot(ctx, &proto.ProgramAccountsSnapshotRequest{
+		ProgramId: solana.SystemProgramID.String(),
+		ChunkSize: 10,
+	})
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		for _, account := range chunk.Accounts {
+			if account.Pubkey == env.payer.PublicKey().String() {
+				found = true
+			}
+		}
+		if chunk.SnapshotComplete {
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("snapshot does not include the payer")
+	}
+	return nil
+}
+
+// checkCommitmentLatency expects at least one finalized sample
+func checkCommitmentLatency(ctx context.Context, env *environment) error {
+	stream, err := env.client.MeasureCommitmentLatency(ctx, &proto.CommitmentLatencyRequest{DurationSeconds: 30})
+	if err != nil {
+		return err
+	}
+	sample, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("no sample: %v", err)
+	}
+	if sample.ProcessedToFinalizedMs == 0 {
+		return fmt.Errorf("sample for slot %d has no latency", sample.Slot)
+	}
+	return nil
+}
+
+func checkStreamTransactions(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamTransactions(ctx, &proto.TransactionStreamRequest{})
+	if err != nil {
+		return err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return fmt.Errorf("no transaction update: %v", err)
+	}
+	return nil
+}
+
+// checkStreamBlocks expects a produced block at confirmed commitment
+func checkStreamBlocks(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamBlocks(ctx, &proto.BlockStreamRequest{Commitment: "confirmed"})
+	if err != nil {
+		return err
+	}
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("no block update: %v", err)
+		}
+		if !update.Skipped && update.Reorg == nil && !update.StatusChanged && update.Blockhash != "" {
+			return nil
+		}
+	}
+}
+
+// checkBenchmark runs a benchmark job to completion and expects every gRPC
+// and JSON-RPC request to succeed
+func checkBenchmark(ctx context.Context, env *environment) error {
+	job, err := env.client.StartBenchmark(ctx, &proto.BenchmarkRequest{
+		Iterations:      3,
+		TestAccounts:    []string{env.payer.PublicKey().String()},
+		TestSignatures:  []string{env.signature.String()},
+		TestSlots:       []uint64{env.slot},
+		RunClusterNodes: true,
+		RunGrpcTests:    true,
+		RunJsonrpcTests: true,
+		SolanaRpcUrl:    *rpcEndpoint,
+		BypassCache:     true,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Follow the job's progress until it finishes
+	stream, err := env.client.StreamBenchmarkProgress(ctx, &proto.BenchmarkJobRequest{JobId: job.JobId})
+	if err != nil {
+		return err
+	}
+	var progress *proto.BenchmarkProgress
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("progress stream failed: %v", err)
+		}
+		progress = update
+	}
+	if progress == nil || progress.CompletedRequests != progress.TotalRequests {
+		return fmt.Errorf("job finished without completing every request: %v", progress)
+	}
+
+	result, err := env.client.GetBenchmarkResult(ctx, &proto.BenchmarkJobRequest{JobId: job.JobId})
+	if err != nil {
+		return err
+	}
+	if result.State != proto.BenchmarkJobState_BENCHMARK_JOB_STATE_SUCCEEDED {
+		return fmt.Errorf("job finished in state %v", result.State)
+	}
+	results := result.Results
+
+	// Failures are only counted when some requests succeed, so check both
+	counts := map[string][2]uint32{
+		"account gRPC":           {results.AccountGrpc.GetSuccessfulRequests(), results.AccountGrpc.GetFailedRequests()},
+		"account JSON-RPC":       {results.AccountJsonrpc.GetSuccessfulRequests(), results.AccountJsonrpc.GetFailedRequests()},
+		"transaction gRPC":       {results.TransactionGrpc.GetSuccessfulRequests(), results.TransactionGrpc.GetFailedRequests()},
+		"transaction JSON-RPC":   {results.TransactionJsonrpc.GetSuccessfulRequests(), results.TransactionJsonrpc.GetFailedRequests()},
+		"block gRPC":             {results.BlockGrpc.GetSuccessfulRequests(), results.BlockGrpc.GetFailedRequests()},
+		"block JSON-RPC":         {results.BlockJsonrpc.GetSuccessfulRequests(), results.BlockJsonrpc.GetFailedRequests()},
+		"cluster nodes gRPC":     {results.ClusterNodesGrpc.GetSuccessfulRequests(), results.ClusterNodesGrpc.GetFailedRequests()},
+		"cluster nodes JSON-RPC": {results.ClusterNodesJsonrpc.GetSuccessfulRequests(), results.ClusterNodesJsonrpc.GetFailedRequests()},
+	}
+	for name, count := range counts {
+		succeeded, failed := count[0], count[1]
+		if succeeded == 0 || failed > 0 {
+			return fmt.Errorf("%s: %d requests succeeded, %d failed", name, succeeded, failed)
+		}
+	}
+	return nil
+}
+
+// checkRunBenchmark runs a small benchmark synchronously
+func checkRunBenchmark(ctx context.Context, env *environment) error {
+	results, err := env.client.RunBenchmark(ctx, &proto.BenchmarkRequest{
+		Iterations:     1,
+		TestAccounts:   []string{env.payer.PublicKey().String()},
+		TestSignatures: []string{env.signature.String()},
+		TestSlots:      []uint64{env.slot},
+		RunGrpcTests:   true,
+		BypassCache:    true,
+	})
+	if err != nil {
+		return err
+	}
+	if results.AccountGrpc.GetSuccessfulRequests() == 0 {
+		return fmt.Errorf("no account request succeeded")
+	}
+	return nil
+}
+
+// checkCancelBenchmark cancels a job too long to finish first
+func checkCancelBenchmark(ctx context.Context, env *environment) error {
+	job, err := env.client.StartBenchmark(ctx, &proto.BenchmarkRequest{
+		Iterations:   1000,
+		TestAccounts: []string{env.payer.PublicKey().String()},
+		RunGrpcTests: true,
+		BypassCache:  true,
+	})
+	if err != nil {
+		return err
+	}
+	cancelled, err := env.client.CancelBenchmark(ctx, &proto.BenchmarkJobRequest{JobId: job.JobId})
+	if err != nil {
+		return err
+	}
+	if cancelled.State != proto.BenchmarkJobState_BENCHMARK_JOB_STATE_CANCELLED {
+		return fmt.Errorf("job is in state %v after cancelling", cancelled.State)
+	}
+	return nil
+}
+
+// checkDualServe benchmarks every kind of target over both protocols
+func checkDualServe(ctx context.Context, env *environment) error {
+	results, err := env.client.BenchmarkDualServe(ctx, &proto.DualServeBenchmarkRequest{
+		TestAccounts:   []string{env.payer.PublicKey().String()},
+		TestSignatures: []string{env.signature.String()},
+		TestSlots:      []uint64{env.slot},
+		Iterations:     2,
+	})
+	if err != nil {
+		return err
+	}
+	for name, category := range map[string]*proto.DualServeCategory{
+		"account":     results.Account,
+		"transaction": results.Transaction,
+		"block":       results.Block,
+	} {
+		if category.GetGrpc().GetCalls() == 0 || category.GetJson().GetCalls() == 0 {
+			return fmt.Errorf("%s: not called over both protocols", name)
+		}
+	}
+	return nil
+}
+
+// checkStreamBenchmark compares slot delivery over both transports, as the
+// test validator does not serve blockSubscribe unless asked to
+func checkStreamBenchmark(ctx context.Context, env *environment) error {
+	results, err := env.client.StreamBenchmark(ctx, &proto.StreamBenchmarkRequest{
+		Kinds:           []proto.StreamBenchmarkKind{proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_SLOT},
+		DurationSeconds: 5,
+	})
+	if err != nil {
+		return err
+	}
+	if len(results.Categories) != 1 {
+		return fmt.Errorf("got %d categories, want 1", len(results.Categories))
+	}
+	category := results.Categories[0]
+	if category.Grpc.GetMessages() == 0 || category.Websocket.GetMessages() == 0 {
+		return fmt.Errorf("gRPC delivered %d updates and WebSocket %d (errors %q, %q)",
+			category.Grpc.GetMessages(), category.Websocket.GetMessages(), category.Grpc.GetError(), category.Websocket.GetError())
+	}
+	return nil
+}
+
+// checkBalanceChanges expects the recipient's balance change from a new transfer
+func checkBalanceChanges(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamBalanceChanges(ctx, &proto.BalanceChangeStreamRequest{
+		Accounts:   []string{env.recipient.String()},
+		Commitment: "confirmed",
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := env.transfer(ctx); err != nil {
+		return err
+	}
+	for {
+		block, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("no balance change after transfer: %v", err)
+		}
+		for _, change := range block.Changes {
+			if change.Account == env.recipient.String() && change.Delta == transferLamports {
+				return nil
+			}
+		}
+	}
+}
+
+// checkAlerts expects an alert for a new transfer to the recipient
+func checkAlerts(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamAlerts(ctx, &proto.AlertStreamRequest{
+		Conditions: []*proto.AlertCondition{{
+			Name:    "recipient",
+			Type:    proto.AlertConditionType_ALERT_CONDITION_LAMPORT_TRANSFER,
+			Account: env.recipient.String(),
+		}},
+		Commitment: "confirmed",
+	})
+	if err != nil {
+		return err
+	}
+	sig, err := env.transfer(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		alert, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("no alert for transfer: %v", err)
+		}
+		if alert.Signature == sig.String() {
+			return nil
+		}
+	}
+}
+
+func checkFeeStats(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamFeeStats(ctx, &proto.FeeStatsRequest{WindowSlots: 10, Commitment: "confirmed"})
+	if err != nil {
+		return err
+	}
+	stats, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("no fee stats: %v", err)
+	}
+	if stats.Blocks == 0 {
+		return fmt.Errorf("fee stats for slots %d-%d cover no blocks", stats.StartSlot, stats.EndSlot)
+	}
+	return nil
+}
+
+// checkAddressHistory expects the payer's history to include the first transfer
+func checkAddressHistory(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamAddressHistory(ctx, &proto.AddressHistoryRequest{
+		Address:    env.payer.PublicKey().String(),
+		Commitment: "confirmed",
+	})
+	if err != nil {
+		return err
+	}
+	found := false
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if update.Transaction.GetSignature() == env.signature.String() {
+			found = true
+		}
+		if update.HistoryComplete {
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("history does not include transfer %s", env.signature)
+	}
+	return nil
+}
+
+// checkReadConsistent expects both accounts read at one slot
+func checkReadConsistent(ctx context.Context, env *environment) error {
+	resp, err := env.client.ReadConsistent(ctx, &proto.ConsistentReadRequest{
+		Pubkeys:    []string{env.payer.PublicKey().String(), env.recipient.String()},
+		Commitment: "confirmed",
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.Accounts) != 2 {
+		return fmt.Errorf("got %d accounts, want 2", len(resp.Accounts))
+	}
+	for _, account := range resp.Accounts {
+		if !account.Exists {
+			return fmt.Errorf("account %s does not exist", account.Pubkey)
+		}
+	}
+	return nil
+}
+
+// checkBuildTransfer expects an unsigned transfer the payer must sign
+func checkBuildTransfer(ctx context.Context, env *environment) error {
+	tx, err := env.client.BuildTransferTransaction(ctx, &proto.TransferTransactionRequest{
+		From:     env.payer.PublicKey().String(),
+		To:       env.recipient.String(),
+		Lamports: transferLamports,
+	})
+	if err != nil {
+		return err
+	}
+	if len(tx.Transaction) == 0 || tx.Blockhash == "" {
+		return fmt.Errorf("transaction or blockhash missing")
+	}
+	if len(tx.Signers) != 1 || tx.Signers[0] != env.payer.PublicKey().String() {
+		return fmt.Errorf("signers are %v, want the payer", tx.Signers)
+	}
+	return nil
+}
+
+func checkCurrentSlot(ctx context.Context, env *environment) error {
+	resp, err := env.client.GetCurrentSlot(ctx, &proto.CurrentSlotRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.Slot < env.slot {
+		return fmt.Errorf("current slot %d is before the transfer slot %d", resp.Slot, env.slot)
+	}
+	return nil
+}
+
+func checkBlockTime(ctx context.Context, env *environment) error {
+	resp, err := env.client.GetBlockTime(ctx, &proto.BlockTimeRequest{Slot: env.slot})
+	if err != nil {
+		return err
+	}
+	if resp.BlockTime == 0 {
+		return fmt.Errorf("slot %d has no block time", env.slot)
+	}
+	return nil
+}
+
+// checkSlotAtTime expects the current time to map to a slot no earlier than
+// the transfer's
+func checkSlotAtTime(ctx context.Context, env *environment) error {
+	resp, err := env.client.EstimateSlotAtTime(ctx, &proto.SlotAtTimeRequest{UnixTime: time.Now().Unix()})
+	if err != nil {
+		return err
+	}
+	if resp.Slot < env.slot {
+		return fmt.Errorf("estimated slot %d is before the transfer slot %d", resp.Slot, env.slot)
+	}
+	return nil
+}
+
+func checkEncodedBlock(ctx context.Context, env *environment) error {
+	for _, encoding := range []proto.BlockEncoding{proto.BlockEncoding_BLOCK_ENCODING_PROTOBUF, proto.BlockEncoding_BLOCK_ENCODING_FLATBUFFERS} {
+		resp, err := env.client.GetEncodedBlock(ctx, &proto.EncodedBlockRequest{Slot: env.slot, Encoding: encoding})
+		if err != nil {
+			return fmt.Errorf("%v: %v", encoding, err)
+		}
+		if len(resp.Payload) == 0 {
+			return fmt.Errorf("%v: empty payload", encoding)
+		}
+	}
+	return nil
+}
+
+func checkSerialization(ctx context.Context, env *environment) error {
+	resp, err := env.client.BenchmarkSerialization(ctx, &proto.SerializationBenchmarkRequest{Slot: env.slot, Iterations: 3})
+	if err != nil {
+		return err
+	}
+	if resp.Transactions == 0 {
+		return fmt.Errorf("block %d has no transactions", env.slot)
+	}
+	return nil
+}
+
+// checkCapabilities expects the WebSocket upstream to be reported
+func checkCapabilities(ctx context.Context, env *environment) error {
+	resp, err := env.client.GetCapabilities(ctx, &proto.CapabilitiesRequest{})
+	if err != nil {
+		return err
+	}
+	if !resp.Websocket {
+		return fmt.Errorf("WebSocket upstream not reported")
+	}
+	return nil
+}
+
+func checkStreamStats(ctx context.Context, env *environment) error {
+	_, err := env.client.GetStreamStats(ctx, &proto.StreamStatsRequest{})
+	return err
+}
+
+func checkUpstreamHealth(ctx context.Context, env *environment) error {
+	resp, err := env.client.GetUpstreamHealth(ctx, &proto.UpstreamHealthRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.Websocket == nil {
+		return fmt.Errorf("no WebSocket health reported")
+	}
+	return nil
+}
+
+// checkClusterNodes expects the test validator to be the only node
+func checkClusterNodes(ctx context.Context, env *environment) error {
+	resp, err := env.client.GetClusterNodes(ctx, &proto.ClusterNodesRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.TotalNodes != 1 {
+		return fmt.Errorf("got %d nodes, want 1", resp.TotalNodes)
+	}
+	return nil
+}
+
+// checkBlockProduction expects the test validator to have produced blocks
+// this epoch
+func checkBlockProduction(ctx context.Context, env *environment) error {
+	resp, err := env.client.GetBlockProduction(ctx, &proto.BlockProductionRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.BlocksProduced == 0 {
+		return fmt.Errorf("no blocks produced in slots %d-%d", resp.FirstSlot, resp.LastSlot)
+	}
+	return nil
+}
+
+func checkStreamBlockProduction(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamBlockProduction(ctx, &proto.BlockProductionStreamRequest{IntervalSeconds: 1})
+	if err != nil {
+		return err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return fmt.Errorf("no block production update: %v", err)
+	}
+	return nil
+}
+
+// checkEpochEvents expects the current epoch to be reported first
+func checkEpochEvents(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamEpochEvents(ctx, &proto.EpochEventsRequest{IncludeCurrent: true})
+	if err != nil {
+		return err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return fmt.Errorf("no epoch event: %v", err)
+	}
+	return nil
+}
+
+// checkInflationReward expects the previous epoch to be reported for the
+// payer, which earns no rewards
+func checkInflationReward(ctx context.Context, env *environment) error {
+	resp, err := env.client.GetInflationReward(ctx, &proto.InflationRewardRequest{
+		Addresses: []string{env.payer.PublicKey().String()},
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.Epochs) != 1 {
+		return fmt.Errorf("got %d epochs, want 1", len(resp.Epochs))
+	}
+	return nil
+}
+
+// checkListOwnedAccounts expects the system program to own accounts
+func checkListOwnedAccounts(ctx context.Context, env *environment) error {
+	resp, err := env.client.ListOwnedAccounts(ctx, &proto.ListOwnedAccountsRequest{
+		Owner:       solana.SystemProgramID.String(),
+		Commitment:  "confirmed",
+		PageSize:    10,
+		ExcludeData: true,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.TotalAccounts == 0 || len(resp.Accounts) == 0 {
+		return fmt.Errorf("no accounts listed")
+	}
+	return nil
+}
+
+// checkOwnedAccountChanges expects a snapshot of the system program's accounts
+func checkOwnedAccountChanges(ctx context.Context, env *environment) error {
+	stream, err := env.client.StreamOwnedAccountChanges(ctx, &proto.OwnedAccountChangesRequest{
+		Owner:           solana.SystemProgramID.String(),
+		Commitment:      "confirmed",
+		IncludeSnapshot: true,
+		ExcludeData:     true,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return fmt.Errorf("no snapshot: %v", err)
+	}
+	return nil
+}
+
+// checkFindProgramAddress expects the PDA solana-go derives for the same seeds
+func checkFindProgramAddress(ctx context.Context, env *environment) error {
+	seeds := [][]byte{[]byte("e2e"), env.payer.PublicKey().Bytes()}
+	want, bump, err := solana.FindProgramAddress(seeds, solana.SystemProgramID)
+	if err != nil {
+		return err
+	}
+	resp, err := env.utils.FindProgramAddress(ctx, &proto.FindProgramAddressRequest{
+		ProgramId: solana.SystemProgramID.String(),
+		Seeds:     seeds,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Address != want.String() || resp.Bump != uint32(bump) {
+		return fmt.Errorf("got %s with bump %d, want %s with bump %d", resp.Address, resp.Bump, want, bump)
+	}
+	return nil
+}
+
+func checkCreateWithSeed(ctx context.Context, env *environment) error {
+	want, err := solana.CreateWithSeed(env.payer.PublicKey(), "e2e", solana.SystemProgramID)
+	if err != nil {
+		return err
+	}
+	resp, err := env.utils.CreateWithSeed(ctx, &proto.CreateWithSeedRequest{
+		Base:  env.payer.PublicKey().String(),
+		Seed:  "e2e",
+		Owner: solana.SystemProgramID.String(),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Address != want.String() {
+		return fmt.Errorf("got %s, want %s", resp.Address, want)
+	}
+	return nil
+}
+
+func checkAssociatedTokenAddress(ctx context.Context, env *environment) error {
+	mint := solana.SolMint
+	want, bump, err := solana.FindAssociatedTokenAddress(env.payer.PublicKey(), mint)
+	if err != nil {
+		return err
+	}
+	resp, err := env.utils.GetAssociatedTokenAddress(ctx, &proto.AssociatedTokenAddressRequest{
+		Wallet: env.payer.PublicKey().String(),
+		Mint:   mint.String(),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Address != want.String() || resp.Bump != uint32(bump) {
+		return fmt.Errorf("got %s with bump %d, want %s with bump %d", resp.Address, resp.Bump, want, bump)
+	}
+	return nil
+}
+
+// checkVerifySignature expects a payer signature to verify, and not to once
+// the message changes
+func checkVerifySignature(ctx context.Context, env *environment) error {
+	message := []byte("solana-grpc e2e")
+	signature, err := env.payer.Sign(message)
+	if err != nil {
+		return err
+	}
+	for _, test := range []struct {
+		message []byte
+		valid   bool
+	}{
+		{message, true},
+		{append(message, '!'), false},
+	} {
+		resp, err := env.utils.VerifySignature(ctx, &proto.VerifySignatureRequest{
+			Pubkey:    env.payer.PublicKey().String(),
+			Signature: signature.String(),
+			Message:   test.message,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.Valid != test.valid {
+			return fmt.Errorf("signature of %q reported valid %t, want %t", test.message, resp.Valid, test.valid)
+		}
+	}
+	return nil
+}
+
+// checkDecodeTransaction decodes the setup transfer as the validator stored it
+func checkDecodeTransaction(ctx context.Context, env *environment) error {
+	out, err := env.solanaClient.GetTransaction(ctx, env.signature, &rpc.GetTransactionOpts{
+		Encoding:   solana.EncodingBase64,
+		Commitment: rpc.CommitmentFinalized,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get transfer: %v", err)
+	}
+	resp, err := env.utils.DecodeTransaction(ctx, &proto.DecodeTransactionRequest{
+		Transaction: base64.StdEncoding.EncodeToString(out.Transaction.GetBinary()),
+		Encoding:    proto.TransactionEncoding_TRANSACTION_ENCODING_BASE64,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.SignaturesValid || len(resp.Signatures) != 1 || resp.Signatures[0].Signature != env.signature.String() {
+		return fmt.Errorf("signatures are %v, valid %t; want the transfer signature, valid", resp.Signatures, resp.SignaturesValid)
+	}
+	if resp.Version != "legacy" || len(resp.Instructions) != 1 || resp.Instructions[0].ProgramId != solana.SystemProgramID.String() {
+		return fmt.Errorf("got a %s transaction with %d instructions, want a legacy system transfer", resp.Version, len(resp.Instructions))
+	}
+	return nil
+}
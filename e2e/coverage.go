@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// unchecked lists the methods no check calls, with why the test validator or
+// the e2e server cannot exercise them. Every other method of the services the
+// e2e server registers must be called by a check.
+var unchecked = map[string]string{
+	"GetNonceAccount":               "needs a durable nonce account",
+	"GetTokenAccount":               "needs an SPL mint and token account",
+	"BuildTokenTransferTransaction": "needs an SPL mint and token account",
+	"GetNFTsByOwner":                "the test validator serves no DAS API",
+	"GetAsset":                      "the test validator serves no DAS API",
+	"GetAssetsByOwner":              "the test validator serves no DAS API",
+	"GetAssetProof":                 "the test validator serves no DAS API",
+	"GetStakeActivation":            "needs a stake account, and Agave 2.0 validators no longer serve it",
+	"GetAccountHistory":             "the e2e server runs without an account history recorder",
+	"HandoffAckSession":             "the e2e server is not part of a cluster",
+	"GetSLOStatus":                  "the e2e server has no latency objectives configured",
+	"StreamSLOEvents":               "the e2e server has no latency objectives configured",
+	"ListBenchmarks":                "the e2e server has no scheduled benchmarks",
+}
+
+// methodRecorder records the full name of every method called on a connection
+type methodRecorder struct {
+	mu     sync.Mutex
+	called map[string]bool
+}
+
+func newMethodRecorder() *methodRecorder {
+	return &methodRecorder{called: make(map[string]bool)}
+}
+
+func (r *methodRecorder) record(method string) {
+	r.mu.Lock()
+	r.called[method] = true
+	r.mu.Unlock()
+}
+
+// unary records unary calls
+func (r *methodRecorder) unary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	r.record(method)
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// stream records streaming calls
+func (r *methodRecorder) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	r.record(method)
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// uncovered returns the methods of every registered service that no check
+// called and that are not listed as unchecked
+func (r *methodRecorder) uncovered(services map[string]grpc.ServiceInfo) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var missing []string
+	for service, info := range services {
+		for _, m := range info.Methods {
+			if _, ok := unchecked[m.Name]; ok {
+				continue
+			}
+			if !r.called["/"+service+"/"+m.Name] {
+				missing = append(missing, m.Name)
+			}
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
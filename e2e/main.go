@@ -0,0 +1,281 @@
+// Command e2e runs the server against a local solana-test-validator and
+// exercises every RPC and stream end to end, without mainnet dependencies.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/services"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	attach       = flag.Bool("attach", false, "Attach to an already running validator at --rpc-endpoint instead of launching one")
+	rpcEndpoint  = flag.String("rpc-endpoint", "http://127.0.0.1:8899", "RPC endpoint of the test validator")
+	validatorBin = flag.String("validator-bin", "solana-test-validator", "Path to the solana-test-validator binary")
+	ledgerDir    = flag.String("ledger", "", "Ledger directory for a launched validator (a temporary directory if empty)")
+	timeout      = flag.Duration("timeout", 5*time.Minute, "Overall time limit for the run")
+)
+
+const (
+	// airdropLamports funds the payer keypair
+	airdropLamports = 10 * solana.LAMPORTS_PER_SOL
+	// transferLamports is sent by each generated transaction
+	transferLamports = 1_000_000
+	// validatorStartTimeout bounds how long a launched validator may take to become healthy
+	validatorStartTimeout = 60 * time.Second
+)
+
+func main() {
+	flag.Parse()
+
+	if !run() {
+		os.Exit(1)
+	}
+}
+
+// run launches the stack and runs every check, reporting whether all passed
+func run() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	// Launch the validator unless attaching to a running one
+	if !*attach {
+		stop, err := startValidator()
+		if err != nil {
+			log.Printf("failed to start validator: %v", err)
+			return false
+		}
+		defer stop()
+	}
+
+	solanaClient := rpc.New(*rpcEndpoint)
+	if err := waitHealthy(ctx, solanaClient); err != nil {
+		log.Printf("validator is not healthy: %v", err)
+		return false
+	}
+	log.Printf("Validator is healthy at %s", *rpcEndpoint)
+
+	// Run the server in-process on a local port
+	endpoint, err := upstream.NewEndpoint(*rpcEndpoint, upstream.WSEndpoint(*rpcEndpoint), nil)
+	if err != nil {
+		log.Printf("invalid endpoint: %v", err)
+		return false
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		log.Printf("failed to listen: %v", err)
+		return false
+	}
+	grpcServer := grpc.NewServer()
+	proto.RegisterBenchmarkServiceServer(grpcServer, services.NewBenchmarkService(endpoint))
+	proto.RegisterUtilsServiceServer(grpcServer, services.NewUtilsService())
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	recorder := newMethodRecorder()
+	conn, err := grpc.Dial(lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(recorder.unary),
+		grpc.WithStreamInterceptor(recorder.stream),
+	)
+	if err != nil {
+		log.Printf("did not connect: %v", err)
+		return false
+	}
+	defer conn.Close()
+
+	// Fund a keypair and generate a transaction to query
+	env, err := setup(ctx, solanaClient)
+	if err != nil {
+		log.Printf("setup failed: %v", err)
+		return false
+	}
+	env.client = proto.NewBenchmarkServiceClient(conn)
+	env.utils = proto.NewUtilsServiceClient(conn)
+	log.Printf("Funded payer %s, transfer %s in slot %d", env.payer.PublicKey(), env.signature, env.slot)
+
+	failed := runChecks(ctx, env)
+	// Every method must be exercised, so new ones cannot go untested
+	uncovered := recorder.uncovered(grpcServer.GetServiceInfo())
+	for _, method := range uncovered {
+		log.Printf("UNCHECKED %s", method)
+	}
+	if failed > 0 || len(uncovered) > 0 {
+		log.Printf("%d checks failed, %d methods unchecked", failed, len(uncovered))
+		return false
+	}
+	log.Println("All checks passed")
+	return true
+}
+
+// startValidator launches solana-test-validator on the port of --rpc-endpoint
+// and returns a function that stops it
+func startValidator() (func(), error) {
+	port, err := rpcPort(*rpcEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := *ledgerDir
+	removeLedger := false
+	if ledger == "" {
+		ledger, err = os.MkdirTemp("", "solana-grpc-e2e-ledger")
+		if err != nil {
+			return nil, err
+		}
+		removeLedger = true
+	}
+
+	cmd := exec.Command(*validatorBin, "--reset", "--quiet", "--ledger", ledger, "--rpc-port", port)
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	log.Printf("Launched %s (pid %d) with ledger %s", *validatorBin, cmd.Process.Pid, ledger)
+
+	return func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+		if removeLedger {
+			os.RemoveAll(ledger)
+		}
+	}, nil
+}
+
+// rpcPort returns the explicit port of an RPC endpoint
+func rpcPort(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Port() == "" {
+		return "", fmt.Errorf("rpc endpoint must include a port: %s", endpoint)
+	}
+	return u.Port(), nil
+}
+
+// waitHealthy polls the validator until it reports healthy
+func waitHealthy(ctx context.Context, client *rpc.Client) error {
+	ctx, cancel := context.WithTimeout(ctx, validatorStartTimeout)
+	defer cancel()
+
+	for {
+		health, err := client.GetHealth(ctx)
+		if err == nil && health == rpc.HealthOk {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for validator: %v", err)
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// environment is the chain state the checks run against
+type environment struct {
+	client       proto.BenchmarkServiceClient
+	utils        proto.UtilsServiceClient
+	solanaClient *rpc.Client
+	payer        solana.PrivateKey
+	recipient    solana.PublicKey
+	signature    solana.Signature
+	slot         uint64
+}
+
+// setup funds a fresh payer and sends a finalized transfer to a fresh recipient
+func setup(ctx context.Context, client *rpc.Client) (*environment, error) {
+	env := &environment{
+		solanaClient: client,
+		payer:        solana.NewWallet().PrivateKey,
+		recipient:    solana.NewWallet().PublicKey(),
+	}
+
+	airdrop, err := client.RequestAirdrop(ctx, env.payer.PublicKey(), airdropLamports, rpc.CommitmentFinalized)
+	if err != nil {
+		return nil, fmt.Errorf("airdrop failed: %v", err)
+	}
+	if _, err := waitForSignature(ctx, client, airdrop, rpc.ConfirmationStatusFinalized); err != nil {
+		return nil, fmt.Errorf("airdrop did not finalize: %v", err)
+	}
+
+	env.signature, err = env.transfer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	env.slot, err = waitForSignature(ctx, client, env.signature, rpc.ConfirmationStatusFinalized)
+	if err != nil {
+		return nil, fmt.Errorf("transfer did not finalize: %v", err)
+	}
+	return env, nil
+}
+
+// transfer sends lamports from the payer to the recipient without waiting for
+// confirmation
+func (env *environment) transfer(ctx context.Context) (solana.Signature, error) {
+	recent, err := env.solanaClient.GetLatestBlockhash(ctx, rpc.CommitmentFinalized)
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("failed to get blockhash: %v", err)
+	}
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			system.NewTransferInstruction(transferLamports, env.payer.PublicKey(), env.recipient).Build(),
+		},
+		recent.Value.Blockhash,
+		solana.TransactionPayer(env.payer.PublicKey()),
+	)
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
+		if key.Equals(env.payer.PublicKey()) {
+			return &env.payer
+		}
+		return nil
+	})
+	if err != nil {
+		return solana.Signature{}, err
+	}
+
+	sig, err := env.solanaClient.SendTransaction(ctx, tx)
+	if err != nil {
+		return solana.Signature{}, fmt.Errorf("failed to send transfer: %v", err)
+	}
+	return sig, nil
+}
+
+// waitForSignature polls until a transaction reaches the given confirmation
+// status and returns its slot
+func waitForSignature(ctx context.Context, client *rpc.Client, sig solana.Signature, want rpc.ConfirmationStatusType) (uint64, error) {
+	for {
+		statuses, err := client.GetSignatureStatuses(ctx, false, sig)
+		if err == nil && len(statuses.Value) == 1 && statuses.Value[0] != nil {
+			st := statuses.Value[0]
+			if st.Err != nil {
+				return 0, fmt.Errorf("transaction failed: %v", st.Err)
+			}
+			if st.ConfirmationStatus == want || st.ConfirmationStatus == rpc.ConfirmationStatusFinalized {
+				return st.Slot, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,684 +21,1136 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// AccountInfoRequest represents a request for account information
-type AccountInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// TokenAccountStatus is the state of a token account
+type TokenAccountStatus int32
 
-	Pubkey         string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	Commitment     string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
-	EncodingBinary bool   `protobuf:"varint,3,opt,name=encoding_binary,json=encodingBinary,proto3" json:"encoding_binary,omitempty"`
-}
+const (
+	TokenAccountStatus_TOKEN_ACCOUNT_STATUS_UNINITIALIZED TokenAccountStatus = 0
+	TokenAccountStatus_TOKEN_ACCOUNT_STATUS_INITIALIZED   TokenAccountStatus = 1
+	TokenAccountStatus_TOKEN_ACCOUNT_STATUS_FROZEN        TokenAccountStatus = 2
+)
 
-func (x *AccountInfoRequest) Reset() {
-	*x = AccountInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for TokenAccountStatus.
+var (
+	TokenAccountStatus_name = map[int32]string{
+		0: "TOKEN_ACCOUNT_STATUS_UNINITIALIZED",
+		1: "TOKEN_ACCOUNT_STATUS_INITIALIZED",
+		2: "TOKEN_ACCOUNT_STATUS_FROZEN",
 	}
+	TokenAccountStatus_value = map[string]int32{
+		"TOKEN_ACCOUNT_STATUS_UNINITIALIZED": 0,
+		"TOKEN_ACCOUNT_STATUS_INITIALIZED":   1,
+		"TOKEN_ACCOUNT_STATUS_FROZEN":        2,
+	}
+)
+
+func (x TokenAccountStatus) Enum() *TokenAccountStatus {
+	p := new(TokenAccountStatus)
+	*p = x
+	return p
 }
 
-func (x *AccountInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x TokenAccountStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*AccountInfoRequest) ProtoMessage() {}
+func (TokenAccountStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[0].Descriptor()
+}
 
-func (x *AccountInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (TokenAccountStatus) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[0]
 }
 
-// Deprecated: Use AccountInfoRequest.ProtoReflect.Descriptor instead.
-func (*AccountInfoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{0}
+func (x TokenAccountStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *AccountInfoRequest) GetPubkey() string {
-	if x != nil {
-		return x.Pubkey
-	}
-	return ""
+// Deprecated: Use TokenAccountStatus.Descriptor instead.
+func (TokenAccountStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *AccountInfoRequest) GetCommitment() string {
-	if x != nil {
-		return x.Commitment
+type TransactionEncoding int32
+
+const (
+	TransactionEncoding_TRANSACTION_ENCODING_BASE64 TransactionEncoding = 0
+	TransactionEncoding_TRANSACTION_ENCODING_BASE58 TransactionEncoding = 1
+)
+
+// Enum value maps for TransactionEncoding.
+var (
+	TransactionEncoding_name = map[int32]string{
+		0: "TRANSACTION_ENCODING_BASE64",
+		1: "TRANSACTION_ENCODING_BASE58",
 	}
-	return ""
+	TransactionEncoding_value = map[string]int32{
+		"TRANSACTION_ENCODING_BASE64": 0,
+		"TRANSACTION_ENCODING_BASE58": 1,
+	}
+)
+
+func (x TransactionEncoding) Enum() *TransactionEncoding {
+	p := new(TransactionEncoding)
+	*p = x
+	return p
 }
 
-func (x *AccountInfoRequest) GetEncodingBinary() bool {
-	if x != nil {
-		return x.EncodingBinary
-	}
-	return false
+func (x TransactionEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// AccountInfoResponse represents the response with account information
-type AccountInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (TransactionEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[1].Descriptor()
+}
 
-	Pubkey         string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	Data           []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Owner          string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	Lamports       uint64 `protobuf:"varint,4,opt,name=lamports,proto3" json:"lamports,omitempty"`
-	Executable     bool   `protobuf:"varint,5,opt,name=executable,proto3" json:"executable,omitempty"`
-	RentEpoch      uint64 `protobuf:"varint,6,opt,name=rent_epoch,json=rentEpoch,proto3" json:"rent_epoch,omitempty"`
-	ResponseTimeMs uint64 `protobuf:"varint,7,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+func (TransactionEncoding) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[1]
 }
 
-func (x *AccountInfoResponse) Reset() {
-	*x = AccountInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x TransactionEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *AccountInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use TransactionEncoding.Descriptor instead.
+func (TransactionEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{1}
 }
 
-func (*AccountInfoResponse) ProtoMessage() {}
+type WebSocketState int32
 
-func (x *AccountInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// The server does not monitor the WebSocket endpoint
+	WebSocketState_WEBSOCKET_STATE_UNMONITORED  WebSocketState = 0
+	WebSocketState_WEBSOCKET_STATE_CONNECTING   WebSocketState = 1
+	WebSocketState_WEBSOCKET_STATE_CONNECTED    WebSocketState = 2
+	WebSocketState_WEBSOCKET_STATE_DISCONNECTED WebSocketState = 3
+)
+
+// Enum value maps for WebSocketState.
+var (
+	WebSocketState_name = map[int32]string{
+		0: "WEBSOCKET_STATE_UNMONITORED",
+		1: "WEBSOCKET_STATE_CONNECTING",
+		2: "WEBSOCKET_STATE_CONNECTED",
+		3: "WEBSOCKET_STATE_DISCONNECTED",
 	}
-	return mi.MessageOf(x)
-}
+	WebSocketState_value = map[string]int32{
+		"WEBSOCKET_STATE_UNMONITORED":  0,
+		"WEBSOCKET_STATE_CONNECTING":   1,
+		"WEBSOCKET_STATE_CONNECTED":    2,
+		"WEBSOCKET_STATE_DISCONNECTED": 3,
+	}
+)
 
-// Deprecated: Use AccountInfoResponse.ProtoReflect.Descriptor instead.
-func (*AccountInfoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{1}
+func (x WebSocketState) Enum() *WebSocketState {
+	p := new(WebSocketState)
+	*p = x
+	return p
 }
 
-func (x *AccountInfoResponse) GetPubkey() string {
-	if x != nil {
-		return x.Pubkey
-	}
-	return ""
+func (x WebSocketState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *AccountInfoResponse) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+func (WebSocketState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[2].Descriptor()
 }
 
-func (x *AccountInfoResponse) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
+func (WebSocketState) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[2]
 }
 
-func (x *AccountInfoResponse) GetLamports() uint64 {
-	if x != nil {
-		return x.Lamports
-	}
-	return 0
+func (x WebSocketState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *AccountInfoResponse) GetExecutable() bool {
-	if x != nil {
-		return x.Executable
-	}
-	return false
+// Deprecated: Use WebSocketState.Descriptor instead.
+func (WebSocketState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *AccountInfoResponse) GetRentEpoch() uint64 {
-	if x != nil {
-		return x.RentEpoch
+// StreamSource says where the updates of a stream come from
+type StreamSource int32
+
+const (
+	// The stream cannot be served by this deployment
+	StreamSource_STREAM_SOURCE_UNAVAILABLE StreamSource = 0
+	// A fixed number of polls for demonstration, not a live feed
+	StreamSource_STREAM_SOURCE_SIMULATED StreamSource = 1
+	// Live updates read by polling the RPC upstream
+	StreamSource_STREAM_SOURCE_POLLED StreamSource = 2
+	// Live updates pushed by an upstream subscription
+	StreamSource_STREAM_SOURCE_PUSHED StreamSource = 3
+)
+
+// Enum value maps for StreamSource.
+var (
+	StreamSource_name = map[int32]string{
+		0: "STREAM_SOURCE_UNAVAILABLE",
+		1: "STREAM_SOURCE_SIMULATED",
+		2: "STREAM_SOURCE_POLLED",
+		3: "STREAM_SOURCE_PUSHED",
 	}
-	return 0
+	StreamSource_value = map[string]int32{
+		"STREAM_SOURCE_UNAVAILABLE": 0,
+		"STREAM_SOURCE_SIMULATED":   1,
+		"STREAM_SOURCE_POLLED":      2,
+		"STREAM_SOURCE_PUSHED":      3,
+	}
+)
+
+func (x StreamSource) Enum() *StreamSource {
+	p := new(StreamSource)
+	*p = x
+	return p
 }
 
-func (x *AccountInfoResponse) GetResponseTimeMs() uint64 {
-	if x != nil {
-		return x.ResponseTimeMs
-	}
-	return 0
+func (x StreamSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// TransactionRequest represents a request for transaction information
-type TransactionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (StreamSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[3].Descriptor()
+}
 
-	Signature  string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+func (StreamSource) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[3]
 }
 
-func (x *TransactionRequest) Reset() {
-	*x = TransactionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x StreamSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TransactionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use StreamSource.Descriptor instead.
+func (StreamSource) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{3}
 }
 
-func (*TransactionRequest) ProtoMessage() {}
+// EpochEventType says what an epoch event reports
+type EpochEventType int32
 
-func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	EpochEventType_EPOCH_EVENT_TYPE_UNSPECIFIED EpochEventType = 0
+	// The epoch in progress when the stream opened
+	EpochEventType_EPOCH_EVENT_TYPE_CURRENT EpochEventType = 1
+	// A new epoch started
+	EpochEventType_EPOCH_EVENT_TYPE_STARTED EpochEventType = 2
+	// The inflation of an epoch that started before the upstream reported it
+	EpochEventType_EPOCH_EVENT_TYPE_INFLATION EpochEventType = 3
+	// The inflation rewards of the watched addresses for an epoch that ended
+	EpochEventType_EPOCH_EVENT_TYPE_REWARDS EpochEventType = 4
+)
+
+// Enum value maps for EpochEventType.
+var (
+	EpochEventType_name = map[int32]string{
+		0: "EPOCH_EVENT_TYPE_UNSPECIFIED",
+		1: "EPOCH_EVENT_TYPE_CURRENT",
+		2: "EPOCH_EVENT_TYPE_STARTED",
+		3: "EPOCH_EVENT_TYPE_INFLATION",
+		4: "EPOCH_EVENT_TYPE_REWARDS",
 	}
-	return mi.MessageOf(x)
+	EpochEventType_value = map[string]int32{
+		"EPOCH_EVENT_TYPE_UNSPECIFIED": 0,
+		"EPOCH_EVENT_TYPE_CURRENT":     1,
+		"EPOCH_EVENT_TYPE_STARTED":     2,
+		"EPOCH_EVENT_TYPE_INFLATION":   3,
+		"EPOCH_EVENT_TYPE_REWARDS":     4,
+	}
+)
+
+func (x EpochEventType) Enum() *EpochEventType {
+	p := new(EpochEventType)
+	*p = x
+	return p
 }
 
-// Deprecated: Use TransactionRequest.ProtoReflect.Descriptor instead.
-func (*TransactionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{2}
+func (x EpochEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *TransactionRequest) GetSignature() string {
-	if x != nil {
-		return x.Signature
-	}
-	return ""
+func (EpochEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[4].Descriptor()
 }
 
-func (x *TransactionRequest) GetCommitment() string {
-	if x != nil {
-		return x.Commitment
-	}
-	return ""
+func (EpochEventType) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[4]
 }
 
-// TransactionResponse represents the response with transaction information
-type TransactionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x EpochEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	Signature      string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	Slot           uint64 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Transaction    []byte `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	Success        bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	ResponseTimeMs uint64 `protobuf:"varint,5,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+// Deprecated: Use EpochEventType.Descriptor instead.
+func (EpochEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *TransactionResponse) Reset() {
-	*x = TransactionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// StakeActivationState is the activation state of a stake account
+type StakeActivationState int32
+
+const (
+	StakeActivationState_STAKE_ACTIVATION_STATE_UNSPECIFIED  StakeActivationState = 0
+	StakeActivationState_STAKE_ACTIVATION_STATE_ACTIVE       StakeActivationState = 1
+	StakeActivationState_STAKE_ACTIVATION_STATE_INACTIVE     StakeActivationState = 2
+	StakeActivationState_STAKE_ACTIVATION_STATE_ACTIVATING   StakeActivationState = 3
+	StakeActivationState_STAKE_ACTIVATION_STATE_DEACTIVATING StakeActivationState = 4
+)
+
+// Enum value maps for StakeActivationState.
+var (
+	StakeActivationState_name = map[int32]string{
+		0: "STAKE_ACTIVATION_STATE_UNSPECIFIED",
+		1: "STAKE_ACTIVATION_STATE_ACTIVE",
+		2: "STAKE_ACTIVATION_STATE_INACTIVE",
+		3: "STAKE_ACTIVATION_STATE_ACTIVATING",
+		4: "STAKE_ACTIVATION_STATE_DEACTIVATING",
 	}
+	StakeActivationState_value = map[string]int32{
+		"STAKE_ACTIVATION_STATE_UNSPECIFIED":  0,
+		"STAKE_ACTIVATION_STATE_ACTIVE":       1,
+		"STAKE_ACTIVATION_STATE_INACTIVE":     2,
+		"STAKE_ACTIVATION_STATE_ACTIVATING":   3,
+		"STAKE_ACTIVATION_STATE_DEACTIVATING": 4,
+	}
+)
+
+func (x StakeActivationState) Enum() *StakeActivationState {
+	p := new(StakeActivationState)
+	*p = x
+	return p
 }
 
-func (x *TransactionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x StakeActivationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*TransactionResponse) ProtoMessage() {}
+func (StakeActivationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[5].Descriptor()
+}
 
-func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (StakeActivationState) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[5]
 }
 
-// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
-func (*TransactionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{3}
+func (x StakeActivationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TransactionResponse) GetSignature() string {
-	if x != nil {
-		return x.Signature
-	}
-	return ""
+// Deprecated: Use StakeActivationState.Descriptor instead.
+func (StakeActivationState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *TransactionResponse) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
+// OwnedAccountEventType says what an owned account event reports
+type OwnedAccountEventType int32
+
+const (
+	OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_UNSPECIFIED OwnedAccountEventType = 0
+	// An account the set had when the stream opened
+	OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_SNAPSHOT OwnedAccountEventType = 1
+	// Follows the snapshot events, without an account
+	OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_SNAPSHOT_COMPLETE OwnedAccountEventType = 2
+	// An account joined the set
+	OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_CREATED OwnedAccountEventType = 3
+	// An account of the set changed
+	OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_UPDATED OwnedAccountEventType = 4
+	// An account left the set, closed or assigned to another owner
+	OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_CLOSED OwnedAccountEventType = 5
+)
+
+// Enum value maps for OwnedAccountEventType.
+var (
+	OwnedAccountEventType_name = map[int32]string{
+		0: "OWNED_ACCOUNT_EVENT_TYPE_UNSPECIFIED",
+		1: "OWNED_ACCOUNT_EVENT_TYPE_SNAPSHOT",
+		2: "OWNED_ACCOUNT_EVENT_TYPE_SNAPSHOT_COMPLETE",
+		3: "OWNED_ACCOUNT_EVENT_TYPE_CREATED",
+		4: "OWNED_ACCOUNT_EVENT_TYPE_UPDATED",
+		5: "OWNED_ACCOUNT_EVENT_TYPE_CLOSED",
 	}
-	return 0
+	OwnedAccountEventType_value = map[string]int32{
+		"OWNED_ACCOUNT_EVENT_TYPE_UNSPECIFIED":       0,
+		"OWNED_ACCOUNT_EVENT_TYPE_SNAPSHOT":          1,
+		"OWNED_ACCOUNT_EVENT_TYPE_SNAPSHOT_COMPLETE": 2,
+		"OWNED_ACCOUNT_EVENT_TYPE_CREATED":           3,
+		"OWNED_ACCOUNT_EVENT_TYPE_UPDATED":           4,
+		"OWNED_ACCOUNT_EVENT_TYPE_CLOSED":            5,
+	}
+)
+
+func (x OwnedAccountEventType) Enum() *OwnedAccountEventType {
+	p := new(OwnedAccountEventType)
+	*p = x
+	return p
 }
 
-func (x *TransactionResponse) GetTransaction() []byte {
-	if x != nil {
-		return x.Transaction
-	}
-	return nil
+func (x OwnedAccountEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *TransactionResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+func (OwnedAccountEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[6].Descriptor()
 }
 
-func (x *TransactionResponse) GetResponseTimeMs() uint64 {
-	if x != nil {
-		return x.ResponseTimeMs
-	}
-	return 0
+func (OwnedAccountEventType) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[6]
 }
 
-// BlockRequest represents a request for block information
-type BlockRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Slot       uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
-	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+func (x OwnedAccountEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *BlockRequest) Reset() {
-	*x = BlockRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Deprecated: Use OwnedAccountEventType.Descriptor instead.
+func (OwnedAccountEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *BlockRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+// AccountClosureReason says how an account left its owner's set
+type AccountClosureReason int32
 
-func (*BlockRequest) ProtoMessage() {}
+const (
+	AccountClosureReason_ACCOUNT_CLOSURE_REASON_UNSPECIFIED AccountClosureReason = 0
+	// Its lamports were withdrawn to zero, so the runtime deleted it
+	AccountClosureReason_ACCOUNT_CLOSURE_REASON_DRAINED AccountClosureReason = 1
+	// It was assigned to the System program
+	AccountClosureReason_ACCOUNT_CLOSURE_REASON_ASSIGNED_TO_SYSTEM AccountClosureReason = 2
+	// It was assigned to another program
+	AccountClosureReason_ACCOUNT_CLOSURE_REASON_ASSIGNED AccountClosureReason = 3
+)
 
-func (x *BlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// Enum value maps for AccountClosureReason.
+var (
+	AccountClosureReason_name = map[int32]string{
+		0: "ACCOUNT_CLOSURE_REASON_UNSPECIFIED",
+		1: "ACCOUNT_CLOSURE_REASON_DRAINED",
+		2: "ACCOUNT_CLOSURE_REASON_ASSIGNED_TO_SYSTEM",
+		3: "ACCOUNT_CLOSURE_REASON_ASSIGNED",
 	}
-	return mi.MessageOf(x)
-}
+	AccountClosureReason_value = map[string]int32{
+		"ACCOUNT_CLOSURE_REASON_UNSPECIFIED":        0,
+		"ACCOUNT_CLOSURE_REASON_DRAINED":            1,
+		"ACCOUNT_CLOSURE_REASON_ASSIGNED_TO_SYSTEM": 2,
+		"ACCOUNT_CLOSURE_REASON_ASSIGNED":           3,
+	}
+)
 
-// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
-func (*BlockRequest) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{4}
+func (x AccountClosureReason) Enum() *AccountClosureReason {
+	p := new(AccountClosureReason)
+	*p = x
+	return p
 }
 
-func (x *BlockRequest) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
+func (x AccountClosureReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *BlockRequest) GetCommitment() string {
-	if x != nil {
-		return x.Commitment
-	}
-	return ""
+func (AccountClosureReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[7].Descriptor()
 }
 
-// BlockResponse represents the response with block information
-type BlockResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Slot              uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
-	Blockhash         string   `protobuf:"bytes,2,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
-	PreviousBlockhash string   `protobuf:"bytes,3,opt,name=previous_blockhash,json=previousBlockhash,proto3" json:"previous_blockhash,omitempty"`
-	ParentSlot        uint64   `protobuf:"varint,4,opt,name=parent_slot,json=parentSlot,proto3" json:"parent_slot,omitempty"`
-	Transactions      []string `protobuf:"bytes,5,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	ResponseTimeMs    uint64   `protobuf:"varint,6,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+func (AccountClosureReason) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[7]
 }
 
-func (x *BlockResponse) Reset() {
-	*x = BlockResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x AccountClosureReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *BlockResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use AccountClosureReason.Descriptor instead.
+func (AccountClosureReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{7}
 }
 
-func (*BlockResponse) ProtoMessage() {}
+// SLOSource is the metric a latency objective is evaluated from
+type SLOSource int32
 
-func (x *BlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// Latency of the unary gRPC calls the server serves
+	SLOSource_SLO_SOURCE_HANDLER SLOSource = 0
+	// Latency of the JSON-RPC calls the server makes upstream
+	SLOSource_SLO_SOURCE_UPSTREAM SLOSource = 1
+)
+
+// Enum value maps for SLOSource.
+var (
+	SLOSource_name = map[int32]string{
+		0: "SLO_SOURCE_HANDLER",
+		1: "SLO_SOURCE_UPSTREAM",
 	}
-	return mi.MessageOf(x)
+	SLOSource_value = map[string]int32{
+		"SLO_SOURCE_HANDLER":  0,
+		"SLO_SOURCE_UPSTREAM": 1,
+	}
+)
+
+func (x SLOSource) Enum() *SLOSource {
+	p := new(SLOSource)
+	*p = x
+	return p
 }
 
-// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
-func (*BlockResponse) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{5}
+func (x SLOSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *BlockResponse) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
+func (SLOSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[8].Descriptor()
 }
 
-func (x *BlockResponse) GetBlockhash() string {
-	if x != nil {
-		return x.Blockhash
-	}
-	return ""
+func (SLOSource) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[8]
 }
 
-func (x *BlockResponse) GetPreviousBlockhash() string {
-	if x != nil {
-		return x.PreviousBlockhash
-	}
-	return ""
+func (x SLOSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *BlockResponse) GetParentSlot() uint64 {
-	if x != nil {
-		return x.ParentSlot
-	}
-	return 0
+// Deprecated: Use SLOSource.Descriptor instead.
+func (SLOSource) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BlockResponse) GetTransactions() []string {
-	if x != nil {
-		return x.Transactions
+// SLOEventType says what a latency objective event reports
+type SLOEventType int32
+
+const (
+	SLOEventType_SLO_EVENT_TYPE_UNSPECIFIED SLOEventType = 0
+	// The objective's state when the stream opened
+	SLOEventType_SLO_EVENT_TYPE_CURRENT SLOEventType = 1
+	// The objective stopped being met
+	SLOEventType_SLO_EVENT_TYPE_VIOLATED SLOEventType = 2
+	// The objective was met again
+	SLOEventType_SLO_EVENT_TYPE_RECOVERED SLOEventType = 3
+)
+
+// Enum value maps for SLOEventType.
+var (
+	SLOEventType_name = map[int32]string{
+		0: "SLO_EVENT_TYPE_UNSPECIFIED",
+		1: "SLO_EVENT_TYPE_CURRENT",
+		2: "SLO_EVENT_TYPE_VIOLATED",
+		3: "SLO_EVENT_TYPE_RECOVERED",
 	}
-	return nil
+	SLOEventType_value = map[string]int32{
+		"SLO_EVENT_TYPE_UNSPECIFIED": 0,
+		"SLO_EVENT_TYPE_CURRENT":     1,
+		"SLO_EVENT_TYPE_VIOLATED":    2,
+		"SLO_EVENT_TYPE_RECOVERED":   3,
+	}
+)
+
+func (x SLOEventType) Enum() *SLOEventType {
+	p := new(SLOEventType)
+	*p = x
+	return p
 }
 
-func (x *BlockResponse) GetResponseTimeMs() uint64 {
-	if x != nil {
-		return x.ResponseTimeMs
-	}
-	return 0
+func (x SLOEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// AccountStreamRequest represents a request to stream account updates
-type AccountStreamRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (SLOEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[9].Descriptor()
+}
 
-	Pubkeys    []string `protobuf:"bytes,1,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
-	Commitment string   `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+func (SLOEventType) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[9]
 }
 
-func (x *AccountStreamRequest) Reset() {
-	*x = AccountStreamRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x SLOEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *AccountStreamRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use SLOEventType.Descriptor instead.
+func (SLOEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{9}
 }
 
-func (*AccountStreamRequest) ProtoMessage() {}
+// BlockEncoding is the encoding of an EncodedBlock payload
+type BlockEncoding int32
 
-func (x *AccountStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// A SerializedBlock
+	BlockEncoding_BLOCK_ENCODING_PROTOBUF BlockEncoding = 0
+	// A FlatBuffers block, laid out by proto/block.fbs
+	BlockEncoding_BLOCK_ENCODING_FLATBUFFERS BlockEncoding = 1
+)
+
+// Enum value maps for BlockEncoding.
+var (
+	BlockEncoding_name = map[int32]string{
+		0: "BLOCK_ENCODING_PROTOBUF",
+		1: "BLOCK_ENCODING_FLATBUFFERS",
 	}
-	return mi.MessageOf(x)
-}
+	BlockEncoding_value = map[string]int32{
+		"BLOCK_ENCODING_PROTOBUF":    0,
+		"BLOCK_ENCODING_FLATBUFFERS": 1,
+	}
+)
 
-// Deprecated: Use AccountStreamRequest.ProtoReflect.Descriptor instead.
-func (*AccountStreamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{6}
+func (x BlockEncoding) Enum() *BlockEncoding {
+	p := new(BlockEncoding)
+	*p = x
+	return p
 }
 
-func (x *AccountStreamRequest) GetPubkeys() []string {
-	if x != nil {
-		return x.Pubkeys
-	}
-	return nil
+func (x BlockEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *AccountStreamRequest) GetCommitment() string {
-	if x != nil {
-		return x.Commitment
-	}
-	return ""
+func (BlockEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[10].Descriptor()
 }
 
-// AccountUpdate represents a real-time account update
-type AccountUpdate struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Pubkey    string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	Data      []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Owner     string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	Lamports  uint64 `protobuf:"varint,4,opt,name=lamports,proto3" json:"lamports,omitempty"`
-	Slot      uint64 `protobuf:"varint,5,opt,name=slot,proto3" json:"slot,omitempty"`
-	Timestamp uint64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+func (BlockEncoding) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[10]
 }
 
-func (x *AccountUpdate) Reset() {
-	*x = AccountUpdate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x BlockEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *AccountUpdate) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use BlockEncoding.Descriptor instead.
+func (BlockEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{10}
 }
 
-func (*AccountUpdate) ProtoMessage() {}
+// StreamBenchmarkKind is a kind of update a stream benchmark subscribes to
+type StreamBenchmarkKind int32
 
-func (x *AccountUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// Changes to an account: StreamAccountUpdates against accountSubscribe
+	StreamBenchmarkKind_STREAM_BENCHMARK_KIND_ACCOUNT StreamBenchmarkKind = 0
+	// Processed slots: StreamAccountUpdates of the Clock sysvar, which the
+	// runtime writes every slot, against slotSubscribe
+	StreamBenchmarkKind_STREAM_BENCHMARK_KIND_SLOT StreamBenchmarkKind = 1
+	// Blocks: StreamBlocks against blockSubscribe without transactions, which
+	// upstreams only serve when started with block subscriptions enabled
+	StreamBenchmarkKind_STREAM_BENCHMARK_KIND_BLOCK StreamBenchmarkKind = 2
+)
+
+// Enum value maps for StreamBenchmarkKind.
+var (
+	StreamBenchmarkKind_name = map[int32]string{
+		0: "STREAM_BENCHMARK_KIND_ACCOUNT",
+		1: "STREAM_BENCHMARK_KIND_SLOT",
+		2: "STREAM_BENCHMARK_KIND_BLOCK",
 	}
-	return mi.MessageOf(x)
+	StreamBenchmarkKind_value = map[string]int32{
+		"STREAM_BENCHMARK_KIND_ACCOUNT": 0,
+		"STREAM_BENCHMARK_KIND_SLOT":    1,
+		"STREAM_BENCHMARK_KIND_BLOCK":   2,
+	}
+)
+
+func (x StreamBenchmarkKind) Enum() *StreamBenchmarkKind {
+	p := new(StreamBenchmarkKind)
+	*p = x
+	return p
 }
 
-// Deprecated: Use AccountUpdate.ProtoReflect.Descriptor instead.
-func (*AccountUpdate) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{7}
+func (x StreamBenchmarkKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *AccountUpdate) GetPubkey() string {
-	if x != nil {
-		return x.Pubkey
-	}
-	return ""
+func (StreamBenchmarkKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[11].Descriptor()
 }
 
-func (x *AccountUpdate) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+func (StreamBenchmarkKind) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[11]
 }
 
-func (x *AccountUpdate) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
+func (x StreamBenchmarkKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *AccountUpdate) GetLamports() uint64 {
-	if x != nil {
-		return x.Lamports
-	}
-	return 0
+// Deprecated: Use StreamBenchmarkKind.Descriptor instead.
+func (StreamBenchmarkKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AccountUpdate) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
+// BlockDetail is a getBlock transactionDetails tier. Rewards are fetched at
+// every tier, so BLOCK_DETAIL_NONE fetches the block's rewards only.
+type BlockDetail int32
+
+const (
+	// Full transactions with their status metadata
+	BlockDetail_BLOCK_DETAIL_FULL BlockDetail = 0
+	// The signatures and account keys of each transaction with its metadata
+	BlockDetail_BLOCK_DETAIL_ACCOUNTS BlockDetail = 1
+	// The transaction signatures only
+	BlockDetail_BLOCK_DETAIL_SIGNATURES BlockDetail = 2
+	// No transactions
+	BlockDetail_BLOCK_DETAIL_NONE BlockDetail = 3
+)
+
+// Enum value maps for BlockDetail.
+var (
+	BlockDetail_name = map[int32]string{
+		0: "BLOCK_DETAIL_FULL",
+		1: "BLOCK_DETAIL_ACCOUNTS",
+		2: "BLOCK_DETAIL_SIGNATURES",
+		3: "BLOCK_DETAIL_NONE",
 	}
-	return 0
+	BlockDetail_value = map[string]int32{
+		"BLOCK_DETAIL_FULL":       0,
+		"BLOCK_DETAIL_ACCOUNTS":   1,
+		"BLOCK_DETAIL_SIGNATURES": 2,
+		"BLOCK_DETAIL_NONE":       3,
+	}
+)
+
+func (x BlockDetail) Enum() *BlockDetail {
+	p := new(BlockDetail)
+	*p = x
+	return p
 }
 
-func (x *AccountUpdate) GetTimestamp() uint64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+func (x BlockDetail) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// TransactionStreamRequest represents a request to stream transactions
-type TransactionStreamRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (BlockDetail) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[12].Descriptor()
+}
 
-	Accounts      []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	IncludeFailed bool     `protobuf:"varint,2,opt,name=include_failed,json=includeFailed,proto3" json:"include_failed,omitempty"`
-	Commitment    string   `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+func (BlockDetail) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[12]
 }
 
-func (x *TransactionStreamRequest) Reset() {
-	*x = TransactionStreamRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x BlockDetail) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockDetail.Descriptor instead.
+func (BlockDetail) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{12}
+}
+
+// StreamQoS is the quality of service class of a stream
+type StreamQoS int32
+
+const (
+	// Latency-critical streams: updates are sent as they arrive, upstream work
+	// takes priority, and a stream that falls behind is dropped quickly
+	StreamQoS_STREAM_QOS_REALTIME StreamQoS = 0
+	// Throughput-oriented streams such as backfills: updates may be batched,
+	// upstream work yields to realtime streams for up to a second, and queues
+	// are larger
+	StreamQoS_STREAM_QOS_BULK StreamQoS = 1
+)
+
+// Enum value maps for StreamQoS.
+var (
+	StreamQoS_name = map[int32]string{
+		0: "STREAM_QOS_REALTIME",
+		1: "STREAM_QOS_BULK",
 	}
+	StreamQoS_value = map[string]int32{
+		"STREAM_QOS_REALTIME": 0,
+		"STREAM_QOS_BULK":     1,
+	}
+)
+
+func (x StreamQoS) Enum() *StreamQoS {
+	p := new(StreamQoS)
+	*p = x
+	return p
 }
 
-func (x *TransactionStreamRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x StreamQoS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*TransactionStreamRequest) ProtoMessage() {}
+func (StreamQoS) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[13].Descriptor()
+}
 
-func (x *TransactionStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (StreamQoS) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[13]
+}
+
+func (x StreamQoS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StreamQoS.Descriptor instead.
+func (StreamQoS) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{13}
+}
+
+// PollChangeDetection is how a stream polling for lack of a WebSocket
+// upstream decides what to send. Either way, nothing is read while the slot
+// at the stream's commitment has not advanced.
+type PollChangeDetection int32
+
+const (
+	// Send the accounts whose lamports, owner or data hash changed since the
+	// previous poll
+	PollChangeDetection_POLL_CHANGE_DETECTION_DATA_HASH PollChangeDetection = 0
+	// Send every account whenever the slot has advanced, without comparing
+	// states: fewer server resources, more data sent
+	PollChangeDetection_POLL_CHANGE_DETECTION_SLOT PollChangeDetection = 1
+)
+
+// Enum value maps for PollChangeDetection.
+var (
+	PollChangeDetection_name = map[int32]string{
+		0: "POLL_CHANGE_DETECTION_DATA_HASH",
+		1: "POLL_CHANGE_DETECTION_SLOT",
 	}
-	return mi.MessageOf(x)
+	PollChangeDetection_value = map[string]int32{
+		"POLL_CHANGE_DETECTION_DATA_HASH": 0,
+		"POLL_CHANGE_DETECTION_SLOT":      1,
+	}
+)
+
+func (x PollChangeDetection) Enum() *PollChangeDetection {
+	p := new(PollChangeDetection)
+	*p = x
+	return p
 }
 
-// Deprecated: Use TransactionStreamRequest.ProtoReflect.Descriptor instead.
-func (*TransactionStreamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{8}
+func (x PollChangeDetection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *TransactionStreamRequest) GetAccounts() []string {
-	if x != nil {
-		return x.Accounts
+func (PollChangeDetection) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[14].Descriptor()
+}
+
+func (PollChangeDetection) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[14]
+}
+
+func (x PollChangeDetection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PollChangeDetection.Descriptor instead.
+func (PollChangeDetection) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{14}
+}
+
+// TransactionFailureKind classifies why a transaction failed
+type TransactionFailureKind int32
+
+const (
+	TransactionFailureKind_TRANSACTION_FAILURE_KIND_UNSPECIFIED TransactionFailureKind = 0
+	// A swap returned less than its minimum output, or needed more than its
+	// maximum input, on a DEX or aggregator whose errors are recognized
+	TransactionFailureKind_TRANSACTION_FAILURE_KIND_SLIPPAGE TransactionFailureKind = 1
+	// An instruction ran out of compute units
+	TransactionFailureKind_TRANSACTION_FAILURE_KIND_COMPUTE_EXHAUSTED TransactionFailureKind = 2
+	// The recent blockhash expired before the transaction was processed
+	TransactionFailureKind_TRANSACTION_FAILURE_KIND_BLOCKHASH_EXPIRED TransactionFailureKind = 3
+	// An account lacked the lamports or tokens to pay the fee, rent or a transfer
+	TransactionFailureKind_TRANSACTION_FAILURE_KIND_INSUFFICIENT_FUNDS TransactionFailureKind = 4
+	// Any other custom program error; the code is in custom_code
+	TransactionFailureKind_TRANSACTION_FAILURE_KIND_CUSTOM_PROGRAM_ERROR TransactionFailureKind = 5
+	// Any other instruction error
+	TransactionFailureKind_TRANSACTION_FAILURE_KIND_INSTRUCTION_ERROR TransactionFailureKind = 6
+	// Any other transaction error
+	TransactionFailureKind_TRANSACTION_FAILURE_KIND_OTHER TransactionFailureKind = 7
+)
+
+// Enum value maps for TransactionFailureKind.
+var (
+	TransactionFailureKind_name = map[int32]string{
+		0: "TRANSACTION_FAILURE_KIND_UNSPECIFIED",
+		1: "TRANSACTION_FAILURE_KIND_SLIPPAGE",
+		2: "TRANSACTION_FAILURE_KIND_COMPUTE_EXHAUSTED",
+		3: "TRANSACTION_FAILURE_KIND_BLOCKHASH_EXPIRED",
+		4: "TRANSACTION_FAILURE_KIND_INSUFFICIENT_FUNDS",
+		5: "TRANSACTION_FAILURE_KIND_CUSTOM_PROGRAM_ERROR",
+		6: "TRANSACTION_FAILURE_KIND_INSTRUCTION_ERROR",
+		7: "TRANSACTION_FAILURE_KIND_OTHER",
 	}
-	return nil
+	TransactionFailureKind_value = map[string]int32{
+		"TRANSACTION_FAILURE_KIND_UNSPECIFIED":          0,
+		"TRANSACTION_FAILURE_KIND_SLIPPAGE":             1,
+		"TRANSACTION_FAILURE_KIND_COMPUTE_EXHAUSTED":    2,
+		"TRANSACTION_FAILURE_KIND_BLOCKHASH_EXPIRED":    3,
+		"TRANSACTION_FAILURE_KIND_INSUFFICIENT_FUNDS":   4,
+		"TRANSACTION_FAILURE_KIND_CUSTOM_PROGRAM_ERROR": 5,
+		"TRANSACTION_FAILURE_KIND_INSTRUCTION_ERROR":    6,
+		"TRANSACTION_FAILURE_KIND_OTHER":                7,
+	}
+)
+
+func (x TransactionFailureKind) Enum() *TransactionFailureKind {
+	p := new(TransactionFailureKind)
+	*p = x
+	return p
 }
 
-func (x *TransactionStreamRequest) GetIncludeFailed() bool {
-	if x != nil {
-		return x.IncludeFailed
+func (x TransactionFailureKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionFailureKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[15].Descriptor()
+}
+
+func (TransactionFailureKind) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[15]
+}
+
+func (x TransactionFailureKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionFailureKind.Descriptor instead.
+func (TransactionFailureKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{15}
+}
+
+// AlertConditionType is the kind of event an alert condition matches
+type AlertConditionType int32
+
+const (
+	// A transaction moves more than min_lamports into or out of an account,
+	// fees excluded. When account is set, only that account is considered.
+	AlertConditionType_ALERT_CONDITION_LAMPORT_TRANSFER AlertConditionType = 0
+	// A transaction moves account's lamport balance across threshold_lamports
+	// in either direction
+	AlertConditionType_ALERT_CONDITION_BALANCE_CROSSES AlertConditionType = 1
+	// A transaction invokes program_id, directly or through a cross-program call
+	AlertConditionType_ALERT_CONDITION_PROGRAM_INVOKED AlertConditionType = 2
+)
+
+// Enum value maps for AlertConditionType.
+var (
+	AlertConditionType_name = map[int32]string{
+		0: "ALERT_CONDITION_LAMPORT_TRANSFER",
+		1: "ALERT_CONDITION_BALANCE_CROSSES",
+		2: "ALERT_CONDITION_PROGRAM_INVOKED",
 	}
-	return false
+	AlertConditionType_value = map[string]int32{
+		"ALERT_CONDITION_LAMPORT_TRANSFER": 0,
+		"ALERT_CONDITION_BALANCE_CROSSES":  1,
+		"ALERT_CONDITION_PROGRAM_INVOKED":  2,
+	}
+)
+
+func (x AlertConditionType) Enum() *AlertConditionType {
+	p := new(AlertConditionType)
+	*p = x
+	return p
 }
 
-func (x *TransactionStreamRequest) GetCommitment() string {
-	if x != nil {
-		return x.Commitment
+func (x AlertConditionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertConditionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[16].Descriptor()
+}
+
+func (AlertConditionType) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[16]
+}
+
+func (x AlertConditionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertConditionType.Descriptor instead.
+func (AlertConditionType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{16}
+}
+
+// SlotStatus is the commitment level a streamed slot has reached
+type SlotStatus int32
+
+const (
+	SlotStatus_SLOT_STATUS_PROCESSED SlotStatus = 0
+	SlotStatus_SLOT_STATUS_CONFIRMED SlotStatus = 1
+	SlotStatus_SLOT_STATUS_FINALIZED SlotStatus = 2
+)
+
+// Enum value maps for SlotStatus.
+var (
+	SlotStatus_name = map[int32]string{
+		0: "SLOT_STATUS_PROCESSED",
+		1: "SLOT_STATUS_CONFIRMED",
+		2: "SLOT_STATUS_FINALIZED",
 	}
-	return ""
+	SlotStatus_value = map[string]int32{
+		"SLOT_STATUS_PROCESSED": 0,
+		"SLOT_STATUS_CONFIRMED": 1,
+		"SLOT_STATUS_FINALIZED": 2,
+	}
+)
+
+func (x SlotStatus) Enum() *SlotStatus {
+	p := new(SlotStatus)
+	*p = x
+	return p
 }
 
-// TransactionUpdate represents a real-time transaction update
-type TransactionUpdate struct {
+func (x SlotStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlotStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[17].Descriptor()
+}
+
+func (SlotStatus) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[17]
+}
+
+func (x SlotStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlotStatus.Descriptor instead.
+func (SlotStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{17}
+}
+
+// OutlierHandling selects how extreme samples are treated in the adjusted
+// benchmark statistics. Raw statistics are always reported as well.
+type OutlierHandling int32
+
+const (
+	OutlierHandling_OUTLIER_HANDLING_NONE OutlierHandling = 0
+	// Drop samples in each tail
+	OutlierHandling_OUTLIER_HANDLING_TRIM OutlierHandling = 1
+	// Clamp samples in each tail to the nearest remaining sample
+	OutlierHandling_OUTLIER_HANDLING_WINSORIZE OutlierHandling = 2
+)
+
+// Enum value maps for OutlierHandling.
+var (
+	OutlierHandling_name = map[int32]string{
+		0: "OUTLIER_HANDLING_NONE",
+		1: "OUTLIER_HANDLING_TRIM",
+		2: "OUTLIER_HANDLING_WINSORIZE",
+	}
+	OutlierHandling_value = map[string]int32{
+		"OUTLIER_HANDLING_NONE":      0,
+		"OUTLIER_HANDLING_TRIM":      1,
+		"OUTLIER_HANDLING_WINSORIZE": 2,
+	}
+)
+
+func (x OutlierHandling) Enum() *OutlierHandling {
+	p := new(OutlierHandling)
+	*p = x
+	return p
+}
+
+func (x OutlierHandling) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutlierHandling) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[18].Descriptor()
+}
+
+func (OutlierHandling) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[18]
+}
+
+func (x OutlierHandling) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutlierHandling.Descriptor instead.
+func (OutlierHandling) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{18}
+}
+
+// BenchmarkJobState is the lifecycle state of a benchmark job
+type BenchmarkJobState int32
+
+const (
+	// Waiting for earlier benchmarks to finish
+	BenchmarkJobState_BENCHMARK_JOB_STATE_QUEUED    BenchmarkJobState = 0
+	BenchmarkJobState_BENCHMARK_JOB_STATE_RUNNING   BenchmarkJobState = 1
+	BenchmarkJobState_BENCHMARK_JOB_STATE_SUCCEEDED BenchmarkJobState = 2
+	// Cancelled jobs keep the results gathered before cancellation
+	BenchmarkJobState_BENCHMARK_JOB_STATE_CANCELLED BenchmarkJobState = 3
+)
+
+// Enum value maps for BenchmarkJobState.
+var (
+	BenchmarkJobState_name = map[int32]string{
+		0: "BENCHMARK_JOB_STATE_QUEUED",
+		1: "BENCHMARK_JOB_STATE_RUNNING",
+		2: "BENCHMARK_JOB_STATE_SUCCEEDED",
+		3: "BENCHMARK_JOB_STATE_CANCELLED",
+	}
+	BenchmarkJobState_value = map[string]int32{
+		"BENCHMARK_JOB_STATE_QUEUED":    0,
+		"BENCHMARK_JOB_STATE_RUNNING":   1,
+		"BENCHMARK_JOB_STATE_SUCCEEDED": 2,
+		"BENCHMARK_JOB_STATE_CANCELLED": 3,
+	}
+)
+
+func (x BenchmarkJobState) Enum() *BenchmarkJobState {
+	p := new(BenchmarkJobState)
+	*p = x
+	return p
+}
+
+func (x BenchmarkJobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BenchmarkJobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_solana_benchmark_proto_enumTypes[19].Descriptor()
+}
+
+func (BenchmarkJobState) Type() protoreflect.EnumType {
+	return &file_proto_solana_benchmark_proto_enumTypes[19]
+}
+
+func (x BenchmarkJobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BenchmarkJobState.Descriptor instead.
+func (BenchmarkJobState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{19}
+}
+
+// AccountInfoRequest represents a request for account information
+type AccountInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signature   string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	Slot        uint64 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Transaction []byte `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	Success     bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	Timestamp   uint64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Pubkey         string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Commitment     string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	EncodingBinary bool   `protobuf:"varint,3,opt,name=encoding_binary,json=encodingBinary,proto3" json:"encoding_binary,omitempty"`
+	// field_mask selects the AccountInfoResponse fields to return; empty returns all
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
 }
 
-func (x *TransactionUpdate) Reset() {
-	*x = TransactionUpdate{}
+func (x *AccountInfoRequest) Reset() {
+	*x = AccountInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[9]
+		mi := &file_proto_solana_benchmark_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionUpdate) String() string {
+func (x *AccountInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionUpdate) ProtoMessage() {}
+func (*AccountInfoRequest) ProtoMessage() {}
 
-func (x *TransactionUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[9]
+func (x *AccountInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,72 +1161,74 @@ func (x *TransactionUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionUpdate.ProtoReflect.Descriptor instead.
-func (*TransactionUpdate) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AccountInfoRequest.ProtoReflect.Descriptor instead.
+func (*AccountInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *TransactionUpdate) GetSignature() string {
+func (x *AccountInfoRequest) GetPubkey() string {
 	if x != nil {
-		return x.Signature
+		return x.Pubkey
 	}
 	return ""
 }
 
-func (x *TransactionUpdate) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
-}
-
-func (x *TransactionUpdate) GetTransaction() []byte {
+func (x *AccountInfoRequest) GetCommitment() string {
 	if x != nil {
-		return x.Transaction
+		return x.Commitment
 	}
-	return nil
+	return ""
 }
 
-func (x *TransactionUpdate) GetSuccess() bool {
+func (x *AccountInfoRequest) GetEncodingBinary() bool {
 	if x != nil {
-		return x.Success
+		return x.EncodingBinary
 	}
 	return false
 }
 
-func (x *TransactionUpdate) GetTimestamp() uint64 {
+func (x *AccountInfoRequest) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Timestamp
+		return x.FieldMask
 	}
-	return 0
+	return nil
 }
 
-// BlockStreamRequest represents a request to stream blocks
-type BlockStreamRequest struct {
+// AccountInfoResponse represents the response with account information
+type AccountInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commitment string `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Pubkey         string           `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Data           []byte           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Owner          string           `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Lamports       uint64           `protobuf:"varint,4,opt,name=lamports,proto3" json:"lamports,omitempty"`
+	Executable     bool             `protobuf:"varint,5,opt,name=executable,proto3" json:"executable,omitempty"`
+	RentEpoch      uint64           `protobuf:"varint,6,opt,name=rent_epoch,json=rentEpoch,proto3" json:"rent_epoch,omitempty"`
+	ResponseTimeMs uint64           `protobuf:"varint,7,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	Timing         *TimingBreakdown `protobuf:"bytes,8,opt,name=timing,proto3" json:"timing,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,9,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
 }
 
-func (x *BlockStreamRequest) Reset() {
-	*x = BlockStreamRequest{}
+func (x *AccountInfoResponse) Reset() {
+	*x = AccountInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[10]
+		mi := &file_proto_solana_benchmark_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockStreamRequest) String() string {
+func (x *AccountInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockStreamRequest) ProtoMessage() {}
+func (*AccountInfoResponse) ProtoMessage() {}
 
-func (x *BlockStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[10]
+func (x *AccountInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -784,130 +1239,112 @@ func (x *BlockStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockStreamRequest.ProtoReflect.Descriptor instead.
-func (*BlockStreamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use AccountInfoResponse.ProtoReflect.Descriptor instead.
+func (*AccountInfoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *BlockStreamRequest) GetCommitment() string {
+func (x *AccountInfoResponse) GetPubkey() string {
 	if x != nil {
-		return x.Commitment
+		return x.Pubkey
 	}
 	return ""
 }
 
-// BlockUpdate represents a real-time block update
-type BlockUpdate struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Slot              uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
-	Blockhash         string `protobuf:"bytes,2,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
-	PreviousBlockhash string `protobuf:"bytes,3,opt,name=previous_blockhash,json=previousBlockhash,proto3" json:"previous_blockhash,omitempty"`
-	ParentSlot        uint64 `protobuf:"varint,4,opt,name=parent_slot,json=parentSlot,proto3" json:"parent_slot,omitempty"`
-	Timestamp         uint64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-}
-
-func (x *BlockUpdate) Reset() {
-	*x = BlockUpdate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AccountInfoResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
+	return nil
 }
 
-func (x *BlockUpdate) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BlockUpdate) ProtoMessage() {}
-
-func (x *BlockUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AccountInfoResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BlockUpdate.ProtoReflect.Descriptor instead.
-func (*BlockUpdate) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{11}
+	return ""
 }
 
-func (x *BlockUpdate) GetSlot() uint64 {
+func (x *AccountInfoResponse) GetLamports() uint64 {
 	if x != nil {
-		return x.Slot
+		return x.Lamports
 	}
 	return 0
 }
 
-func (x *BlockUpdate) GetBlockhash() string {
+func (x *AccountInfoResponse) GetExecutable() bool {
 	if x != nil {
-		return x.Blockhash
+		return x.Executable
 	}
-	return ""
+	return false
 }
 
-func (x *BlockUpdate) GetPreviousBlockhash() string {
+func (x *AccountInfoResponse) GetRentEpoch() uint64 {
 	if x != nil {
-		return x.PreviousBlockhash
+		return x.RentEpoch
 	}
-	return ""
+	return 0
 }
 
-func (x *BlockUpdate) GetParentSlot() uint64 {
+func (x *AccountInfoResponse) GetResponseTimeMs() uint64 {
 	if x != nil {
-		return x.ParentSlot
+		return x.ResponseTimeMs
 	}
 	return 0
 }
 
-func (x *BlockUpdate) GetTimestamp() uint64 {
+func (x *AccountInfoResponse) GetTiming() *TimingBreakdown {
 	if x != nil {
-		return x.Timestamp
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *AccountInfoResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
 	}
 	return 0
 }
 
-// BenchmarkRequest represents a request to run a benchmark suite
-type BenchmarkRequest struct {
+// TimingBreakdown splits the time the server spent on a response or stream
+// update into stages, so it is visible which stage each transport optimizes.
+// Upstream stages of stream updates cover the upstream calls they were built
+// from, which may be shared by several updates.
+type TimingBreakdown struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Iterations      uint32   `protobuf:"varint,1,opt,name=iterations,proto3" json:"iterations,omitempty"`
-	TestAccounts    []string `protobuf:"bytes,2,rep,name=test_accounts,json=testAccounts,proto3" json:"test_accounts,omitempty"`
-	TestSignatures  []string `protobuf:"bytes,3,rep,name=test_signatures,json=testSignatures,proto3" json:"test_signatures,omitempty"`
-	TestSlots       []uint64 `protobuf:"varint,4,rep,packed,name=test_slots,json=testSlots,proto3" json:"test_slots,omitempty"`
-	RunGrpcTests    bool     `protobuf:"varint,5,opt,name=run_grpc_tests,json=runGrpcTests,proto3" json:"run_grpc_tests,omitempty"`
-	RunJsonrpcTests bool     `protobuf:"varint,6,opt,name=run_jsonrpc_tests,json=runJsonrpcTests,proto3" json:"run_jsonrpc_tests,omitempty"`
-	SolanaRpcUrl    string   `protobuf:"bytes,7,opt,name=solana_rpc_url,json=solanaRpcUrl,proto3" json:"solana_rpc_url,omitempty"`
+	// Upstream JSON-RPC round trips, excluding decoding their results
+	UpstreamRpcMs float64 `protobuf:"fixed64,1,opt,name=upstream_rpc_ms,json=upstreamRpcMs,proto3" json:"upstream_rpc_ms,omitempty"`
+	// Decoding the upstream results
+	DecodeMs float64 `protobuf:"fixed64,2,opt,name=decode_ms,json=decodeMs,proto3" json:"decode_ms,omitempty"`
+	// Building the response message from the decoded results and marshaling
+	// it to the protobuf wire format
+	SerializeMs float64 `protobuf:"fixed64,3,opt,name=serialize_ms,json=serializeMs,proto3" json:"serialize_ms,omitempty"`
+	// Time spent waiting: before the first upstream call, and between the last
+	// one and building, such as behind earlier updates of the same poll
+	QueueMs float64 `protobuf:"fixed64,4,opt,name=queue_ms,json=queueMs,proto3" json:"queue_ms,omitempty"`
 }
 
-func (x *BenchmarkRequest) Reset() {
-	*x = BenchmarkRequest{}
+func (x *TimingBreakdown) Reset() {
+	*x = TimingBreakdown{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[12]
+		mi := &file_proto_solana_benchmark_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BenchmarkRequest) String() string {
+func (x *TimingBreakdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BenchmarkRequest) ProtoMessage() {}
+func (*TimingBreakdown) ProtoMessage() {}
 
-func (x *BenchmarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[12]
+func (x *TimingBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,96 +1355,132 @@ func (x *BenchmarkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BenchmarkRequest.ProtoReflect.Descriptor instead.
-func (*BenchmarkRequest) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use TimingBreakdown.ProtoReflect.Descriptor instead.
+func (*TimingBreakdown) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *BenchmarkRequest) GetIterations() uint32 {
+func (x *TimingBreakdown) GetUpstreamRpcMs() float64 {
 	if x != nil {
-		return x.Iterations
+		return x.UpstreamRpcMs
 	}
 	return 0
 }
 
-func (x *BenchmarkRequest) GetTestAccounts() []string {
+func (x *TimingBreakdown) GetDecodeMs() float64 {
 	if x != nil {
-		return x.TestAccounts
+		return x.DecodeMs
 	}
-	return nil
+	return 0
 }
 
-func (x *BenchmarkRequest) GetTestSignatures() []string {
+func (x *TimingBreakdown) GetSerializeMs() float64 {
 	if x != nil {
-		return x.TestSignatures
+		return x.SerializeMs
 	}
-	return nil
+	return 0
 }
 
-func (x *BenchmarkRequest) GetTestSlots() []uint64 {
+func (x *TimingBreakdown) GetQueueMs() float64 {
 	if x != nil {
-		return x.TestSlots
+		return x.QueueMs
 	}
-	return nil
+	return 0
 }
 
-func (x *BenchmarkRequest) GetRunGrpcTests() bool {
-	if x != nil {
-		return x.RunGrpcTests
+// NonceAccountRequest represents a request for a durable nonce account
+type NonceAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey     string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *NonceAccountRequest) Reset() {
+	*x = NonceAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *BenchmarkRequest) GetRunJsonrpcTests() bool {
+func (x *NonceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonceAccountRequest) ProtoMessage() {}
+
+func (x *NonceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NonceAccountRequest.ProtoReflect.Descriptor instead.
+func (*NonceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NonceAccountRequest) GetPubkey() string {
 	if x != nil {
-		return x.RunJsonrpcTests
+		return x.Pubkey
 	}
-	return false
+	return ""
 }
 
-func (x *BenchmarkRequest) GetSolanaRpcUrl() string {
+func (x *NonceAccountRequest) GetCommitment() string {
 	if x != nil {
-		return x.SolanaRpcUrl
+		return x.Commitment
 	}
 	return ""
 }
 
-// BenchmarkResults represents the results of a benchmark run
-type BenchmarkResults struct {
+// NonceAccountResponse holds the state of a durable nonce account. The nonce
+// is used in place of a recent blockhash by transactions signed offline, which
+// must start by advancing it with the authority's signature.
+type NonceAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Account benchmark results
-	AccountGrpc    *AccountBenchmark `protobuf:"bytes,1,opt,name=account_grpc,json=accountGrpc,proto3" json:"account_grpc,omitempty"`
-	AccountJsonrpc *AccountBenchmark `protobuf:"bytes,2,opt,name=account_jsonrpc,json=accountJsonrpc,proto3" json:"account_jsonrpc,omitempty"`
-	// Transaction benchmark results
-	TransactionGrpc    *TransactionBenchmark `protobuf:"bytes,3,opt,name=transaction_grpc,json=transactionGrpc,proto3" json:"transaction_grpc,omitempty"`
-	TransactionJsonrpc *TransactionBenchmark `protobuf:"bytes,4,opt,name=transaction_jsonrpc,json=transactionJsonrpc,proto3" json:"transaction_jsonrpc,omitempty"`
-	// Block benchmark results
-	BlockGrpc    *BlockBenchmark `protobuf:"bytes,5,opt,name=block_grpc,json=blockGrpc,proto3" json:"block_grpc,omitempty"`
-	BlockJsonrpc *BlockBenchmark `protobuf:"bytes,6,opt,name=block_jsonrpc,json=blockJsonrpc,proto3" json:"block_jsonrpc,omitempty"`
-	// Overall benchmark summary
-	Summary *BenchmarkSummary `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	Pubkey               string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Authority            string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	Nonce                string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	LamportsPerSignature uint64 `protobuf:"varint,4,opt,name=lamports_per_signature,json=lamportsPerSignature,proto3" json:"lamports_per_signature,omitempty"`
+	Lamports             uint64 `protobuf:"varint,5,opt,name=lamports,proto3" json:"lamports,omitempty"`
+	// Slot the state was read at
+	Slot           uint64 `protobuf:"varint,6,opt,name=slot,proto3" json:"slot,omitempty"`
+	ResponseTimeMs uint64 `protobuf:"varint,7,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,8,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
 }
 
-func (x *BenchmarkResults) Reset() {
-	*x = BenchmarkResults{}
+func (x *NonceAccountResponse) Reset() {
+	*x = NonceAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[13]
+		mi := &file_proto_solana_benchmark_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BenchmarkResults) String() string {
+func (x *NonceAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BenchmarkResults) ProtoMessage() {}
+func (*NonceAccountResponse) ProtoMessage() {}
 
-func (x *BenchmarkResults) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[13]
+func (x *NonceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,90 +1491,95 @@ func (x *BenchmarkResults) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BenchmarkResults.ProtoReflect.Descriptor instead.
-func (*BenchmarkResults) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use NonceAccountResponse.ProtoReflect.Descriptor instead.
+func (*NonceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *BenchmarkResults) GetAccountGrpc() *AccountBenchmark {
+func (x *NonceAccountResponse) GetPubkey() string {
 	if x != nil {
-		return x.AccountGrpc
+		return x.Pubkey
 	}
-	return nil
+	return ""
 }
 
-func (x *BenchmarkResults) GetAccountJsonrpc() *AccountBenchmark {
+func (x *NonceAccountResponse) GetAuthority() string {
 	if x != nil {
-		return x.AccountJsonrpc
+		return x.Authority
 	}
-	return nil
+	return ""
 }
 
-func (x *BenchmarkResults) GetTransactionGrpc() *TransactionBenchmark {
+func (x *NonceAccountResponse) GetNonce() string {
 	if x != nil {
-		return x.TransactionGrpc
+		return x.Nonce
 	}
-	return nil
+	return ""
 }
 
-func (x *BenchmarkResults) GetTransactionJsonrpc() *TransactionBenchmark {
+func (x *NonceAccountResponse) GetLamportsPerSignature() uint64 {
 	if x != nil {
-		return x.TransactionJsonrpc
+		return x.LamportsPerSignature
 	}
-	return nil
+	return 0
 }
 
-func (x *BenchmarkResults) GetBlockGrpc() *BlockBenchmark {
+func (x *NonceAccountResponse) GetLamports() uint64 {
 	if x != nil {
-		return x.BlockGrpc
+		return x.Lamports
 	}
-	return nil
+	return 0
 }
 
-func (x *BenchmarkResults) GetBlockJsonrpc() *BlockBenchmark {
+func (x *NonceAccountResponse) GetSlot() uint64 {
 	if x != nil {
-		return x.BlockJsonrpc
+		return x.Slot
 	}
-	return nil
+	return 0
 }
 
-func (x *BenchmarkResults) GetSummary() *BenchmarkSummary {
+func (x *NonceAccountResponse) GetResponseTimeMs() uint64 {
 	if x != nil {
-		return x.Summary
+		return x.ResponseTimeMs
 	}
-	return nil
+	return 0
 }
 
-// AccountBenchmark represents benchmark results for account operations
-type AccountBenchmark struct {
+func (x *NonceAccountResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// ConsistentReadRequest represents a request for the states of several
+// accounts as of the same slot
+type ConsistentReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AvgResponseTimeMs  uint64 `protobuf:"varint,1,opt,name=avg_response_time_ms,json=avgResponseTimeMs,proto3" json:"avg_response_time_ms,omitempty"`
-	MinResponseTimeMs  uint64 `protobuf:"varint,2,opt,name=min_response_time_ms,json=minResponseTimeMs,proto3" json:"min_response_time_ms,omitempty"`
-	MaxResponseTimeMs  uint64 `protobuf:"varint,3,opt,name=max_response_time_ms,json=maxResponseTimeMs,proto3" json:"max_response_time_ms,omitempty"`
-	SuccessfulRequests uint32 `protobuf:"varint,4,opt,name=successful_requests,json=successfulRequests,proto3" json:"successful_requests,omitempty"`
-	FailedRequests     uint32 `protobuf:"varint,5,opt,name=failed_requests,json=failedRequests,proto3" json:"failed_requests,omitempty"`
+	Pubkeys    []string `protobuf:"bytes,1,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	Commitment string   `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-func (x *AccountBenchmark) Reset() {
-	*x = AccountBenchmark{}
+func (x *ConsistentReadRequest) Reset() {
+	*x = ConsistentReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[14]
+		mi := &file_proto_solana_benchmark_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AccountBenchmark) String() string {
+func (x *ConsistentReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountBenchmark) ProtoMessage() {}
+func (*ConsistentReadRequest) ProtoMessage() {}
 
-func (x *AccountBenchmark) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[14]
+func (x *ConsistentReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,76 +1590,155 @@ func (x *AccountBenchmark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountBenchmark.ProtoReflect.Descriptor instead.
-func (*AccountBenchmark) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ConsistentReadRequest.ProtoReflect.Descriptor instead.
+func (*ConsistentReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AccountBenchmark) GetAvgResponseTimeMs() uint64 {
+func (x *ConsistentReadRequest) GetPubkeys() []string {
 	if x != nil {
-		return x.AvgResponseTimeMs
+		return x.Pubkeys
 	}
-	return 0
+	return nil
 }
 
-func (x *AccountBenchmark) GetMinResponseTimeMs() uint64 {
+func (x *ConsistentReadRequest) GetCommitment() string {
 	if x != nil {
-		return x.MinResponseTimeMs
+		return x.Commitment
 	}
-	return 0
+	return ""
 }
 
-func (x *AccountBenchmark) GetMaxResponseTimeMs() uint64 {
-	if x != nil {
-		return x.MaxResponseTimeMs
-	}
-	return 0
-}
+// ConsistentAccount is the state of one account in a consistent read. Accounts
+// that do not exist at the slot are returned with exists unset.
+type ConsistentAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AccountBenchmark) GetSuccessfulRequests() uint32 {
-	if x != nil {
-		return x.SuccessfulRequests
-	}
-	return 0
+	Pubkey     string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Exists     bool   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	Data       []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Owner      string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Lamports   uint64 `protobuf:"varint,5,opt,name=lamports,proto3" json:"lamports,omitempty"`
+	Executable bool   `protobuf:"varint,6,opt,name=executable,proto3" json:"executable,omitempty"`
+	RentEpoch  uint64 `protobuf:"varint,7,opt,name=rent_epoch,json=rentEpoch,proto3" json:"rent_epoch,omitempty"`
 }
 
-func (x *AccountBenchmark) GetFailedRequests() uint32 {
-	if x != nil {
-		return x.FailedRequests
+func (x *ConsistentAccount) Reset() {
+	*x = ConsistentAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-// TransactionBenchmark represents benchmark results for transaction operations
-type TransactionBenchmark struct {
+func (x *ConsistentAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistentAccount) ProtoMessage() {}
+
+func (x *ConsistentAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistentAccount.ProtoReflect.Descriptor instead.
+func (*ConsistentAccount) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConsistentAccount) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *ConsistentAccount) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *ConsistentAccount) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ConsistentAccount) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ConsistentAccount) GetLamports() uint64 {
+	if x != nil {
+		return x.Lamports
+	}
+	return 0
+}
+
+func (x *ConsistentAccount) GetExecutable() bool {
+	if x != nil {
+		return x.Executable
+	}
+	return false
+}
+
+func (x *ConsistentAccount) GetRentEpoch() uint64 {
+	if x != nil {
+		return x.RentEpoch
+	}
+	return 0
+}
+
+// ConsistentReadResponse holds account states, in request order, that were
+// all read at the same context slot
+type ConsistentReadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AvgResponseTimeMs  uint64 `protobuf:"varint,1,opt,name=avg_response_time_ms,json=avgResponseTimeMs,proto3" json:"avg_response_time_ms,omitempty"`
-	MinResponseTimeMs  uint64 `protobuf:"varint,2,opt,name=min_response_time_ms,json=minResponseTimeMs,proto3" json:"min_response_time_ms,omitempty"`
-	MaxResponseTimeMs  uint64 `protobuf:"varint,3,opt,name=max_response_time_ms,json=maxResponseTimeMs,proto3" json:"max_response_time_ms,omitempty"`
-	SuccessfulRequests uint32 `protobuf:"varint,4,opt,name=successful_requests,json=successfulRequests,proto3" json:"successful_requests,omitempty"`
-	FailedRequests     uint32 `protobuf:"varint,5,opt,name=failed_requests,json=failedRequests,proto3" json:"failed_requests,omitempty"`
+	Slot     uint64               `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Accounts []*ConsistentAccount `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// Number of reads it took to obtain a consistent snapshot
+	Attempts       uint32 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResponseTimeMs uint64 `protobuf:"varint,4,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,5,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
 }
 
-func (x *TransactionBenchmark) Reset() {
-	*x = TransactionBenchmark{}
+func (x *ConsistentReadResponse) Reset() {
+	*x = ConsistentReadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[15]
+		mi := &file_proto_solana_benchmark_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionBenchmark) String() string {
+func (x *ConsistentReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionBenchmark) ProtoMessage() {}
+func (*ConsistentReadResponse) ProtoMessage() {}
 
-func (x *TransactionBenchmark) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[15]
+func (x *ConsistentReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,76 +1749,73 @@ func (x *TransactionBenchmark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionBenchmark.ProtoReflect.Descriptor instead.
-func (*TransactionBenchmark) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ConsistentReadResponse.ProtoReflect.Descriptor instead.
+func (*ConsistentReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TransactionBenchmark) GetAvgResponseTimeMs() uint64 {
+func (x *ConsistentReadResponse) GetSlot() uint64 {
 	if x != nil {
-		return x.AvgResponseTimeMs
+		return x.Slot
 	}
 	return 0
 }
 
-func (x *TransactionBenchmark) GetMinResponseTimeMs() uint64 {
+func (x *ConsistentReadResponse) GetAccounts() []*ConsistentAccount {
 	if x != nil {
-		return x.MinResponseTimeMs
+		return x.Accounts
 	}
-	return 0
+	return nil
 }
 
-func (x *TransactionBenchmark) GetMaxResponseTimeMs() uint64 {
+func (x *ConsistentReadResponse) GetAttempts() uint32 {
 	if x != nil {
-		return x.MaxResponseTimeMs
+		return x.Attempts
 	}
 	return 0
 }
 
-func (x *TransactionBenchmark) GetSuccessfulRequests() uint32 {
+func (x *ConsistentReadResponse) GetResponseTimeMs() uint64 {
 	if x != nil {
-		return x.SuccessfulRequests
+		return x.ResponseTimeMs
 	}
 	return 0
 }
 
-func (x *TransactionBenchmark) GetFailedRequests() uint32 {
+func (x *ConsistentReadResponse) GetServerSlot() uint64 {
 	if x != nil {
-		return x.FailedRequests
+		return x.ServerSlot
 	}
 	return 0
 }
 
-// BlockBenchmark represents benchmark results for block operations
-type BlockBenchmark struct {
+// TokenAccountRequest represents a request for a mint or token account
+type TokenAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AvgResponseTimeMs  uint64 `protobuf:"varint,1,opt,name=avg_response_time_ms,json=avgResponseTimeMs,proto3" json:"avg_response_time_ms,omitempty"`
-	MinResponseTimeMs  uint64 `protobuf:"varint,2,opt,name=min_response_time_ms,json=minResponseTimeMs,proto3" json:"min_response_time_ms,omitempty"`
-	MaxResponseTimeMs  uint64 `protobuf:"varint,3,opt,name=max_response_time_ms,json=maxResponseTimeMs,proto3" json:"max_response_time_ms,omitempty"`
-	SuccessfulRequests uint32 `protobuf:"varint,4,opt,name=successful_requests,json=successfulRequests,proto3" json:"successful_requests,omitempty"`
-	FailedRequests     uint32 `protobuf:"varint,5,opt,name=failed_requests,json=failedRequests,proto3" json:"failed_requests,omitempty"`
+	Pubkey     string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-func (x *BlockBenchmark) Reset() {
-	*x = BlockBenchmark{}
+func (x *TokenAccountRequest) Reset() {
+	*x = TokenAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[16]
+		mi := &file_proto_solana_benchmark_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockBenchmark) String() string {
+func (x *TokenAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockBenchmark) ProtoMessage() {}
+func (*TokenAccountRequest) ProtoMessage() {}
 
-func (x *BlockBenchmark) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[16]
+func (x *TokenAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,74 +1826,162 @@ func (x *BlockBenchmark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockBenchmark.ProtoReflect.Descriptor instead.
-func (*BlockBenchmark) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use TokenAccountRequest.ProtoReflect.Descriptor instead.
+func (*TokenAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BlockBenchmark) GetAvgResponseTimeMs() uint64 {
+func (x *TokenAccountRequest) GetPubkey() string {
 	if x != nil {
-		return x.AvgResponseTimeMs
+		return x.Pubkey
 	}
-	return 0
+	return ""
 }
 
-func (x *BlockBenchmark) GetMinResponseTimeMs() uint64 {
+func (x *TokenAccountRequest) GetCommitment() string {
 	if x != nil {
-		return x.MinResponseTimeMs
+		return x.Commitment
 	}
-	return 0
+	return ""
 }
 
-func (x *BlockBenchmark) GetMaxResponseTimeMs() uint64 {
+// TokenAccountResponse holds a decoded mint or token account; exactly one of
+// mint and account is set. Extensions are only found on Token-2022 accounts.
+type TokenAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey     string             `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	ProgramId  string             `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Mint       *TokenMint         `protobuf:"bytes,3,opt,name=mint,proto3" json:"mint,omitempty"`
+	Account    *TokenAccountState `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Extensions *TokenExtensions   `protobuf:"bytes,5,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	// Slot the state was read at
+	Slot           uint64 `protobuf:"varint,6,opt,name=slot,proto3" json:"slot,omitempty"`
+	ResponseTimeMs uint64 `protobuf:"varint,7,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,8,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *TokenAccountResponse) Reset() {
+	*x = TokenAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenAccountResponse) ProtoMessage() {}
+
+func (x *TokenAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenAccountResponse.ProtoReflect.Descriptor instead.
+func (*TokenAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TokenAccountResponse) GetPubkey() string {
 	if x != nil {
-		return x.MaxResponseTimeMs
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *TokenAccountResponse) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *TokenAccountResponse) GetMint() *TokenMint {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *TokenAccountResponse) GetAccount() *TokenAccountState {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *TokenAccountResponse) GetExtensions() *TokenExtensions {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *TokenAccountResponse) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
 	}
 	return 0
 }
 
-func (x *BlockBenchmark) GetSuccessfulRequests() uint32 {
+func (x *TokenAccountResponse) GetResponseTimeMs() uint64 {
 	if x != nil {
-		return x.SuccessfulRequests
+		return x.ResponseTimeMs
 	}
 	return 0
 }
 
-func (x *BlockBenchmark) GetFailedRequests() uint32 {
+func (x *TokenAccountResponse) GetServerSlot() uint64 {
 	if x != nil {
-		return x.FailedRequests
+		return x.ServerSlot
 	}
 	return 0
 }
 
-// BenchmarkSummary represents an overall summary of benchmark results
-type BenchmarkSummary struct {
+// TokenMint is the base state of a mint. Unset authorities are empty.
+type TokenMint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalDurationMs      uint64  `protobuf:"varint,1,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
-	GrpcVsJsonrpcSpeedup float64 `protobuf:"fixed64,2,opt,name=grpc_vs_jsonrpc_speedup,json=grpcVsJsonrpcSpeedup,proto3" json:"grpc_vs_jsonrpc_speedup,omitempty"`
-	Conclusion           string  `protobuf:"bytes,3,opt,name=conclusion,proto3" json:"conclusion,omitempty"`
+	MintAuthority   string `protobuf:"bytes,1,opt,name=mint_authority,json=mintAuthority,proto3" json:"mint_authority,omitempty"`
+	Supply          uint64 `protobuf:"varint,2,opt,name=supply,proto3" json:"supply,omitempty"`
+	Decimals        uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	IsInitialized   bool   `protobuf:"varint,4,opt,name=is_initialized,json=isInitialized,proto3" json:"is_initialized,omitempty"`
+	FreezeAuthority string `protobuf:"bytes,5,opt,name=freeze_authority,json=freezeAuthority,proto3" json:"freeze_authority,omitempty"`
 }
 
-func (x *BenchmarkSummary) Reset() {
-	*x = BenchmarkSummary{}
+func (x *TokenMint) Reset() {
+	*x = TokenMint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_solana_benchmark_proto_msgTypes[17]
+		mi := &file_proto_solana_benchmark_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BenchmarkSummary) String() string {
+func (x *TokenMint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BenchmarkSummary) ProtoMessage() {}
+func (*TokenMint) ProtoMessage() {}
 
-func (x *BenchmarkSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_benchmark_proto_msgTypes[17]
+func (x *TokenMint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,369 +1992,17988 @@ func (x *BenchmarkSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BenchmarkSummary.ProtoReflect.Descriptor instead.
-func (*BenchmarkSummary) Descriptor() ([]byte, []int) {
-	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use TokenMint.ProtoReflect.Descriptor instead.
+func (*TokenMint) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BenchmarkSummary) GetTotalDurationMs() uint64 {
+func (x *TokenMint) GetMintAuthority() string {
 	if x != nil {
-		return x.TotalDurationMs
+		return x.MintAuthority
+	}
+	return ""
+}
+
+func (x *TokenMint) GetSupply() uint64 {
+	if x != nil {
+		return x.Supply
 	}
 	return 0
 }
 
-func (x *BenchmarkSummary) GetGrpcVsJsonrpcSpeedup() float64 {
+func (x *TokenMint) GetDecimals() uint32 {
 	if x != nil {
-		return x.GrpcVsJsonrpcSpeedup
+		return x.Decimals
 	}
 	return 0
 }
 
-func (x *BenchmarkSummary) GetConclusion() string {
+func (x *TokenMint) GetIsInitialized() bool {
 	if x != nil {
-		return x.Conclusion
+		return x.IsInitialized
+	}
+	return false
+}
+
+func (x *TokenMint) GetFreezeAuthority() string {
+	if x != nil {
+		return x.FreezeAuthority
 	}
 	return ""
 }
 
-var File_proto_solana_benchmark_proto protoreflect.FileDescriptor
+// TokenAccountState is the base state of a token account. Unset delegates and
+// authorities are empty; native_reserve is set for wrapped SOL accounts.
+type TokenAccountState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_solana_benchmark_proto_rawDesc = []byte{
-	0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x5f, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
-	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x22, 0x75, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27,
-	0x0a, 0x0f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
-	0x67, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x22, 0xdc, 0x01, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x10,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x52, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x13, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x42, 0x0a, 0x0c, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
-	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xdf,
-	0x01, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61,
-	0x73, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c,
-	0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
-	0x22, 0x50, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x6b,
-	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0x7d, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x34, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x0b,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a,
-	0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69,
-	0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x97, 0x02, 0x0a, 0x10,
-	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x04, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x24, 0x0a,
-	0x0e, 0x72, 0x75, 0x6e, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x75, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x65,
-	0x73, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x72,
-	0x70, 0x63, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
-	0x72, 0x75, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12,
-	0x24, 0x0a, 0x0e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x5f, 0x72, 0x70, 0x63, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x52,
-	0x70, 0x63, 0x55, 0x72, 0x6c, 0x22, 0x98, 0x04, 0x0a, 0x10, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
-	0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
-	0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68,
-	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x72, 0x70,
-	0x63, 0x12, 0x4b, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c,
-	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0e,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x12, 0x51,
-	0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72,
-	0x70, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
-	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x70,
-	0x63, 0x12, 0x57, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x6e,
-	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x12, 0x3f, 0x0a, 0x0a, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x72, 0x70, 0x63, 0x12, 0x45, 0x0a, 0x0d, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x65, 0x6e, 0x63, 0x68,
-	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4a, 0x73, 0x6f, 0x6e, 0x72,
-	0x70, 0x63, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x22, 0xff, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x2f, 0x0a, 0x14, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x76, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x2f, 0x0a, 0x14, 0x61,
-	0x76, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x76, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14,
-	0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a,
-	0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f,
-	0x0a, 0x13, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x0e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x2f, 0x0a, 0x14, 0x61,
-	0x76, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x76, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14,
-	0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a,
-	0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f,
-	0x0a, 0x13, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x42, 0x65, 0x6e,
-	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2a, 0x0a,
-	0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x67, 0x72, 0x70,
-	0x63, 0x5f, 0x76, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x70, 0x65,
-	0x65, 0x64, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x67, 0x72, 0x70, 0x63,
-	0x56, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x53, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
-	0x32, 0x98, 0x05, 0x0a, 0x10, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
-	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
-	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73,
-	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x61, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
-	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
-	0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
-	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x0c,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x2e, 0x73,
-	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
-	0x61, 0x72, 0x6b, 0x12, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
-	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68,
-	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x42, 0x32, 0x5a, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x2d, 0x74, 0x6f, 0x7a, 0x65,
-	0x72, 0x2f, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x65, 0x78,
-	0x70, 0x6c, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Mint            string             `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	Owner           string             `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Amount          uint64             `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Delegate        string             `protobuf:"bytes,4,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Status          TokenAccountStatus `protobuf:"varint,5,opt,name=status,proto3,enum=solana.benchmark.TokenAccountStatus" json:"status,omitempty"`
+	IsNative        bool               `protobuf:"varint,6,opt,name=is_native,json=isNative,proto3" json:"is_native,omitempty"`
+	NativeReserve   uint64             `protobuf:"varint,7,opt,name=native_reserve,json=nativeReserve,proto3" json:"native_reserve,omitempty"`
+	DelegatedAmount uint64             `protobuf:"varint,8,opt,name=delegated_amount,json=delegatedAmount,proto3" json:"delegated_amount,omitempty"`
+	CloseAuthority  string             `protobuf:"bytes,9,opt,name=close_authority,json=closeAuthority,proto3" json:"close_authority,omitempty"`
 }
 
-var (
-	file_proto_solana_benchmark_proto_rawDescOnce sync.Once
-	file_proto_solana_benchmark_proto_rawDescData = file_proto_solana_benchmark_proto_rawDesc
-)
+func (x *TokenAccountState) Reset() {
+	*x = TokenAccountState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_solana_benchmark_proto_rawDescGZIP() []byte {
-	file_proto_solana_benchmark_proto_rawDescOnce.Do(func() {
-		file_proto_solana_benchmark_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_solana_benchmark_proto_rawDescData)
-	})
-	return file_proto_solana_benchmark_proto_rawDescData
+func (x *TokenAccountState) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_solana_benchmark_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_proto_solana_benchmark_proto_goTypes = []interface{}{
-	(*AccountInfoRequest)(nil),       // 0: solana.benchmark.AccountInfoRequest
-	(*AccountInfoResponse)(nil),      // 1: solana.benchmark.AccountInfoResponse
-	(*TransactionRequest)(nil),       // 2: solana.benchmark.TransactionRequest
-	(*TransactionResponse)(nil),      // 3: solana.benchmark.TransactionResponse
-	(*BlockRequest)(nil),             // 4: solana.benchmark.BlockRequest
-	(*BlockResponse)(nil),            // 5: solana.benchmark.BlockResponse
-	(*AccountStreamRequest)(nil),     // 6: solana.benchmark.AccountStreamRequest
-	(*AccountUpdate)(nil),            // 7: solana.benchmark.AccountUpdate
-	(*TransactionStreamRequest)(nil), // 8: solana.benchmark.TransactionStreamRequest
-	(*TransactionUpdate)(nil),        // 9: solana.benchmark.TransactionUpdate
-	(*BlockStreamRequest)(nil),       // 10: solana.benchmark.BlockStreamRequest
-	(*BlockUpdate)(nil),              // 11: solana.benchmark.BlockUpdate
-	(*BenchmarkRequest)(nil),         // 12: solana.benchmark.BenchmarkRequest
-	(*BenchmarkResults)(nil),         // 13: solana.benchmark.BenchmarkResults
-	(*AccountBenchmark)(nil),         // 14: solana.benchmark.AccountBenchmark
-	(*TransactionBenchmark)(nil),     // 15: solana.benchmark.TransactionBenchmark
-	(*BlockBenchmark)(nil),           // 16: solana.benchmark.BlockBenchmark
-	(*BenchmarkSummary)(nil),         // 17: solana.benchmark.BenchmarkSummary
+func (*TokenAccountState) ProtoMessage() {}
+
+func (x *TokenAccountState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_solana_benchmark_proto_depIdxs = []int32{
-	14, // 0: solana.benchmark.BenchmarkResults.account_grpc:type_name -> solana.benchmark.AccountBenchmark
-	14, // 1: solana.benchmark.BenchmarkResults.account_jsonrpc:type_name -> solana.benchmark.AccountBenchmark
-	15, // 2: solana.benchmark.BenchmarkResults.transaction_grpc:type_name -> solana.benchmark.TransactionBenchmark
-	15, // 3: solana.benchmark.BenchmarkResults.transaction_jsonrpc:type_name -> solana.benchmark.TransactionBenchmark
-	16, // 4: solana.benchmark.BenchmarkResults.block_grpc:type_name -> solana.benchmark.BlockBenchmark
-	16, // 5: solana.benchmark.BenchmarkResults.block_jsonrpc:type_name -> solana.benchmark.BlockBenchmark
-	17, // 6: solana.benchmark.BenchmarkResults.summary:type_name -> solana.benchmark.BenchmarkSummary
-	0,  // 7: solana.benchmark.BenchmarkService.GetAccountInfo:input_type -> solana.benchmark.AccountInfoRequest
-	2,  // 8: solana.benchmark.BenchmarkService.GetTransaction:input_type -> solana.benchmark.TransactionRequest
-	4,  // 9: solana.benchmark.BenchmarkService.GetBlock:input_type -> solana.benchmark.BlockRequest
-	6,  // 10: solana.benchmark.BenchmarkService.StreamAccountUpdates:input_type -> solana.benchmark.AccountStreamRequest
-	8,  // 11: solana.benchmark.BenchmarkService.StreamTransactions:input_type -> solana.benchmark.TransactionStreamRequest
-	10, // 12: solana.benchmark.BenchmarkService.StreamBlocks:input_type -> solana.benchmark.BlockStreamRequest
-	12, // 13: solana.benchmark.BenchmarkService.RunBenchmark:input_type -> solana.benchmark.BenchmarkRequest
-	1,  // 14: solana.benchmark.BenchmarkService.GetAccountInfo:output_type -> solana.benchmark.AccountInfoResponse
-	3,  // 15: solana.benchmark.BenchmarkService.GetTransaction:output_type -> solana.benchmark.TransactionResponse
-	5,  // 16: solana.benchmark.BenchmarkService.GetBlock:output_type -> solana.benchmark.BlockResponse
-	7,  // 17: solana.benchmark.BenchmarkService.StreamAccountUpdates:output_type -> solana.benchmark.AccountUpdate
-	9,  // 18: solana.benchmark.BenchmarkService.StreamTransactions:output_type -> solana.benchmark.TransactionUpdate
-	11, // 19: solana.benchmark.BenchmarkService.StreamBlocks:output_type -> solana.benchmark.BlockUpdate
-	13, // 20: solana.benchmark.BenchmarkService.RunBenchmark:output_type -> solana.benchmark.BenchmarkResults
-	14, // [14:21] is the sub-list for method output_type
-	7,  // [7:14] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+// Deprecated: Use TokenAccountState.ProtoReflect.Descriptor instead.
+func (*TokenAccountState) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{11}
 }
 
-func init() { file_proto_solana_benchmark_proto_init() }
-func file_proto_solana_benchmark_proto_init() {
-	if File_proto_solana_benchmark_proto != nil {
-		return
+func (x *TokenAccountState) GetMint() string {
+	if x != nil {
+		return x.Mint
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_solana_benchmark_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountInfoRequest); i {
+	return ""
+}
+
+func (x *TokenAccountState) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *TokenAccountState) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TokenAccountState) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *TokenAccountState) GetStatus() TokenAccountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TokenAccountStatus_TOKEN_ACCOUNT_STATUS_UNINITIALIZED
+}
+
+func (x *TokenAccountState) GetIsNative() bool {
+	if x != nil {
+		return x.IsNative
+	}
+	return false
+}
+
+func (x *TokenAccountState) GetNativeReserve() uint64 {
+	if x != nil {
+		return x.NativeReserve
+	}
+	return 0
+}
+
+func (x *TokenAccountState) GetDelegatedAmount() uint64 {
+	if x != nil {
+		return x.DelegatedAmount
+	}
+	return 0
+}
+
+func (x *TokenAccountState) GetCloseAuthority() string {
+	if x != nil {
+		return x.CloseAuthority
+	}
+	return ""
+}
+
+// TokenExtensions holds the decoded Token-2022 extensions of a mint or token
+// account. Extensions that are present but not decoded are listed by type.
+type TokenExtensions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferFeeConfig     *TransferFeeConfig     `protobuf:"bytes,1,opt,name=transfer_fee_config,json=transferFeeConfig,proto3" json:"transfer_fee_config,omitempty"`
+	TransferFeeAmount     *TransferFeeAmount     `protobuf:"bytes,2,opt,name=transfer_fee_amount,json=transferFeeAmount,proto3" json:"transfer_fee_amount,omitempty"`
+	InterestBearingConfig *InterestBearingConfig `protobuf:"bytes,3,opt,name=interest_bearing_config,json=interestBearingConfig,proto3" json:"interest_bearing_config,omitempty"`
+	MetadataPointer       *MetadataPointer       `protobuf:"bytes,4,opt,name=metadata_pointer,json=metadataPointer,proto3" json:"metadata_pointer,omitempty"`
+	OtherTypes            []uint32               `protobuf:"varint,5,rep,packed,name=other_types,json=otherTypes,proto3" json:"other_types,omitempty"`
+}
+
+func (x *TokenExtensions) Reset() {
+	*x = TokenExtensions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenExtensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenExtensions) ProtoMessage() {}
+
+func (x *TokenExtensions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenExtensions.ProtoReflect.Descriptor instead.
+func (*TokenExtensions) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TokenExtensions) GetTransferFeeConfig() *TransferFeeConfig {
+	if x != nil {
+		return x.TransferFeeConfig
+	}
+	return nil
+}
+
+func (x *TokenExtensions) GetTransferFeeAmount() *TransferFeeAmount {
+	if x != nil {
+		return x.TransferFeeAmount
+	}
+	return nil
+}
+
+func (x *TokenExtensions) GetInterestBearingConfig() *InterestBearingConfig {
+	if x != nil {
+		return x.InterestBearingConfig
+	}
+	return nil
+}
+
+func (x *TokenExtensions) GetMetadataPointer() *MetadataPointer {
+	if x != nil {
+		return x.MetadataPointer
+	}
+	return nil
+}
+
+func (x *TokenExtensions) GetOtherTypes() []uint32 {
+	if x != nil {
+		return x.OtherTypes
+	}
+	return nil
+}
+
+// TransferFee is a transfer fee schedule, in effect from its epoch on
+type TransferFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch       uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	MaximumFee  uint64 `protobuf:"varint,2,opt,name=maximum_fee,json=maximumFee,proto3" json:"maximum_fee,omitempty"`
+	BasisPoints uint32 `protobuf:"varint,3,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (x *TransferFee) Reset() {
+	*x = TransferFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferFee) ProtoMessage() {}
+
+func (x *TransferFee) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferFee.ProtoReflect.Descriptor instead.
+func (*TransferFee) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransferFee) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *TransferFee) GetMaximumFee() uint64 {
+	if x != nil {
+		return x.MaximumFee
+	}
+	return 0
+}
+
+func (x *TransferFee) GetBasisPoints() uint32 {
+	if x != nil {
+		return x.BasisPoints
+	}
+	return 0
+}
+
+// TransferFeeConfig is the transfer fee extension of a mint. The newer fee
+// takes over from the older one at its epoch.
+type TransferFeeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigAuthority           string       `protobuf:"bytes,1,opt,name=config_authority,json=configAuthority,proto3" json:"config_authority,omitempty"`
+	WithdrawWithheldAuthority string       `protobuf:"bytes,2,opt,name=withdraw_withheld_authority,json=withdrawWithheldAuthority,proto3" json:"withdraw_withheld_authority,omitempty"`
+	WithheldAmount            uint64       `protobuf:"varint,3,opt,name=withheld_amount,json=withheldAmount,proto3" json:"withheld_amount,omitempty"`
+	OlderTransferFee          *TransferFee `protobuf:"bytes,4,opt,name=older_transfer_fee,json=olderTransferFee,proto3" json:"older_transfer_fee,omitempty"`
+	NewerTransferFee          *TransferFee `protobuf:"bytes,5,opt,name=newer_transfer_fee,json=newerTransferFee,proto3" json:"newer_transfer_fee,omitempty"`
+}
+
+func (x *TransferFeeConfig) Reset() {
+	*x = TransferFeeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferFeeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferFeeConfig) ProtoMessage() {}
+
+func (x *TransferFeeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferFeeConfig.ProtoReflect.Descriptor instead.
+func (*TransferFeeConfig) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TransferFeeConfig) GetConfigAuthority() string {
+	if x != nil {
+		return x.ConfigAuthority
+	}
+	return ""
+}
+
+func (x *TransferFeeConfig) GetWithdrawWithheldAuthority() string {
+	if x != nil {
+		return x.WithdrawWithheldAuthority
+	}
+	return ""
+}
+
+func (x *TransferFeeConfig) GetWithheldAmount() uint64 {
+	if x != nil {
+		return x.WithheldAmount
+	}
+	return 0
+}
+
+func (x *TransferFeeConfig) GetOlderTransferFee() *TransferFee {
+	if x != nil {
+		return x.OlderTransferFee
+	}
+	return nil
+}
+
+func (x *TransferFeeConfig) GetNewerTransferFee() *TransferFee {
+	if x != nil {
+		return x.NewerTransferFee
+	}
+	return nil
+}
+
+// TransferFeeAmount holds the fees withheld in a token account
+type TransferFeeAmount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WithheldAmount uint64 `protobuf:"varint,1,opt,name=withheld_amount,json=withheldAmount,proto3" json:"withheld_amount,omitempty"`
+}
+
+func (x *TransferFeeAmount) Reset() {
+	*x = TransferFeeAmount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferFeeAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferFeeAmount) ProtoMessage() {}
+
+func (x *TransferFeeAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferFeeAmount.ProtoReflect.Descriptor instead.
+func (*TransferFeeAmount) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TransferFeeAmount) GetWithheldAmount() uint64 {
+	if x != nil {
+		return x.WithheldAmount
+	}
+	return 0
+}
+
+// InterestBearingConfig is the interest-bearing extension of a mint. Rates
+// are in basis points per year and timestamps are Unix times.
+type InterestBearingConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RateAuthority           string `protobuf:"bytes,1,opt,name=rate_authority,json=rateAuthority,proto3" json:"rate_authority,omitempty"`
+	InitializationTimestamp int64  `protobuf:"varint,2,opt,name=initialization_timestamp,json=initializationTimestamp,proto3" json:"initialization_timestamp,omitempty"`
+	PreUpdateAverageRate    int32  `protobuf:"varint,3,opt,name=pre_update_average_rate,json=preUpdateAverageRate,proto3" json:"pre_update_average_rate,omitempty"`
+	LastUpdateTimestamp     int64  `protobuf:"varint,4,opt,name=last_update_timestamp,json=lastUpdateTimestamp,proto3" json:"last_update_timestamp,omitempty"`
+	CurrentRate             int32  `protobuf:"varint,5,opt,name=current_rate,json=currentRate,proto3" json:"current_rate,omitempty"`
+}
+
+func (x *InterestBearingConfig) Reset() {
+	*x = InterestBearingConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterestBearingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterestBearingConfig) ProtoMessage() {}
+
+func (x *InterestBearingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterestBearingConfig.ProtoReflect.Descriptor instead.
+func (*InterestBearingConfig) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InterestBearingConfig) GetRateAuthority() string {
+	if x != nil {
+		return x.RateAuthority
+	}
+	return ""
+}
+
+func (x *InterestBearingConfig) GetInitializationTimestamp() int64 {
+	if x != nil {
+		return x.InitializationTimestamp
+	}
+	return 0
+}
+
+func (x *InterestBearingConfig) GetPreUpdateAverageRate() int32 {
+	if x != nil {
+		return x.PreUpdateAverageRate
+	}
+	return 0
+}
+
+func (x *InterestBearingConfig) GetLastUpdateTimestamp() int64 {
+	if x != nil {
+		return x.LastUpdateTimestamp
+	}
+	return 0
+}
+
+func (x *InterestBearingConfig) GetCurrentRate() int32 {
+	if x != nil {
+		return x.CurrentRate
+	}
+	return 0
+}
+
+// MetadataPointer is the metadata pointer extension of a mint
+type MetadataPointer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MetadataAddress string `protobuf:"bytes,2,opt,name=metadata_address,json=metadataAddress,proto3" json:"metadata_address,omitempty"`
+}
+
+func (x *MetadataPointer) Reset() {
+	*x = MetadataPointer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataPointer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataPointer) ProtoMessage() {}
+
+func (x *MetadataPointer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataPointer.ProtoReflect.Descriptor instead.
+func (*MetadataPointer) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MetadataPointer) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MetadataPointer) GetMetadataAddress() string {
+	if x != nil {
+		return x.MetadataAddress
+	}
+	return ""
+}
+
+// NFTsByOwnerRequest represents a request for the NFTs held by an owner
+type NFTsByOwnerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner      string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *NFTsByOwnerRequest) Reset() {
+	*x = NFTsByOwnerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NFTsByOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTsByOwnerRequest) ProtoMessage() {}
+
+func (x *NFTsByOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTsByOwnerRequest.ProtoReflect.Descriptor instead.
+func (*NFTsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *NFTsByOwnerRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *NFTsByOwnerRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+// NFTsByOwnerResponse lists the NFTs held by an owner: token accounts holding
+// exactly one token of a mint with no decimals
+type NFTsByOwnerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Nfts  []*NFT `protobuf:"bytes,2,rep,name=nfts,proto3" json:"nfts,omitempty"`
+	// Set when the owner holds more candidate NFTs than a request resolves
+	Truncated      bool   `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	ResponseTimeMs uint64 `protobuf:"varint,4,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,5,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *NFTsByOwnerResponse) Reset() {
+	*x = NFTsByOwnerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NFTsByOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTsByOwnerResponse) ProtoMessage() {}
+
+func (x *NFTsByOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTsByOwnerResponse.ProtoReflect.Descriptor instead.
+func (*NFTsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *NFTsByOwnerResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *NFTsByOwnerResponse) GetNfts() []*NFT {
+	if x != nil {
+		return x.Nfts
+	}
+	return nil
+}
+
+func (x *NFTsByOwnerResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *NFTsByOwnerResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *NFTsByOwnerResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// NFT is one NFT held by the owner
+type NFT struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mint         string `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	TokenAccount string `protobuf:"bytes,2,opt,name=token_account,json=tokenAccount,proto3" json:"token_account,omitempty"`
+	ProgramId    string `protobuf:"bytes,3,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// Metaplex metadata, unset when the mint has none
+	Metadata *NFTMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *NFT) Reset() {
+	*x = NFT{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NFT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFT) ProtoMessage() {}
+
+func (x *NFT) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFT.ProtoReflect.Descriptor instead.
+func (*NFT) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NFT) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *NFT) GetTokenAccount() string {
+	if x != nil {
+		return x.TokenAccount
+	}
+	return ""
+}
+
+func (x *NFT) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *NFT) GetMetadata() *NFTMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// NFTMetadata is the decoded Metaplex token metadata of a mint. The off-chain
+// JSON at the URI is not fetched.
+type NFTMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address              string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	UpdateAuthority      string        `protobuf:"bytes,2,opt,name=update_authority,json=updateAuthority,proto3" json:"update_authority,omitempty"`
+	Name                 string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol               string        `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Uri                  string        `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	SellerFeeBasisPoints uint32        `protobuf:"varint,6,opt,name=seller_fee_basis_points,json=sellerFeeBasisPoints,proto3" json:"seller_fee_basis_points,omitempty"`
+	Creators             []*NFTCreator `protobuf:"bytes,7,rep,name=creators,proto3" json:"creators,omitempty"`
+	PrimarySaleHappened  bool          `protobuf:"varint,8,opt,name=primary_sale_happened,json=primarySaleHappened,proto3" json:"primary_sale_happened,omitempty"`
+	IsMutable            bool          `protobuf:"varint,9,opt,name=is_mutable,json=isMutable,proto3" json:"is_mutable,omitempty"`
+	// Collection the NFT claims to belong to, empty if none
+	Collection         string `protobuf:"bytes,10,opt,name=collection,proto3" json:"collection,omitempty"`
+	CollectionVerified bool   `protobuf:"varint,11,opt,name=collection_verified,json=collectionVerified,proto3" json:"collection_verified,omitempty"`
+}
+
+func (x *NFTMetadata) Reset() {
+	*x = NFTMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NFTMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTMetadata) ProtoMessage() {}
+
+func (x *NFTMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTMetadata.ProtoReflect.Descriptor instead.
+func (*NFTMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NFTMetadata) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetUpdateAuthority() string {
+	if x != nil {
+		return x.UpdateAuthority
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetSellerFeeBasisPoints() uint32 {
+	if x != nil {
+		return x.SellerFeeBasisPoints
+	}
+	return 0
+}
+
+func (x *NFTMetadata) GetCreators() []*NFTCreator {
+	if x != nil {
+		return x.Creators
+	}
+	return nil
+}
+
+func (x *NFTMetadata) GetPrimarySaleHappened() bool {
+	if x != nil {
+		return x.PrimarySaleHappened
+	}
+	return false
+}
+
+func (x *NFTMetadata) GetIsMutable() bool {
+	if x != nil {
+		return x.IsMutable
+	}
+	return false
+}
+
+func (x *NFTMetadata) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *NFTMetadata) GetCollectionVerified() bool {
+	if x != nil {
+		return x.CollectionVerified
+	}
+	return false
+}
+
+// NFTCreator is a creator of an NFT with their share of royalties in percent
+type NFTCreator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Verified bool   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	Share    uint32 `protobuf:"varint,3,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (x *NFTCreator) Reset() {
+	*x = NFTCreator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NFTCreator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTCreator) ProtoMessage() {}
+
+func (x *NFTCreator) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTCreator.ProtoReflect.Descriptor instead.
+func (*NFTCreator) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NFTCreator) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NFTCreator) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *NFTCreator) GetShare() uint32 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+// AssetRequest represents a request for a digital asset by ID
+type AssetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AssetRequest) Reset() {
+	*x = AssetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetRequest) ProtoMessage() {}
+
+func (x *AssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetRequest.ProtoReflect.Descriptor instead.
+func (*AssetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AssetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// AssetsByOwnerRequest represents a request for a page of an owner's digital
+// assets. Pages start at 1; limit 0 uses the upstream's default.
+type AssetsByOwnerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Page  uint32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *AssetsByOwnerRequest) Reset() {
+	*x = AssetsByOwnerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetsByOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetsByOwnerRequest) ProtoMessage() {}
+
+func (x *AssetsByOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetsByOwnerRequest.ProtoReflect.Descriptor instead.
+func (*AssetsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AssetsByOwnerRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *AssetsByOwnerRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AssetsByOwnerRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Asset is a digital asset as returned by the Digital Asset Standard API,
+// including compressed NFTs, with the commonly used fields mapped
+type Asset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Asset interface, such as V1_NFT or ProgrammableNFT
+	Interface  string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol     string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	JsonUri    string `protobuf:"bytes,5,opt,name=json_uri,json=jsonUri,proto3" json:"json_uri,omitempty"`
+	Owner      string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Collection string `protobuf:"bytes,7,opt,name=collection,proto3" json:"collection,omitempty"`
+	Mutable    bool   `protobuf:"varint,8,opt,name=mutable,proto3" json:"mutable,omitempty"`
+	Burnt      bool   `protobuf:"varint,9,opt,name=burnt,proto3" json:"burnt,omitempty"`
+	// Compression details, set for compressed assets
+	Compressed bool   `protobuf:"varint,10,opt,name=compressed,proto3" json:"compressed,omitempty"`
+	Tree       string `protobuf:"bytes,11,opt,name=tree,proto3" json:"tree,omitempty"`
+	LeafId     uint64 `protobuf:"varint,12,opt,name=leaf_id,json=leafId,proto3" json:"leaf_id,omitempty"`
+	// The upstream's JSON for the asset, for fields not mapped above
+	RawJson []byte `protobuf:"bytes,13,opt,name=raw_json,json=rawJson,proto3" json:"raw_json,omitempty"`
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Asset) ProtoMessage() {}
+
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Asset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Asset) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Asset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Asset) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Asset) GetJsonUri() string {
+	if x != nil {
+		return x.JsonUri
+	}
+	return ""
+}
+
+func (x *Asset) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Asset) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *Asset) GetMutable() bool {
+	if x != nil {
+		return x.Mutable
+	}
+	return false
+}
+
+func (x *Asset) GetBurnt() bool {
+	if x != nil {
+		return x.Burnt
+	}
+	return false
+}
+
+func (x *Asset) GetCompressed() bool {
+	if x != nil {
+		return x.Compressed
+	}
+	return false
+}
+
+func (x *Asset) GetTree() string {
+	if x != nil {
+		return x.Tree
+	}
+	return ""
+}
+
+func (x *Asset) GetLeafId() uint64 {
+	if x != nil {
+		return x.LeafId
+	}
+	return 0
+}
+
+func (x *Asset) GetRawJson() []byte {
+	if x != nil {
+		return x.RawJson
+	}
+	return nil
+}
+
+// AssetResponse holds a single digital asset
+type AssetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset          *Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	ResponseTimeMs uint64 `protobuf:"varint,2,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,3,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *AssetResponse) Reset() {
+	*x = AssetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetResponse) ProtoMessage() {}
+
+func (x *AssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetResponse.ProtoReflect.Descriptor instead.
+func (*AssetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *AssetResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *AssetResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// AssetsByOwnerResponse holds a page of an owner's digital assets
+type AssetsByOwnerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assets         []*Asset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	Total          uint32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page           uint32   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	ResponseTimeMs uint64   `protobuf:"varint,5,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,6,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *AssetsByOwnerResponse) Reset() {
+	*x = AssetsByOwnerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetsByOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetsByOwnerResponse) ProtoMessage() {}
+
+func (x *AssetsByOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetsByOwnerResponse.ProtoReflect.Descriptor instead.
+func (*AssetsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AssetsByOwnerResponse) GetAssets() []*Asset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *AssetsByOwnerResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AssetsByOwnerResponse) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AssetsByOwnerResponse) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AssetsByOwnerResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *AssetsByOwnerResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// AssetProofRequest represents a request for the Merkle proof of a compressed asset
+type AssetProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AssetProofRequest) Reset() {
+	*x = AssetProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetProofRequest) ProtoMessage() {}
+
+func (x *AssetProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetProofRequest.ProtoReflect.Descriptor instead.
+func (*AssetProofRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AssetProofRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// AssetProofResponse contains the decoded Merkle proof of a compressed asset.
+// Hashes are raw 32-byte values; proof is ordered from the leaf up.
+type AssetProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tree           string   `protobuf:"bytes,2,opt,name=tree,proto3" json:"tree,omitempty"`
+	Root           []byte   `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Leaf           []byte   `protobuf:"bytes,4,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Proof          [][]byte `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+	NodeIndex      uint64   `protobuf:"varint,6,opt,name=node_index,json=nodeIndex,proto3" json:"node_index,omitempty"`
+	LeafIndex      uint64   `protobuf:"varint,7,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	Depth          uint32   `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	ResponseTimeMs uint64   `protobuf:"varint,9,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,10,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *AssetProofResponse) Reset() {
+	*x = AssetProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetProofResponse) ProtoMessage() {}
+
+func (x *AssetProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetProofResponse.ProtoReflect.Descriptor instead.
+func (*AssetProofResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AssetProofResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssetProofResponse) GetTree() string {
+	if x != nil {
+		return x.Tree
+	}
+	return ""
+}
+
+func (x *AssetProofResponse) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *AssetProofResponse) GetLeaf() []byte {
+	if x != nil {
+		return x.Leaf
+	}
+	return nil
+}
+
+func (x *AssetProofResponse) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *AssetProofResponse) GetNodeIndex() uint64 {
+	if x != nil {
+		return x.NodeIndex
+	}
+	return 0
+}
+
+func (x *AssetProofResponse) GetLeafIndex() uint64 {
+	if x != nil {
+		return x.LeafIndex
+	}
+	return 0
+}
+
+func (x *AssetProofResponse) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *AssetProofResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *AssetProofResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// FindProgramAddressRequest represents a request to derive a PDA
+type FindProgramAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProgramId string   `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Seeds     [][]byte `protobuf:"bytes,2,rep,name=seeds,proto3" json:"seeds,omitempty"`
+}
+
+func (x *FindProgramAddressRequest) Reset() {
+	*x = FindProgramAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindProgramAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindProgramAddressRequest) ProtoMessage() {}
+
+func (x *FindProgramAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindProgramAddressRequest.ProtoReflect.Descriptor instead.
+func (*FindProgramAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FindProgramAddressRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *FindProgramAddressRequest) GetSeeds() [][]byte {
+	if x != nil {
+		return x.Seeds
+	}
+	return nil
+}
+
+// CreateWithSeedRequest represents a request to derive a seeded address
+type CreateWithSeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base  string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Seed  string `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *CreateWithSeedRequest) Reset() {
+	*x = CreateWithSeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWithSeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWithSeedRequest) ProtoMessage() {}
+
+func (x *CreateWithSeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWithSeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateWithSeedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateWithSeedRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *CreateWithSeedRequest) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *CreateWithSeedRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// AssociatedTokenAddressRequest represents a request to derive an associated
+// token account. The token program defaults to SPL Token.
+type AssociatedTokenAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wallet         string `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Mint           string `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	TokenProgramId string `protobuf:"bytes,3,opt,name=token_program_id,json=tokenProgramId,proto3" json:"token_program_id,omitempty"`
+}
+
+func (x *AssociatedTokenAddressRequest) Reset() {
+	*x = AssociatedTokenAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssociatedTokenAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssociatedTokenAddressRequest) ProtoMessage() {}
+
+func (x *AssociatedTokenAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssociatedTokenAddressRequest.ProtoReflect.Descriptor instead.
+func (*AssociatedTokenAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AssociatedTokenAddressRequest) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *AssociatedTokenAddressRequest) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *AssociatedTokenAddressRequest) GetTokenProgramId() string {
+	if x != nil {
+		return x.TokenProgramId
+	}
+	return ""
+}
+
+// DerivedAddress contains a derived address and, for program-derived
+// addresses, its bump seed
+type DerivedAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Bump    uint32 `protobuf:"varint,2,opt,name=bump,proto3" json:"bump,omitempty"`
+}
+
+func (x *DerivedAddress) Reset() {
+	*x = DerivedAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DerivedAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivedAddress) ProtoMessage() {}
+
+func (x *DerivedAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DerivedAddress.ProtoReflect.Descriptor instead.
+func (*DerivedAddress) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DerivedAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DerivedAddress) GetBump() uint32 {
+	if x != nil {
+		return x.Bump
+	}
+	return 0
+}
+
+// ComputeBudget sets the compute budget instructions of a built transaction.
+// A zero unit limit selects a default for the transaction; a zero price adds
+// no priority fee.
+type ComputeBudget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnitLimit              uint32 `protobuf:"varint,1,opt,name=unit_limit,json=unitLimit,proto3" json:"unit_limit,omitempty"`
+	UnitPriceMicroLamports uint64 `protobuf:"varint,2,opt,name=unit_price_micro_lamports,json=unitPriceMicroLamports,proto3" json:"unit_price_micro_lamports,omitempty"`
+}
+
+func (x *ComputeBudget) Reset() {
+	*x = ComputeBudget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeBudget) ProtoMessage() {}
+
+func (x *ComputeBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeBudget.ProtoReflect.Descriptor instead.
+func (*ComputeBudget) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ComputeBudget) GetUnitLimit() uint32 {
+	if x != nil {
+		return x.UnitLimit
+	}
+	return 0
+}
+
+func (x *ComputeBudget) GetUnitPriceMicroLamports() uint64 {
+	if x != nil {
+		return x.UnitPriceMicroLamports
+	}
+	return 0
+}
+
+// TransferTransactionRequest represents a request to build a SOL transfer.
+// The fee payer defaults to the sender.
+type TransferTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From          string         `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string         `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Lamports      uint64         `protobuf:"varint,3,opt,name=lamports,proto3" json:"lamports,omitempty"`
+	FeePayer      string         `protobuf:"bytes,4,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	ComputeBudget *ComputeBudget `protobuf:"bytes,5,opt,name=compute_budget,json=computeBudget,proto3" json:"compute_budget,omitempty"`
+	Commitment    string         `protobuf:"bytes,6,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *TransferTransactionRequest) Reset() {
+	*x = TransferTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTransactionRequest) ProtoMessage() {}
+
+func (x *TransferTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTransactionRequest.ProtoReflect.Descriptor instead.
+func (*TransferTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TransferTransactionRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TransferTransactionRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TransferTransactionRequest) GetLamports() uint64 {
+	if x != nil {
+		return x.Lamports
+	}
+	return 0
+}
+
+func (x *TransferTransactionRequest) GetFeePayer() string {
+	if x != nil {
+		return x.FeePayer
+	}
+	return ""
+}
+
+func (x *TransferTransactionRequest) GetComputeBudget() *ComputeBudget {
+	if x != nil {
+		return x.ComputeBudget
+	}
+	return nil
+}
+
+func (x *TransferTransactionRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+// TokenTransferTransactionRequest represents a request to build a token
+// transfer from the owner's associated token account to the recipient's.
+// The amount is in base units; the fee payer defaults to the owner.
+type TokenTransferTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Mint      string `protobuf:"bytes,3,opt,name=mint,proto3" json:"mint,omitempty"`
+	Amount    uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeePayer  string `protobuf:"bytes,5,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// create_recipient_account creates the recipient's associated token
+	// account, paid by the fee payer, if it does not exist yet
+	CreateRecipientAccount bool           `protobuf:"varint,6,opt,name=create_recipient_account,json=createRecipientAccount,proto3" json:"create_recipient_account,omitempty"`
+	ComputeBudget          *ComputeBudget `protobuf:"bytes,7,opt,name=compute_budget,json=computeBudget,proto3" json:"compute_budget,omitempty"`
+	Commitment             string         `protobuf:"bytes,8,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *TokenTransferTransactionRequest) Reset() {
+	*x = TokenTransferTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenTransferTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransferTransactionRequest) ProtoMessage() {}
+
+func (x *TokenTransferTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransferTransactionRequest.ProtoReflect.Descriptor instead.
+func (*TokenTransferTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TokenTransferTransactionRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *TokenTransferTransactionRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *TokenTransferTransactionRequest) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *TokenTransferTransactionRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TokenTransferTransactionRequest) GetFeePayer() string {
+	if x != nil {
+		return x.FeePayer
+	}
+	return ""
+}
+
+func (x *TokenTransferTransactionRequest) GetCreateRecipientAccount() bool {
+	if x != nil {
+		return x.CreateRecipientAccount
+	}
+	return false
+}
+
+func (x *TokenTransferTransactionRequest) GetComputeBudget() *ComputeBudget {
+	if x != nil {
+		return x.ComputeBudget
+	}
+	return nil
+}
+
+func (x *TokenTransferTransactionRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+// BuiltTransaction contains an unsigned transaction: the serialized
+// transaction with zeroed signatures, and the message its signers sign.
+// The transaction expires after last_valid_block_height.
+type BuiltTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction          []byte   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Message              []byte   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signers              []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	Blockhash            string   `protobuf:"bytes,4,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
+	LastValidBlockHeight uint64   `protobuf:"varint,5,opt,name=last_valid_block_height,json=lastValidBlockHeight,proto3" json:"last_valid_block_height,omitempty"`
+	ComputeUnitLimit     uint32   `protobuf:"varint,6,opt,name=compute_unit_limit,json=computeUnitLimit,proto3" json:"compute_unit_limit,omitempty"`
+	ResponseTimeMs       uint64   `protobuf:"varint,7,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,8,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *BuiltTransaction) Reset() {
+	*x = BuiltTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltTransaction) ProtoMessage() {}
+
+func (x *BuiltTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltTransaction.ProtoReflect.Descriptor instead.
+func (*BuiltTransaction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BuiltTransaction) GetTransaction() []byte {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *BuiltTransaction) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *BuiltTransaction) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *BuiltTransaction) GetBlockhash() string {
+	if x != nil {
+		return x.Blockhash
+	}
+	return ""
+}
+
+func (x *BuiltTransaction) GetLastValidBlockHeight() uint64 {
+	if x != nil {
+		return x.LastValidBlockHeight
+	}
+	return 0
+}
+
+func (x *BuiltTransaction) GetComputeUnitLimit() uint32 {
+	if x != nil {
+		return x.ComputeUnitLimit
+	}
+	return 0
+}
+
+func (x *BuiltTransaction) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *BuiltTransaction) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// VerifySignatureRequest represents a request to verify a base58 signature
+// of a message by a public key
+type VerifySignatureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey    string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Message   []byte `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *VerifySignatureRequest) Reset() {
+	*x = VerifySignatureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifySignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySignatureRequest) ProtoMessage() {}
+
+func (x *VerifySignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySignatureRequest.ProtoReflect.Descriptor instead.
+func (*VerifySignatureRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VerifySignatureRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *VerifySignatureRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *VerifySignatureRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// VerifySignatureResponse contains the result of a signature verification
+type VerifySignatureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *VerifySignatureResponse) Reset() {
+	*x = VerifySignatureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifySignatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySignatureResponse) ProtoMessage() {}
+
+func (x *VerifySignatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySignatureResponse.ProtoReflect.Descriptor instead.
+func (*VerifySignatureResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VerifySignatureResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+// DecodeTransactionRequest represents a request to decode a serialized
+// legacy or version 0 transaction
+type DecodeTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction string              `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Encoding    TransactionEncoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=solana.benchmark.TransactionEncoding" json:"encoding,omitempty"`
+}
+
+func (x *DecodeTransactionRequest) Reset() {
+	*x = DecodeTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeTransactionRequest) ProtoMessage() {}
+
+func (x *DecodeTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DecodeTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DecodeTransactionRequest) GetTransaction() string {
+	if x != nil {
+		return x.Transaction
+	}
+	return ""
+}
+
+func (x *DecodeTransactionRequest) GetEncoding() TransactionEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return TransactionEncoding_TRANSACTION_ENCODING_BASE64
+}
+
+// DecodedTransaction is the structured form of a transaction. Instruction
+// account indexes past the static account keys refer to accounts loaded from
+// the address lookup tables, writable ones first.
+type DecodedTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signatures []*DecodedSignature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// signatures_valid is set if every required signature is present and valid
+	SignaturesValid bool `protobuf:"varint,2,opt,name=signatures_valid,json=signaturesValid,proto3" json:"signatures_valid,omitempty"`
+	// version is "legacy" or "0"
+	Version                     string                       `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	NumRequiredSignatures       uint32                       `protobuf:"varint,4,opt,name=num_required_signatures,json=numRequiredSignatures,proto3" json:"num_required_signatures,omitempty"`
+	NumReadonlySignedAccounts   uint32                       `protobuf:"varint,5,opt,name=num_readonly_signed_accounts,json=numReadonlySignedAccounts,proto3" json:"num_readonly_signed_accounts,omitempty"`
+	NumReadonlyUnsignedAccounts uint32                       `protobuf:"varint,6,opt,name=num_readonly_unsigned_accounts,json=numReadonlyUnsignedAccounts,proto3" json:"num_readonly_unsigned_accounts,omitempty"`
+	AccountKeys                 []*DecodedAccountKey         `protobuf:"bytes,7,rep,name=account_keys,json=accountKeys,proto3" json:"account_keys,omitempty"`
+	RecentBlockhash             string                       `protobuf:"bytes,8,opt,name=recent_blockhash,json=recentBlockhash,proto3" json:"recent_blockhash,omitempty"`
+	Instructions                []*DecodedInstruction        `protobuf:"bytes,9,rep,name=instructions,proto3" json:"instructions,omitempty"`
+	AddressTableLookups         []*DecodedAddressTableLookup `protobuf:"bytes,10,rep,name=address_table_lookups,json=addressTableLookups,proto3" json:"address_table_lookups,omitempty"`
+}
+
+func (x *DecodedTransaction) Reset() {
+	*x = DecodedTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedTransaction) ProtoMessage() {}
+
+func (x *DecodedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedTransaction.ProtoReflect.Descriptor instead.
+func (*DecodedTransaction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DecodedTransaction) GetSignatures() []*DecodedSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *DecodedTransaction) GetSignaturesValid() bool {
+	if x != nil {
+		return x.SignaturesValid
+	}
+	return false
+}
+
+func (x *DecodedTransaction) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetNumRequiredSignatures() uint32 {
+	if x != nil {
+		return x.NumRequiredSignatures
+	}
+	return 0
+}
+
+func (x *DecodedTransaction) GetNumReadonlySignedAccounts() uint32 {
+	if x != nil {
+		return x.NumReadonlySignedAccounts
+	}
+	return 0
+}
+
+func (x *DecodedTransaction) GetNumReadonlyUnsignedAccounts() uint32 {
+	if x != nil {
+		return x.NumReadonlyUnsignedAccounts
+	}
+	return 0
+}
+
+func (x *DecodedTransaction) GetAccountKeys() []*DecodedAccountKey {
+	if x != nil {
+		return x.AccountKeys
+	}
+	return nil
+}
+
+func (x *DecodedTransaction) GetRecentBlockhash() string {
+	if x != nil {
+		return x.RecentBlockhash
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetInstructions() []*DecodedInstruction {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+func (x *DecodedTransaction) GetAddressTableLookups() []*DecodedAddressTableLookup {
+	if x != nil {
+		return x.AddressTableLookups
+	}
+	return nil
+}
+
+// DecodedSignature is a signature of a transaction and its signer. Unsigned
+// transactions carry zeroed signatures, which are marked as not signed.
+type DecodedSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signed    bool   `protobuf:"varint,3,opt,name=signed,proto3" json:"signed,omitempty"`
+	Valid     bool   `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *DecodedSignature) Reset() {
+	*x = DecodedSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedSignature) ProtoMessage() {}
+
+func (x *DecodedSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedSignature.ProtoReflect.Descriptor instead.
+func (*DecodedSignature) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DecodedSignature) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *DecodedSignature) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *DecodedSignature) GetSigned() bool {
+	if x != nil {
+		return x.Signed
+	}
+	return false
+}
+
+func (x *DecodedSignature) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+// DecodedAccountKey is a static account key of a transaction
+type DecodedAccountKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey   string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signer   bool   `protobuf:"varint,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Writable bool   `protobuf:"varint,3,opt,name=writable,proto3" json:"writable,omitempty"`
+}
+
+func (x *DecodedAccountKey) Reset() {
+	*x = DecodedAccountKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedAccountKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedAccountKey) ProtoMessage() {}
+
+func (x *DecodedAccountKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedAccountKey.ProtoReflect.Descriptor instead.
+func (*DecodedAccountKey) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DecodedAccountKey) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *DecodedAccountKey) GetSigner() bool {
+	if x != nil {
+		return x.Signer
+	}
+	return false
+}
+
+func (x *DecodedAccountKey) GetWritable() bool {
+	if x != nil {
+		return x.Writable
+	}
+	return false
+}
+
+// DecodedInstruction is an instruction of a transaction
+type DecodedInstruction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProgramId      string   `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	AccountIndexes []uint32 `protobuf:"varint,2,rep,packed,name=account_indexes,json=accountIndexes,proto3" json:"account_indexes,omitempty"`
+	Data           []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DecodedInstruction) Reset() {
+	*x = DecodedInstruction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedInstruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedInstruction) ProtoMessage() {}
+
+func (x *DecodedInstruction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedInstruction.ProtoReflect.Descriptor instead.
+func (*DecodedInstruction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DecodedInstruction) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *DecodedInstruction) GetAccountIndexes() []uint32 {
+	if x != nil {
+		return x.AccountIndexes
+	}
+	return nil
+}
+
+func (x *DecodedInstruction) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DecodedAddressTableLookup is an address lookup table of a version 0
+// transaction and the indexes of the accounts it loads
+type DecodedAddressTableLookup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountKey      string   `protobuf:"bytes,1,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`
+	WritableIndexes []uint32 `protobuf:"varint,2,rep,packed,name=writable_indexes,json=writableIndexes,proto3" json:"writable_indexes,omitempty"`
+	ReadonlyIndexes []uint32 `protobuf:"varint,3,rep,packed,name=readonly_indexes,json=readonlyIndexes,proto3" json:"readonly_indexes,omitempty"`
+}
+
+func (x *DecodedAddressTableLookup) Reset() {
+	*x = DecodedAddressTableLookup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedAddressTableLookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedAddressTableLookup) ProtoMessage() {}
+
+func (x *DecodedAddressTableLookup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedAddressTableLookup.ProtoReflect.Descriptor instead.
+func (*DecodedAddressTableLookup) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DecodedAddressTableLookup) GetAccountKey() string {
+	if x != nil {
+		return x.AccountKey
+	}
+	return ""
+}
+
+func (x *DecodedAddressTableLookup) GetWritableIndexes() []uint32 {
+	if x != nil {
+		return x.WritableIndexes
+	}
+	return nil
+}
+
+func (x *DecodedAddressTableLookup) GetReadonlyIndexes() []uint32 {
+	if x != nil {
+		return x.ReadonlyIndexes
+	}
+	return nil
+}
+
+// CurrentSlotRequest represents a request for the server's current slot
+type CurrentSlotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CurrentSlotRequest) Reset() {
+	*x = CurrentSlotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentSlotRequest) ProtoMessage() {}
+
+func (x *CurrentSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentSlotRequest.ProtoReflect.Descriptor instead.
+func (*CurrentSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{46}
+}
+
+// CurrentSlot is the latest slot the server has seen
+type CurrentSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	// When the server learned of the slot
+	ObservedAtUnixMs int64 `protobuf:"varint,2,opt,name=observed_at_unix_ms,json=observedAtUnixMs,proto3" json:"observed_at_unix_ms,omitempty"`
+	// False when the slot clock was not live and the slot was fetched from the
+	// upstream instead
+	FromSlotClock bool `protobuf:"varint,3,opt,name=from_slot_clock,json=fromSlotClock,proto3" json:"from_slot_clock,omitempty"`
+}
+
+func (x *CurrentSlot) Reset() {
+	*x = CurrentSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentSlot) ProtoMessage() {}
+
+func (x *CurrentSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentSlot.ProtoReflect.Descriptor instead.
+func (*CurrentSlot) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CurrentSlot) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CurrentSlot) GetObservedAtUnixMs() int64 {
+	if x != nil {
+		return x.ObservedAtUnixMs
+	}
+	return 0
+}
+
+func (x *CurrentSlot) GetFromSlotClock() bool {
+	if x != nil {
+		return x.FromSlotClock
+	}
+	return false
+}
+
+// UpstreamHealthRequest represents a request for the upstream health
+type UpstreamHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpstreamHealthRequest) Reset() {
+	*x = UpstreamHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpstreamHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamHealthRequest) ProtoMessage() {}
+
+func (x *UpstreamHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamHealthRequest.ProtoReflect.Descriptor instead.
+func (*UpstreamHealthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{48}
+}
+
+// UpstreamHealth contains the state of the upstream connections
+type UpstreamHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Websocket *WebSocketHealth `protobuf:"bytes,1,opt,name=websocket,proto3" json:"websocket,omitempty"`
+}
+
+func (x *UpstreamHealth) Reset() {
+	*x = UpstreamHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpstreamHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamHealth) ProtoMessage() {}
+
+func (x *UpstreamHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamHealth.ProtoReflect.Descriptor instead.
+func (*UpstreamHealth) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpstreamHealth) GetWebsocket() *WebSocketHealth {
+	if x != nil {
+		return x.Websocket
+	}
+	return nil
+}
+
+// WebSocketHealth is the state of the monitored upstream WebSocket connection
+type WebSocketHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State            WebSocketState `protobuf:"varint,1,opt,name=state,proto3,enum=solana.benchmark.WebSocketState" json:"state,omitempty"`
+	StateSinceUnixMs int64          `protobuf:"varint,2,opt,name=state_since_unix_ms,json=stateSinceUnixMs,proto3" json:"state_since_unix_ms,omitempty"`
+	Connects         uint64         `protobuf:"varint,3,opt,name=connects,proto3" json:"connects,omitempty"`
+	Disconnects      uint64         `protobuf:"varint,4,opt,name=disconnects,proto3" json:"disconnects,omitempty"`
+	LastError        string         `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastSlot         uint64         `protobuf:"varint,6,opt,name=last_slot,json=lastSlot,proto3" json:"last_slot,omitempty"`
+	// last_notification_unix_ms is zero if no notification was received yet
+	LastNotificationUnixMs int64 `protobuf:"varint,7,opt,name=last_notification_unix_ms,json=lastNotificationUnixMs,proto3" json:"last_notification_unix_ms,omitempty"`
+	// reconnect_delay_ms is the backoff before the next attempt while disconnected
+	ReconnectDelayMs uint64 `protobuf:"varint,8,opt,name=reconnect_delay_ms,json=reconnectDelayMs,proto3" json:"reconnect_delay_ms,omitempty"`
+}
+
+func (x *WebSocketHealth) Reset() {
+	*x = WebSocketHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebSocketHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebSocketHealth) ProtoMessage() {}
+
+func (x *WebSocketHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebSocketHealth.ProtoReflect.Descriptor instead.
+func (*WebSocketHealth) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WebSocketHealth) GetState() WebSocketState {
+	if x != nil {
+		return x.State
+	}
+	return WebSocketState_WEBSOCKET_STATE_UNMONITORED
+}
+
+func (x *WebSocketHealth) GetStateSinceUnixMs() int64 {
+	if x != nil {
+		return x.StateSinceUnixMs
+	}
+	return 0
+}
+
+func (x *WebSocketHealth) GetConnects() uint64 {
+	if x != nil {
+		return x.Connects
+	}
+	return 0
+}
+
+func (x *WebSocketHealth) GetDisconnects() uint64 {
+	if x != nil {
+		return x.Disconnects
+	}
+	return 0
+}
+
+func (x *WebSocketHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebSocketHealth) GetLastSlot() uint64 {
+	if x != nil {
+		return x.LastSlot
+	}
+	return 0
+}
+
+func (x *WebSocketHealth) GetLastNotificationUnixMs() int64 {
+	if x != nil {
+		return x.LastNotificationUnixMs
+	}
+	return 0
+}
+
+func (x *WebSocketHealth) GetReconnectDelayMs() uint64 {
+	if x != nil {
+		return x.ReconnectDelayMs
+	}
+	return 0
+}
+
+// StreamStatsRequest represents a request for the stream fan-out statistics
+type StreamStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamStatsRequest) Reset() {
+	*x = StreamStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStatsRequest) ProtoMessage() {}
+
+func (x *StreamStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStatsRequest.ProtoReflect.Descriptor instead.
+func (*StreamStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{51}
+}
+
+// StreamStatsResponse lists the topics with subscribers
+type StreamStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics []*TopicStats `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	// open_streams counts the streaming calls in progress, against the
+	// server-wide max_streams (0 for unlimited)
+	OpenStreams uint32 `protobuf:"varint,2,opt,name=open_streams,json=openStreams,proto3" json:"open_streams,omitempty"`
+	MaxStreams  uint32 `protobuf:"varint,3,opt,name=max_streams,json=maxStreams,proto3" json:"max_streams,omitempty"`
+	// Streams reaped since the server started because their client stopped
+	// reading, or because they sent and received nothing for too long
+	ReapedStalled uint64 `protobuf:"varint,4,opt,name=reaped_stalled,json=reapedStalled,proto3" json:"reaped_stalled,omitempty"`
+	ReapedIdle    uint64 `protobuf:"varint,5,opt,name=reaped_idle,json=reapedIdle,proto3" json:"reaped_idle,omitempty"`
+}
+
+func (x *StreamStatsResponse) Reset() {
+	*x = StreamStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStatsResponse) ProtoMessage() {}
+
+func (x *StreamStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStatsResponse.ProtoReflect.Descriptor instead.
+func (*StreamStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StreamStatsResponse) GetTopics() []*TopicStats {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *StreamStatsResponse) GetOpenStreams() uint32 {
+	if x != nil {
+		return x.OpenStreams
+	}
+	return 0
+}
+
+func (x *StreamStatsResponse) GetMaxStreams() uint32 {
+	if x != nil {
+		return x.MaxStreams
+	}
+	return 0
+}
+
+func (x *StreamStatsResponse) GetReapedStalled() uint64 {
+	if x != nil {
+		return x.ReapedStalled
+	}
+	return 0
+}
+
+func (x *StreamStatsResponse) GetReapedIdle() uint64 {
+	if x != nil {
+		return x.ReapedIdle
+	}
+	return 0
+}
+
+// TopicStats describes a topic of a fan-out hub and its subscriber queues
+type TopicStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hub is the source of the topic: blocks for the shared block feeds,
+	// owned_accounts for the change feeds of owned account sets, or gateway for
+	// the Yellowstone gateway
+	Hub string `protobuf:"bytes,1,opt,name=hub,proto3" json:"hub,omitempty"`
+	// kind is account, program or slot
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// key is the account or program pubkey, followed by the commitment for
+	// owned account sets, or the commitment of a slot topic
+	Key         string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Subscribers uint32 `protobuf:"varint,4,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
+	// queued is the number of messages waiting in all subscriber queues, and
+	// max_queued the longest subscriber queue
+	Queued    uint64 `protobuf:"varint,5,opt,name=queued,proto3" json:"queued,omitempty"`
+	MaxQueued uint64 `protobuf:"varint,6,opt,name=max_queued,json=maxQueued,proto3" json:"max_queued,omitempty"`
+	// capacity is the combined size of the subscriber queues
+	Capacity  uint64 `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Published uint64 `protobuf:"varint,8,opt,name=published,proto3" json:"published,omitempty"`
+	// dropped counts the subscribers dropped for falling behind since the
+	// topic gained its first subscriber
+	Dropped uint64 `protobuf:"varint,9,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *TopicStats) Reset() {
+	*x = TopicStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicStats) ProtoMessage() {}
+
+func (x *TopicStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicStats.ProtoReflect.Descriptor instead.
+func (*TopicStats) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TopicStats) GetHub() string {
+	if x != nil {
+		return x.Hub
+	}
+	return ""
+}
+
+func (x *TopicStats) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TopicStats) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TopicStats) GetSubscribers() uint32 {
+	if x != nil {
+		return x.Subscribers
+	}
+	return 0
+}
+
+func (x *TopicStats) GetQueued() uint64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *TopicStats) GetMaxQueued() uint64 {
+	if x != nil {
+		return x.MaxQueued
+	}
+	return 0
+}
+
+func (x *TopicStats) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *TopicStats) GetPublished() uint64 {
+	if x != nil {
+		return x.Published
+	}
+	return 0
+}
+
+func (x *TopicStats) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+// CapabilitiesRequest represents a request for the server's capabilities
+type CapabilitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CapabilitiesRequest) Reset() {
+	*x = CapabilitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilitiesRequest) ProtoMessage() {}
+
+func (x *CapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*CapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{54}
+}
+
+// Capabilities describes what the server can serve in this deployment
+type Capabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// upstream_type is rpc for a Solana JSON-RPC node, or in-memory for the
+	// mock backend
+	UpstreamType string `protobuf:"bytes,1,opt,name=upstream_type,json=upstreamType,proto3" json:"upstream_type,omitempty"`
+	// Whether account streams are served from a Yellowstone gateway, and
+	// whether a WebSocket upstream is available for subscriptions
+	Yellowstone bool                `protobuf:"varint,2,opt,name=yellowstone,proto3" json:"yellowstone,omitempty"`
+	Websocket   bool                `protobuf:"varint,3,opt,name=websocket,proto3" json:"websocket,omitempty"`
+	Streams     []*StreamCapability `protobuf:"bytes,4,rep,name=streams,proto3" json:"streams,omitempty"`
+	// Whether identical benchmark requests are served from the result cache,
+	// and for how long
+	BenchmarkCache      bool   `protobuf:"varint,5,opt,name=benchmark_cache,json=benchmarkCache,proto3" json:"benchmark_cache,omitempty"`
+	BenchmarkCacheTtlMs uint64 `protobuf:"varint,6,opt,name=benchmark_cache_ttl_ms,json=benchmarkCacheTtlMs,proto3" json:"benchmark_cache_ttl_ms,omitempty"`
+	// Whether requests for old slots are routed to an archive upstream
+	ArchiveRouting bool `protobuf:"varint,7,opt,name=archive_routing,json=archiveRouting,proto3" json:"archive_routing,omitempty"`
+	// Whether accounts are recorded for GetAccountHistory
+	AccountHistory bool `protobuf:"varint,8,opt,name=account_history,json=accountHistory,proto3" json:"account_history,omitempty"`
+	// The feature flags gating the server's subsystems
+	Features []*FeatureFlag `protobuf:"bytes,9,rep,name=features,proto3" json:"features,omitempty"`
+	// degraded is set when the WebSocket upstream is missing or down and the
+	// streams that subscribe to it poll the RPC upstream instead, every
+	// fallback_poll_interval_ms
+	Degraded               bool   `protobuf:"varint,10,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	FallbackPollIntervalMs uint32 `protobuf:"varint,11,opt,name=fallback_poll_interval_ms,json=fallbackPollIntervalMs,proto3" json:"fallback_poll_interval_ms,omitempty"`
+}
+
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Capabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capabilities) ProtoMessage() {}
+
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Capabilities) GetUpstreamType() string {
+	if x != nil {
+		return x.UpstreamType
+	}
+	return ""
+}
+
+func (x *Capabilities) GetYellowstone() bool {
+	if x != nil {
+		return x.Yellowstone
+	}
+	return false
+}
+
+func (x *Capabilities) GetWebsocket() bool {
+	if x != nil {
+		return x.Websocket
+	}
+	return false
+}
+
+func (x *Capabilities) GetStreams() []*StreamCapability {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+func (x *Capabilities) GetBenchmarkCache() bool {
+	if x != nil {
+		return x.BenchmarkCache
+	}
+	return false
+}
+
+func (x *Capabilities) GetBenchmarkCacheTtlMs() uint64 {
+	if x != nil {
+		return x.BenchmarkCacheTtlMs
+	}
+	return 0
+}
+
+func (x *Capabilities) GetArchiveRouting() bool {
+	if x != nil {
+		return x.ArchiveRouting
+	}
+	return false
+}
+
+func (x *Capabilities) GetAccountHistory() bool {
+	if x != nil {
+		return x.AccountHistory
+	}
+	return false
+}
+
+func (x *Capabilities) GetFeatures() []*FeatureFlag {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *Capabilities) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *Capabilities) GetFallbackPollIntervalMs() uint32 {
+	if x != nil {
+		return x.FallbackPollIntervalMs
+	}
+	return 0
+}
+
+// FeatureFlag is the state of a feature flag
+type FeatureFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *FeatureFlag) Reset() {
+	*x = FeatureFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeatureFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureFlag) ProtoMessage() {}
+
+func (x *FeatureFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureFlag.ProtoReflect.Descriptor instead.
+func (*FeatureFlag) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FeatureFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// StreamCapability describes how a streaming method is served
+type StreamCapability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// method is the full gRPC method name
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// source is where live updates come from; the snapshot of
+	// StreamProgramAccountsSnapshot is served without live updates regardless
+	Source StreamSource `protobuf:"varint,2,opt,name=source,proto3,enum=solana.benchmark.StreamSource" json:"source,omitempty"`
+}
+
+func (x *StreamCapability) Reset() {
+	*x = StreamCapability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCapability) ProtoMessage() {}
+
+func (x *StreamCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCapability.ProtoReflect.Descriptor instead.
+func (*StreamCapability) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *StreamCapability) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *StreamCapability) GetSource() StreamSource {
+	if x != nil {
+		return x.Source
+	}
+	return StreamSource_STREAM_SOURCE_UNAVAILABLE
+}
+
+// BlockTimeRequest represents a request for the time of a block
+type BlockTimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+}
+
+func (x *BlockTimeRequest) Reset() {
+	*x = BlockTimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTimeRequest) ProtoMessage() {}
+
+func (x *BlockTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTimeRequest.ProtoReflect.Descriptor instead.
+func (*BlockTimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BlockTimeRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+// BlockTimeResponse holds the time of a block
+type BlockTimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	// Unix time in seconds
+	BlockTime      int64  `protobuf:"varint,2,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	ResponseTimeMs uint64 `protobuf:"varint,3,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,4,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *BlockTimeResponse) Reset() {
+	*x = BlockTimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTimeResponse) ProtoMessage() {}
+
+func (x *BlockTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTimeResponse.ProtoReflect.Descriptor instead.
+func (*BlockTimeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BlockTimeResponse) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *BlockTimeResponse) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *BlockTimeResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *BlockTimeResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// SlotAtTimeRequest represents a request for the slot produced at a time
+type SlotAtTimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix time in seconds
+	UnixTime int64 `protobuf:"varint,1,opt,name=unix_time,json=unixTime,proto3" json:"unix_time,omitempty"`
+}
+
+func (x *SlotAtTimeRequest) Reset() {
+	*x = SlotAtTimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlotAtTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotAtTimeRequest) ProtoMessage() {}
+
+func (x *SlotAtTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotAtTimeRequest.ProtoReflect.Descriptor instead.
+func (*SlotAtTimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SlotAtTimeRequest) GetUnixTime() int64 {
+	if x != nil {
+		return x.UnixTime
+	}
+	return 0
+}
+
+// SlotAtTimeResponse holds the estimated slot at a time and its epoch. Block
+// times have a resolution of a second, so even a calibrated estimate is only
+// accurate to a few slots.
+type SlotAtTimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot         uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch        uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	SlotIndex    uint64 `protobuf:"varint,3,opt,name=slot_index,json=slotIndex,proto3" json:"slot_index,omitempty"`
+	SlotsInEpoch uint64 `protobuf:"varint,4,opt,name=slots_in_epoch,json=slotsInEpoch,proto3" json:"slots_in_epoch,omitempty"`
+	// Average slot time over the recent performance samples the estimate
+	// extrapolates with
+	MsPerSlot float64 `protobuf:"fixed64,5,opt,name=ms_per_slot,json=msPerSlot,proto3" json:"ms_per_slot,omitempty"`
+	// The latest finalized slot and its block time, the estimate's starting point
+	ReferenceSlot uint64 `protobuf:"varint,6,opt,name=reference_slot,json=referenceSlot,proto3" json:"reference_slot,omitempty"`
+	ReferenceTime int64  `protobuf:"varint,7,opt,name=reference_time,json=referenceTime,proto3" json:"reference_time,omitempty"`
+	// Set for a past time when the estimate was corrected against the block
+	// times of the slots around it
+	Calibrated bool `protobuf:"varint,8,opt,name=calibrated,proto3" json:"calibrated,omitempty"`
+	// Set when the time is after the reference time, so the slot is not yet
+	// finalized and may not have been produced
+	Future         bool   `protobuf:"varint,9,opt,name=future,proto3" json:"future,omitempty"`
+	ResponseTimeMs uint64 `protobuf:"varint,10,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,11,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *SlotAtTimeResponse) Reset() {
+	*x = SlotAtTimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlotAtTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotAtTimeResponse) ProtoMessage() {}
+
+func (x *SlotAtTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotAtTimeResponse.ProtoReflect.Descriptor instead.
+func (*SlotAtTimeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SlotAtTimeResponse) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SlotAtTimeResponse) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SlotAtTimeResponse) GetSlotIndex() uint64 {
+	if x != nil {
+		return x.SlotIndex
+	}
+	return 0
+}
+
+func (x *SlotAtTimeResponse) GetSlotsInEpoch() uint64 {
+	if x != nil {
+		return x.SlotsInEpoch
+	}
+	return 0
+}
+
+func (x *SlotAtTimeResponse) GetMsPerSlot() float64 {
+	if x != nil {
+		return x.MsPerSlot
+	}
+	return 0
+}
+
+func (x *SlotAtTimeResponse) GetReferenceSlot() uint64 {
+	if x != nil {
+		return x.ReferenceSlot
+	}
+	return 0
+}
+
+func (x *SlotAtTimeResponse) GetReferenceTime() int64 {
+	if x != nil {
+		return x.ReferenceTime
+	}
+	return 0
+}
+
+func (x *SlotAtTimeResponse) GetCalibrated() bool {
+	if x != nil {
+		return x.Calibrated
+	}
+	return false
+}
+
+func (x *SlotAtTimeResponse) GetFuture() bool {
+	if x != nil {
+		return x.Future
+	}
+	return false
+}
+
+func (x *SlotAtTimeResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *SlotAtTimeResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// BlockProductionRequest represents a request for block production
+type BlockProductionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// First slot of the range; 0 for the start of the current epoch
+	FirstSlot uint64 `protobuf:"varint,1,opt,name=first_slot,json=firstSlot,proto3" json:"first_slot,omitempty"`
+	// Last slot of the range; 0 for the latest slot
+	LastSlot uint64 `protobuf:"varint,2,opt,name=last_slot,json=lastSlot,proto3" json:"last_slot,omitempty"`
+	// Only report this validator identity (base58); all validators if empty
+	Identity   string `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Commitment string `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *BlockProductionRequest) Reset() {
+	*x = BlockProductionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockProductionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockProductionRequest) ProtoMessage() {}
+
+func (x *BlockProductionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockProductionRequest.ProtoReflect.Descriptor instead.
+func (*BlockProductionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *BlockProductionRequest) GetFirstSlot() uint64 {
+	if x != nil {
+		return x.FirstSlot
+	}
+	return 0
+}
+
+func (x *BlockProductionRequest) GetLastSlot() uint64 {
+	if x != nil {
+		return x.LastSlot
+	}
+	return 0
+}
+
+func (x *BlockProductionRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *BlockProductionRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+// BlockProductionStreamRequest represents a request to stream per-epoch
+// block production
+type BlockProductionStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only report this validator identity (base58); all validators if empty
+	Identity   string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// Seconds between updates of the current epoch; 0 for the server default
+	IntervalSeconds uint32 `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string `protobuf:"bytes,4,opt,name=compression,proto3" json:"compression,omitempty"`
+}
+
+func (x *BlockProductionStreamRequest) Reset() {
+	*x = BlockProductionStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockProductionStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockProductionStreamRequest) ProtoMessage() {}
+
+func (x *BlockProductionStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockProductionStreamRequest.ProtoReflect.Descriptor instead.
+func (*BlockProductionStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *BlockProductionStreamRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *BlockProductionStreamRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *BlockProductionStreamRequest) GetIntervalSeconds() uint32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *BlockProductionStreamRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+// ValidatorProduction is the block production of one validator identity
+type ValidatorProduction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identity       string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	LeaderSlots    uint64 `protobuf:"varint,2,opt,name=leader_slots,json=leaderSlots,proto3" json:"leader_slots,omitempty"`
+	BlocksProduced uint64 `protobuf:"varint,3,opt,name=blocks_produced,json=blocksProduced,proto3" json:"blocks_produced,omitempty"`
+	// Leader slots without a block
+	SkippedSlots uint64 `protobuf:"varint,4,opt,name=skipped_slots,json=skippedSlots,proto3" json:"skipped_slots,omitempty"`
+	// Fraction of leader slots skipped
+	SkipRate float64 `protobuf:"fixed64,5,opt,name=skip_rate,json=skipRate,proto3" json:"skip_rate,omitempty"`
+}
+
+func (x *ValidatorProduction) Reset() {
+	*x = ValidatorProduction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorProduction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorProduction) ProtoMessage() {}
+
+func (x *ValidatorProduction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorProduction.ProtoReflect.Descriptor instead.
+func (*ValidatorProduction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ValidatorProduction) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *ValidatorProduction) GetLeaderSlots() uint64 {
+	if x != nil {
+		return x.LeaderSlots
+	}
+	return 0
+}
+
+func (x *ValidatorProduction) GetBlocksProduced() uint64 {
+	if x != nil {
+		return x.BlocksProduced
+	}
+	return 0
+}
+
+func (x *ValidatorProduction) GetSkippedSlots() uint64 {
+	if x != nil {
+		return x.SkippedSlots
+	}
+	return 0
+}
+
+func (x *ValidatorProduction) GetSkipRate() float64 {
+	if x != nil {
+		return x.SkipRate
+	}
+	return 0
+}
+
+// BlockProduction is the block production of the validators over the slots
+// from first_slot through last_slot
+type BlockProduction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstSlot uint64 `protobuf:"varint,1,opt,name=first_slot,json=firstSlot,proto3" json:"first_slot,omitempty"`
+	LastSlot  uint64 `protobuf:"varint,2,opt,name=last_slot,json=lastSlot,proto3" json:"last_slot,omitempty"`
+	// Epoch of first_slot
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Totals over the validators reported
+	LeaderSlots    uint64  `protobuf:"varint,4,opt,name=leader_slots,json=leaderSlots,proto3" json:"leader_slots,omitempty"`
+	BlocksProduced uint64  `protobuf:"varint,5,opt,name=blocks_produced,json=blocksProduced,proto3" json:"blocks_produced,omitempty"`
+	SkipRate       float64 `protobuf:"fixed64,6,opt,name=skip_rate,json=skipRate,proto3" json:"skip_rate,omitempty"`
+	// Ordered by leader slots, most first
+	Validators []*ValidatorProduction `protobuf:"bytes,7,rep,name=validators,proto3" json:"validators,omitempty"`
+	// Set on the final summary of an epoch streamed once it ended
+	EpochComplete  bool   `protobuf:"varint,8,opt,name=epoch_complete,json=epochComplete,proto3" json:"epoch_complete,omitempty"`
+	ResponseTimeMs uint64 `protobuf:"varint,9,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,10,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *BlockProduction) Reset() {
+	*x = BlockProduction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockProduction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockProduction) ProtoMessage() {}
+
+func (x *BlockProduction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockProduction.ProtoReflect.Descriptor instead.
+func (*BlockProduction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *BlockProduction) GetFirstSlot() uint64 {
+	if x != nil {
+		return x.FirstSlot
+	}
+	return 0
+}
+
+func (x *BlockProduction) GetLastSlot() uint64 {
+	if x != nil {
+		return x.LastSlot
+	}
+	return 0
+}
+
+func (x *BlockProduction) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *BlockProduction) GetLeaderSlots() uint64 {
+	if x != nil {
+		return x.LeaderSlots
+	}
+	return 0
+}
+
+func (x *BlockProduction) GetBlocksProduced() uint64 {
+	if x != nil {
+		return x.BlocksProduced
+	}
+	return 0
+}
+
+func (x *BlockProduction) GetSkipRate() float64 {
+	if x != nil {
+		return x.SkipRate
+	}
+	return 0
+}
+
+func (x *BlockProduction) GetValidators() []*ValidatorProduction {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *BlockProduction) GetEpochComplete() bool {
+	if x != nil {
+		return x.EpochComplete
+	}
+	return false
+}
+
+func (x *BlockProduction) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *BlockProduction) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// EpochEventsRequest represents a request to stream epoch boundary events
+type EpochEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Send the epoch in progress when the stream opens
+	IncludeCurrent bool `protobuf:"varint,1,opt,name=include_current,json=includeCurrent,proto3" json:"include_current,omitempty"`
+	// Stake or vote accounts whose inflation rewards to report for every epoch
+	// that ends (base58)
+	RewardAddresses []string `protobuf:"bytes,2,rep,name=reward_addresses,json=rewardAddresses,proto3" json:"reward_addresses,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string `protobuf:"bytes,3,opt,name=compression,proto3" json:"compression,omitempty"`
+}
+
+func (x *EpochEventsRequest) Reset() {
+	*x = EpochEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochEventsRequest) ProtoMessage() {}
+
+func (x *EpochEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochEventsRequest.ProtoReflect.Descriptor instead.
+func (*EpochEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *EpochEventsRequest) GetIncludeCurrent() bool {
+	if x != nil {
+		return x.IncludeCurrent
+	}
+	return false
+}
+
+func (x *EpochEventsRequest) GetRewardAddresses() []string {
+	if x != nil {
+		return x.RewardAddresses
+	}
+	return nil
+}
+
+func (x *EpochEventsRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+// EpochInflation is the annual inflation rate of an epoch and its split
+type EpochInflation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total      float64 `protobuf:"fixed64,1,opt,name=total,proto3" json:"total,omitempty"`
+	Validator  float64 `protobuf:"fixed64,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Foundation float64 `protobuf:"fixed64,3,opt,name=foundation,proto3" json:"foundation,omitempty"`
+}
+
+func (x *EpochInflation) Reset() {
+	*x = EpochInflation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochInflation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochInflation) ProtoMessage() {}
+
+func (x *EpochInflation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochInflation.ProtoReflect.Descriptor instead.
+func (*EpochInflation) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EpochInflation) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *EpochInflation) GetValidator() float64 {
+	if x != nil {
+		return x.Validator
+	}
+	return 0
+}
+
+func (x *EpochInflation) GetFoundation() float64 {
+	if x != nil {
+		return x.Foundation
+	}
+	return 0
+}
+
+// InflationReward is the inflation reward of an address for an epoch
+type InflationReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Epoch   uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// False if no reward was credited to the address for the epoch, or not yet
+	Credited            bool   `protobuf:"varint,3,opt,name=credited,proto3" json:"credited,omitempty"`
+	AmountLamports      uint64 `protobuf:"varint,4,opt,name=amount_lamports,json=amountLamports,proto3" json:"amount_lamports,omitempty"`
+	PostBalanceLamports uint64 `protobuf:"varint,5,opt,name=post_balance_lamports,json=postBalanceLamports,proto3" json:"post_balance_lamports,omitempty"`
+	// Slot the reward was credited in
+	EffectiveSlot uint64 `protobuf:"varint,6,opt,name=effective_slot,json=effectiveSlot,proto3" json:"effective_slot,omitempty"`
+	// Commission of a vote account when the reward was credited
+	Commission uint32 `protobuf:"varint,7,opt,name=commission,proto3" json:"commission,omitempty"`
+}
+
+func (x *InflationReward) Reset() {
+	*x = InflationReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationReward) ProtoMessage() {}
+
+func (x *InflationReward) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InflationReward.ProtoReflect.Descriptor instead.
+func (*InflationReward) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *InflationReward) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *InflationReward) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *InflationReward) GetCredited() bool {
+	if x != nil {
+		return x.Credited
+	}
+	return false
+}
+
+func (x *InflationReward) GetAmountLamports() uint64 {
+	if x != nil {
+		return x.AmountLamports
+	}
+	return 0
+}
+
+func (x *InflationReward) GetPostBalanceLamports() uint64 {
+	if x != nil {
+		return x.PostBalanceLamports
+	}
+	return 0
+}
+
+func (x *InflationReward) GetEffectiveSlot() uint64 {
+	if x != nil {
+		return x.EffectiveSlot
+	}
+	return 0
+}
+
+func (x *InflationReward) GetCommission() uint32 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
+
+// StakeActivationRequest represents a request for the activation of a stake
+// account
+type StakeActivationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// Epoch to report the activation in; 0 for the current epoch
+	Epoch      uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *StakeActivationRequest) Reset() {
+	*x = StakeActivationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakeActivationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeActivationRequest) ProtoMessage() {}
+
+func (x *StakeActivationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakeActivationRequest.ProtoReflect.Descriptor instead.
+func (*StakeActivationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StakeActivationRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *StakeActivationRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *StakeActivationRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+// StakeActivationResponse contains the activation of a stake account
+type StakeActivationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey string               `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	State  StakeActivationState `protobuf:"varint,2,opt,name=state,proto3,enum=solana.benchmark.StakeActivationState" json:"state,omitempty"`
+	// Stake active during the epoch
+	ActiveLamports uint64 `protobuf:"varint,3,opt,name=active_lamports,json=activeLamports,proto3" json:"active_lamports,omitempty"`
+	// Stake inactive during the epoch
+	InactiveLamports uint64 `protobuf:"varint,4,opt,name=inactive_lamports,json=inactiveLamports,proto3" json:"inactive_lamports,omitempty"`
+	ResponseTimeMs   uint64 `protobuf:"varint,5,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,6,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *StakeActivationResponse) Reset() {
+	*x = StakeActivationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakeActivationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeActivationResponse) ProtoMessage() {}
+
+func (x *StakeActivationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakeActivationResponse.ProtoReflect.Descriptor instead.
+func (*StakeActivationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *StakeActivationResponse) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *StakeActivationResponse) GetState() StakeActivationState {
+	if x != nil {
+		return x.State
+	}
+	return StakeActivationState_STAKE_ACTIVATION_STATE_UNSPECIFIED
+}
+
+func (x *StakeActivationResponse) GetActiveLamports() uint64 {
+	if x != nil {
+		return x.ActiveLamports
+	}
+	return 0
+}
+
+func (x *StakeActivationResponse) GetInactiveLamports() uint64 {
+	if x != nil {
+		return x.InactiveLamports
+	}
+	return 0
+}
+
+func (x *StakeActivationResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *StakeActivationResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// InflationRewardRequest represents a request for the inflation rewards of
+// addresses over a range of epochs
+type InflationRewardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Stake or vote accounts (base58)
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// First epoch of the range; 0 for the previous epoch
+	FirstEpoch uint64 `protobuf:"varint,2,opt,name=first_epoch,json=firstEpoch,proto3" json:"first_epoch,omitempty"`
+	// Last epoch of the range; 0 for first_epoch alone
+	LastEpoch  uint64 `protobuf:"varint,3,opt,name=last_epoch,json=lastEpoch,proto3" json:"last_epoch,omitempty"`
+	Commitment string `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *InflationRewardRequest) Reset() {
+	*x = InflationRewardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationRewardRequest) ProtoMessage() {}
+
+func (x *InflationRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InflationRewardRequest.ProtoReflect.Descriptor instead.
+func (*InflationRewardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *InflationRewardRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *InflationRewardRequest) GetFirstEpoch() uint64 {
+	if x != nil {
+		return x.FirstEpoch
+	}
+	return 0
+}
+
+func (x *InflationRewardRequest) GetLastEpoch() uint64 {
+	if x != nil {
+		return x.LastEpoch
+	}
+	return 0
+}
+
+func (x *InflationRewardRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+// EpochRewards are the inflation rewards of the requested addresses for one
+// epoch, in request order
+type EpochRewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch   uint64             `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Rewards []*InflationReward `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// Sum of the rewards credited
+	TotalLamports uint64 `protobuf:"varint,3,opt,name=total_lamports,json=totalLamports,proto3" json:"total_lamports,omitempty"`
+}
+
+func (x *EpochRewards) Reset() {
+	*x = EpochRewards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochRewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochRewards) ProtoMessage() {}
+
+func (x *EpochRewards) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochRewards.ProtoReflect.Descriptor instead.
+func (*EpochRewards) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *EpochRewards) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochRewards) GetRewards() []*InflationReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *EpochRewards) GetTotalLamports() uint64 {
+	if x != nil {
+		return x.TotalLamports
+	}
+	return 0
+}
+
+// InflationRewardResponse contains the rewards of each epoch requested, in
+// epoch order
+type InflationRewardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epochs         []*EpochRewards `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	ResponseTimeMs uint64          `protobuf:"varint,2,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,3,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *InflationRewardResponse) Reset() {
+	*x = InflationRewardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationRewardResponse) ProtoMessage() {}
+
+func (x *InflationRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InflationRewardResponse.ProtoReflect.Descriptor instead.
+func (*InflationRewardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *InflationRewardResponse) GetEpochs() []*EpochRewards {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+func (x *InflationRewardResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *InflationRewardResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// EpochEvent reports an epoch boundary, or information about an epoch that
+// became available after it
+type EpochEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         EpochEventType `protobuf:"varint,1,opt,name=type,proto3,enum=solana.benchmark.EpochEventType" json:"type,omitempty"`
+	Epoch        uint64         `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	FirstSlot    uint64         `protobuf:"varint,3,opt,name=first_slot,json=firstSlot,proto3" json:"first_slot,omitempty"`
+	LastSlot     uint64         `protobuf:"varint,4,opt,name=last_slot,json=lastSlot,proto3" json:"last_slot,omitempty"`
+	SlotsInEpoch uint64         `protobuf:"varint,5,opt,name=slots_in_epoch,json=slotsInEpoch,proto3" json:"slots_in_epoch,omitempty"`
+	// Slot the server had reached when it sent the event
+	ObservedSlot uint64 `protobuf:"varint,6,opt,name=observed_slot,json=observedSlot,proto3" json:"observed_slot,omitempty"`
+	// Set once the upstream reports the epoch's inflation
+	Inflation *EpochInflation `protobuf:"bytes,7,opt,name=inflation,proto3" json:"inflation,omitempty"`
+	// Set on rewards events, one per watched address
+	Rewards   []*InflationReward `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Timestamp uint64             `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,10,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *EpochEvent) Reset() {
+	*x = EpochEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochEvent) ProtoMessage() {}
+
+func (x *EpochEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochEvent.ProtoReflect.Descriptor instead.
+func (*EpochEvent) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *EpochEvent) GetType() EpochEventType {
+	if x != nil {
+		return x.Type
+	}
+	return EpochEventType_EPOCH_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *EpochEvent) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochEvent) GetFirstSlot() uint64 {
+	if x != nil {
+		return x.FirstSlot
+	}
+	return 0
+}
+
+func (x *EpochEvent) GetLastSlot() uint64 {
+	if x != nil {
+		return x.LastSlot
+	}
+	return 0
+}
+
+func (x *EpochEvent) GetSlotsInEpoch() uint64 {
+	if x != nil {
+		return x.SlotsInEpoch
+	}
+	return 0
+}
+
+func (x *EpochEvent) GetObservedSlot() uint64 {
+	if x != nil {
+		return x.ObservedSlot
+	}
+	return 0
+}
+
+func (x *EpochEvent) GetInflation() *EpochInflation {
+	if x != nil {
+		return x.Inflation
+	}
+	return nil
+}
+
+func (x *EpochEvent) GetRewards() []*InflationReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *EpochEvent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *EpochEvent) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// ClusterNodesRequest represents a request for the nodes of the cluster
+type ClusterNodesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only nodes running this version, or a release of it such as 1.18 for
+	// 1.18.22; all versions if empty
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Only nodes with this feature set identifier; all if 0
+	FeatureSet uint32 `protobuf:"varint,2,opt,name=feature_set,json=featureSet,proto3" json:"feature_set,omitempty"`
+	// Only nodes serving JSON-RPC
+	RpcOnly bool `protobuf:"varint,3,opt,name=rpc_only,json=rpcOnly,proto3" json:"rpc_only,omitempty"`
+}
+
+func (x *ClusterNodesRequest) Reset() {
+	*x = ClusterNodesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterNodesRequest) ProtoMessage() {}
+
+func (x *ClusterNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterNodesRequest.ProtoReflect.Descriptor instead.
+func (*ClusterNodesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ClusterNodesRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ClusterNodesRequest) GetFeatureSet() uint32 {
+	if x != nil {
+		return x.FeatureSet
+	}
+	return 0
+}
+
+func (x *ClusterNodesRequest) GetRpcOnly() bool {
+	if x != nil {
+		return x.RpcOnly
+	}
+	return false
+}
+
+// ClusterNode is a node gossiping in the cluster. Addresses are empty for
+// services the node does not advertise.
+type ClusterNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Gossip string `protobuf:"bytes,2,opt,name=gossip,proto3" json:"gossip,omitempty"`
+	Tpu    string `protobuf:"bytes,3,opt,name=tpu,proto3" json:"tpu,omitempty"`
+	Rpc    string `protobuf:"bytes,4,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	// Empty if the node does not report its version
+	Version      string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	FeatureSet   uint32 `protobuf:"varint,6,opt,name=feature_set,json=featureSet,proto3" json:"feature_set,omitempty"`
+	ShredVersion uint32 `protobuf:"varint,7,opt,name=shred_version,json=shredVersion,proto3" json:"shred_version,omitempty"`
+}
+
+func (x *ClusterNode) Reset() {
+	*x = ClusterNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterNode) ProtoMessage() {}
+
+func (x *ClusterNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterNode.ProtoReflect.Descriptor instead.
+func (*ClusterNode) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ClusterNode) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *ClusterNode) GetGossip() string {
+	if x != nil {
+		return x.Gossip
+	}
+	return ""
+}
+
+func (x *ClusterNode) GetTpu() string {
+	if x != nil {
+		return x.Tpu
+	}
+	return ""
+}
+
+func (x *ClusterNode) GetRpc() string {
+	if x != nil {
+		return x.Rpc
+	}
+	return ""
+}
+
+func (x *ClusterNode) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ClusterNode) GetFeatureSet() uint32 {
+	if x != nil {
+		return x.FeatureSet
+	}
+	return 0
+}
+
+func (x *ClusterNode) GetShredVersion() uint32 {
+	if x != nil {
+		return x.ShredVersion
+	}
+	return 0
+}
+
+// ClusterNodesResponse contains the nodes matching a request
+type ClusterNodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ordered by pubkey
+	Nodes []*ClusterNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// Nodes in the cluster before filtering
+	TotalNodes uint32 `protobuf:"varint,2,opt,name=total_nodes,json=totalNodes,proto3" json:"total_nodes,omitempty"`
+	// Matching nodes per version; "unknown" counts those without one
+	Versions       map[string]uint32 `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ResponseTimeMs uint64            `protobuf:"varint,4,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,5,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *ClusterNodesResponse) Reset() {
+	*x = ClusterNodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterNodesResponse) ProtoMessage() {}
+
+func (x *ClusterNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterNodesResponse.ProtoReflect.Descriptor instead.
+func (*ClusterNodesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ClusterNodesResponse) GetNodes() []*ClusterNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ClusterNodesResponse) GetTotalNodes() uint32 {
+	if x != nil {
+		return x.TotalNodes
+	}
+	return 0
+}
+
+func (x *ClusterNodesResponse) GetVersions() map[string]uint32 {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ClusterNodesResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *ClusterNodesResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// ListOwnedAccountsRequest represents a request for a page of the accounts
+// of an owner
+type ListOwnedAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Owning program (base58)
+	Owner      string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// Accounts per page; defaults to 100, at most 1000
+	PageSize uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page; empty for the first page
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Leave the account data out
+	ExcludeData bool `protobuf:"varint,5,opt,name=exclude_data,json=excludeData,proto3" json:"exclude_data,omitempty"`
+}
+
+func (x *ListOwnedAccountsRequest) Reset() {
+	*x = ListOwnedAccountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOwnedAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOwnedAccountsRequest) ProtoMessage() {}
+
+func (x *ListOwnedAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOwnedAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListOwnedAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListOwnedAccountsRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListOwnedAccountsRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *ListOwnedAccountsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOwnedAccountsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListOwnedAccountsRequest) GetExcludeData() bool {
+	if x != nil {
+		return x.ExcludeData
+	}
+	return false
+}
+
+// OwnedAccount is an account in an owner's materialized set
+type OwnedAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey     string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Lamports   uint64 `protobuf:"varint,2,opt,name=lamports,proto3" json:"lamports,omitempty"`
+	Data       []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Executable bool   `protobuf:"varint,4,opt,name=executable,proto3" json:"executable,omitempty"`
+	RentEpoch  uint64 `protobuf:"varint,5,opt,name=rent_epoch,json=rentEpoch,proto3" json:"rent_epoch,omitempty"`
+	// Slot of the account's latest state the server has seen
+	Slot uint64 `protobuf:"varint,6,opt,name=slot,proto3" json:"slot,omitempty"`
+}
+
+func (x *OwnedAccount) Reset() {
+	*x = OwnedAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OwnedAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnedAccount) ProtoMessage() {}
+
+func (x *OwnedAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnedAccount.ProtoReflect.Descriptor instead.
+func (*OwnedAccount) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *OwnedAccount) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *OwnedAccount) GetLamports() uint64 {
+	if x != nil {
+		return x.Lamports
+	}
+	return 0
+}
+
+func (x *OwnedAccount) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *OwnedAccount) GetExecutable() bool {
+	if x != nil {
+		return x.Executable
+	}
+	return false
+}
+
+func (x *OwnedAccount) GetRentEpoch() uint64 {
+	if x != nil {
+		return x.RentEpoch
+	}
+	return 0
+}
+
+func (x *OwnedAccount) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+// ListOwnedAccountsResponse contains a page of the accounts of an owner
+type ListOwnedAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ordered by the bytes of the pubkey
+	Accounts []*OwnedAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// Accounts in the set
+	TotalAccounts uint32 `protobuf:"varint,2,opt,name=total_accounts,json=totalAccounts,proto3" json:"total_accounts,omitempty"`
+	// Empty on the last page
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Newest slot applied to the set
+	IndexSlot uint64 `protobuf:"varint,4,opt,name=index_slot,json=indexSlot,proto3" json:"index_slot,omitempty"`
+	// Set when no WebSocket upstream is available and the set is kept current
+	// by rereading it this often instead
+	PollIntervalMs uint32 `protobuf:"varint,5,opt,name=poll_interval_ms,json=pollIntervalMs,proto3" json:"poll_interval_ms,omitempty"`
+	ResponseTimeMs uint64 `protobuf:"varint,6,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,7,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *ListOwnedAccountsResponse) Reset() {
+	*x = ListOwnedAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOwnedAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOwnedAccountsResponse) ProtoMessage() {}
+
+func (x *ListOwnedAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOwnedAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListOwnedAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListOwnedAccountsResponse) GetAccounts() []*OwnedAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ListOwnedAccountsResponse) GetTotalAccounts() uint32 {
+	if x != nil {
+		return x.TotalAccounts
+	}
+	return 0
+}
+
+func (x *ListOwnedAccountsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListOwnedAccountsResponse) GetIndexSlot() uint64 {
+	if x != nil {
+		return x.IndexSlot
+	}
+	return 0
+}
+
+func (x *ListOwnedAccountsResponse) GetPollIntervalMs() uint32 {
+	if x != nil {
+		return x.PollIntervalMs
+	}
+	return 0
+}
+
+func (x *ListOwnedAccountsResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *ListOwnedAccountsResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// OwnedAccountChangesRequest represents a request to stream the changes to
+// the accounts of an owner
+type OwnedAccountChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Owning program (base58)
+	Owner      string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// Send the accounts the set has when the stream opens before its changes
+	IncludeSnapshot bool `protobuf:"varint,3,opt,name=include_snapshot,json=includeSnapshot,proto3" json:"include_snapshot,omitempty"`
+	// Leave the account data out
+	ExcludeData bool `protobuf:"varint,4,opt,name=exclude_data,json=excludeData,proto3" json:"exclude_data,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string `protobuf:"bytes,5,opt,name=compression,proto3" json:"compression,omitempty"`
+	// Send closed events alone, such as to track reclaimed rent
+	ClosedOnly bool `protobuf:"varint,6,opt,name=closed_only,json=closedOnly,proto3" json:"closed_only,omitempty"`
+}
+
+func (x *OwnedAccountChangesRequest) Reset() {
+	*x = OwnedAccountChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OwnedAccountChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnedAccountChangesRequest) ProtoMessage() {}
+
+func (x *OwnedAccountChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnedAccountChangesRequest.ProtoReflect.Descriptor instead.
+func (*OwnedAccountChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *OwnedAccountChangesRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OwnedAccountChangesRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *OwnedAccountChangesRequest) GetIncludeSnapshot() bool {
+	if x != nil {
+		return x.IncludeSnapshot
+	}
+	return false
+}
+
+func (x *OwnedAccountChangesRequest) GetExcludeData() bool {
+	if x != nil {
+		return x.ExcludeData
+	}
+	return false
+}
+
+func (x *OwnedAccountChangesRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *OwnedAccountChangesRequest) GetClosedOnly() bool {
+	if x != nil {
+		return x.ClosedOnly
+	}
+	return false
+}
+
+// OwnedAccountEvent reports an account of an owner's set, or a change to it
+type OwnedAccountEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type OwnedAccountEventType `protobuf:"varint,1,opt,name=type,proto3,enum=solana.benchmark.OwnedAccountEventType" json:"type,omitempty"`
+	// The account's new state; closed accounts carry the state they were left
+	// in, without lamports or data if they were deleted
+	Account *OwnedAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// Accounts in the set after the event
+	TotalAccounts uint32 `protobuf:"varint,3,opt,name=total_accounts,json=totalAccounts,proto3" json:"total_accounts,omitempty"`
+	// resync marks changes found by rereading the set, after the upstream
+	// subscription dropped or while it is polled
+	Resync    bool   `protobuf:"varint,4,opt,name=resync,proto3" json:"resync,omitempty"`
+	Timestamp uint64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,6,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+	// How the account closed; set on closed events
+	Closure *AccountClosure `protobuf:"bytes,7,opt,name=closure,proto3" json:"closure,omitempty"`
+}
+
+func (x *OwnedAccountEvent) Reset() {
+	*x = OwnedAccountEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OwnedAccountEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnedAccountEvent) ProtoMessage() {}
+
+func (x *OwnedAccountEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnedAccountEvent.ProtoReflect.Descriptor instead.
+func (*OwnedAccountEvent) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *OwnedAccountEvent) GetType() OwnedAccountEventType {
+	if x != nil {
+		return x.Type
+	}
+	return OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *OwnedAccountEvent) GetAccount() *OwnedAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *OwnedAccountEvent) GetTotalAccounts() uint32 {
+	if x != nil {
+		return x.TotalAccounts
+	}
+	return 0
+}
+
+func (x *OwnedAccountEvent) GetResync() bool {
+	if x != nil {
+		return x.Resync
+	}
+	return false
+}
+
+func (x *OwnedAccountEvent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *OwnedAccountEvent) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+func (x *OwnedAccountEvent) GetClosure() *AccountClosure {
+	if x != nil {
+		return x.Closure
+	}
+	return nil
+}
+
+// AccountClosure describes how an account of an owner's set closed
+type AccountClosure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason AccountClosureReason `protobuf:"varint,1,opt,name=reason,proto3,enum=solana.benchmark.AccountClosureReason" json:"reason,omitempty"`
+	// Lamports withdrawn as it closed, usually its rent-exempt reserve
+	ReclaimedLamports uint64 `protobuf:"varint,2,opt,name=reclaimed_lamports,json=reclaimedLamports,proto3" json:"reclaimed_lamports,omitempty"`
+	// Lamports and data size of its last state in the set
+	PreviousLamports uint64 `protobuf:"varint,3,opt,name=previous_lamports,json=previousLamports,proto3" json:"previous_lamports,omitempty"`
+	PreviousDataSize uint64 `protobuf:"varint,4,opt,name=previous_data_size,json=previousDataSize,proto3" json:"previous_data_size,omitempty"`
+	// Program it was assigned to; empty if it was deleted
+	NewOwner string `protobuf:"bytes,5,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (x *AccountClosure) Reset() {
+	*x = AccountClosure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountClosure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountClosure) ProtoMessage() {}
+
+func (x *AccountClosure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountClosure.ProtoReflect.Descriptor instead.
+func (*AccountClosure) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AccountClosure) GetReason() AccountClosureReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AccountClosureReason_ACCOUNT_CLOSURE_REASON_UNSPECIFIED
+}
+
+func (x *AccountClosure) GetReclaimedLamports() uint64 {
+	if x != nil {
+		return x.ReclaimedLamports
+	}
+	return 0
+}
+
+func (x *AccountClosure) GetPreviousLamports() uint64 {
+	if x != nil {
+		return x.PreviousLamports
+	}
+	return 0
+}
+
+func (x *AccountClosure) GetPreviousDataSize() uint64 {
+	if x != nil {
+		return x.PreviousDataSize
+	}
+	return 0
+}
+
+func (x *AccountClosure) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+// SLOStatusRequest represents a request for the state of the latency
+// objectives
+type SLOStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SLOStatusRequest) Reset() {
+	*x = SLOStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLOStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLOStatusRequest) ProtoMessage() {}
+
+func (x *SLOStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLOStatusRequest.ProtoReflect.Descriptor instead.
+func (*SLOStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{84}
+}
+
+// SLOStatus is the state of a latency objective: the percentile of a
+// method's latency over a trailing window must stay under a threshold
+type SLOStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source SLOSource `protobuf:"varint,2,opt,name=source,proto3,enum=solana.benchmark.SLOSource" json:"source,omitempty"`
+	// Full gRPC method name, or JSON-RPC method for upstream objectives
+	Method      string  `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Percentile  float64 `protobuf:"fixed64,4,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	ThresholdMs uint64  `protobuf:"varint,5,opt,name=threshold_ms,json=thresholdMs,proto3" json:"threshold_ms,omitempty"`
+	WindowMs    uint64  `protobuf:"varint,6,opt,name=window_ms,json=windowMs,proto3" json:"window_ms,omitempty"`
+	// latency_ms is the percentile over the window, from samples calls.
+	// Without calls in the window the objective is met.
+	LatencyMs float64 `protobuf:"fixed64,7,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Samples   uint32  `protobuf:"varint,8,opt,name=samples,proto3" json:"samples,omitempty"`
+	Met       bool    `protobuf:"varint,9,opt,name=met,proto3" json:"met,omitempty"`
+	// When the objective became met or violated, or was first evaluated
+	SinceUnixMs       int64 `protobuf:"varint,10,opt,name=since_unix_ms,json=sinceUnixMs,proto3" json:"since_unix_ms,omitempty"`
+	EvaluatedAtUnixMs int64 `protobuf:"varint,11,opt,name=evaluated_at_unix_ms,json=evaluatedAtUnixMs,proto3" json:"evaluated_at_unix_ms,omitempty"`
+}
+
+func (x *SLOStatus) Reset() {
+	*x = SLOStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLOStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLOStatus) ProtoMessage() {}
+
+func (x *SLOStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLOStatus.ProtoReflect.Descriptor instead.
+func (*SLOStatus) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SLOStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SLOStatus) GetSource() SLOSource {
+	if x != nil {
+		return x.Source
+	}
+	return SLOSource_SLO_SOURCE_HANDLER
+}
+
+func (x *SLOStatus) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SLOStatus) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *SLOStatus) GetThresholdMs() uint64 {
+	if x != nil {
+		return x.ThresholdMs
+	}
+	return 0
+}
+
+func (x *SLOStatus) GetWindowMs() uint64 {
+	if x != nil {
+		return x.WindowMs
+	}
+	return 0
+}
+
+func (x *SLOStatus) GetLatencyMs() float64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *SLOStatus) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *SLOStatus) GetMet() bool {
+	if x != nil {
+		return x.Met
+	}
+	return false
+}
+
+func (x *SLOStatus) GetSinceUnixMs() int64 {
+	if x != nil {
+		return x.SinceUnixMs
+	}
+	return 0
+}
+
+func (x *SLOStatus) GetEvaluatedAtUnixMs() int64 {
+	if x != nil {
+		return x.EvaluatedAtUnixMs
+	}
+	return 0
+}
+
+// SLOStatusResponse lists the configured latency objectives in
+// configuration order, empty before their first evaluation
+type SLOStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Objectives []*SLOStatus `protobuf:"bytes,1,rep,name=objectives,proto3" json:"objectives,omitempty"`
+}
+
+func (x *SLOStatusResponse) Reset() {
+	*x = SLOStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLOStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLOStatusResponse) ProtoMessage() {}
+
+func (x *SLOStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLOStatusResponse.ProtoReflect.Descriptor instead.
+func (*SLOStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SLOStatusResponse) GetObjectives() []*SLOStatus {
+	if x != nil {
+		return x.Objectives
+	}
+	return nil
+}
+
+// SLOEventsRequest represents a request to stream latency objective events
+type SLOEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Send the state of every objective when the stream opens
+	IncludeCurrent bool `protobuf:"varint,1,opt,name=include_current,json=includeCurrent,proto3" json:"include_current,omitempty"`
+}
+
+func (x *SLOEventsRequest) Reset() {
+	*x = SLOEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLOEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLOEventsRequest) ProtoMessage() {}
+
+func (x *SLOEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLOEventsRequest.ProtoReflect.Descriptor instead.
+func (*SLOEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SLOEventsRequest) GetIncludeCurrent() bool {
+	if x != nil {
+		return x.IncludeCurrent
+	}
+	return false
+}
+
+// SLOEvent reports that a latency objective was violated or recovered
+type SLOEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      SLOEventType `protobuf:"varint,1,opt,name=type,proto3,enum=solana.benchmark.SLOEventType" json:"type,omitempty"`
+	Status    *SLOStatus   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Timestamp uint64       `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SLOEvent) Reset() {
+	*x = SLOEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLOEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLOEvent) ProtoMessage() {}
+
+func (x *SLOEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLOEvent.ProtoReflect.Descriptor instead.
+func (*SLOEvent) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SLOEvent) GetType() SLOEventType {
+	if x != nil {
+		return x.Type
+	}
+	return SLOEventType_SLO_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *SLOEvent) GetStatus() *SLOStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SLOEvent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// AddressHistoryRequest represents a request for the transactions of an address
+type AddressHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// History starts after the until signature, such as the cursor of the
+	// last update a previous stream delivered. Without it, history goes back
+	// limit transactions.
+	Until string `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	// History ends before the before signature instead of at the newest
+	// transaction. Live updates are not available with it.
+	Before string `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	// limit caps the history transactions; defaults to 1000, at most 10000
+	Limit         uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeFailed bool   `protobuf:"varint,5,opt,name=include_failed,json=includeFailed,proto3" json:"include_failed,omitempty"`
+	// Continue with new transactions of the address once history is sent
+	ContinueLive bool `protobuf:"varint,6,opt,name=continue_live,json=continueLive,proto3" json:"continue_live,omitempty"`
+	// confirmed or finalized; defaults to finalized
+	Commitment  string    `protobuf:"bytes,7,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Compression string    `protobuf:"bytes,8,opt,name=compression,proto3" json:"compression,omitempty"`
+	Qos         StreamQoS `protobuf:"varint,9,opt,name=qos,proto3,enum=solana.benchmark.StreamQoS" json:"qos,omitempty"`
+}
+
+func (x *AddressHistoryRequest) Reset() {
+	*x = AddressHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressHistoryRequest) ProtoMessage() {}
+
+func (x *AddressHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AddressHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AddressHistoryRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressHistoryRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *AddressHistoryRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AddressHistoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AddressHistoryRequest) GetIncludeFailed() bool {
+	if x != nil {
+		return x.IncludeFailed
+	}
+	return false
+}
+
+func (x *AddressHistoryRequest) GetContinueLive() bool {
+	if x != nil {
+		return x.ContinueLive
+	}
+	return false
+}
+
+func (x *AddressHistoryRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *AddressHistoryRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *AddressHistoryRequest) GetQos() StreamQoS {
+	if x != nil {
+		return x.Qos
+	}
+	return StreamQoS_STREAM_QOS_REALTIME
+}
+
+// AddressHistoryUpdate carries a transaction of the address or, once the
+// history is sent, a marker with history_complete set and no transaction
+type AddressHistoryUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction *TransactionUpdate `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Set on transactions sent after the history
+	Live            bool `protobuf:"varint,2,opt,name=live,proto3" json:"live,omitempty"`
+	HistoryComplete bool `protobuf:"varint,3,opt,name=history_complete,json=historyComplete,proto3" json:"history_complete,omitempty"`
+	// Set when the history reached the limit, so older transactions may exist
+	Truncated bool `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// cursor is the signature to pass as until to resume after this update
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,6,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *AddressHistoryUpdate) Reset() {
+	*x = AddressHistoryUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressHistoryUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressHistoryUpdate) ProtoMessage() {}
+
+func (x *AddressHistoryUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressHistoryUpdate.ProtoReflect.Descriptor instead.
+func (*AddressHistoryUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AddressHistoryUpdate) GetTransaction() *TransactionUpdate {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *AddressHistoryUpdate) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+func (x *AddressHistoryUpdate) GetHistoryComplete() bool {
+	if x != nil {
+		return x.HistoryComplete
+	}
+	return false
+}
+
+func (x *AddressHistoryUpdate) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *AddressHistoryUpdate) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AddressHistoryUpdate) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// SerializationBenchmarkRequest selects the block to benchmark serialization on
+type SerializationBenchmarkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	// Encodes and decodes per format; 0 for the server default of 100
+	Iterations uint32 `protobuf:"varint,2,opt,name=iterations,proto3" json:"iterations,omitempty"`
+}
+
+func (x *SerializationBenchmarkRequest) Reset() {
+	*x = SerializationBenchmarkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerializationBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializationBenchmarkRequest) ProtoMessage() {}
+
+func (x *SerializationBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializationBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*SerializationBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SerializationBenchmarkRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SerializationBenchmarkRequest) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+// SerializationBenchmarkResults compares the cost of encoding and decoding one
+// block with full transactions in each format
+type SerializationBenchmarkResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot         uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Iterations   uint32 `protobuf:"varint,2,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Transactions uint32 `protobuf:"varint,3,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	// The block as a SerializedBlock
+	Protobuf *SerializationCost `protobuf:"bytes,4,opt,name=protobuf,proto3" json:"protobuf,omitempty"`
+	// The block as the getBlock JSON-RPC result, with base64 transactions
+	Json *SerializationCost `protobuf:"bytes,5,opt,name=json,proto3" json:"json,omitempty"`
+	// The block as a FlatBuffers block. Decoding reads every field in place,
+	// the work of a reader consuming the whole block.
+	Flatbuffers *SerializationCost `protobuf:"bytes,6,opt,name=flatbuffers,proto3" json:"flatbuffers,omitempty"`
+}
+
+func (x *SerializationBenchmarkResults) Reset() {
+	*x = SerializationBenchmarkResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerializationBenchmarkResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializationBenchmarkResults) ProtoMessage() {}
+
+func (x *SerializationBenchmarkResults) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializationBenchmarkResults.ProtoReflect.Descriptor instead.
+func (*SerializationBenchmarkResults) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SerializationBenchmarkResults) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SerializationBenchmarkResults) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *SerializationBenchmarkResults) GetTransactions() uint32 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *SerializationBenchmarkResults) GetProtobuf() *SerializationCost {
+	if x != nil {
+		return x.Protobuf
+	}
+	return nil
+}
+
+func (x *SerializationBenchmarkResults) GetJson() *SerializationCost {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *SerializationBenchmarkResults) GetFlatbuffers() *SerializationCost {
+	if x != nil {
+		return x.Flatbuffers
+	}
+	return nil
+}
+
+// SerializationCost is the cost of one format over every iteration. Runtime
+// stats are process-wide, so other calls served meanwhile add to them.
+type SerializationCost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncodedBytes uint64 `protobuf:"varint,1,opt,name=encoded_bytes,json=encodedBytes,proto3" json:"encoded_bytes,omitempty"`
+	// Average wall time of one encode and one decode
+	EncodeUs      float64       `protobuf:"fixed64,2,opt,name=encode_us,json=encodeUs,proto3" json:"encode_us,omitempty"`
+	DecodeUs      float64       `protobuf:"fixed64,3,opt,name=decode_us,json=decodeUs,proto3" json:"decode_us,omitempty"`
+	EncodeRuntime *RuntimeStats `protobuf:"bytes,4,opt,name=encode_runtime,json=encodeRuntime,proto3" json:"encode_runtime,omitempty"`
+	DecodeRuntime *RuntimeStats `protobuf:"bytes,5,opt,name=decode_runtime,json=decodeRuntime,proto3" json:"decode_runtime,omitempty"`
+}
+
+func (x *SerializationCost) Reset() {
+	*x = SerializationCost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerializationCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializationCost) ProtoMessage() {}
+
+func (x *SerializationCost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializationCost.ProtoReflect.Descriptor instead.
+func (*SerializationCost) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SerializationCost) GetEncodedBytes() uint64 {
+	if x != nil {
+		return x.EncodedBytes
+	}
+	return 0
+}
+
+func (x *SerializationCost) GetEncodeUs() float64 {
+	if x != nil {
+		return x.EncodeUs
+	}
+	return 0
+}
+
+func (x *SerializationCost) GetDecodeUs() float64 {
+	if x != nil {
+		return x.DecodeUs
+	}
+	return 0
+}
+
+func (x *SerializationCost) GetEncodeRuntime() *RuntimeStats {
+	if x != nil {
+		return x.EncodeRuntime
+	}
+	return nil
+}
+
+func (x *SerializationCost) GetDecodeRuntime() *RuntimeStats {
+	if x != nil {
+		return x.DecodeRuntime
+	}
+	return nil
+}
+
+// SerializedBlock is a block with its full transactions and their status
+// metadata, carrying the content of a getBlock JSON-RPC result in protobuf
+type SerializedBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot              uint64                   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Blockhash         []byte                   `protobuf:"bytes,2,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
+	PreviousBlockhash []byte                   `protobuf:"bytes,3,opt,name=previous_blockhash,json=previousBlockhash,proto3" json:"previous_blockhash,omitempty"`
+	ParentSlot        uint64                   `protobuf:"varint,4,opt,name=parent_slot,json=parentSlot,proto3" json:"parent_slot,omitempty"`
+	BlockTime         int64                    `protobuf:"varint,5,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	BlockHeight       uint64                   `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Transactions      []*SerializedTransaction `protobuf:"bytes,7,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Rewards           []*SerializedReward      `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *SerializedBlock) Reset() {
+	*x = SerializedBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerializedBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializedBlock) ProtoMessage() {}
+
+func (x *SerializedBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializedBlock.ProtoReflect.Descriptor instead.
+func (*SerializedBlock) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SerializedBlock) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SerializedBlock) GetBlockhash() []byte {
+	if x != nil {
+		return x.Blockhash
+	}
+	return nil
+}
+
+func (x *SerializedBlock) GetPreviousBlockhash() []byte {
+	if x != nil {
+		return x.PreviousBlockhash
+	}
+	return nil
+}
+
+func (x *SerializedBlock) GetParentSlot() uint64 {
+	if x != nil {
+		return x.ParentSlot
+	}
+	return 0
+}
+
+func (x *SerializedBlock) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *SerializedBlock) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *SerializedBlock) GetTransactions() []*SerializedTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *SerializedBlock) GetRewards() []*SerializedReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// SerializedTransaction is a transaction in wire format with its metadata
+type SerializedTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction []byte `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The transaction error as JSON, empty if it succeeded
+	Err               string                         `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	Fee               uint64                         `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	PreBalances       []uint64                       `protobuf:"varint,4,rep,packed,name=pre_balances,json=preBalances,proto3" json:"pre_balances,omitempty"`
+	PostBalances      []uint64                       `protobuf:"varint,5,rep,packed,name=post_balances,json=postBalances,proto3" json:"post_balances,omitempty"`
+	InnerInstructions []*SerializedInnerInstructions `protobuf:"bytes,6,rep,name=inner_instructions,json=innerInstructions,proto3" json:"inner_instructions,omitempty"`
+	PreTokenBalances  []*SerializedTokenBalance      `protobuf:"bytes,7,rep,name=pre_token_balances,json=preTokenBalances,proto3" json:"pre_token_balances,omitempty"`
+	PostTokenBalances []*SerializedTokenBalance      `protobuf:"bytes,8,rep,name=post_token_balances,json=postTokenBalances,proto3" json:"post_token_balances,omitempty"`
+	LogMessages       []string                       `protobuf:"bytes,9,rep,name=log_messages,json=logMessages,proto3" json:"log_messages,omitempty"`
+	LoadedWritable    [][]byte                       `protobuf:"bytes,10,rep,name=loaded_writable,json=loadedWritable,proto3" json:"loaded_writable,omitempty"`
+	LoadedReadonly    [][]byte                       `protobuf:"bytes,11,rep,name=loaded_readonly,json=loadedReadonly,proto3" json:"loaded_readonly,omitempty"`
+	Rewards           []*SerializedReward            `protobuf:"bytes,12,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *SerializedTransaction) Reset() {
+	*x = SerializedTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerializedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializedTransaction) ProtoMessage() {}
+
+func (x *SerializedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializedTransaction.ProtoReflect.Descriptor instead.
+func (*SerializedTransaction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SerializedTransaction) GetTransaction() []byte {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *SerializedTransaction) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+func (x *SerializedTransaction) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *SerializedTransaction) GetPreBalances() []uint64 {
+	if x != nil {
+		return x.PreBalances
+	}
+	return nil
+}
+
+func (x *SerializedTransaction) GetPostBalances() []uint64 {
+	if x != nil {
+		return x.PostBalances
+	}
+	return nil
+}
+
+func (x *SerializedTransaction) GetInnerInstructions() []*SerializedInnerInstructions {
+	if x != nil {
+		return x.InnerInstructions
+	}
+	return nil
+}
+
+func (x *SerializedTransaction) GetPreTokenBalances() []*SerializedTokenBalance {
+	if x != nil {
+		return x.PreTokenBalances
+	}
+	return nil
+}
+
+func (x *SerializedTransaction) GetPostTokenBalances() []*SerializedTokenBalance {
+	if x != nil {
+		return x.PostTokenBalances
+	}
+	return nil
+}
+
+func (x *SerializedTransaction) GetLogMessages() []string {
+	if x != nil {
+		return x.LogMessages
+	}
+	return nil
+}
+
+func (x *SerializedTransaction) GetLoadedWritable() [][]byte {
+	if x != nil {
+		return x.LoadedWritable
+	}
+	return nil
+}
+
+func (x *SerializedTransaction) GetLoadedReadonly() [][]byte {
+	if x != nil {
+		return x.LoadedReadonly
+	}
+	return nil
+}
+
+func (x *SerializedTransaction) GetRewards() []*SerializedReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+// SerializedInnerInstructions are the inner instructions of one instruction
+type SerializedInnerInstructions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index        uint32                   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Instructions []*SerializedInstruction `protobuf:"bytes,2,rep,name=instructions,proto3" json:"instructions,omitempty"`
+}
+
+func (x *SerializedInnerInstructions) Reset() {
+	*x = SerializedInnerInstructions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerializedInnerInstructions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializedInnerInstructions) ProtoMessage() {}
+
+func (x *SerializedInnerInstructions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializedInnerInstructions.ProtoReflect.Descriptor instead.
+func (*SerializedInnerInstructions) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SerializedInnerInstructions) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SerializedInnerInstructions) GetInstructions() []*SerializedInstruction {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+// SerializedInstruction is a compiled instruction
+type SerializedInstruction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProgramIdIndex uint32 `protobuf:"varint,1,opt,name=program_id_index,json=programIdIndex,proto3" json:"program_id_index,omitempty"`
+	Accounts       []byte `protobuf:"bytes,2,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	Data           []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SerializedInstruction) Reset() {
+	*x = SerializedInstruction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerializedInstruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializedInstruction) ProtoMessage() {}
+
+func (x *SerializedInstruction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializedInstruction.ProtoReflect.Descriptor instead.
+func (*SerializedInstruction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SerializedInstruction) GetProgramIdIndex() uint32 {
+	if x != nil {
+		return x.ProgramIdIndex
+	}
+	return 0
+}
+
+func (x *SerializedInstruction) GetAccounts() []byte {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *SerializedInstruction) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// SerializedTokenBalance is a token balance of a transaction account
+type SerializedTokenBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountIndex uint32 `protobuf:"varint,1,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	Mint         []byte `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Owner        []byte `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Amount       string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimals     uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (x *SerializedTokenBalance) Reset() {
+	*x = SerializedTokenBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerializedTokenBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializedTokenBalance) ProtoMessage() {}
+
+func (x *SerializedTokenBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializedTokenBalance.ProtoReflect.Descriptor instead.
+func (*SerializedTokenBalance) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SerializedTokenBalance) GetAccountIndex() uint32 {
+	if x != nil {
+		return x.AccountIndex
+	}
+	return 0
+}
+
+func (x *SerializedTokenBalance) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *SerializedTokenBalance) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *SerializedTokenBalance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SerializedTokenBalance) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+// SerializedReward is a reward credited in a block or by a transaction
+type SerializedReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey      []byte `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Lamports    int64  `protobuf:"varint,2,opt,name=lamports,proto3" json:"lamports,omitempty"`
+	PostBalance uint64 `protobuf:"varint,3,opt,name=post_balance,json=postBalance,proto3" json:"post_balance,omitempty"`
+	RewardType  string `protobuf:"bytes,4,opt,name=reward_type,json=rewardType,proto3" json:"reward_type,omitempty"`
+}
+
+func (x *SerializedReward) Reset() {
+	*x = SerializedReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerializedReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializedReward) ProtoMessage() {}
+
+func (x *SerializedReward) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializedReward.ProtoReflect.Descriptor instead.
+func (*SerializedReward) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SerializedReward) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *SerializedReward) GetLamports() int64 {
+	if x != nil {
+		return x.Lamports
+	}
+	return 0
+}
+
+func (x *SerializedReward) GetPostBalance() uint64 {
+	if x != nil {
+		return x.PostBalance
+	}
+	return 0
+}
+
+func (x *SerializedReward) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+// EncodedBlockRequest selects a block and the encoding to return it in
+type EncodedBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot     uint64        `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Encoding BlockEncoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=solana.benchmark.BlockEncoding" json:"encoding,omitempty"`
+}
+
+func (x *EncodedBlockRequest) Reset() {
+	*x = EncodedBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodedBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodedBlockRequest) ProtoMessage() {}
+
+func (x *EncodedBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodedBlockRequest.ProtoReflect.Descriptor instead.
+func (*EncodedBlockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *EncodedBlockRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *EncodedBlockRequest) GetEncoding() BlockEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return BlockEncoding_BLOCK_ENCODING_PROTOBUF
+}
+
+// EncodedBlock is a block with full transactions in the requested encoding
+type EncodedBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot     uint64        `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Encoding BlockEncoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=solana.benchmark.BlockEncoding" json:"encoding,omitempty"`
+	Payload  []byte        `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Time to fetch the block from the upstream
+	ResponseTimeMs uint64 `protobuf:"varint,4,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Time to encode the payload on the server
+	EncodeUs float64 `protobuf:"fixed64,5,opt,name=encode_us,json=encodeUs,proto3" json:"encode_us,omitempty"`
+}
+
+func (x *EncodedBlock) Reset() {
+	*x = EncodedBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodedBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodedBlock) ProtoMessage() {}
+
+func (x *EncodedBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodedBlock.ProtoReflect.Descriptor instead.
+func (*EncodedBlock) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *EncodedBlock) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *EncodedBlock) GetEncoding() BlockEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return BlockEncoding_BLOCK_ENCODING_PROTOBUF
+}
+
+func (x *EncodedBlock) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *EncodedBlock) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *EncodedBlock) GetEncodeUs() float64 {
+	if x != nil {
+		return x.EncodeUs
+	}
+	return 0
+}
+
+// DualServeBenchmarkRequest selects the targets of a dual-serve benchmark;
+// at least one is required
+type DualServeBenchmarkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestAccounts   []string `protobuf:"bytes,1,rep,name=test_accounts,json=testAccounts,proto3" json:"test_accounts,omitempty"`
+	TestSignatures []string `protobuf:"bytes,2,rep,name=test_signatures,json=testSignatures,proto3" json:"test_signatures,omitempty"`
+	TestSlots      []uint64 `protobuf:"varint,3,rep,packed,name=test_slots,json=testSlots,proto3" json:"test_slots,omitempty"`
+	// Calls per target and protocol; 0 for the server default of 100
+	Iterations uint32 `protobuf:"varint,4,opt,name=iterations,proto3" json:"iterations,omitempty"`
+}
+
+func (x *DualServeBenchmarkRequest) Reset() {
+	*x = DualServeBenchmarkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DualServeBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DualServeBenchmarkRequest) ProtoMessage() {}
+
+func (x *DualServeBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DualServeBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*DualServeBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DualServeBenchmarkRequest) GetTestAccounts() []string {
+	if x != nil {
+		return x.TestAccounts
+	}
+	return nil
+}
+
+func (x *DualServeBenchmarkRequest) GetTestSignatures() []string {
+	if x != nil {
+		return x.TestSignatures
+	}
+	return nil
+}
+
+func (x *DualServeBenchmarkRequest) GetTestSlots() []uint64 {
+	if x != nil {
+		return x.TestSlots
+	}
+	return nil
+}
+
+func (x *DualServeBenchmarkRequest) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+// DualServeBenchmarkResults compares gRPC with JSON over HTTP for each
+// category of target requested
+type DualServeBenchmarkResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Iterations  uint32             `protobuf:"varint,1,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Account     *DualServeCategory `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Transaction *DualServeCategory `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Block       *DualServeCategory `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *DualServeBenchmarkResults) Reset() {
+	*x = DualServeBenchmarkResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DualServeBenchmarkResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DualServeBenchmarkResults) ProtoMessage() {}
+
+func (x *DualServeBenchmarkResults) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DualServeBenchmarkResults.ProtoReflect.Descriptor instead.
+func (*DualServeBenchmarkResults) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DualServeBenchmarkResults) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *DualServeBenchmarkResults) GetAccount() *DualServeCategory {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DualServeBenchmarkResults) GetTransaction() *DualServeCategory {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *DualServeBenchmarkResults) GetBlock() *DualServeCategory {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+// DualServeCategory holds both protocols' results for one category
+type DualServeCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grpc *DualServeProtocol `protobuf:"bytes,1,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Json *DualServeProtocol `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	// JSON over gRPC average call time
+	Speedup float64 `protobuf:"fixed64,3,opt,name=speedup,proto3" json:"speedup,omitempty"`
+}
+
+func (x *DualServeCategory) Reset() {
+	*x = DualServeCategory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DualServeCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DualServeCategory) ProtoMessage() {}
+
+func (x *DualServeCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DualServeCategory.ProtoReflect.Descriptor instead.
+func (*DualServeCategory) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DualServeCategory) GetGrpc() *DualServeProtocol {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *DualServeCategory) GetJson() *DualServeProtocol {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *DualServeCategory) GetSpeedup() float64 {
+	if x != nil {
+		return x.Speedup
+	}
+	return 0
+}
+
+// DualServeProtocol is the cost of one protocol's calls, each timed from
+// issuing the request to decoding the response
+type DualServeProtocol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calls uint32  `protobuf:"varint,1,opt,name=calls,proto3" json:"calls,omitempty"`
+	AvgUs float64 `protobuf:"fixed64,2,opt,name=avg_us,json=avgUs,proto3" json:"avg_us,omitempty"`
+	MinUs float64 `protobuf:"fixed64,3,opt,name=min_us,json=minUs,proto3" json:"min_us,omitempty"`
+	MaxUs float64 `protobuf:"fixed64,4,opt,name=max_us,json=maxUs,proto3" json:"max_us,omitempty"`
+	// Size of the response body: the protobuf message for gRPC and the
+	// protojson encoding for JSON
+	AvgPayloadBytes uint64 `protobuf:"varint,5,opt,name=avg_payload_bytes,json=avgPayloadBytes,proto3" json:"avg_payload_bytes,omitempty"`
+}
+
+func (x *DualServeProtocol) Reset() {
+	*x = DualServeProtocol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DualServeProtocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DualServeProtocol) ProtoMessage() {}
+
+func (x *DualServeProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DualServeProtocol.ProtoReflect.Descriptor instead.
+func (*DualServeProtocol) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DualServeProtocol) GetCalls() uint32 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *DualServeProtocol) GetAvgUs() float64 {
+	if x != nil {
+		return x.AvgUs
+	}
+	return 0
+}
+
+func (x *DualServeProtocol) GetMinUs() float64 {
+	if x != nil {
+		return x.MinUs
+	}
+	return 0
+}
+
+func (x *DualServeProtocol) GetMaxUs() float64 {
+	if x != nil {
+		return x.MaxUs
+	}
+	return 0
+}
+
+func (x *DualServeProtocol) GetAvgPayloadBytes() uint64 {
+	if x != nil {
+		return x.AvgPayloadBytes
+	}
+	return 0
+}
+
+// StreamBenchmarkRequest selects the updates a stream benchmark compares
+type StreamBenchmarkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kinds of update to subscribe to; empty for slots and blocks, and
+	// accounts too if pubkey is set
+	Kinds []StreamBenchmarkKind `protobuf:"varint,1,rep,packed,name=kinds,proto3,enum=solana.benchmark.StreamBenchmarkKind" json:"kinds,omitempty"`
+	// Account followed for STREAM_BENCHMARK_KIND_ACCOUNT
+	Pubkey string `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// How long to subscribe; 0 for the server default of 30
+	DurationSeconds uint32 `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Commitment of account and block updates: confirmed (the default) or
+	// finalized. Slots are followed as they are processed.
+	Commitment string `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *StreamBenchmarkRequest) Reset() {
+	*x = StreamBenchmarkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBenchmarkRequest) ProtoMessage() {}
+
+func (x *StreamBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*StreamBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *StreamBenchmarkRequest) GetKinds() []StreamBenchmarkKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *StreamBenchmarkRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *StreamBenchmarkRequest) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *StreamBenchmarkRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+// StreamBenchmarkResults compares gRPC streams with WebSocket subscriptions
+// for each kind of update requested
+type StreamBenchmarkResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DurationSeconds uint32                     `protobuf:"varint,1,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Commitment      string                     `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Categories      []*StreamBenchmarkCategory `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+}
+
+func (x *StreamBenchmarkResults) Reset() {
+	*x = StreamBenchmarkResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBenchmarkResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBenchmarkResults) ProtoMessage() {}
+
+func (x *StreamBenchmarkResults) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBenchmarkResults.ProtoReflect.Descriptor instead.
+func (*StreamBenchmarkResults) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *StreamBenchmarkResults) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *StreamBenchmarkResults) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *StreamBenchmarkResults) GetCategories() []*StreamBenchmarkCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// StreamBenchmarkCategory holds both transports' results for one kind of
+// update
+type StreamBenchmarkCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      StreamBenchmarkKind       `protobuf:"varint,1,opt,name=kind,proto3,enum=solana.benchmark.StreamBenchmarkKind" json:"kind,omitempty"`
+	Grpc      *StreamBenchmarkTransport `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Websocket *StreamBenchmarkTransport `protobuf:"bytes,3,opt,name=websocket,proto3" json:"websocket,omitempty"`
+}
+
+func (x *StreamBenchmarkCategory) Reset() {
+	*x = StreamBenchmarkCategory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBenchmarkCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBenchmarkCategory) ProtoMessage() {}
+
+func (x *StreamBenchmarkCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBenchmarkCategory.ProtoReflect.Descriptor instead.
+func (*StreamBenchmarkCategory) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *StreamBenchmarkCategory) GetKind() StreamBenchmarkKind {
+	if x != nil {
+		return x.Kind
+	}
+	return StreamBenchmarkKind_STREAM_BENCHMARK_KIND_ACCOUNT
+}
+
+func (x *StreamBenchmarkCategory) GetGrpc() *StreamBenchmarkTransport {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *StreamBenchmarkCategory) GetWebsocket() *StreamBenchmarkTransport {
+	if x != nil {
+		return x.Websocket
+	}
+	return nil
+}
+
+// StreamBenchmarkTransport is what one transport delivered. Delivery latency
+// runs from when a reference slot subscription, on a WebSocket connection of
+// its own, saw an update's slot processed to when the update arrived, so it
+// includes the time the slot took to reach the commitment.
+type StreamBenchmarkTransport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The method subscribed with, such as StreamAccountUpdates or
+	// accountSubscribe
+	Method            string  `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Messages          uint64  `protobuf:"varint,2,opt,name=messages,proto3" json:"messages,omitempty"`
+	MessagesPerSecond float64 `protobuf:"fixed64,3,opt,name=messages_per_second,json=messagesPerSecond,proto3" json:"messages_per_second,omitempty"`
+	// Updates whose slot the reference subscription saw, which latency is
+	// measured over
+	LatencySamples uint64  `protobuf:"varint,4,opt,name=latency_samples,json=latencySamples,proto3" json:"latency_samples,omitempty"`
+	AvgLatencyMs   float64 `protobuf:"fixed64,5,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	P50LatencyMs   float64 `protobuf:"fixed64,6,opt,name=p50_latency_ms,json=p50LatencyMs,proto3" json:"p50_latency_ms,omitempty"`
+	P99LatencyMs   float64 `protobuf:"fixed64,7,opt,name=p99_latency_ms,json=p99LatencyMs,proto3" json:"p99_latency_ms,omitempty"`
+	MaxLatencyMs   float64 `protobuf:"fixed64,8,opt,name=max_latency_ms,json=maxLatencyMs,proto3" json:"max_latency_ms,omitempty"`
+	// Slots the other transport delivered that this one never did. Slots
+	// first delivered near the start or end of the run are not counted.
+	Dropped uint64 `protobuf:"varint,9,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// Why the subscription failed, if it did; the counts cover what it
+	// delivered before
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StreamBenchmarkTransport) Reset() {
+	*x = StreamBenchmarkTransport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBenchmarkTransport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBenchmarkTransport) ProtoMessage() {}
+
+func (x *StreamBenchmarkTransport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBenchmarkTransport.ProtoReflect.Descriptor instead.
+func (*StreamBenchmarkTransport) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *StreamBenchmarkTransport) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *StreamBenchmarkTransport) GetMessages() uint64 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+func (x *StreamBenchmarkTransport) GetMessagesPerSecond() float64 {
+	if x != nil {
+		return x.MessagesPerSecond
+	}
+	return 0
+}
+
+func (x *StreamBenchmarkTransport) GetLatencySamples() uint64 {
+	if x != nil {
+		return x.LatencySamples
+	}
+	return 0
+}
+
+func (x *StreamBenchmarkTransport) GetAvgLatencyMs() float64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *StreamBenchmarkTransport) GetP50LatencyMs() float64 {
+	if x != nil {
+		return x.P50LatencyMs
+	}
+	return 0
+}
+
+func (x *StreamBenchmarkTransport) GetP99LatencyMs() float64 {
+	if x != nil {
+		return x.P99LatencyMs
+	}
+	return 0
+}
+
+func (x *StreamBenchmarkTransport) GetMaxLatencyMs() float64 {
+	if x != nil {
+		return x.MaxLatencyMs
+	}
+	return 0
+}
+
+func (x *StreamBenchmarkTransport) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *StreamBenchmarkTransport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// TransactionRequest represents a request for transaction information
+type TransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature  string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// field_mask selects the TransactionResponse fields to return; empty returns all
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+}
+
+func (x *TransactionRequest) Reset() {
+	*x = TransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRequest) ProtoMessage() {}
+
+func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRequest.ProtoReflect.Descriptor instead.
+func (*TransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *TransactionRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TransactionRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *TransactionRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// TransactionResponse represents the response with transaction information
+type TransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature      string           `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Slot           uint64           `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Transaction    []byte           `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Success        bool             `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	ResponseTimeMs uint64           `protobuf:"varint,5,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	Timing         *TimingBreakdown `protobuf:"bytes,6,opt,name=timing,proto3" json:"timing,omitempty"`
+	// SHA-256 hash of the canonical encoding of the fields returned, without
+	// response_time_ms and timing, which differ between replicas
+	ContentHash []byte `protobuf:"bytes,7,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,8,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *TransactionResponse) Reset() {
+	*x = TransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResponse) ProtoMessage() {}
+
+func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
+func (*TransactionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *TransactionResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TransactionResponse) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *TransactionResponse) GetTransaction() []byte {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TransactionResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *TransactionResponse) GetTiming() *TimingBreakdown {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetContentHash() []byte {
+	if x != nil {
+		return x.ContentHash
+	}
+	return nil
+}
+
+func (x *TransactionResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// BlockRequest represents a request for block information
+type BlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot       uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Commitment string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// field_mask selects the BlockResponse fields to return; empty returns all
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// detail is the getBlock transaction detail tier to fetch the block at
+	Detail BlockDetail `protobuf:"varint,4,opt,name=detail,proto3,enum=solana.benchmark.BlockDetail" json:"detail,omitempty"`
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *BlockRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *BlockRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *BlockRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *BlockRequest) GetDetail() BlockDetail {
+	if x != nil {
+		return x.Detail
+	}
+	return BlockDetail_BLOCK_DETAIL_FULL
+}
+
+// BlockResponse represents the response with block information
+type BlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot              uint64           `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Blockhash         string           `protobuf:"bytes,2,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
+	PreviousBlockhash string           `protobuf:"bytes,3,opt,name=previous_blockhash,json=previousBlockhash,proto3" json:"previous_blockhash,omitempty"`
+	ParentSlot        uint64           `protobuf:"varint,4,opt,name=parent_slot,json=parentSlot,proto3" json:"parent_slot,omitempty"`
+	Transactions      []string         `protobuf:"bytes,5,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	ResponseTimeMs    uint64           `protobuf:"varint,6,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	Timing            *TimingBreakdown `protobuf:"bytes,7,opt,name=timing,proto3" json:"timing,omitempty"`
+	// Number of rewards credited in the block
+	Rewards uint32 `protobuf:"varint,8,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	// SHA-256 hash of the canonical encoding of the fields returned, without
+	// response_time_ms and timing, which differ between replicas
+	ContentHash []byte `protobuf:"bytes,9,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,10,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockResponse) ProtoMessage() {}
+
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *BlockResponse) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *BlockResponse) GetBlockhash() string {
+	if x != nil {
+		return x.Blockhash
+	}
+	return ""
+}
+
+func (x *BlockResponse) GetPreviousBlockhash() string {
+	if x != nil {
+		return x.PreviousBlockhash
+	}
+	return ""
+}
+
+func (x *BlockResponse) GetParentSlot() uint64 {
+	if x != nil {
+		return x.ParentSlot
+	}
+	return 0
+}
+
+func (x *BlockResponse) GetTransactions() []string {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *BlockResponse) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *BlockResponse) GetTiming() *TimingBreakdown {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *BlockResponse) GetRewards() uint32 {
+	if x != nil {
+		return x.Rewards
+	}
+	return 0
+}
+
+func (x *BlockResponse) GetContentHash() []byte {
+	if x != nil {
+		return x.ContentHash
+	}
+	return nil
+}
+
+func (x *BlockResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// AccountStreamRequest represents a request to stream account updates.
+// Accounts are selected by explicit pubkeys and/or by owner programs, in which
+// case the server resolves and keeps tracking the set of owned accounts.
+// When include_snapshot is set, the current state of every selected account is
+// sent (marked as snapshot) before live updates begin.
+type AccountStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkeys         []string `protobuf:"bytes,1,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	Commitment      string   `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	IncludeSnapshot bool     `protobuf:"varint,3,opt,name=include_snapshot,json=includeSnapshot,proto3" json:"include_snapshot,omitempty"`
+	Owners          []string `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string    `protobuf:"bytes,5,opt,name=compression,proto3" json:"compression,omitempty"`
+	Qos         StreamQoS `protobuf:"varint,6,opt,name=qos,proto3,enum=solana.benchmark.StreamQoS" json:"qos,omitempty"`
+	// field_mask selects the AccountUpdate fields to return; empty returns all
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// batch_max_messages coalesces up to this many updates into one batch,
+	// trading latency for throughput; 0 or 1 sends each update on its own, and
+	// bulk streams batch unless it is set. Only StreamAccountUpdateBatches
+	// batches; the other account streams reject the batching fields.
+	BatchMaxMessages uint32 `protobuf:"varint,8,opt,name=batch_max_messages,json=batchMaxMessages,proto3" json:"batch_max_messages,omitempty"`
+	// batch_max_wait_ms is the longest an update is held for its batch to fill,
+	// 100 ms by default when batching
+	BatchMaxWaitMs uint32 `protobuf:"varint,9,opt,name=batch_max_wait_ms,json=batchMaxWaitMs,proto3" json:"batch_max_wait_ms,omitempty"`
+}
+
+func (x *AccountStreamRequest) Reset() {
+	*x = AccountStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStreamRequest) ProtoMessage() {}
+
+func (x *AccountStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStreamRequest.ProtoReflect.Descriptor instead.
+func (*AccountStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *AccountStreamRequest) GetPubkeys() []string {
+	if x != nil {
+		return x.Pubkeys
+	}
+	return nil
+}
+
+func (x *AccountStreamRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *AccountStreamRequest) GetIncludeSnapshot() bool {
+	if x != nil {
+		return x.IncludeSnapshot
+	}
+	return false
+}
+
+func (x *AccountStreamRequest) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *AccountStreamRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *AccountStreamRequest) GetQos() StreamQoS {
+	if x != nil {
+		return x.Qos
+	}
+	return StreamQoS_STREAM_QOS_REALTIME
+}
+
+func (x *AccountStreamRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *AccountStreamRequest) GetBatchMaxMessages() uint32 {
+	if x != nil {
+		return x.BatchMaxMessages
+	}
+	return 0
+}
+
+func (x *AccountStreamRequest) GetBatchMaxWaitMs() uint32 {
+	if x != nil {
+		return x.BatchMaxWaitMs
+	}
+	return 0
+}
+
+// AccountUpdateBatch carries account updates coalesced by
+// StreamAccountUpdateBatches, in the order they were observed
+type AccountUpdateBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updates []*AccountUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+}
+
+func (x *AccountUpdateBatch) Reset() {
+	*x = AccountUpdateBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountUpdateBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountUpdateBatch) ProtoMessage() {}
+
+func (x *AccountUpdateBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountUpdateBatch.ProtoReflect.Descriptor instead.
+func (*AccountUpdateBatch) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *AccountUpdateBatch) GetUpdates() []*AccountUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+// AccountUpdate represents a real-time account update.
+// Updates for a given pubkey are delivered in slot order; write_version is a
+// per-pubkey sequence maintained by the server that increases each time a new
+// account state is observed, so consumers can detect missed or stale updates.
+// Accounts no open stream watches are forgotten; versions of accounts watched
+// again continue above any forgotten, so they never go back.
+type AccountUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey       string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Data         []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Owner        string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Lamports     uint64 `protobuf:"varint,4,opt,name=lamports,proto3" json:"lamports,omitempty"`
+	Slot         uint64 `protobuf:"varint,5,opt,name=slot,proto3" json:"slot,omitempty"`
+	Timestamp    uint64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	WriteVersion uint64 `protobuf:"varint,7,opt,name=write_version,json=writeVersion,proto3" json:"write_version,omitempty"`
+	Snapshot     bool   `protobuf:"varint,8,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// Unset for updates pushed by a Yellowstone gateway and for recorded states
+	Timing *TimingBreakdown `protobuf:"bytes,9,opt,name=timing,proto3" json:"timing,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,10,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *AccountUpdate) Reset() {
+	*x = AccountUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountUpdate) ProtoMessage() {}
+
+func (x *AccountUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountUpdate.ProtoReflect.Descriptor instead.
+func (*AccountUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *AccountUpdate) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *AccountUpdate) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AccountUpdate) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *AccountUpdate) GetLamports() uint64 {
+	if x != nil {
+		return x.Lamports
+	}
+	return 0
+}
+
+func (x *AccountUpdate) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *AccountUpdate) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AccountUpdate) GetWriteVersion() uint64 {
+	if x != nil {
+		return x.WriteVersion
+	}
+	return 0
+}
+
+func (x *AccountUpdate) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+func (x *AccountUpdate) GetTiming() *TimingBreakdown {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *AccountUpdate) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// AccountHistoryRequest represents a request for the recorded states of an account.
+// The first state returned is the one in effect at start_slot; end_slot 0 means
+// up to the latest recorded state.
+type AccountHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey    string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	StartSlot uint64 `protobuf:"varint,2,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot   uint64 `protobuf:"varint,3,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	Limit     uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *AccountHistoryRequest) Reset() {
+	*x = AccountHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountHistoryRequest) ProtoMessage() {}
+
+func (x *AccountHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AccountHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *AccountHistoryRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *AccountHistoryRequest) GetStartSlot() uint64 {
+	if x != nil {
+		return x.StartSlot
+	}
+	return 0
+}
+
+func (x *AccountHistoryRequest) GetEndSlot() uint64 {
+	if x != nil {
+		return x.EndSlot
+	}
+	return 0
+}
+
+func (x *AccountHistoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// AccountHistoryResponse holds the recorded states of an account in slot order
+type AccountHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	States []*AccountUpdate `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	// truncated is set when more states fall in the range than the limit
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,3,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *AccountHistoryResponse) Reset() {
+	*x = AccountHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountHistoryResponse) ProtoMessage() {}
+
+func (x *AccountHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountHistoryResponse.ProtoReflect.Descriptor instead.
+func (*AccountHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *AccountHistoryResponse) GetStates() []*AccountUpdate {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *AccountHistoryResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *AccountHistoryResponse) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// AccountAckStreamRequest is sent by the client on an acknowledged account stream.
+// The first message either starts a new session (subscription set) or resumes a
+// previous one (session_id set); later messages acknowledge processed cursors.
+type AccountAckStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *AccountStreamRequest `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	SessionId    string                `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AckCursor    uint64                `protobuf:"varint,3,opt,name=ack_cursor,json=ackCursor,proto3" json:"ack_cursor,omitempty"`
+	MaxUnacked   uint32                `protobuf:"varint,4,opt,name=max_unacked,json=maxUnacked,proto3" json:"max_unacked,omitempty"`
+	// Compression for this stream's responses, honored on the first message:
+	// gzip, none, or empty for the channel default
+	Compression string `protobuf:"bytes,5,opt,name=compression,proto3" json:"compression,omitempty"`
+}
+
+func (x *AccountAckStreamRequest) Reset() {
+	*x = AccountAckStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountAckStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountAckStreamRequest) ProtoMessage() {}
+
+func (x *AccountAckStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountAckStreamRequest.ProtoReflect.Descriptor instead.
+func (*AccountAckStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *AccountAckStreamRequest) GetSubscription() *AccountStreamRequest {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *AccountAckStreamRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AccountAckStreamRequest) GetAckCursor() uint64 {
+	if x != nil {
+		return x.AckCursor
+	}
+	return 0
+}
+
+func (x *AccountAckStreamRequest) GetMaxUnacked() uint32 {
+	if x != nil {
+		return x.MaxUnacked
+	}
+	return 0
+}
+
+func (x *AccountAckStreamRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+// AckedAccountUpdate represents an account update delivered on an acknowledged stream
+type AckedAccountUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string         `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Cursor    uint64         `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Update    *AccountUpdate `protobuf:"bytes,3,opt,name=update,proto3" json:"update,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,4,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *AckedAccountUpdate) Reset() {
+	*x = AckedAccountUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckedAccountUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckedAccountUpdate) ProtoMessage() {}
+
+func (x *AckedAccountUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckedAccountUpdate.ProtoReflect.Descriptor instead.
+func (*AckedAccountUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *AckedAccountUpdate) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AckedAccountUpdate) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *AckedAccountUpdate) GetUpdate() *AccountUpdate {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *AckedAccountUpdate) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// AckSessionHandoffRequest asks a replica for a detached session it holds
+type AckSessionHandoffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *AckSessionHandoffRequest) Reset() {
+	*x = AckSessionHandoffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckSessionHandoffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckSessionHandoffRequest) ProtoMessage() {}
+
+func (x *AckSessionHandoffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckSessionHandoffRequest.ProtoReflect.Descriptor instead.
+func (*AckSessionHandoffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *AckSessionHandoffRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// AckSessionHandoff is the state of an acknowledged stream session moved
+// between replicas
+type AckSessionHandoff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId    string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Subscription *AccountStreamRequest `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	MaxUnacked   uint32                `protobuf:"varint,3,opt,name=max_unacked,json=maxUnacked,proto3" json:"max_unacked,omitempty"`
+	// Cursor of the last update buffered; the next update gets the one after
+	LastCursor uint64 `protobuf:"varint,4,opt,name=last_cursor,json=lastCursor,proto3" json:"last_cursor,omitempty"`
+	// Updates awaiting acknowledgement, oldest first
+	Pending []*AckedAccountUpdate `protobuf:"bytes,5,rep,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *AckSessionHandoff) Reset() {
+	*x = AckSessionHandoff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckSessionHandoff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckSessionHandoff) ProtoMessage() {}
+
+func (x *AckSessionHandoff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckSessionHandoff.ProtoReflect.Descriptor instead.
+func (*AckSessionHandoff) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *AckSessionHandoff) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AckSessionHandoff) GetSubscription() *AccountStreamRequest {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *AckSessionHandoff) GetMaxUnacked() uint32 {
+	if x != nil {
+		return x.MaxUnacked
+	}
+	return 0
+}
+
+func (x *AckSessionHandoff) GetLastCursor() uint64 {
+	if x != nil {
+		return x.LastCursor
+	}
+	return 0
+}
+
+func (x *AckSessionHandoff) GetPending() []*AckedAccountUpdate {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+// ProgramAccountsSnapshotRequest represents a request to stream all accounts owned by a program
+type ProgramAccountsSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProgramId    string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Commitment   string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	ChunkSize    uint32 `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	ContinueLive bool   `protobuf:"varint,4,opt,name=continue_live,json=continueLive,proto3" json:"continue_live,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string `protobuf:"bytes,5,opt,name=compression,proto3" json:"compression,omitempty"`
+	// batch_max_messages coalesces up to this many live updates into one chunk,
+	// trading latency for throughput; 0 or 1 sends each update on its own
+	BatchMaxMessages uint32 `protobuf:"varint,6,opt,name=batch_max_messages,json=batchMaxMessages,proto3" json:"batch_max_messages,omitempty"`
+	// batch_max_wait_ms is the longest a live update is held for its chunk to
+	// fill, 100 ms by default when batching
+	BatchMaxWaitMs uint32 `protobuf:"varint,7,opt,name=batch_max_wait_ms,json=batchMaxWaitMs,proto3" json:"batch_max_wait_ms,omitempty"`
+	// qos is the stream's QoS class. Bulk streams batch live updates unless
+	// batching is set explicitly.
+	Qos StreamQoS `protobuf:"varint,8,opt,name=qos,proto3,enum=solana.benchmark.StreamQoS" json:"qos,omitempty"`
+	// poll_interval_ms overrides the server's poll interval when live updates
+	// are polled for lack of a WebSocket upstream; 0 keeps the server's
+	PollIntervalMs uint32 `protobuf:"varint,9,opt,name=poll_interval_ms,json=pollIntervalMs,proto3" json:"poll_interval_ms,omitempty"`
+	// change_detection is how polled live updates are found
+	ChangeDetection PollChangeDetection `protobuf:"varint,10,opt,name=change_detection,json=changeDetection,proto3,enum=solana.benchmark.PollChangeDetection" json:"change_detection,omitempty"`
+}
+
+func (x *ProgramAccountsSnapshotRequest) Reset() {
+	*x = ProgramAccountsSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgramAccountsSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgramAccountsSnapshotRequest) ProtoMessage() {}
+
+func (x *ProgramAccountsSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgramAccountsSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*ProgramAccountsSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ProgramAccountsSnapshotRequest) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *ProgramAccountsSnapshotRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *ProgramAccountsSnapshotRequest) GetChunkSize() uint32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *ProgramAccountsSnapshotRequest) GetContinueLive() bool {
+	if x != nil {
+		return x.ContinueLive
+	}
+	return false
+}
+
+func (x *ProgramAccountsSnapshotRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *ProgramAccountsSnapshotRequest) GetBatchMaxMessages() uint32 {
+	if x != nil {
+		return x.BatchMaxMessages
+	}
+	return 0
+}
+
+func (x *ProgramAccountsSnapshotRequest) GetBatchMaxWaitMs() uint32 {
+	if x != nil {
+		return x.BatchMaxWaitMs
+	}
+	return 0
+}
+
+func (x *ProgramAccountsSnapshotRequest) GetQos() StreamQoS {
+	if x != nil {
+		return x.Qos
+	}
+	return StreamQoS_STREAM_QOS_REALTIME
+}
+
+func (x *ProgramAccountsSnapshotRequest) GetPollIntervalMs() uint32 {
+	if x != nil {
+		return x.PollIntervalMs
+	}
+	return 0
+}
+
+func (x *ProgramAccountsSnapshotRequest) GetChangeDetection() PollChangeDetection {
+	if x != nil {
+		return x.ChangeDetection
+	}
+	return PollChangeDetection_POLL_CHANGE_DETECTION_DATA_HASH
+}
+
+// ProgramAccountsChunk carries a page of the program accounts snapshot with
+// progress markers, or a live update once the snapshot is complete
+type ProgramAccountsChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts         []*AccountUpdate `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	SentAccounts     uint32           `protobuf:"varint,2,opt,name=sent_accounts,json=sentAccounts,proto3" json:"sent_accounts,omitempty"`
+	TotalAccounts    uint32           `protobuf:"varint,3,opt,name=total_accounts,json=totalAccounts,proto3" json:"total_accounts,omitempty"`
+	SnapshotComplete bool             `protobuf:"varint,4,opt,name=snapshot_complete,json=snapshotComplete,proto3" json:"snapshot_complete,omitempty"`
+	Live             bool             `protobuf:"varint,5,opt,name=live,proto3" json:"live,omitempty"`
+	// gap_repair marks live chunks carrying the current state of the accounts
+	// written while the upstream subscription was down. At least one such
+	// chunk, possibly empty, follows each resubscription.
+	GapRepair bool `protobuf:"varint,6,opt,name=gap_repair,json=gapRepair,proto3" json:"gap_repair,omitempty"`
+	// poll_interval_ms is set on live chunks when no WebSocket upstream is
+	// available and changes are found by polling the program's accounts this
+	// often instead
+	PollIntervalMs uint32 `protobuf:"varint,7,opt,name=poll_interval_ms,json=pollIntervalMs,proto3" json:"poll_interval_ms,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,8,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *ProgramAccountsChunk) Reset() {
+	*x = ProgramAccountsChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgramAccountsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgramAccountsChunk) ProtoMessage() {}
+
+func (x *ProgramAccountsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgramAccountsChunk.ProtoReflect.Descriptor instead.
+func (*ProgramAccountsChunk) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ProgramAccountsChunk) GetAccounts() []*AccountUpdate {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ProgramAccountsChunk) GetSentAccounts() uint32 {
+	if x != nil {
+		return x.SentAccounts
+	}
+	return 0
+}
+
+func (x *ProgramAccountsChunk) GetTotalAccounts() uint32 {
+	if x != nil {
+		return x.TotalAccounts
+	}
+	return 0
+}
+
+func (x *ProgramAccountsChunk) GetSnapshotComplete() bool {
+	if x != nil {
+		return x.SnapshotComplete
+	}
+	return false
+}
+
+func (x *ProgramAccountsChunk) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+func (x *ProgramAccountsChunk) GetGapRepair() bool {
+	if x != nil {
+		return x.GapRepair
+	}
+	return false
+}
+
+func (x *ProgramAccountsChunk) GetPollIntervalMs() uint32 {
+	if x != nil {
+		return x.PollIntervalMs
+	}
+	return 0
+}
+
+func (x *ProgramAccountsChunk) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// CommitmentLatencyRequest represents a request to measure commitment transition
+// latency. The pubkey defaults to the Clock sysvar, which changes every slot.
+type CommitmentLatencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey          string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	DurationSeconds uint32 `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string `protobuf:"bytes,3,opt,name=compression,proto3" json:"compression,omitempty"`
+	// poll_interval_ms overrides the server's poll interval when the slots are
+	// polled for lack of a WebSocket upstream; 0 keeps the server's
+	PollIntervalMs uint32 `protobuf:"varint,4,opt,name=poll_interval_ms,json=pollIntervalMs,proto3" json:"poll_interval_ms,omitempty"`
+}
+
+func (x *CommitmentLatencyRequest) Reset() {
+	*x = CommitmentLatencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitmentLatencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitmentLatencyRequest) ProtoMessage() {}
+
+func (x *CommitmentLatencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitmentLatencyRequest.ProtoReflect.Descriptor instead.
+func (*CommitmentLatencyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *CommitmentLatencyRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *CommitmentLatencyRequest) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CommitmentLatencyRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *CommitmentLatencyRequest) GetPollIntervalMs() uint32 {
+	if x != nil {
+		return x.PollIntervalMs
+	}
+	return 0
+}
+
+// CommitmentLatencySample reports how long after a slot was first seen at
+// processed commitment it was seen at confirmed and finalized commitment
+type CommitmentLatencySample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot                   uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ProcessedToConfirmedMs uint64 `protobuf:"varint,2,opt,name=processed_to_confirmed_ms,json=processedToConfirmedMs,proto3" json:"processed_to_confirmed_ms,omitempty"`
+	ConfirmedToFinalizedMs uint64 `protobuf:"varint,3,opt,name=confirmed_to_finalized_ms,json=confirmedToFinalizedMs,proto3" json:"confirmed_to_finalized_ms,omitempty"`
+	ProcessedToFinalizedMs uint64 `protobuf:"varint,4,opt,name=processed_to_finalized_ms,json=processedToFinalizedMs,proto3" json:"processed_to_finalized_ms,omitempty"`
+	Timestamp              uint64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// poll_interval_ms is set when no WebSocket upstream is available and the
+	// slot at each level is polled this often instead, which bounds the
+	// precision of the sample
+	PollIntervalMs uint32 `protobuf:"varint,6,opt,name=poll_interval_ms,json=pollIntervalMs,proto3" json:"poll_interval_ms,omitempty"`
+}
+
+func (x *CommitmentLatencySample) Reset() {
+	*x = CommitmentLatencySample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitmentLatencySample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitmentLatencySample) ProtoMessage() {}
+
+func (x *CommitmentLatencySample) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitmentLatencySample.ProtoReflect.Descriptor instead.
+func (*CommitmentLatencySample) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *CommitmentLatencySample) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CommitmentLatencySample) GetProcessedToConfirmedMs() uint64 {
+	if x != nil {
+		return x.ProcessedToConfirmedMs
+	}
+	return 0
+}
+
+func (x *CommitmentLatencySample) GetConfirmedToFinalizedMs() uint64 {
+	if x != nil {
+		return x.ConfirmedToFinalizedMs
+	}
+	return 0
+}
+
+func (x *CommitmentLatencySample) GetProcessedToFinalizedMs() uint64 {
+	if x != nil {
+		return x.ProcessedToFinalizedMs
+	}
+	return 0
+}
+
+func (x *CommitmentLatencySample) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CommitmentLatencySample) GetPollIntervalMs() uint32 {
+	if x != nil {
+		return x.PollIntervalMs
+	}
+	return 0
+}
+
+// TransactionStreamRequest represents a request to stream transactions. When
+// accounts are set, only transactions referencing one of them are streamed.
+type TransactionStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts      []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	IncludeFailed bool     `protobuf:"varint,2,opt,name=include_failed,json=includeFailed,proto3" json:"include_failed,omitempty"`
+	Commitment    string   `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string    `protobuf:"bytes,4,opt,name=compression,proto3" json:"compression,omitempty"`
+	Qos         StreamQoS `protobuf:"varint,5,opt,name=qos,proto3,enum=solana.benchmark.StreamQoS" json:"qos,omitempty"`
+	// sample_rate streams a 1-in-N sample of the matching transactions, chosen
+	// by signature; 0 or 1 streams all of them
+	SampleRate uint32 `protobuf:"varint,6,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// field_mask selects the TransactionUpdate fields to return; empty returns all
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+}
+
+func (x *TransactionStreamRequest) Reset() {
+	*x = TransactionStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStreamRequest) ProtoMessage() {}
+
+func (x *TransactionStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStreamRequest.ProtoReflect.Descriptor instead.
+func (*TransactionStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *TransactionStreamRequest) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *TransactionStreamRequest) GetIncludeFailed() bool {
+	if x != nil {
+		return x.IncludeFailed
+	}
+	return false
+}
+
+func (x *TransactionStreamRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *TransactionStreamRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *TransactionStreamRequest) GetQos() StreamQoS {
+	if x != nil {
+		return x.Qos
+	}
+	return StreamQoS_STREAM_QOS_REALTIME
+}
+
+func (x *TransactionStreamRequest) GetSampleRate() uint32 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *TransactionStreamRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// TransactionUpdate represents a real-time transaction update
+type TransactionUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature   string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Slot        uint64 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Transaction []byte `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Success     bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Timestamp   uint64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// SPL Token and Token-2022 transfers made by the transaction, including
+	// those in inner instructions, in execution order
+	TokenTransfers []*TokenTransfer `protobuf:"bytes,6,rep,name=token_transfers,json=tokenTransfers,proto3" json:"token_transfers,omitempty"`
+	// Swaps executed on supported DEX programs, in execution order
+	Swaps  []*SwapEvent     `protobuf:"bytes,7,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	Timing *TimingBreakdown `protobuf:"bytes,8,opt,name=timing,proto3" json:"timing,omitempty"`
+	// SHA-256 hash of the canonical encoding of the fields sent, without
+	// timestamp and timing, which differ between replicas
+	ContentHash []byte `protobuf:"bytes,9,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,10,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+	// Why the transaction failed; unset if it succeeded
+	Failure *TransactionFailure `protobuf:"bytes,11,opt,name=failure,proto3" json:"failure,omitempty"`
+}
+
+func (x *TransactionUpdate) Reset() {
+	*x = TransactionUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionUpdate) ProtoMessage() {}
+
+func (x *TransactionUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionUpdate.ProtoReflect.Descriptor instead.
+func (*TransactionUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *TransactionUpdate) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *TransactionUpdate) GetTransaction() []byte {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransactionUpdate) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TransactionUpdate) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TransactionUpdate) GetTokenTransfers() []*TokenTransfer {
+	if x != nil {
+		return x.TokenTransfers
+	}
+	return nil
+}
+
+func (x *TransactionUpdate) GetSwaps() []*SwapEvent {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *TransactionUpdate) GetTiming() *TimingBreakdown {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *TransactionUpdate) GetContentHash() []byte {
+	if x != nil {
+		return x.ContentHash
+	}
+	return nil
+}
+
+func (x *TransactionUpdate) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+func (x *TransactionUpdate) GetFailure() *TransactionFailure {
+	if x != nil {
+		return x.Failure
+	}
+	return nil
+}
+
+// TransactionFailure describes why a transaction failed
+type TransactionFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind TransactionFailureKind `protobuf:"varint,1,opt,name=kind,proto3,enum=solana.benchmark.TransactionFailureKind" json:"kind,omitempty"`
+	// Name of the transaction error, such as BlockhashNotFound, or of the
+	// instruction error, such as ComputationalBudgetExceeded or Custom
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Top-level instruction that failed; -1 for errors of the whole transaction
+	InstructionIndex int32 `protobuf:"varint,3,opt,name=instruction_index,json=instructionIndex,proto3" json:"instruction_index,omitempty"`
+	// Program that returned the error, which may be one the failing
+	// instruction invoked; empty for errors of the whole transaction
+	ProgramId string `protobuf:"bytes,4,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// Code of a custom program error
+	CustomCode uint32 `protobuf:"varint,5,opt,name=custom_code,json=customCode,proto3" json:"custom_code,omitempty"`
+}
+
+func (x *TransactionFailure) Reset() {
+	*x = TransactionFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionFailure) ProtoMessage() {}
+
+func (x *TransactionFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionFailure.ProtoReflect.Descriptor instead.
+func (*TransactionFailure) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *TransactionFailure) GetKind() TransactionFailureKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TransactionFailureKind_TRANSACTION_FAILURE_KIND_UNSPECIFIED
+}
+
+func (x *TransactionFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TransactionFailure) GetInstructionIndex() int32 {
+	if x != nil {
+		return x.InstructionIndex
+	}
+	return 0
+}
+
+func (x *TransactionFailure) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *TransactionFailure) GetCustomCode() uint32 {
+	if x != nil {
+		return x.CustomCode
+	}
+	return 0
+}
+
+// SwapEvent is a swap executed on a DEX program. The trader is the authority
+// the input tokens were transferred from; amounts are in raw token units.
+type SwapEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DEX name, such as raydium, orca-whirlpool or phoenix
+	Dex       string `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	ProgramId string `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// Pool or market the swap executed against
+	Pool      string `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	Trader    string `protobuf:"bytes,4,opt,name=trader,proto3" json:"trader,omitempty"`
+	MintIn    string `protobuf:"bytes,5,opt,name=mint_in,json=mintIn,proto3" json:"mint_in,omitempty"`
+	MintOut   string `protobuf:"bytes,6,opt,name=mint_out,json=mintOut,proto3" json:"mint_out,omitempty"`
+	AmountIn  uint64 `protobuf:"varint,7,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	AmountOut uint64 `protobuf:"varint,8,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+}
+
+func (x *SwapEvent) Reset() {
+	*x = SwapEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapEvent) ProtoMessage() {}
+
+func (x *SwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapEvent.ProtoReflect.Descriptor instead.
+func (*SwapEvent) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SwapEvent) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetTrader() string {
+	if x != nil {
+		return x.Trader
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetMintIn() string {
+	if x != nil {
+		return x.MintIn
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetMintOut() string {
+	if x != nil {
+		return x.MintOut
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetAmountIn() uint64 {
+	if x != nil {
+		return x.AmountIn
+	}
+	return 0
+}
+
+func (x *SwapEvent) GetAmountOut() uint64 {
+	if x != nil {
+		return x.AmountOut
+	}
+	return 0
+}
+
+// TokenTransfer is a transfer or transferChecked instruction of a token
+// program. Amounts are in raw token units.
+type TokenTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProgramId   string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Mint        string `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Source      string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	Authority   string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	Amount      uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimals    uint32 `protobuf:"varint,7,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (x *TokenTransfer) Reset() {
+	*x = TokenTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransfer) ProtoMessage() {}
+
+func (x *TokenTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransfer.ProtoReflect.Descriptor instead.
+func (*TokenTransfer) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *TokenTransfer) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TokenTransfer) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+// BlockStreamRequest represents a request to stream blocks
+type BlockStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commitment string `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string    `protobuf:"bytes,2,opt,name=compression,proto3" json:"compression,omitempty"`
+	Qos         StreamQoS `protobuf:"varint,3,opt,name=qos,proto3,enum=solana.benchmark.StreamQoS" json:"qos,omitempty"`
+	// field_mask selects the BlockUpdate fields to return; empty returns all
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+}
+
+func (x *BlockStreamRequest) Reset() {
+	*x = BlockStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockStreamRequest) ProtoMessage() {}
+
+func (x *BlockStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockStreamRequest.ProtoReflect.Descriptor instead.
+func (*BlockStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *BlockStreamRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *BlockStreamRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *BlockStreamRequest) GetQos() StreamQoS {
+	if x != nil {
+		return x.Qos
+	}
+	return StreamQoS_STREAM_QOS_REALTIME
+}
+
+func (x *BlockStreamRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// BalanceChangeStreamRequest represents a request to stream the balance changes
+// of a set of accounts. Token balances are matched by token account or owner.
+type BalanceChangeStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts   []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Commitment string   `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string    `protobuf:"bytes,3,opt,name=compression,proto3" json:"compression,omitempty"`
+	Qos         StreamQoS `protobuf:"varint,4,opt,name=qos,proto3,enum=solana.benchmark.StreamQoS" json:"qos,omitempty"`
+}
+
+func (x *BalanceChangeStreamRequest) Reset() {
+	*x = BalanceChangeStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChangeStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChangeStreamRequest) ProtoMessage() {}
+
+func (x *BalanceChangeStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChangeStreamRequest.ProtoReflect.Descriptor instead.
+func (*BalanceChangeStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *BalanceChangeStreamRequest) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *BalanceChangeStreamRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *BalanceChangeStreamRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *BalanceChangeStreamRequest) GetQos() StreamQoS {
+	if x != nil {
+		return x.Qos
+	}
+	return StreamQoS_STREAM_QOS_REALTIME
+}
+
+// BalanceChange is the net change of one balance over a block. An empty mint
+// means the account's lamports; otherwise amounts are raw token units.
+type BalanceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account     string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Mint        string `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Owner       string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	PreBalance  uint64 `protobuf:"varint,4,opt,name=pre_balance,json=preBalance,proto3" json:"pre_balance,omitempty"`
+	PostBalance uint64 `protobuf:"varint,5,opt,name=post_balance,json=postBalance,proto3" json:"post_balance,omitempty"`
+	Delta       int64  `protobuf:"varint,6,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Number of transactions in the block that changed the balance
+	Transactions uint32 `protobuf:"varint,7,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	Decimals     uint32 `protobuf:"varint,8,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (x *BalanceChange) Reset() {
+	*x = BalanceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChange) ProtoMessage() {}
+
+func (x *BalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChange.ProtoReflect.Descriptor instead.
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *BalanceChange) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetPreBalance() uint64 {
+	if x != nil {
+		return x.PreBalance
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetPostBalance() uint64 {
+	if x != nil {
+		return x.PostBalance
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetTransactions() uint32 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+// BlockBalanceChanges holds the balance changes of watched accounts in a block
+type BlockBalanceChanges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot      uint64           `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Blockhash string           `protobuf:"bytes,2,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
+	Timestamp uint64           `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Status    SlotStatus       `protobuf:"varint,4,opt,name=status,proto3,enum=solana.benchmark.SlotStatus" json:"status,omitempty"`
+	Changes   []*BalanceChange `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,6,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *BlockBalanceChanges) Reset() {
+	*x = BlockBalanceChanges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockBalanceChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockBalanceChanges) ProtoMessage() {}
+
+func (x *BlockBalanceChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockBalanceChanges.ProtoReflect.Descriptor instead.
+func (*BlockBalanceChanges) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *BlockBalanceChanges) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *BlockBalanceChanges) GetBlockhash() string {
+	if x != nil {
+		return x.Blockhash
+	}
+	return ""
+}
+
+func (x *BlockBalanceChanges) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BlockBalanceChanges) GetStatus() SlotStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SlotStatus_SLOT_STATUS_PROCESSED
+}
+
+func (x *BlockBalanceChanges) GetChanges() []*BalanceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *BlockBalanceChanges) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// AlertCondition is a condition registered on an alert stream
+type AlertCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name identifying the condition in the alerts it raises
+	Name              string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type              AlertConditionType `protobuf:"varint,2,opt,name=type,proto3,enum=solana.benchmark.AlertConditionType" json:"type,omitempty"`
+	MinLamports       uint64             `protobuf:"varint,3,opt,name=min_lamports,json=minLamports,proto3" json:"min_lamports,omitempty"`
+	Account           string             `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	ThresholdLamports uint64             `protobuf:"varint,5,opt,name=threshold_lamports,json=thresholdLamports,proto3" json:"threshold_lamports,omitempty"`
+	ProgramId         string             `protobuf:"bytes,6,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+}
+
+func (x *AlertCondition) Reset() {
+	*x = AlertCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlertCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertCondition) ProtoMessage() {}
+
+func (x *AlertCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertCondition.ProtoReflect.Descriptor instead.
+func (*AlertCondition) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *AlertCondition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertCondition) GetType() AlertConditionType {
+	if x != nil {
+		return x.Type
+	}
+	return AlertConditionType_ALERT_CONDITION_LAMPORT_TRANSFER
+}
+
+func (x *AlertCondition) GetMinLamports() uint64 {
+	if x != nil {
+		return x.MinLamports
+	}
+	return 0
+}
+
+func (x *AlertCondition) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AlertCondition) GetThresholdLamports() uint64 {
+	if x != nil {
+		return x.ThresholdLamports
+	}
+	return 0
+}
+
+func (x *AlertCondition) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+// AlertStreamRequest represents a request to stream alerts
+type AlertStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Conditions []*AlertCondition `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Commitment string            `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// Failed transactions only move fees, so they are skipped unless set
+	IncludeFailed bool `protobuf:"varint,3,opt,name=include_failed,json=includeFailed,proto3" json:"include_failed,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string    `protobuf:"bytes,4,opt,name=compression,proto3" json:"compression,omitempty"`
+	Qos         StreamQoS `protobuf:"varint,5,opt,name=qos,proto3,enum=solana.benchmark.StreamQoS" json:"qos,omitempty"`
+}
+
+func (x *AlertStreamRequest) Reset() {
+	*x = AlertStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlertStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertStreamRequest) ProtoMessage() {}
+
+func (x *AlertStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertStreamRequest.ProtoReflect.Descriptor instead.
+func (*AlertStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *AlertStreamRequest) GetConditions() []*AlertCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *AlertStreamRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *AlertStreamRequest) GetIncludeFailed() bool {
+	if x != nil {
+		return x.IncludeFailed
+	}
+	return false
+}
+
+func (x *AlertStreamRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *AlertStreamRequest) GetQos() StreamQoS {
+	if x != nil {
+		return x.Qos
+	}
+	return StreamQoS_STREAM_QOS_REALTIME
+}
+
+// Alert reports a transaction matching an alert condition. Account and
+// balance fields are set for lamport conditions, program_id for program ones.
+type Alert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Condition     string             `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Type          AlertConditionType `protobuf:"varint,2,opt,name=type,proto3,enum=solana.benchmark.AlertConditionType" json:"type,omitempty"`
+	Slot          uint64             `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Signature     string             `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Timestamp     uint64             `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Account       string             `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
+	LamportsDelta int64              `protobuf:"varint,7,opt,name=lamports_delta,json=lamportsDelta,proto3" json:"lamports_delta,omitempty"`
+	PreBalance    uint64             `protobuf:"varint,8,opt,name=pre_balance,json=preBalance,proto3" json:"pre_balance,omitempty"`
+	PostBalance   uint64             `protobuf:"varint,9,opt,name=post_balance,json=postBalance,proto3" json:"post_balance,omitempty"`
+	ProgramId     string             `protobuf:"bytes,10,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Success       bool               `protobuf:"varint,11,opt,name=success,proto3" json:"success,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,12,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *Alert) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Alert) GetType() AlertConditionType {
+	if x != nil {
+		return x.Type
+	}
+	return AlertConditionType_ALERT_CONDITION_LAMPORT_TRANSFER
+}
+
+func (x *Alert) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *Alert) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *Alert) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Alert) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Alert) GetLamportsDelta() int64 {
+	if x != nil {
+		return x.LamportsDelta
+	}
+	return 0
+}
+
+func (x *Alert) GetPreBalance() uint64 {
+	if x != nil {
+		return x.PreBalance
+	}
+	return 0
+}
+
+func (x *Alert) GetPostBalance() uint64 {
+	if x != nil {
+		return x.PostBalance
+	}
+	return 0
+}
+
+func (x *Alert) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *Alert) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Alert) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// FeeStatsRequest represents a request to stream fee statistics
+type FeeStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of slots aggregated per update; 0 means every block
+	WindowSlots uint32 `protobuf:"varint,1,opt,name=window_slots,json=windowSlots,proto3" json:"window_slots,omitempty"`
+	Commitment  string `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// Compression for this stream's responses: gzip, none, or empty for the
+	// channel default
+	Compression string    `protobuf:"bytes,3,opt,name=compression,proto3" json:"compression,omitempty"`
+	Qos         StreamQoS `protobuf:"varint,4,opt,name=qos,proto3,enum=solana.benchmark.StreamQoS" json:"qos,omitempty"`
+}
+
+func (x *FeeStatsRequest) Reset() {
+	*x = FeeStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeStatsRequest) ProtoMessage() {}
+
+func (x *FeeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeStatsRequest.ProtoReflect.Descriptor instead.
+func (*FeeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *FeeStatsRequest) GetWindowSlots() uint32 {
+	if x != nil {
+		return x.WindowSlots
+	}
+	return 0
+}
+
+func (x *FeeStatsRequest) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *FeeStatsRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *FeeStatsRequest) GetQos() StreamQoS {
+	if x != nil {
+		return x.Qos
+	}
+	return StreamQoS_STREAM_QOS_REALTIME
+}
+
+// FeePercentiles summarizes a fee distribution
+type FeePercentiles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min uint64 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	P25 uint64 `protobuf:"varint,2,opt,name=p25,proto3" json:"p25,omitempty"`
+	P50 uint64 `protobuf:"varint,3,opt,name=p50,proto3" json:"p50,omitempty"`
+	P75 uint64 `protobuf:"varint,4,opt,name=p75,proto3" json:"p75,omitempty"`
+	P90 uint64 `protobuf:"varint,5,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99 uint64 `protobuf:"varint,6,opt,name=p99,proto3" json:"p99,omitempty"`
+	Max uint64 `protobuf:"varint,7,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *FeePercentiles) Reset() {
+	*x = FeePercentiles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeePercentiles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeePercentiles) ProtoMessage() {}
+
+func (x *FeePercentiles) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeePercentiles.ProtoReflect.Descriptor instead.
+func (*FeePercentiles) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *FeePercentiles) GetMin() uint64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *FeePercentiles) GetP25() uint64 {
+	if x != nil {
+		return x.P25
+	}
+	return 0
+}
+
+func (x *FeePercentiles) GetP50() uint64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *FeePercentiles) GetP75() uint64 {
+	if x != nil {
+		return x.P75
+	}
+	return 0
+}
+
+func (x *FeePercentiles) GetP90() uint64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+func (x *FeePercentiles) GetP99() uint64 {
+	if x != nil {
+		return x.P99
+	}
+	return 0
+}
+
+func (x *FeePercentiles) GetMax() uint64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// FeeStats summarizes the fees of the non-vote transactions included in the
+// slots from start_slot through end_slot
+type FeeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartSlot    uint64 `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot      uint64 `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	Blocks       uint32 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Transactions uint32 `protobuf:"varint,4,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	// Transactions that paid a priority fee
+	PrioritizedTransactions uint32 `protobuf:"varint,5,opt,name=prioritized_transactions,json=prioritizedTransactions,proto3" json:"prioritized_transactions,omitempty"`
+	// Fee paid beyond the base fee per signature, in lamports
+	PriorityFeeLamports *FeePercentiles `protobuf:"bytes,6,opt,name=priority_fee_lamports,json=priorityFeeLamports,proto3" json:"priority_fee_lamports,omitempty"`
+	// Compute unit price set by the transaction, in micro-lamports
+	ComputeUnitPrice *FeePercentiles `protobuf:"bytes,7,opt,name=compute_unit_price,json=computeUnitPrice,proto3" json:"compute_unit_price,omitempty"`
+	Timestamp        uint64          `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,9,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *FeeStats) Reset() {
+	*x = FeeStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeStats) ProtoMessage() {}
+
+func (x *FeeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeStats.ProtoReflect.Descriptor instead.
+func (*FeeStats) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *FeeStats) GetStartSlot() uint64 {
+	if x != nil {
+		return x.StartSlot
+	}
+	return 0
+}
+
+func (x *FeeStats) GetEndSlot() uint64 {
+	if x != nil {
+		return x.EndSlot
+	}
+	return 0
+}
+
+func (x *FeeStats) GetBlocks() uint32 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *FeeStats) GetTransactions() uint32 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *FeeStats) GetPrioritizedTransactions() uint32 {
+	if x != nil {
+		return x.PrioritizedTransactions
+	}
+	return 0
+}
+
+func (x *FeeStats) GetPriorityFeeLamports() *FeePercentiles {
+	if x != nil {
+		return x.PriorityFeeLamports
+	}
+	return nil
+}
+
+func (x *FeeStats) GetComputeUnitPrice() *FeePercentiles {
+	if x != nil {
+		return x.ComputeUnitPrice
+	}
+	return nil
+}
+
+func (x *FeeStats) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *FeeStats) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// BlockUpdate represents a real-time block update. When status_changed is set,
+// the update reports that a previously streamed slot reached a higher status.
+// When reorg is set, the update only reports a fork switch. When skipped is set,
+// no block was produced for the slot.
+type BlockUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot              uint64      `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Blockhash         string      `protobuf:"bytes,2,opt,name=blockhash,proto3" json:"blockhash,omitempty"`
+	PreviousBlockhash string      `protobuf:"bytes,3,opt,name=previous_blockhash,json=previousBlockhash,proto3" json:"previous_blockhash,omitempty"`
+	ParentSlot        uint64      `protobuf:"varint,4,opt,name=parent_slot,json=parentSlot,proto3" json:"parent_slot,omitempty"`
+	Timestamp         uint64      `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Status            SlotStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=solana.benchmark.SlotStatus" json:"status,omitempty"`
+	StatusChanged     bool        `protobuf:"varint,7,opt,name=status_changed,json=statusChanged,proto3" json:"status_changed,omitempty"`
+	Reorg             *ReorgEvent `protobuf:"bytes,8,opt,name=reorg,proto3" json:"reorg,omitempty"`
+	Skipped           bool        `protobuf:"varint,9,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// Set on updates carrying a fetched block
+	Timing *TimingBreakdown `protobuf:"bytes,10,opt,name=timing,proto3" json:"timing,omitempty"`
+	// SHA-256 hash of the canonical encoding of the fields sent, without
+	// timestamp and timing, which differ between replicas
+	ContentHash []byte `protobuf:"bytes,11,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// Latest slot the server's slot clock had seen; 0 if it was not live
+	ServerSlot uint64 `protobuf:"varint,12,opt,name=server_slot,json=serverSlot,proto3" json:"server_slot,omitempty"`
+}
+
+func (x *BlockUpdate) Reset() {
+	*x = BlockUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUpdate) ProtoMessage() {}
+
+func (x *BlockUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUpdate.ProtoReflect.Descriptor instead.
+func (*BlockUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *BlockUpdate) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *BlockUpdate) GetBlockhash() string {
+	if x != nil {
+		return x.Blockhash
+	}
+	return ""
+}
+
+func (x *BlockUpdate) GetPreviousBlockhash() string {
+	if x != nil {
+		return x.PreviousBlockhash
+	}
+	return ""
+}
+
+func (x *BlockUpdate) GetParentSlot() uint64 {
+	if x != nil {
+		return x.ParentSlot
+	}
+	return 0
+}
+
+func (x *BlockUpdate) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BlockUpdate) GetStatus() SlotStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SlotStatus_SLOT_STATUS_PROCESSED
+}
+
+func (x *BlockUpdate) GetStatusChanged() bool {
+	if x != nil {
+		return x.StatusChanged
+	}
+	return false
+}
+
+func (x *BlockUpdate) GetReorg() *ReorgEvent {
+	if x != nil {
+		return x.Reorg
+	}
+	return nil
+}
+
+func (x *BlockUpdate) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *BlockUpdate) GetTiming() *TimingBreakdown {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *BlockUpdate) GetContentHash() []byte {
+	if x != nil {
+		return x.ContentHash
+	}
+	return nil
+}
+
+func (x *BlockUpdate) GetServerSlot() uint64 {
+	if x != nil {
+		return x.ServerSlot
+	}
+	return 0
+}
+
+// ReorgEvent reports that the observed chain switched forks. The abandoned
+// slots were streamed but are no longer canonical; the canonical chain
+// continues from common_ancestor_slot through new_chain_slots, whose blocks
+// follow as regular block updates.
+type ReorgEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AbandonedSlots     []uint64 `protobuf:"varint,1,rep,packed,name=abandoned_slots,json=abandonedSlots,proto3" json:"abandoned_slots,omitempty"`
+	CommonAncestorSlot uint64   `protobuf:"varint,2,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3" json:"common_ancestor_slot,omitempty"`
+	NewChainSlots      []uint64 `protobuf:"varint,3,rep,packed,name=new_chain_slots,json=newChainSlots,proto3" json:"new_chain_slots,omitempty"`
+}
+
+func (x *ReorgEvent) Reset() {
+	*x = ReorgEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorgEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorgEvent) ProtoMessage() {}
+
+func (x *ReorgEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorgEvent.ProtoReflect.Descriptor instead.
+func (*ReorgEvent) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ReorgEvent) GetAbandonedSlots() []uint64 {
+	if x != nil {
+		return x.AbandonedSlots
+	}
+	return nil
+}
+
+func (x *ReorgEvent) GetCommonAncestorSlot() uint64 {
+	if x != nil {
+		return x.CommonAncestorSlot
+	}
+	return 0
+}
+
+func (x *ReorgEvent) GetNewChainSlots() []uint64 {
+	if x != nil {
+		return x.NewChainSlots
+	}
+	return nil
+}
+
+// BenchmarkRequest represents a request to run a benchmark suite
+type BenchmarkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Iterations      uint32          `protobuf:"varint,1,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	TestAccounts    []string        `protobuf:"bytes,2,rep,name=test_accounts,json=testAccounts,proto3" json:"test_accounts,omitempty"`
+	TestSignatures  []string        `protobuf:"bytes,3,rep,name=test_signatures,json=testSignatures,proto3" json:"test_signatures,omitempty"`
+	TestSlots       []uint64        `protobuf:"varint,4,rep,packed,name=test_slots,json=testSlots,proto3" json:"test_slots,omitempty"`
+	RunGrpcTests    bool            `protobuf:"varint,5,opt,name=run_grpc_tests,json=runGrpcTests,proto3" json:"run_grpc_tests,omitempty"`
+	RunJsonrpcTests bool            `protobuf:"varint,6,opt,name=run_jsonrpc_tests,json=runJsonrpcTests,proto3" json:"run_jsonrpc_tests,omitempty"`
+	SolanaRpcUrl    string          `protobuf:"bytes,7,opt,name=solana_rpc_url,json=solanaRpcUrl,proto3" json:"solana_rpc_url,omitempty"`
+	OutlierHandling OutlierHandling `protobuf:"varint,8,opt,name=outlier_handling,json=outlierHandling,proto3,enum=solana.benchmark.OutlierHandling" json:"outlier_handling,omitempty"`
+	// Fraction of samples adjusted in each tail, e.g. 0.01 for the top and
+	// bottom 1%. Defaults to 0.01 when outlier handling is enabled.
+	OutlierFraction float64 `protobuf:"fixed64,9,opt,name=outlier_fraction,json=outlierFraction,proto3" json:"outlier_fraction,omitempty"`
+	// Load-test mode: when positive, each transport and category issues
+	// requests on a fixed cadence of this many per second, and latency is
+	// measured from each request's intended start rather than its actual one.
+	TargetRate float64 `protobuf:"fixed64,10,opt,name=target_rate,json=targetRate,proto3" json:"target_rate,omitempty"`
+	// Collect server runtime stats for each benchmark. Since they are
+	// process-wide, benchmarks then run one at a time instead of concurrently.
+	CollectRuntimeStats bool `protobuf:"varint,11,opt,name=collect_runtime_stats,json=collectRuntimeStats,proto3" json:"collect_runtime_stats,omitempty"`
+	// Run the benchmark even if results for an identical request are cached
+	BypassCache bool `protobuf:"varint,12,opt,name=bypass_cache,json=bypassCache,proto3" json:"bypass_cache,omitempty"`
+	// When set, each iteration visits the targets in an order shuffled from
+	// this seed instead of the request order, so runs with the same seed issue
+	// the same requests in the same order
+	Seed uint64 `protobuf:"varint,13,opt,name=seed,proto3" json:"seed,omitempty"`
+	// The client's OS and region, recorded in the results' environment
+	ClientOs     string `protobuf:"bytes,14,opt,name=client_os,json=clientOs,proto3" json:"client_os,omitempty"`
+	ClientRegion string `protobuf:"bytes,15,opt,name=client_region,json=clientRegion,proto3" json:"client_region,omitempty"`
+	// block_details runs the block benchmarks once per getBlock detail tier,
+	// with the results of each in block_tiers. block_grpc and block_jsonrpc
+	// then hold the first tier listed. Empty benchmarks full blocks only.
+	BlockDetails []BlockDetail `protobuf:"varint,16,rep,packed,name=block_details,json=blockDetails,proto3,enum=solana.benchmark.BlockDetail" json:"block_details,omitempty"`
+	// run_cluster_nodes also times GetClusterNodes against getClusterNodes once
+	// per iteration, a medium-size payload between accounts and full blocks
+	RunClusterNodes bool `protobuf:"varint,17,opt,name=run_cluster_nodes,json=runClusterNodes,proto3" json:"run_cluster_nodes,omitempty"`
+}
+
+func (x *BenchmarkRequest) Reset() {
+	*x = BenchmarkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkRequest) ProtoMessage() {}
+
+func (x *BenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*BenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *BenchmarkRequest) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetTestAccounts() []string {
+	if x != nil {
+		return x.TestAccounts
+	}
+	return nil
+}
+
+func (x *BenchmarkRequest) GetTestSignatures() []string {
+	if x != nil {
+		return x.TestSignatures
+	}
+	return nil
+}
+
+func (x *BenchmarkRequest) GetTestSlots() []uint64 {
+	if x != nil {
+		return x.TestSlots
+	}
+	return nil
+}
+
+func (x *BenchmarkRequest) GetRunGrpcTests() bool {
+	if x != nil {
+		return x.RunGrpcTests
+	}
+	return false
+}
+
+func (x *BenchmarkRequest) GetRunJsonrpcTests() bool {
+	if x != nil {
+		return x.RunJsonrpcTests
+	}
+	return false
+}
+
+func (x *BenchmarkRequest) GetSolanaRpcUrl() string {
+	if x != nil {
+		return x.SolanaRpcUrl
+	}
+	return ""
+}
+
+func (x *BenchmarkRequest) GetOutlierHandling() OutlierHandling {
+	if x != nil {
+		return x.OutlierHandling
+	}
+	return OutlierHandling_OUTLIER_HANDLING_NONE
+}
+
+func (x *BenchmarkRequest) GetOutlierFraction() float64 {
+	if x != nil {
+		return x.OutlierFraction
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetTargetRate() float64 {
+	if x != nil {
+		return x.TargetRate
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetCollectRuntimeStats() bool {
+	if x != nil {
+		return x.CollectRuntimeStats
+	}
+	return false
+}
+
+func (x *BenchmarkRequest) GetBypassCache() bool {
+	if x != nil {
+		return x.BypassCache
+	}
+	return false
+}
+
+func (x *BenchmarkRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *BenchmarkRequest) GetClientOs() string {
+	if x != nil {
+		return x.ClientOs
+	}
+	return ""
+}
+
+func (x *BenchmarkRequest) GetClientRegion() string {
+	if x != nil {
+		return x.ClientRegion
+	}
+	return ""
+}
+
+func (x *BenchmarkRequest) GetBlockDetails() []BlockDetail {
+	if x != nil {
+		return x.BlockDetails
+	}
+	return nil
+}
+
+func (x *BenchmarkRequest) GetRunClusterNodes() bool {
+	if x != nil {
+		return x.RunClusterNodes
+	}
+	return false
+}
+
+// LatencyStats summarizes response time samples, as measured or after
+// outlier handling
+type LatencyStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvgResponseTimeMs float64 `protobuf:"fixed64,1,opt,name=avg_response_time_ms,json=avgResponseTimeMs,proto3" json:"avg_response_time_ms,omitempty"`
+	MinResponseTimeMs float64 `protobuf:"fixed64,2,opt,name=min_response_time_ms,json=minResponseTimeMs,proto3" json:"min_response_time_ms,omitempty"`
+	MaxResponseTimeMs float64 `protobuf:"fixed64,3,opt,name=max_response_time_ms,json=maxResponseTimeMs,proto3" json:"max_response_time_ms,omitempty"`
+	Samples           uint32  `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	// Nearest-rank percentiles and the sample standard deviation
+	P50ResponseTimeMs    float64 `protobuf:"fixed64,5,opt,name=p50_response_time_ms,json=p50ResponseTimeMs,proto3" json:"p50_response_time_ms,omitempty"`
+	P90ResponseTimeMs    float64 `protobuf:"fixed64,6,opt,name=p90_response_time_ms,json=p90ResponseTimeMs,proto3" json:"p90_response_time_ms,omitempty"`
+	P95ResponseTimeMs    float64 `protobuf:"fixed64,7,opt,name=p95_response_time_ms,json=p95ResponseTimeMs,proto3" json:"p95_response_time_ms,omitempty"`
+	P99ResponseTimeMs    float64 `protobuf:"fixed64,8,opt,name=p99_response_time_ms,json=p99ResponseTimeMs,proto3" json:"p99_response_time_ms,omitempty"`
+	StddevResponseTimeMs float64 `protobuf:"fixed64,9,opt,name=stddev_response_time_ms,json=stddevResponseTimeMs,proto3" json:"stddev_response_time_ms,omitempty"`
+}
+
+func (x *LatencyStats) Reset() {
+	*x = LatencyStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatencyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyStats) ProtoMessage() {}
+
+func (x *LatencyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyStats.ProtoReflect.Descriptor instead.
+func (*LatencyStats) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *LatencyStats) GetAvgResponseTimeMs() float64 {
+	if x != nil {
+		return x.AvgResponseTimeMs
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetMinResponseTimeMs() float64 {
+	if x != nil {
+		return x.MinResponseTimeMs
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetMaxResponseTimeMs() float64 {
+	if x != nil {
+		return x.MaxResponseTimeMs
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetP50ResponseTimeMs() float64 {
+	if x != nil {
+		return x.P50ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetP90ResponseTimeMs() float64 {
+	if x != nil {
+		return x.P90ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetP95ResponseTimeMs() float64 {
+	if x != nil {
+		return x.P95ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetP99ResponseTimeMs() float64 {
+	if x != nil {
+		return x.P99ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetStddevResponseTimeMs() float64 {
+	if x != nil {
+		return x.StddevResponseTimeMs
+	}
+	return 0
+}
+
+// BenchmarkResults represents the results of a benchmark run
+type BenchmarkResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account benchmark results
+	AccountGrpc    *AccountBenchmark `protobuf:"bytes,1,opt,name=account_grpc,json=accountGrpc,proto3" json:"account_grpc,omitempty"`
+	AccountJsonrpc *AccountBenchmark `protobuf:"bytes,2,opt,name=account_jsonrpc,json=accountJsonrpc,proto3" json:"account_jsonrpc,omitempty"`
+	// Transaction benchmark results
+	TransactionGrpc    *TransactionBenchmark `protobuf:"bytes,3,opt,name=transaction_grpc,json=transactionGrpc,proto3" json:"transaction_grpc,omitempty"`
+	TransactionJsonrpc *TransactionBenchmark `protobuf:"bytes,4,opt,name=transaction_jsonrpc,json=transactionJsonrpc,proto3" json:"transaction_jsonrpc,omitempty"`
+	// Block benchmark results
+	BlockGrpc    *BlockBenchmark `protobuf:"bytes,5,opt,name=block_grpc,json=blockGrpc,proto3" json:"block_grpc,omitempty"`
+	BlockJsonrpc *BlockBenchmark `protobuf:"bytes,6,opt,name=block_jsonrpc,json=blockJsonrpc,proto3" json:"block_jsonrpc,omitempty"`
+	// Overall benchmark summary
+	Summary *BenchmarkSummary `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Set when the results were served from the server's result cache for an
+	// identical earlier request, with the Unix time they were measured at
+	Cached   bool   `protobuf:"varint,8,opt,name=cached,proto3" json:"cached,omitempty"`
+	CachedAt uint64 `protobuf:"varint,9,opt,name=cached_at,json=cachedAt,proto3" json:"cached_at,omitempty"`
+	// Set when any benchmark was truncated because the run was cancelled or
+	// exceeded its maximum duration
+	Truncated bool `protobuf:"varint,10,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// The seed the workload was ordered with, 0 for the request order
+	Seed uint64 `protobuf:"varint,11,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Where and against what the benchmark ran
+	Environment *BenchmarkEnvironment `protobuf:"bytes,12,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Block benchmark results per detail tier, when requested
+	BlockTiers []*BlockTierBenchmark `protobuf:"bytes,13,rep,name=block_tiers,json=blockTiers,proto3" json:"block_tiers,omitempty"`
+	// Cluster nodes benchmark results, when requested
+	ClusterNodesGrpc    *ClusterNodesBenchmark `protobuf:"bytes,14,opt,name=cluster_nodes_grpc,json=clusterNodesGrpc,proto3" json:"cluster_nodes_grpc,omitempty"`
+	ClusterNodesJsonrpc *ClusterNodesBenchmark `protobuf:"bytes,15,opt,name=cluster_nodes_jsonrpc,json=clusterNodesJsonrpc,proto3" json:"cluster_nodes_jsonrpc,omitempty"`
+}
+
+func (x *BenchmarkResults) Reset() {
+	*x = BenchmarkResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkResults) ProtoMessage() {}
+
+func (x *BenchmarkResults) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkResults.ProtoReflect.Descriptor instead.
+func (*BenchmarkResults) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *BenchmarkResults) GetAccountGrpc() *AccountBenchmark {
+	if x != nil {
+		return x.AccountGrpc
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetAccountJsonrpc() *AccountBenchmark {
+	if x != nil {
+		return x.AccountJsonrpc
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetTransactionGrpc() *TransactionBenchmark {
+	if x != nil {
+		return x.TransactionGrpc
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetTransactionJsonrpc() *TransactionBenchmark {
+	if x != nil {
+		return x.TransactionJsonrpc
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetBlockGrpc() *BlockBenchmark {
+	if x != nil {
+		return x.BlockGrpc
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetBlockJsonrpc() *BlockBenchmark {
+	if x != nil {
+		return x.BlockJsonrpc
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetSummary() *BenchmarkSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *BenchmarkResults) GetCachedAt() uint64 {
+	if x != nil {
+		return x.CachedAt
+	}
+	return 0
+}
+
+func (x *BenchmarkResults) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *BenchmarkResults) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *BenchmarkResults) GetEnvironment() *BenchmarkEnvironment {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetBlockTiers() []*BlockTierBenchmark {
+	if x != nil {
+		return x.BlockTiers
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetClusterNodesGrpc() *ClusterNodesBenchmark {
+	if x != nil {
+		return x.ClusterNodesGrpc
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetClusterNodesJsonrpc() *ClusterNodesBenchmark {
+	if x != nil {
+		return x.ClusterNodesJsonrpc
+	}
+	return nil
+}
+
+// BlockTierBenchmark holds the block benchmark results of one getBlock detail
+// tier on both transports
+type BlockTierBenchmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Detail  BlockDetail     `protobuf:"varint,1,opt,name=detail,proto3,enum=solana.benchmark.BlockDetail" json:"detail,omitempty"`
+	Grpc    *BlockBenchmark `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Jsonrpc *BlockBenchmark `protobuf:"bytes,3,opt,name=jsonrpc,proto3" json:"jsonrpc,omitempty"`
+	// How much faster gRPC was than JSON-RPC at this tier
+	Speedup float64 `protobuf:"fixed64,4,opt,name=speedup,proto3" json:"speedup,omitempty"`
+}
+
+func (x *BlockTierBenchmark) Reset() {
+	*x = BlockTierBenchmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockTierBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTierBenchmark) ProtoMessage() {}
+
+func (x *BlockTierBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTierBenchmark.ProtoReflect.Descriptor instead.
+func (*BlockTierBenchmark) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *BlockTierBenchmark) GetDetail() BlockDetail {
+	if x != nil {
+		return x.Detail
+	}
+	return BlockDetail_BLOCK_DETAIL_FULL
+}
+
+func (x *BlockTierBenchmark) GetGrpc() *BlockBenchmark {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *BlockTierBenchmark) GetJsonrpc() *BlockBenchmark {
+	if x != nil {
+		return x.Jsonrpc
+	}
+	return nil
+}
+
+func (x *BlockTierBenchmark) GetSpeedup() float64 {
+	if x != nil {
+		return x.Speedup
+	}
+	return 0
+}
+
+// BenchmarkEnvironment records what a benchmark ran on, so stored results are
+// only compared with results from a like environment
+type BenchmarkEnvironment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Module version and VCS revision the server was built from, if known
+	ServerVersion string `protobuf:"bytes,1,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	ServerCommit  string `protobuf:"bytes,2,opt,name=server_commit,json=serverCommit,proto3" json:"server_commit,omitempty"`
+	GoVersion     string `protobuf:"bytes,3,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	// The server's OS/architecture and configured region
+	ServerOs     string `protobuf:"bytes,4,opt,name=server_os,json=serverOs,proto3" json:"server_os,omitempty"`
+	ServerRegion string `protobuf:"bytes,5,opt,name=server_region,json=serverRegion,proto3" json:"server_region,omitempty"`
+	// The upstream RPC endpoint's scheme and host, leaving out any credentials
+	UpstreamEndpoint string `protobuf:"bytes,6,opt,name=upstream_endpoint,json=upstreamEndpoint,proto3" json:"upstream_endpoint,omitempty"`
+	// As sent by the client
+	ClientOs     string `protobuf:"bytes,7,opt,name=client_os,json=clientOs,proto3" json:"client_os,omitempty"`
+	ClientRegion string `protobuf:"bytes,8,opt,name=client_region,json=clientRegion,proto3" json:"client_region,omitempty"`
+	// Fastest round trip of the probes sent to the upstream before the run,
+	// 0 if none succeeded
+	UpstreamRttMs     float64 `protobuf:"fixed64,9,opt,name=upstream_rtt_ms,json=upstreamRttMs,proto3" json:"upstream_rtt_ms,omitempty"`
+	UpstreamRttProbes uint32  `protobuf:"varint,10,opt,name=upstream_rtt_probes,json=upstreamRttProbes,proto3" json:"upstream_rtt_probes,omitempty"`
+}
+
+func (x *BenchmarkEnvironment) Reset() {
+	*x = BenchmarkEnvironment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkEnvironment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkEnvironment) ProtoMessage() {}
+
+func (x *BenchmarkEnvironment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkEnvironment.ProtoReflect.Descriptor instead.
+func (*BenchmarkEnvironment) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *BenchmarkEnvironment) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *BenchmarkEnvironment) GetServerCommit() string {
+	if x != nil {
+		return x.ServerCommit
+	}
+	return ""
+}
+
+func (x *BenchmarkEnvironment) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *BenchmarkEnvironment) GetServerOs() string {
+	if x != nil {
+		return x.ServerOs
+	}
+	return ""
+}
+
+func (x *BenchmarkEnvironment) GetServerRegion() string {
+	if x != nil {
+		return x.ServerRegion
+	}
+	return ""
+}
+
+func (x *BenchmarkEnvironment) GetUpstreamEndpoint() string {
+	if x != nil {
+		return x.UpstreamEndpoint
+	}
+	return ""
+}
+
+func (x *BenchmarkEnvironment) GetClientOs() string {
+	if x != nil {
+		return x.ClientOs
+	}
+	return ""
+}
+
+func (x *BenchmarkEnvironment) GetClientRegion() string {
+	if x != nil {
+		return x.ClientRegion
+	}
+	return ""
+}
+
+func (x *BenchmarkEnvironment) GetUpstreamRttMs() float64 {
+	if x != nil {
+		return x.UpstreamRttMs
+	}
+	return 0
+}
+
+func (x *BenchmarkEnvironment) GetUpstreamRttProbes() uint32 {
+	if x != nil {
+		return x.UpstreamRttProbes
+	}
+	return 0
+}
+
+// RuntimeStats reports the server's resource usage over a benchmark run, so
+// transports can be compared on processing cost as well as latency
+type RuntimeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllocatedBytes uint64  `protobuf:"varint,1,opt,name=allocated_bytes,json=allocatedBytes,proto3" json:"allocated_bytes,omitempty"`
+	Allocations    uint64  `protobuf:"varint,2,opt,name=allocations,proto3" json:"allocations,omitempty"`
+	GcCycles       uint32  `protobuf:"varint,3,opt,name=gc_cycles,json=gcCycles,proto3" json:"gc_cycles,omitempty"`
+	GcPauseMs      float64 `protobuf:"fixed64,4,opt,name=gc_pause_ms,json=gcPauseMs,proto3" json:"gc_pause_ms,omitempty"`
+	// User and system CPU time of the server process
+	CpuTimeMs float64 `protobuf:"fixed64,5,opt,name=cpu_time_ms,json=cpuTimeMs,proto3" json:"cpu_time_ms,omitempty"`
+}
+
+func (x *RuntimeStats) Reset() {
+	*x = RuntimeStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeStats) ProtoMessage() {}
+
+func (x *RuntimeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeStats.ProtoReflect.Descriptor instead.
+func (*RuntimeStats) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *RuntimeStats) GetAllocatedBytes() uint64 {
+	if x != nil {
+		return x.AllocatedBytes
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetAllocations() uint64 {
+	if x != nil {
+		return x.Allocations
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetGcCycles() uint32 {
+	if x != nil {
+		return x.GcCycles
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetGcPauseMs() float64 {
+	if x != nil {
+		return x.GcPauseMs
+	}
+	return 0
+}
+
+func (x *RuntimeStats) GetCpuTimeMs() float64 {
+	if x != nil {
+		return x.CpuTimeMs
+	}
+	return 0
+}
+
+// AccountBenchmark represents benchmark results for account operations
+type AccountBenchmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvgResponseTimeMs  uint64 `protobuf:"varint,1,opt,name=avg_response_time_ms,json=avgResponseTimeMs,proto3" json:"avg_response_time_ms,omitempty"`
+	MinResponseTimeMs  uint64 `protobuf:"varint,2,opt,name=min_response_time_ms,json=minResponseTimeMs,proto3" json:"min_response_time_ms,omitempty"`
+	MaxResponseTimeMs  uint64 `protobuf:"varint,3,opt,name=max_response_time_ms,json=maxResponseTimeMs,proto3" json:"max_response_time_ms,omitempty"`
+	SuccessfulRequests uint32 `protobuf:"varint,4,opt,name=successful_requests,json=successfulRequests,proto3" json:"successful_requests,omitempty"`
+	FailedRequests     uint32 `protobuf:"varint,5,opt,name=failed_requests,json=failedRequests,proto3" json:"failed_requests,omitempty"`
+	// Statistics after outlier handling, set when requested
+	Adjusted *LatencyStats `protobuf:"bytes,6,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
+	// Server resource usage while the benchmark ran, set when requested
+	Runtime *RuntimeStats `protobuf:"bytes,7,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// Set when the run was cancelled or timed out before making every request;
+	// the statistics then cover the requests made
+	Truncated bool `protobuf:"varint,8,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Average size of a successful response: the protobuf message for gRPC and
+	// the JSON-encoded result for JSON-RPC
+	AvgPayloadBytes uint64 `protobuf:"varint,9,opt,name=avg_payload_bytes,json=avgPayloadBytes,proto3" json:"avg_payload_bytes,omitempty"`
+	// Statistics of every successful response time, with the tail percentiles
+	// the averages above hide
+	Distribution *LatencyStats `protobuf:"bytes,10,opt,name=distribution,proto3" json:"distribution,omitempty"`
+}
+
+func (x *AccountBenchmark) Reset() {
+	*x = AccountBenchmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBenchmark) ProtoMessage() {}
+
+func (x *AccountBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBenchmark.ProtoReflect.Descriptor instead.
+func (*AccountBenchmark) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *AccountBenchmark) GetAvgResponseTimeMs() uint64 {
+	if x != nil {
+		return x.AvgResponseTimeMs
+	}
+	return 0
+}
+
+func (x *AccountBenchmark) GetMinResponseTimeMs() uint64 {
+	if x != nil {
+		return x.MinResponseTimeMs
+	}
+	return 0
+}
+
+func (x *AccountBenchmark) GetMaxResponseTimeMs() uint64 {
+	if x != nil {
+		return x.MaxResponseTimeMs
+	}
+	return 0
+}
+
+func (x *AccountBenchmark) GetSuccessfulRequests() uint32 {
+	if x != nil {
+		return x.SuccessfulRequests
+	}
+	return 0
+}
+
+func (x *AccountBenchmark) GetFailedRequests() uint32 {
+	if x != nil {
+		return x.FailedRequests
+	}
+	return 0
+}
+
+func (x *AccountBenchmark) GetAdjusted() *LatencyStats {
+	if x != nil {
+		return x.Adjusted
+	}
+	return nil
+}
+
+func (x *AccountBenchmark) GetRuntime() *RuntimeStats {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+func (x *AccountBenchmark) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *AccountBenchmark) GetAvgPayloadBytes() uint64 {
+	if x != nil {
+		return x.AvgPayloadBytes
+	}
+	return 0
+}
+
+func (x *AccountBenchmark) GetDistribution() *LatencyStats {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+// TransactionBenchmark represents benchmark results for transaction operations
+type TransactionBenchmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvgResponseTimeMs  uint64 `protobuf:"varint,1,opt,name=avg_response_time_ms,json=avgResponseTimeMs,proto3" json:"avg_response_time_ms,omitempty"`
+	MinResponseTimeMs  uint64 `protobuf:"varint,2,opt,name=min_response_time_ms,json=minResponseTimeMs,proto3" json:"min_response_time_ms,omitempty"`
+	MaxResponseTimeMs  uint64 `protobuf:"varint,3,opt,name=max_response_time_ms,json=maxResponseTimeMs,proto3" json:"max_response_time_ms,omitempty"`
+	SuccessfulRequests uint32 `protobuf:"varint,4,opt,name=successful_requests,json=successfulRequests,proto3" json:"successful_requests,omitempty"`
+	FailedRequests     uint32 `protobuf:"varint,5,opt,name=failed_requests,json=failedRequests,proto3" json:"failed_requests,omitempty"`
+	// Statistics after outlier handling, set when requested
+	Adjusted *LatencyStats `protobuf:"bytes,6,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
+	// Server resource usage while the benchmark ran, set when requested
+	Runtime *RuntimeStats `protobuf:"bytes,7,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// Set when the run was cancelled or timed out before making every request;
+	// the statistics then cover the requests made
+	Truncated bool `protobuf:"varint,8,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Average size of a successful response: the protobuf message for gRPC and
+	// the JSON-encoded result for JSON-RPC
+	AvgPayloadBytes uint64 `protobuf:"varint,9,opt,name=avg_payload_bytes,json=avgPayloadBytes,proto3" json:"avg_payload_bytes,omitempty"`
+	// Statistics of every successful response time, with the tail percentiles
+	// the averages above hide
+	Distribution *LatencyStats `protobuf:"bytes,10,opt,name=distribution,proto3" json:"distribution,omitempty"`
+}
+
+func (x *TransactionBenchmark) Reset() {
+	*x = TransactionBenchmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionBenchmark) ProtoMessage() {}
+
+func (x *TransactionBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionBenchmark.ProtoReflect.Descriptor instead.
+func (*TransactionBenchmark) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *TransactionBenchmark) GetAvgResponseTimeMs() uint64 {
+	if x != nil {
+		return x.AvgResponseTimeMs
+	}
+	return 0
+}
+
+func (x *TransactionBenchmark) GetMinResponseTimeMs() uint64 {
+	if x != nil {
+		return x.MinResponseTimeMs
+	}
+	return 0
+}
+
+func (x *TransactionBenchmark) GetMaxResponseTimeMs() uint64 {
+	if x != nil {
+		return x.MaxResponseTimeMs
+	}
+	return 0
+}
+
+func (x *TransactionBenchmark) GetSuccessfulRequests() uint32 {
+	if x != nil {
+		return x.SuccessfulRequests
+	}
+	return 0
+}
+
+func (x *TransactionBenchmark) GetFailedRequests() uint32 {
+	if x != nil {
+		return x.FailedRequests
+	}
+	return 0
+}
+
+func (x *TransactionBenchmark) GetAdjusted() *LatencyStats {
+	if x != nil {
+		return x.Adjusted
+	}
+	return nil
+}
+
+func (x *TransactionBenchmark) GetRuntime() *RuntimeStats {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+func (x *TransactionBenchmark) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *TransactionBenchmark) GetAvgPayloadBytes() uint64 {
+	if x != nil {
+		return x.AvgPayloadBytes
+	}
+	return 0
+}
+
+func (x *TransactionBenchmark) GetDistribution() *LatencyStats {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+// BlockBenchmark represents benchmark results for block operations
+type BlockBenchmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvgResponseTimeMs  uint64 `protobuf:"varint,1,opt,name=avg_response_time_ms,json=avgResponseTimeMs,proto3" json:"avg_response_time_ms,omitempty"`
+	MinResponseTimeMs  uint64 `protobuf:"varint,2,opt,name=min_response_time_ms,json=minResponseTimeMs,proto3" json:"min_response_time_ms,omitempty"`
+	MaxResponseTimeMs  uint64 `protobuf:"varint,3,opt,name=max_response_time_ms,json=maxResponseTimeMs,proto3" json:"max_response_time_ms,omitempty"`
+	SuccessfulRequests uint32 `protobuf:"varint,4,opt,name=successful_requests,json=successfulRequests,proto3" json:"successful_requests,omitempty"`
+	FailedRequests     uint32 `protobuf:"varint,5,opt,name=failed_requests,json=failedRequests,proto3" json:"failed_requests,omitempty"`
+	// Statistics after outlier handling, set when requested
+	Adjusted *LatencyStats `protobuf:"bytes,6,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
+	// Server resource usage while the benchmark ran, set when requested
+	Runtime *RuntimeStats `protobuf:"bytes,7,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// Set when the run was cancelled or timed out before making every request;
+	// the statistics then cover the requests made
+	Truncated bool `protobuf:"varint,8,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Average size of a successful response: the protobuf message for gRPC and
+	// the JSON-encoded result for JSON-RPC
+	AvgPayloadBytes uint64 `protobuf:"varint,9,opt,name=avg_payload_bytes,json=avgPayloadBytes,proto3" json:"avg_payload_bytes,omitempty"`
+	// Statistics of every successful response time, with the tail percentiles
+	// the averages above hide
+	Distribution *LatencyStats `protobuf:"bytes,10,opt,name=distribution,proto3" json:"distribution,omitempty"`
+}
+
+func (x *BlockBenchmark) Reset() {
+	*x = BlockBenchmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockBenchmark) ProtoMessage() {}
+
+func (x *BlockBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockBenchmark.ProtoReflect.Descriptor instead.
+func (*BlockBenchmark) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *BlockBenchmark) GetAvgResponseTimeMs() uint64 {
+	if x != nil {
+		return x.AvgResponseTimeMs
+	}
+	return 0
+}
+
+func (x *BlockBenchmark) GetMinResponseTimeMs() uint64 {
+	if x != nil {
+		return x.MinResponseTimeMs
+	}
+	return 0
+}
+
+func (x *BlockBenchmark) GetMaxResponseTimeMs() uint64 {
+	if x != nil {
+		return x.MaxResponseTimeMs
+	}
+	return 0
+}
+
+func (x *BlockBenchmark) GetSuccessfulRequests() uint32 {
+	if x != nil {
+		return x.SuccessfulRequests
+	}
+	return 0
+}
+
+func (x *BlockBenchmark) GetFailedRequests() uint32 {
+	if x != nil {
+		return x.FailedRequests
+	}
+	return 0
+}
+
+func (x *BlockBenchmark) GetAdjusted() *LatencyStats {
+	if x != nil {
+		return x.Adjusted
+	}
+	return nil
+}
+
+func (x *BlockBenchmark) GetRuntime() *RuntimeStats {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+func (x *BlockBenchmark) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *BlockBenchmark) GetAvgPayloadBytes() uint64 {
+	if x != nil {
+		return x.AvgPayloadBytes
+	}
+	return 0
+}
+
+func (x *BlockBenchmark) GetDistribution() *LatencyStats {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+// ClusterNodesBenchmark represents benchmark results for cluster node listings
+type ClusterNodesBenchmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AvgResponseTimeMs  uint64 `protobuf:"varint,1,opt,name=avg_response_time_ms,json=avgResponseTimeMs,proto3" json:"avg_response_time_ms,omitempty"`
+	MinResponseTimeMs  uint64 `protobuf:"varint,2,opt,name=min_response_time_ms,json=minResponseTimeMs,proto3" json:"min_response_time_ms,omitempty"`
+	MaxResponseTimeMs  uint64 `protobuf:"varint,3,opt,name=max_response_time_ms,json=maxResponseTimeMs,proto3" json:"max_response_time_ms,omitempty"`
+	SuccessfulRequests uint32 `protobuf:"varint,4,opt,name=successful_requests,json=successfulRequests,proto3" json:"successful_requests,omitempty"`
+	FailedRequests     uint32 `protobuf:"varint,5,opt,name=failed_requests,json=failedRequests,proto3" json:"failed_requests,omitempty"`
+	// Statistics after outlier handling, set when requested
+	Adjusted *LatencyStats `protobuf:"bytes,6,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
+	// Server resource usage while the benchmark ran, set when requested
+	Runtime *RuntimeStats `protobuf:"bytes,7,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// Set when the run was cancelled or timed out before making every request;
+	// the statistics then cover the requests made
+	Truncated bool `protobuf:"varint,8,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Average size of a successful response: the protobuf message for gRPC and
+	// the JSON-encoded result for JSON-RPC
+	AvgPayloadBytes uint64 `protobuf:"varint,9,opt,name=avg_payload_bytes,json=avgPayloadBytes,proto3" json:"avg_payload_bytes,omitempty"`
+	// Statistics of every successful response time, with the tail percentiles
+	// the averages above hide
+	Distribution *LatencyStats `protobuf:"bytes,10,opt,name=distribution,proto3" json:"distribution,omitempty"`
+}
+
+func (x *ClusterNodesBenchmark) Reset() {
+	*x = ClusterNodesBenchmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterNodesBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterNodesBenchmark) ProtoMessage() {}
+
+func (x *ClusterNodesBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterNodesBenchmark.ProtoReflect.Descriptor instead.
+func (*ClusterNodesBenchmark) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ClusterNodesBenchmark) GetAvgResponseTimeMs() uint64 {
+	if x != nil {
+		return x.AvgResponseTimeMs
+	}
+	return 0
+}
+
+func (x *ClusterNodesBenchmark) GetMinResponseTimeMs() uint64 {
+	if x != nil {
+		return x.MinResponseTimeMs
+	}
+	return 0
+}
+
+func (x *ClusterNodesBenchmark) GetMaxResponseTimeMs() uint64 {
+	if x != nil {
+		return x.MaxResponseTimeMs
+	}
+	return 0
+}
+
+func (x *ClusterNodesBenchmark) GetSuccessfulRequests() uint32 {
+	if x != nil {
+		return x.SuccessfulRequests
+	}
+	return 0
+}
+
+func (x *ClusterNodesBenchmark) GetFailedRequests() uint32 {
+	if x != nil {
+		return x.FailedRequests
+	}
+	return 0
+}
+
+func (x *ClusterNodesBenchmark) GetAdjusted() *LatencyStats {
+	if x != nil {
+		return x.Adjusted
+	}
+	return nil
+}
+
+func (x *ClusterNodesBenchmark) GetRuntime() *RuntimeStats {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+func (x *ClusterNodesBenchmark) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *ClusterNodesBenchmark) GetAvgPayloadBytes() uint64 {
+	if x != nil {
+		return x.AvgPayloadBytes
+	}
+	return 0
+}
+
+func (x *ClusterNodesBenchmark) GetDistribution() *LatencyStats {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+// BenchmarkSummary represents an overall summary of benchmark results
+type BenchmarkSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalDurationMs uint64 `protobuf:"varint,1,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
+	// Speedup of the account benchmarks, kept for existing clients; see
+	// account_speedup
+	GrpcVsJsonrpcSpeedup float64             `protobuf:"fixed64,2,opt,name=grpc_vs_jsonrpc_speedup,json=grpcVsJsonrpcSpeedup,proto3" json:"grpc_vs_jsonrpc_speedup,omitempty"`
+	Conclusion           string              `protobuf:"bytes,3,opt,name=conclusion,proto3" json:"conclusion,omitempty"`
+	Significance         []*SignificanceTest `protobuf:"bytes,4,rep,name=significance,proto3" json:"significance,omitempty"`
+	// JSON-RPC over gRPC average response time per category, zero when the
+	// category did not succeed on both transports
+	AccountSpeedup     float64 `protobuf:"fixed64,5,opt,name=account_speedup,json=accountSpeedup,proto3" json:"account_speedup,omitempty"`
+	TransactionSpeedup float64 `protobuf:"fixed64,6,opt,name=transaction_speedup,json=transactionSpeedup,proto3" json:"transaction_speedup,omitempty"`
+	BlockSpeedup       float64 `protobuf:"fixed64,7,opt,name=block_speedup,json=blockSpeedup,proto3" json:"block_speedup,omitempty"`
+	// Per-category speedups weighted by their number of successful request
+	// pairs, so categories measured more often count for more
+	OverallSpeedup float64 `protobuf:"fixed64,8,opt,name=overall_speedup,json=overallSpeedup,proto3" json:"overall_speedup,omitempty"`
+	// Composite scores for comparing runs and providers: the geometric mean of
+	// the category speedups, and of the speedups per payload byte
+	GeometricMeanSpeedup float64 `protobuf:"fixed64,9,opt,name=geometric_mean_speedup,json=geometricMeanSpeedup,proto3" json:"geometric_mean_speedup,omitempty"`
+	NormalizedScore      float64 `protobuf:"fixed64,10,opt,name=normalized_score,json=normalizedScore,proto3" json:"normalized_score,omitempty"`
+	// Speedup of the cluster nodes benchmarks, like the categories above
+	ClusterNodesSpeedup float64 `protobuf:"fixed64,11,opt,name=cluster_nodes_speedup,json=clusterNodesSpeedup,proto3" json:"cluster_nodes_speedup,omitempty"`
+}
+
+func (x *BenchmarkSummary) Reset() {
+	*x = BenchmarkSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkSummary) ProtoMessage() {}
+
+func (x *BenchmarkSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkSummary.ProtoReflect.Descriptor instead.
+func (*BenchmarkSummary) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *BenchmarkSummary) GetTotalDurationMs() uint64 {
+	if x != nil {
+		return x.TotalDurationMs
+	}
+	return 0
+}
+
+func (x *BenchmarkSummary) GetGrpcVsJsonrpcSpeedup() float64 {
+	if x != nil {
+		return x.GrpcVsJsonrpcSpeedup
+	}
+	return 0
+}
+
+func (x *BenchmarkSummary) GetConclusion() string {
+	if x != nil {
+		return x.Conclusion
+	}
+	return ""
+}
+
+func (x *BenchmarkSummary) GetSignificance() []*SignificanceTest {
+	if x != nil {
+		return x.Significance
+	}
+	return nil
+}
+
+func (x *BenchmarkSummary) GetAccountSpeedup() float64 {
+	if x != nil {
+		return x.AccountSpeedup
+	}
+	return 0
+}
+
+func (x *BenchmarkSummary) GetTransactionSpeedup() float64 {
+	if x != nil {
+		return x.TransactionSpeedup
+	}
+	return 0
+}
+
+func (x *BenchmarkSummary) GetBlockSpeedup() float64 {
+	if x != nil {
+		return x.BlockSpeedup
+	}
+	return 0
+}
+
+func (x *BenchmarkSummary) GetOverallSpeedup() float64 {
+	if x != nil {
+		return x.OverallSpeedup
+	}
+	return 0
+}
+
+func (x *BenchmarkSummary) GetGeometricMeanSpeedup() float64 {
+	if x != nil {
+		return x.GeometricMeanSpeedup
+	}
+	return 0
+}
+
+func (x *BenchmarkSummary) GetNormalizedScore() float64 {
+	if x != nil {
+		return x.NormalizedScore
+	}
+	return 0
+}
+
+func (x *BenchmarkSummary) GetClusterNodesSpeedup() float64 {
+	if x != nil {
+		return x.ClusterNodesSpeedup
+	}
+	return 0
+}
+
+// SignificanceTest compares the gRPC and JSON-RPC response time samples of one
+// benchmark category with Welch's t-test. The mean difference is JSON-RPC minus
+// gRPC, so a positive difference means gRPC was faster.
+type SignificanceTest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category         string  `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	MeanDifferenceMs float64 `protobuf:"fixed64,2,opt,name=mean_difference_ms,json=meanDifferenceMs,proto3" json:"mean_difference_ms,omitempty"`
+	CiLowMs          float64 `protobuf:"fixed64,3,opt,name=ci_low_ms,json=ciLowMs,proto3" json:"ci_low_ms,omitempty"`
+	CiHighMs         float64 `protobuf:"fixed64,4,opt,name=ci_high_ms,json=ciHighMs,proto3" json:"ci_high_ms,omitempty"`
+	ConfidenceLevel  float64 `protobuf:"fixed64,5,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	TStatistic       float64 `protobuf:"fixed64,6,opt,name=t_statistic,json=tStatistic,proto3" json:"t_statistic,omitempty"`
+	DegreesOfFreedom float64 `protobuf:"fixed64,7,opt,name=degrees_of_freedom,json=degreesOfFreedom,proto3" json:"degrees_of_freedom,omitempty"`
+	PValue           float64 `protobuf:"fixed64,8,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	Significant      bool    `protobuf:"varint,9,opt,name=significant,proto3" json:"significant,omitempty"`
+}
+
+func (x *SignificanceTest) Reset() {
+	*x = SignificanceTest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignificanceTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignificanceTest) ProtoMessage() {}
+
+func (x *SignificanceTest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignificanceTest.ProtoReflect.Descriptor instead.
+func (*SignificanceTest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SignificanceTest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SignificanceTest) GetMeanDifferenceMs() float64 {
+	if x != nil {
+		return x.MeanDifferenceMs
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetCiLowMs() float64 {
+	if x != nil {
+		return x.CiLowMs
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetCiHighMs() float64 {
+	if x != nil {
+		return x.CiHighMs
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetConfidenceLevel() float64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetTStatistic() float64 {
+	if x != nil {
+		return x.TStatistic
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetDegreesOfFreedom() float64 {
+	if x != nil {
+		return x.DegreesOfFreedom
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetSignificant() bool {
+	if x != nil {
+		return x.Significant
+	}
+	return false
+}
+
+// BenchmarkJob identifies a benchmark job and reports its state
+type BenchmarkJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string            `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	State BenchmarkJobState `protobuf:"varint,2,opt,name=state,proto3,enum=solana.benchmark.BenchmarkJobState" json:"state,omitempty"`
+}
+
+func (x *BenchmarkJob) Reset() {
+	*x = BenchmarkJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkJob) ProtoMessage() {}
+
+func (x *BenchmarkJob) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkJob.ProtoReflect.Descriptor instead.
+func (*BenchmarkJob) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *BenchmarkJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *BenchmarkJob) GetState() BenchmarkJobState {
+	if x != nil {
+		return x.State
+	}
+	return BenchmarkJobState_BENCHMARK_JOB_STATE_QUEUED
+}
+
+// BenchmarkJobRequest refers to a benchmark job
+type BenchmarkJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *BenchmarkJobRequest) Reset() {
+	*x = BenchmarkJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkJobRequest) ProtoMessage() {}
+
+func (x *BenchmarkJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkJobRequest.ProtoReflect.Descriptor instead.
+func (*BenchmarkJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *BenchmarkJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// BenchmarkProgress reports a completed benchmark request, along with the
+// job's overall progress. Updates may be dropped for slow consumers, but the
+// counters are cumulative and the final update always carries the terminal state.
+type BenchmarkProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string            `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	State BenchmarkJobState `protobuf:"varint,2,opt,name=state,proto3,enum=solana.benchmark.BenchmarkJobState" json:"state,omitempty"`
+	// Benchmark the request belonged to, e.g. "account gRPC"
+	Benchmark         string `protobuf:"bytes,3,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	Iteration         uint32 `protobuf:"varint,4,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	Success           bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	ResponseTimeMs    uint64 `protobuf:"varint,6,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	CompletedRequests uint32 `protobuf:"varint,7,opt,name=completed_requests,json=completedRequests,proto3" json:"completed_requests,omitempty"`
+	TotalRequests     uint32 `protobuf:"varint,8,opt,name=total_requests,json=totalRequests,proto3" json:"total_requests,omitempty"`
+}
+
+func (x *BenchmarkProgress) Reset() {
+	*x = BenchmarkProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkProgress) ProtoMessage() {}
+
+func (x *BenchmarkProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkProgress.ProtoReflect.Descriptor instead.
+func (*BenchmarkProgress) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *BenchmarkProgress) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *BenchmarkProgress) GetState() BenchmarkJobState {
+	if x != nil {
+		return x.State
+	}
+	return BenchmarkJobState_BENCHMARK_JOB_STATE_QUEUED
+}
+
+func (x *BenchmarkProgress) GetBenchmark() string {
+	if x != nil {
+		return x.Benchmark
+	}
+	return ""
+}
+
+func (x *BenchmarkProgress) GetIteration() uint32 {
+	if x != nil {
+		return x.Iteration
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BenchmarkProgress) GetResponseTimeMs() uint64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetCompletedRequests() uint32 {
+	if x != nil {
+		return x.CompletedRequests
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetTotalRequests() uint32 {
+	if x != nil {
+		return x.TotalRequests
+	}
+	return 0
+}
+
+// BenchmarkJobResult is the state of a benchmark job, with its results once
+// it has finished
+type BenchmarkJobResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId   string            `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	State   BenchmarkJobState `protobuf:"varint,2,opt,name=state,proto3,enum=solana.benchmark.BenchmarkJobState" json:"state,omitempty"`
+	Results *BenchmarkResults `protobuf:"bytes,3,opt,name=results,proto3" json:"results,omitempty"`
+	Request *BenchmarkRequest `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *BenchmarkJobResult) Reset() {
+	*x = BenchmarkJobResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkJobResult) ProtoMessage() {}
+
+func (x *BenchmarkJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkJobResult.ProtoReflect.Descriptor instead.
+func (*BenchmarkJobResult) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *BenchmarkJobResult) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *BenchmarkJobResult) GetState() BenchmarkJobState {
+	if x != nil {
+		return x.State
+	}
+	return BenchmarkJobState_BENCHMARK_JOB_STATE_QUEUED
+}
+
+func (x *BenchmarkJobResult) GetResults() *BenchmarkResults {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BenchmarkJobResult) GetRequest() *BenchmarkRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// ListBenchmarksRequest represents a request for a page of scheduled
+// benchmark runs
+type ListBenchmarksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only runs of this scenario; empty for every scenario
+	Scenario string `protobuf:"bytes,1,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	// Runs per page; defaults to 20, at most 100
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page; empty for the first page
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Leave the results out, listing when each run happened only
+	ExcludeResults bool `protobuf:"varint,4,opt,name=exclude_results,json=excludeResults,proto3" json:"exclude_results,omitempty"`
+}
+
+func (x *ListBenchmarksRequest) Reset() {
+	*x = ListBenchmarksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBenchmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarksRequest) ProtoMessage() {}
+
+func (x *ListBenchmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListBenchmarksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ListBenchmarksRequest) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBenchmarksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetExcludeResults() bool {
+	if x != nil {
+		return x.ExcludeResults
+	}
+	return false
+}
+
+// BenchmarkScenario is a benchmark request the server runs on a schedule
+type BenchmarkScenario struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Cron expression of five fields in UTC, or a descriptor such as @hourly
+	// or "@every 15m"
+	Schedule string            `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Request  *BenchmarkRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	// 0 while a run is in progress
+	NextRunUnixMs int64 `protobuf:"varint,4,opt,name=next_run_unix_ms,json=nextRunUnixMs,proto3" json:"next_run_unix_ms,omitempty"`
+	// Job of the run in progress, if any, for GetBenchmarkResult
+	RunningJobId string `protobuf:"bytes,5,opt,name=running_job_id,json=runningJobId,proto3" json:"running_job_id,omitempty"`
+}
+
+func (x *BenchmarkScenario) Reset() {
+	*x = BenchmarkScenario{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkScenario) ProtoMessage() {}
+
+func (x *BenchmarkScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkScenario.ProtoReflect.Descriptor instead.
+func (*BenchmarkScenario) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *BenchmarkScenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BenchmarkScenario) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *BenchmarkScenario) GetRequest() *BenchmarkRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *BenchmarkScenario) GetNextRunUnixMs() int64 {
+	if x != nil {
+		return x.NextRunUnixMs
+	}
+	return 0
+}
+
+func (x *BenchmarkScenario) GetRunningJobId() string {
+	if x != nil {
+		return x.RunningJobId
+	}
+	return ""
+}
+
+// ScheduledBenchmarkRun is the outcome of a run of a benchmark scenario
+type ScheduledBenchmarkRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Numbers the runs from 1 in the order they finished
+	RunId    uint64 `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Scenario string `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	JobId    string `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// SUCCEEDED, or CANCELLED if the run exceeded its maximum duration
+	State            BenchmarkJobState `protobuf:"varint,4,opt,name=state,proto3,enum=solana.benchmark.BenchmarkJobState" json:"state,omitempty"`
+	StartedAtUnixMs  int64             `protobuf:"varint,5,opt,name=started_at_unix_ms,json=startedAtUnixMs,proto3" json:"started_at_unix_ms,omitempty"`
+	FinishedAtUnixMs int64             `protobuf:"varint,6,opt,name=finished_at_unix_ms,json=finishedAtUnixMs,proto3" json:"finished_at_unix_ms,omitempty"`
+	Results          *BenchmarkResults `protobuf:"bytes,7,opt,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ScheduledBenchmarkRun) Reset() {
+	*x = ScheduledBenchmarkRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledBenchmarkRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledBenchmarkRun) ProtoMessage() {}
+
+func (x *ScheduledBenchmarkRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledBenchmarkRun.ProtoReflect.Descriptor instead.
+func (*ScheduledBenchmarkRun) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ScheduledBenchmarkRun) GetRunId() uint64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *ScheduledBenchmarkRun) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+func (x *ScheduledBenchmarkRun) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ScheduledBenchmarkRun) GetState() BenchmarkJobState {
+	if x != nil {
+		return x.State
+	}
+	return BenchmarkJobState_BENCHMARK_JOB_STATE_QUEUED
+}
+
+func (x *ScheduledBenchmarkRun) GetStartedAtUnixMs() int64 {
+	if x != nil {
+		return x.StartedAtUnixMs
+	}
+	return 0
+}
+
+func (x *ScheduledBenchmarkRun) GetFinishedAtUnixMs() int64 {
+	if x != nil {
+		return x.FinishedAtUnixMs
+	}
+	return 0
+}
+
+func (x *ScheduledBenchmarkRun) GetResults() *BenchmarkResults {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ListBenchmarksResponse contains a page of scheduled benchmark runs
+type ListBenchmarksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Newest first
+	Runs []*ScheduledBenchmarkRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	// Empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The scenarios scheduled, in configuration order
+	Scenarios []*BenchmarkScenario `protobuf:"bytes,3,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+}
+
+func (x *ListBenchmarksResponse) Reset() {
+	*x = ListBenchmarksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_solana_benchmark_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBenchmarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarksResponse) ProtoMessage() {}
+
+func (x *ListBenchmarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_benchmark_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarksResponse.ProtoReflect.Descriptor instead.
+func (*ListBenchmarksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_benchmark_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ListBenchmarksResponse) GetRuns() []*ScheduledBenchmarkRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *ListBenchmarksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListBenchmarksResponse) GetScenarios() []*BenchmarkScenario {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+var File_proto_solana_benchmark_proto protoreflect.FileDescriptor
+
+var file_proto_solana_benchmark_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x5f, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xb8, 0x02, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74,
+	0x22, 0x94, 0x01, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x64, 0x6f, 0x77, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x70, 0x63, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x73, 0x22, 0x4d, 0x0a, 0x13, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x14, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6c,
+	0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6c, 0x61, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x51, 0x0a, 0x15,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0xc8, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xd4, 0x01, 0x0a, 0x16, 0x43,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f,
+	0x74, 0x22, 0x4d, 0x0a, 0x13, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0xdf, 0x02, 0x0a, 0x14, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x2f, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x6d, 0x69, 0x6e,
+	0x74, 0x12, 0x3d, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x41, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c,
+	0x6f, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x69, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x73, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xc7, 0x02,
+	0x0a, 0x11, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x8b, 0x03, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x13, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x53, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x17, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x5f, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x15, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4c, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xc1,
+	0x02, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x3e, 0x0a, 0x1b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x68, 0x65, 0x6c, 0x64, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x57, 0x69,
+	0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65,
+	0x6c, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x12, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x52, 0x10, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x4b, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x52, 0x10, 0x6e, 0x65, 0x77, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46,
+	0x65, 0x65, 0x22, 0x3c, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x68,
+	0x65, 0x6c, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x87, 0x02, 0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x42, 0x65, 0x61,
+	0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x39, 0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x17,
+	0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x70,
+	0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x5a, 0x0a, 0x0f, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4a, 0x0a, 0x12, 0x4e, 0x46, 0x54, 0x73, 0x42, 0x79,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x4e, 0x46, 0x54, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x29, 0x0a, 0x04, 0x6e, 0x66, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x4e, 0x46, 0x54, 0x52, 0x04, 0x6e, 0x66, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x6c, 0x6f, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x03, 0x4e, 0x46, 0x54, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4e, 0x46, 0x54, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xa5, 0x03, 0x0a, 0x0b, 0x4e, 0x46, 0x54, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x61,
+	0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4e,
+	0x46, 0x54, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x73,
+	0x61, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x61, 0x6c, 0x65, 0x48,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6d, 0x75,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4d,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x0a, 0x4e, 0x46, 0x54, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x22, 0x1e, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x56, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xca, 0x02, 0x0a, 0x05, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x19, 0x0a,
+	0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x75, 0x72, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x72,
+	0x65, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x66, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x61, 0x77, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72,
+	0x61, 0x77, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c,
+	0x6f, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x15, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x42, 0x79, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x23, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x95, 0x02,
+	0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x72, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x65, 0x61, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x50, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x65, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x05, 0x73, 0x65, 0x65, 0x64, 0x73, 0x22, 0x55, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x75,
+	0x0a, 0x1d, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x0e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x75, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x62, 0x75, 0x6d, 0x70, 0x22, 0x69, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x5f, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x4c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x22, 0xe1, 0x01, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x50, 0x61, 0x79, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0xc0, 0x02, 0x0a, 0x1f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f,
+	0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65,
+	0x50, 0x61, 0x79, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb6, 0x02, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x35, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74,
+	0x22, 0x68, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2f, 0x0a, 0x17, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x18, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x08, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xf9, 0x04, 0x0a,
+	0x12, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17,
+	0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6e,
+	0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x6e, 0x75, 0x6d, 0x52,
+	0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x1e, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x6e,
+	0x75, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x48, 0x0a,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5f, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x52, 0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x22, 0x5f, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0x70, 0x0a, 0x12, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x92, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f, 0x77, 0x72,
+	0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c,
+	0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x78,
+	0x0a, 0x0b, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x12, 0x2d, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4d, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x53,
+	0x6c, 0x6f, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x51, 0x0a, 0x0e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x22, 0xdb, 0x02, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x57, 0x65, 0x62, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x2d, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f,
+	0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4d, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75,
+	0x6e, 0x69, 0x78, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6c, 0x61,
+	0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e,
+	0x69, 0x78, 0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x4d, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x70,
+	0x65, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x61, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x70, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x70, 0x65, 0x64, 0x49, 0x64,
+	0x6c, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x75, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x68, 0x75, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf3, 0x03,
+	0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x74, 0x6f,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x74, 0x74,
+	0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x74, 0x6c, 0x4d, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x39, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x66, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4d, 0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x22, 0x62, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x36, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x91, 0x01, 0x0a,
+	0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74,
+	0x22, 0x30, 0x0a, 0x11, 0x53, 0x6c, 0x6f, 0x74, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x78, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xf4, 0x02, 0x0a, 0x12, 0x53, 0x6c, 0x6f, 0x74, 0x41, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x6c, 0x6f, 0x74,
+	0x73, 0x49, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x12, 0x28,
+	0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa7, 0x01, 0x0a,
+	0x1c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x6b, 0x69, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x73, 0x6b, 0x69, 0x70, 0x52, 0x61, 0x74, 0x65, 0x22, 0x85, 0x03, 0x0a, 0x0f, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6b,
+	0x69, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73,
+	0x6b, 0x69, 0x70, 0x52, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74,
+	0x22, 0x8a, 0x01, 0x0a, 0x12, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a,
+	0x0e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x81, 0x02, 0x0a, 0x0f, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x61,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x70, 0x6f, 0x73,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x90, 0x02, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x61, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c,
+	0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c,
+	0x6f, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x0c,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x61,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x9b, 0x03, 0x0a, 0x0a, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x49, 0x6e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x49, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x6c, 0x6f, 0x74, 0x22, 0x6b, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x70, 0x63, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x70, 0x63, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0xc1, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x70, 0x75, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x72, 0x70, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x68, 0x72, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x68, 0x72, 0x65, 0x64, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x02, 0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaf, 0x01,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0xa9, 0x01, 0x0a, 0x0c, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65,
+	0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0xba, 0x02, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4f,
+	0x77, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70,
+	0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x1a, 0x4f, 0x77, 0x6e,
+	0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xc4,
+	0x02, 0x0a, 0x11, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x38, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x6c, 0x6f,
+	0x73, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x07, 0x63, 0x6c,
+	0x6f, 0x73, 0x75, 0x72, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x4c,
+	0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4c, 0x61, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22,
+	0x12, 0x0a, 0x10, 0x53, 0x4c, 0x4f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xec, 0x02, 0x0a, 0x09, 0x53, 0x4c, 0x4f, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x4c, 0x4f, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f,
+	0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6d, 0x65, 0x74, 0x12, 0x22, 0x0a,
+	0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6d, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4d,
+	0x73, 0x12, 0x2f, 0x0a, 0x14, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78,
+	0x4d, 0x73, 0x22, 0x50, 0x0a, 0x11, 0x53, 0x4c, 0x4f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53,
+	0x4c, 0x4f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x10, 0x53, 0x4c, 0x4f, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x22, 0x91, 0x01, 0x0a, 0x08, 0x53, 0x4c, 0x4f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x53, 0x4c, 0x4f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x4c, 0x4f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb2, 0x02, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65,
+	0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x69, 0x6e, 0x75, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x71,
+	0x6f, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x51, 0x6f, 0x53, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x14, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74,
+	0x22, 0x53, 0x0a, 0x1d, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb8, 0x02, 0x0a, 0x1d, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x12, 0x37, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x73, 0x74, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0b, 0x66, 0x6c, 0x61,
+	0x74, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x73, 0x74, 0x52, 0x0b, 0x66, 0x6c, 0x61, 0x74, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73,
+	0x22, 0x80, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x55, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x55, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x5f,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0d, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0e,
+	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0xe0, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x07, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0xe8, 0x04, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x65, 0x72, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72,
+	0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x5c,
+	0x0a, 0x12, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x11, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x56, 0x0a, 0x12,
+	0x70, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x10, 0x70, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x13, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x70, 0x6f, 0x73,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x77, 0x72, 0x69, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x57, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x52, 0x65, 0x61, 0x64, 0x6f,
+	0x6e, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x22, 0x80, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x49, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4b, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x71, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x10, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x49, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x66, 0x0a, 0x13, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x3b, 0x0a,
+	0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xc0, 0x01, 0x0a, 0x0c, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x3b, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x55, 0x73, 0x22, 0xa8, 0x01,
+	0x0a, 0x19, 0x44, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x65, 0x73, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x19, 0x44, 0x75, 0x61,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x75, 0x61, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x75,
+	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x05,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44,
+	0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x44, 0x75, 0x61, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x37, 0x0a,
+	0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44,
+	0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x37, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x73, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x44, 0x75,
+	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x76, 0x67, 0x5f, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61, 0x76, 0x67, 0x55, 0x73, 0x12, 0x15, 0x0a, 0x06,
+	0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6d, 0x69,
+	0x6e, 0x55, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76,
+	0x67, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x76, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3b, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0xae, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x39,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3e, 0x0a, 0x04, 0x67, 0x72, 0x70,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x48, 0x0a, 0x09, 0x77, 0x65, 0x62,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x22, 0xef, 0x02, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x0e, 0x61, 0x76, 0x67, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x67, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x35, 0x30, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x35, 0x30,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x39, 0x39,
+	0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x70, 0x39, 0x39, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8d, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xac, 0x02, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x6c, 0x6f, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x12, 0x35, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0xf8, 0x02, 0x0a, 0x0d,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x39, 0x0a,
+	0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e,
+	0x52, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0xf8, 0x02, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
+	0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x6f, 0x53, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x78, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x4d,
+	0x73, 0x22, 0x4f, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x39, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x22, 0xbc, 0x02, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f,
+	0x74, 0x22, 0x7f, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6c, 0x6f,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x41, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x6b, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x61, 0x63, 0x6b, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x61, 0x78, 0x5f, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa5,
+	0x01, 0x0a, 0x12, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x06,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x39, 0x0a, 0x18, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x80, 0x02, 0x0a, 0x11, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0xc9, 0x03, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75,
+	0x65, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x78, 0x57,
+	0x61, 0x69, 0x74, 0x4d, 0x73, 0x12, 0x2d, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x6f, 0x53, 0x52,
+	0x03, 0x71, 0x6f, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x50,
+	0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x50, 0x6f, 0x6c, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xca, 0x02, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x3b, 0x0a, 0x08, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
+	0x69, 0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x70, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x67, 0x61, 0x70, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x6f,
+	0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0xa9, 0x01,
+	0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x6f, 0x6c, 0x6c, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x22, 0xa6, 0x02, 0x0a, 0x17, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x4d, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x54, 0x6f, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x12,
+	0x39, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x54, 0x6f, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x6c,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x4d, 0x73, 0x22, 0xaa, 0x02, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x6f, 0x53, 0x52, 0x03,
+	0x71, 0x6f, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22,
+	0xdb, 0x03, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x48, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x0e, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x39,
+	0x0a, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x3e, 0x0a,
+	0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0xd5, 0x01,
+	0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e,
+	0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x6e,
+	0x74, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74,
+	0x22, 0xce, 0x01, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x73, 0x22, 0xc0, 0x01, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x71, 0x6f,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x51, 0x6f, 0x53, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0xa9, 0x01, 0x0a, 0x1a, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x6f, 0x53, 0x52, 0x03, 0x71, 0x6f, 0x73,
+	0x22, 0xed, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70,
+	0x6f, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73,
+	0x22, 0xf7, 0x01, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x6c, 0x6f, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x0e, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4c,
+	0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x49, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x12, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51,
+	0x6f, 0x53, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x22, 0x8e, 0x03, 0x0a, 0x05, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x70, 0x72, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x46, 0x65, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x6f, 0x53, 0x52, 0x03, 0x71, 0x6f, 0x73,
+	0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x32, 0x35, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x70, 0x32, 0x35, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x35, 0x30, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x37, 0x35,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x70, 0x37, 0x35, 0x12, 0x10, 0x0a, 0x03, 0x70,
+	0x39, 0x30, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x70, 0x39, 0x30, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x39, 0x39, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x70, 0x39, 0x39, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x22, 0xa0, 0x03, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x18, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69,
+	0x7a, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x54, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x6c, 0x61, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x13, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x4c, 0x61, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x6c, 0x6f, 0x74, 0x22, 0xd7, 0x03, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x72, 0x65, 0x6f, 0x72, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12,
+	0x39, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x8f,
+	0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x61, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65,
+	0x64, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x5f, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x6e, 0x63, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x73,
+	0x22, 0xce, 0x05, 0x0a, 0x10, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65,
+	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x75, 0x6e, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x75, 0x6e, 0x47,
+	0x72, 0x70, 0x63, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x5f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x75, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x54,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x5f, 0x72,
+	0x70, 0x63, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x52, 0x70, 0x63, 0x55, 0x72, 0x6c, 0x12, 0x4c, 0x0a, 0x10, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x6c,
+	0x69, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x46, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x79, 0x70, 0x61,
+	0x73, 0x73, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x42, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x72, 0x75, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x22, 0xb6, 0x03, 0x0a, 0x0c, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x11, 0x61, 0x76, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x14, 0x70, 0x35, 0x30, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70,
+	0x35, 0x30, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x12, 0x2f, 0x0a, 0x14, 0x70, 0x39, 0x30, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x70, 0x39, 0x30, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x12, 0x2f, 0x0a, 0x14, 0x70, 0x39, 0x35, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x70, 0x39, 0x35, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x70, 0x39, 0x39, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x11, 0x70, 0x39, 0x39, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0xc4, 0x07, 0x0a, 0x10, 0x42,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x45, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x12, 0x4b, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x73, 0x6f, 0x6e,
+	0x72, 0x70, 0x63, 0x12, 0x51, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x12, 0x57, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x12, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x12,
+	0x3f, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x72, 0x70, 0x63,
+	0x12, 0x45, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70,
+	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x55, 0x0a,
+	0x12, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x52, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x47, 0x72, 0x70, 0x63, 0x12, 0x5b, 0x0a, 0x15, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x13, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x72, 0x70,
+	0x63, 0x22, 0xd7, 0x01, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x42,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x35, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x34, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52,
+	0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x3a, 0x0a, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70,
+	0x63, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x73, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x22, 0x8a, 0x03, 0x0a, 0x14,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x74, 0x74,
+	0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x74, 0x74, 0x4d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x74, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x74, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0c, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x63, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x63, 0x43, 0x79, 0x63, 0x6c, 0x65,
+	0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x63, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x6d, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x67, 0x63, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4d,
+	0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x63, 0x70, 0x75, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x22, 0x83, 0x04, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x2f, 0x0a, 0x14, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x76, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66,
+	0x75, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12,
+	0x38, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x61, 0x76, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x04, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x12, 0x2f, 0x0a, 0x14, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x61, 0x76, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3a, 0x0a,
+	0x08, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x08, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x76,
+	0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x42, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x81, 0x04, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x12, 0x2f, 0x0a, 0x14, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x61, 0x76, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x3a, 0x0a, 0x08, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x08, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a,
+	0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x61, 0x76, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x04, 0x0a, 0x15, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x2f, 0x0a, 0x14, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61,
+	0x76, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x12, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x08,
+	0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08,
+	0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x76, 0x67,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x9a, 0x04, 0x0a, 0x10, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x12, 0x35, 0x0a, 0x17, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x76, 0x73, 0x5f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x14, 0x67, 0x72, 0x70, 0x63, 0x56, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x72, 0x70,
+	0x63, 0x53, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x12,
+	0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x53, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x65, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x4d, 0x65, 0x61, 0x6e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x12, 0x29,
+	0x0a, 0x10, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x22, 0xcb, 0x02,
+	0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2c,
+	0x0a, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6d, 0x65, 0x61, 0x6e,
+	0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x09,
+	0x63, 0x69, 0x5f, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x63, 0x69, 0x4c, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x63, 0x69, 0x5f, 0x68,
+	0x69, 0x67, 0x68, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x69,
+	0x48, 0x69, 0x67, 0x68, 0x4d, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x5f, 0x6f, 0x66,
+	0x5f, 0x66, 0x72, 0x65, 0x65, 0x64, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x4f, 0x66, 0x46, 0x72, 0x65, 0x65, 0x64, 0x6f, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x0c, 0x42,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x6a,
+	0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x2c, 0x0a,
+	0x13, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0xbb, 0x02, 0x0a, 0x11,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x12, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x98,
+	0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x11, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x3c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e,
+	0x55, 0x6e, 0x69, 0x78, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0xb6, 0x02, 0x0a,
+	0x15, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4d, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x55, 0x6e, 0x69, 0x78, 0x4d, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x09, 0x73,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x2a, 0x83, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x26, 0x0a, 0x22, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41,
+	0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a,
+	0x1b, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x02, 0x2a, 0x57,
+	0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41,
+	0x53, 0x45, 0x36, 0x34, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42,
+	0x41, 0x53, 0x45, 0x35, 0x38, 0x10, 0x01, 0x2a, 0x92, 0x01, 0x0a, 0x0e, 0x57, 0x65, 0x62, 0x53,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x45,
+	0x42, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x57,
+	0x45, 0x42, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x57,
+	0x45, 0x42, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x57, 0x45,
+	0x42, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49,
+	0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x7e, 0x0a, 0x0c,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x19,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e,
+	0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53,
+	0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x49, 0x4d,
+	0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x45,
+	0x41, 0x4d, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x50, 0x55, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xac, 0x01, 0x0a,
+	0x0e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x20, 0x0a, 0x1c, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a,
+	0x1a, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x49, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x1c, 0x0a,
+	0x18, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x53, 0x10, 0x04, 0x2a, 0xd6, 0x01, 0x0a, 0x14,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d,
+	0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12,
+	0x23, 0x0a, 0x1f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x53,
+	0x54, 0x41, 0x4b, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49,
+	0x4e, 0x47, 0x10, 0x04, 0x2a, 0x89, 0x02, 0x0a, 0x15, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28,
+	0x0a, 0x24, 0x4f, 0x57, 0x4e, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x4f, 0x57, 0x4e, 0x45,
+	0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x12,
+	0x2e, 0x0a, 0x2a, 0x4f, 0x57, 0x4e, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50,
+	0x53, 0x48, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12,
+	0x24, 0x0a, 0x20, 0x4f, 0x57, 0x4e, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x4f, 0x57, 0x4e, 0x45, 0x44, 0x5f, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x4f,
+	0x57, 0x4e, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x05,
+	0x2a, 0xb6, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x43, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x52, 0x41, 0x49,
+	0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2d, 0x0a, 0x29, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x59, 0x53, 0x54,
+	0x45, 0x4d, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41,
+	0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x3c, 0x0a, 0x09, 0x53, 0x4c, 0x4f,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4c, 0x4f, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x52, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x53, 0x4c, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x53,
+	0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x01, 0x2a, 0x85, 0x01, 0x0a, 0x0c, 0x53, 0x4c, 0x4f, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x4c, 0x4f, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4c, 0x4f, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45,
+	0x4e, 0x54, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4c, 0x4f, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4c, 0x4f, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0x4c, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x1b, 0x0a, 0x17, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49,
+	0x4e, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x10, 0x00, 0x12, 0x1e, 0x0a,
+	0x1a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f,
+	0x46, 0x4c, 0x41, 0x54, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x53, 0x10, 0x01, 0x2a, 0x79, 0x0a,
+	0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x42,
+	0x45, 0x4e, 0x43, 0x48, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x43,
+	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x5f, 0x42, 0x45, 0x4e, 0x43, 0x48, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x5f, 0x42, 0x45, 0x4e, 0x43, 0x48, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x2a, 0x73, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x53, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54,
+	0x55, 0x52, 0x45, 0x53, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x2a, 0x39, 0x0a,
+	0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x6f, 0x53, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54,
+	0x52, 0x45, 0x41, 0x4d, 0x5f, 0x51, 0x4f, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x54, 0x49, 0x4d,
+	0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x51, 0x4f,
+	0x53, 0x5f, 0x42, 0x55, 0x4c, 0x4b, 0x10, 0x01, 0x2a, 0x5a, 0x0a, 0x13, 0x50, 0x6f, 0x6c, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x1f, 0x50, 0x4f, 0x4c, 0x4c, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44,
+	0x45, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x48, 0x41,
+	0x53, 0x48, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x4f, 0x4c, 0x4c, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c,
+	0x4f, 0x54, 0x10, 0x01, 0x2a, 0x81, 0x03, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x28, 0x0a, 0x24, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x4c, 0x49, 0x50, 0x50, 0x41, 0x47, 0x45, 0x10, 0x01,
+	0x12, 0x2e, 0x0a, 0x2a, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4d,
+	0x50, 0x55, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x2e, 0x0a, 0x2a, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x2f, 0x0a, 0x2b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x49, 0x4e, 0x53,
+	0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x10,
+	0x04, 0x12, 0x31, 0x0a, 0x2d, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x55,
+	0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x05, 0x12, 0x2e, 0x0a, 0x2a, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x07, 0x2a, 0x84, 0x01, 0x0a, 0x12, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x24, 0x0a, 0x20, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4c, 0x41, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x45, 0x52, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x43,
+	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45,
+	0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x45, 0x53, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x4c,
+	0x45, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52,
+	0x4f, 0x47, 0x52, 0x41, 0x4d, 0x5f, 0x49, 0x4e, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x2a,
+	0x5d, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a,
+	0x15, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f,
+	0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4c, 0x4f, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x67,
+	0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e,
+	0x67, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x55, 0x54, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x48, 0x41, 0x4e,
+	0x44, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x4f, 0x55, 0x54, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x4c, 0x49, 0x4e, 0x47,
+	0x5f, 0x54, 0x52, 0x49, 0x4d, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x55, 0x54, 0x4c, 0x49,
+	0x45, 0x52, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x49, 0x4e, 0x53,
+	0x4f, 0x52, 0x49, 0x5a, 0x45, 0x10, 0x02, 0x2a, 0x9a, 0x01, 0x0a, 0x11, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a,
+	0x1a, 0x42, 0x45, 0x4e, 0x43, 0x48, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a,
+	0x1b, 0x42, 0x45, 0x4e, 0x43, 0x48, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x21,
+	0x0a, 0x1d, 0x42, 0x45, 0x4e, 0x43, 0x48, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x4a, 0x4f, 0x42, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x45, 0x4e, 0x43, 0x48, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x4a,
+	0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x03, 0x32, 0xde, 0x27, 0x0a, 0x10, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0e, 0x52, 0x65,
+	0x61, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x46, 0x54, 0x73, 0x42, 0x79, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4e, 0x46, 0x54, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4e, 0x46, 0x54,
+	0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c,
+	0x0a, 0x18, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x76, 0x0a, 0x1d,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x61, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x30,
+	0x01, 0x12, 0x72, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x63, 0x6b,
+	0x12, 0x29, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x7b, 0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x30, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x30, 0x01, 0x12, 0x73, 0x0a, 0x18, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2a,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01,
+	0x12, 0x55, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x6d, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x2c, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x46, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x46, 0x65, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x46, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x0c, 0x52, 0x75,
+	0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x22, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x54, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x12, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x12, 0x67, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x30,
+	0x01, 0x12, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x58, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x12, 0x66,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x27, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x55,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x57, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x41, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x30, 0x01, 0x12, 0x64, 0x0a, 0x11, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x41,
+	0x63, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x6b,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x63, 0x6b, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x12, 0x7a, 0x0a, 0x16, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x6e, 0x0a, 0x12, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x44, 0x75, 0x61,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x75, 0x61, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x65, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x12, 0x28, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x61,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x6c, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12,
+	0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x28, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x77, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x64,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x70, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4f,
+	0x77, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x57, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x4c, 0x4f, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x4c, 0x4f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x4c, 0x4f, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0f, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x4c, 0x4f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x53, 0x4c, 0x4f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x53, 0x4c, 0x4f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x63, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x8f, 0x04, 0x0a, 0x0c, 0x55, 0x74, 0x69, 0x6c, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x72,
+	0x69, 0x76, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5b, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x65, 0x64, 0x12, 0x27, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x66, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x28, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x65, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x2d, 0x74, 0x6f, 0x7a, 0x65, 0x72, 0x2f, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x65, 0x78, 0x70, 0x6c, 0x6f, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_solana_benchmark_proto_rawDescOnce sync.Once
+	file_proto_solana_benchmark_proto_rawDescData = file_proto_solana_benchmark_proto_rawDesc
+)
+
+func file_proto_solana_benchmark_proto_rawDescGZIP() []byte {
+	file_proto_solana_benchmark_proto_rawDescOnce.Do(func() {
+		file_proto_solana_benchmark_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_solana_benchmark_proto_rawDescData)
+	})
+	return file_proto_solana_benchmark_proto_rawDescData
+}
+
+var file_proto_solana_benchmark_proto_enumTypes = make([]protoimpl.EnumInfo, 20)
+var file_proto_solana_benchmark_proto_msgTypes = make([]protoimpl.MessageInfo, 165)
+var file_proto_solana_benchmark_proto_goTypes = []interface{}{
+	(TokenAccountStatus)(0),                 // 0: solana.benchmark.TokenAccountStatus
+	(TransactionEncoding)(0),                // 1: solana.benchmark.TransactionEncoding
+	(WebSocketState)(0),                     // 2: solana.benchmark.WebSocketState
+	(StreamSource)(0),                       // 3: solana.benchmark.StreamSource
+	(EpochEventType)(0),                     // 4: solana.benchmark.EpochEventType
+	(StakeActivationState)(0),               // 5: solana.benchmark.StakeActivationState
+	(OwnedAccountEventType)(0),              // 6: solana.benchmark.OwnedAccountEventType
+	(AccountClosureReason)(0),               // 7: solana.benchmark.AccountClosureReason
+	(SLOSource)(0),                          // 8: solana.benchmark.SLOSource
+	(SLOEventType)(0),                       // 9: solana.benchmark.SLOEventType
+	(BlockEncoding)(0),                      // 10: solana.benchmark.BlockEncoding
+	(StreamBenchmarkKind)(0),                // 11: solana.benchmark.StreamBenchmarkKind
+	(BlockDetail)(0),                        // 12: solana.benchmark.BlockDetail
+	(StreamQoS)(0),                          // 13: solana.benchmark.StreamQoS
+	(PollChangeDetection)(0),                // 14: solana.benchmark.PollChangeDetection
+	(TransactionFailureKind)(0),             // 15: solana.benchmark.TransactionFailureKind
+	(AlertConditionType)(0),                 // 16: solana.benchmark.AlertConditionType
+	(SlotStatus)(0),                         // 17: solana.benchmark.SlotStatus
+	(OutlierHandling)(0),                    // 18: solana.benchmark.OutlierHandling
+	(BenchmarkJobState)(0),                  // 19: solana.benchmark.BenchmarkJobState
+	(*AccountInfoRequest)(nil),              // 20: solana.benchmark.AccountInfoRequest
+	(*AccountInfoResponse)(nil),             // 21: solana.benchmark.AccountInfoResponse
+	(*TimingBreakdown)(nil),                 // 22: solana.benchmark.TimingBreakdown
+	(*NonceAccountRequest)(nil),             // 23: solana.benchmark.NonceAccountRequest
+	(*NonceAccountResponse)(nil),            // 24: solana.benchmark.NonceAccountResponse
+	(*ConsistentReadRequest)(nil),           // 25: solana.benchmark.ConsistentReadRequest
+	(*ConsistentAccount)(nil),               // 26: solana.benchmark.ConsistentAccount
+	(*ConsistentReadResponse)(nil),          // 27: solana.benchmark.ConsistentReadResponse
+	(*TokenAccountRequest)(nil),             // 28: solana.benchmark.TokenAccountRequest
+	(*TokenAccountResponse)(nil),            // 29: solana.benchmark.TokenAccountResponse
+	(*TokenMint)(nil),                       // 30: solana.benchmark.TokenMint
+	(*TokenAccountState)(nil),               // 31: solana.benchmark.TokenAccountState
+	(*TokenExtensions)(nil),                 // 32: solana.benchmark.TokenExtensions
+	(*TransferFee)(nil),                     // 33: solana.benchmark.TransferFee
+	(*TransferFeeConfig)(nil),               // 34: solana.benchmark.TransferFeeConfig
+	(*TransferFeeAmount)(nil),               // 35: solana.benchmark.TransferFeeAmount
+	(*InterestBearingConfig)(nil),           // 36: solana.benchmark.InterestBearingConfig
+	(*MetadataPointer)(nil),                 // 37: solana.benchmark.MetadataPointer
+	(*NFTsByOwnerRequest)(nil),              // 38: solana.benchmark.NFTsByOwnerRequest
+	(*NFTsByOwnerResponse)(nil),             // 39: solana.benchmark.NFTsByOwnerResponse
+	(*NFT)(nil),                             // 40: solana.benchmark.NFT
+	(*NFTMetadata)(nil),                     // 41: solana.benchmark.NFTMetadata
+	(*NFTCreator)(nil),                      // 42: solana.benchmark.NFTCreator
+	(*AssetRequest)(nil),                    // 43: solana.benchmark.AssetRequest
+	(*AssetsByOwnerRequest)(nil),            // 44: solana.benchmark.AssetsByOwnerRequest
+	(*Asset)(nil),                           // 45: solana.benchmark.Asset
+	(*AssetResponse)(nil),                   // 46: solana.benchmark.AssetResponse
+	(*AssetsByOwnerResponse)(nil),           // 47: solana.benchmark.AssetsByOwnerResponse
+	(*AssetProofRequest)(nil),               // 48: solana.benchmark.AssetProofRequest
+	(*AssetProofResponse)(nil),              // 49: solana.benchmark.AssetProofResponse
+	(*FindProgramAddressRequest)(nil),       // 50: solana.benchmark.FindProgramAddressRequest
+	(*CreateWithSeedRequest)(nil),           // 51: solana.benchmark.CreateWithSeedRequest
+	(*AssociatedTokenAddressRequest)(nil),   // 52: solana.benchmark.AssociatedTokenAddressRequest
+	(*DerivedAddress)(nil),                  // 53: solana.benchmark.DerivedAddress
+	(*ComputeBudget)(nil),                   // 54: solana.benchmark.ComputeBudget
+	(*TransferTransactionRequest)(nil),      // 55: solana.benchmark.TransferTransactionRequest
+	(*TokenTransferTransactionRequest)(nil), // 56: solana.benchmark.TokenTransferTransactionRequest
+	(*BuiltTransaction)(nil),                // 57: solana.benchmark.BuiltTransaction
+	(*VerifySignatureRequest)(nil),          // 58: solana.benchmark.VerifySignatureRequest
+	(*VerifySignatureResponse)(nil),         // 59: solana.benchmark.VerifySignatureResponse
+	(*DecodeTransactionRequest)(nil),        // 60: solana.benchmark.DecodeTransactionRequest
+	(*DecodedTransaction)(nil),              // 61: solana.benchmark.DecodedTransaction
+	(*DecodedSignature)(nil),                // 62: solana.benchmark.DecodedSignature
+	(*DecodedAccountKey)(nil),               // 63: solana.benchmark.DecodedAccountKey
+	(*DecodedInstruction)(nil),              // 64: solana.benchmark.DecodedInstruction
+	(*DecodedAddressTableLookup)(nil),       // 65: solana.benchmark.DecodedAddressTableLookup
+	(*CurrentSlotRequest)(nil),              // 66: solana.benchmark.CurrentSlotRequest
+	(*CurrentSlot)(nil),                     // 67: solana.benchmark.CurrentSlot
+	(*UpstreamHealthRequest)(nil),           // 68: solana.benchmark.UpstreamHealthRequest
+	(*UpstreamHealth)(nil),                  // 69: solana.benchmark.UpstreamHealth
+	(*WebSocketHealth)(nil),                 // 70: solana.benchmark.WebSocketHealth
+	(*StreamStatsRequest)(nil),              // 71: solana.benchmark.StreamStatsRequest
+	(*StreamStatsResponse)(nil),             // 72: solana.benchmark.StreamStatsResponse
+	(*TopicStats)(nil),                      // 73: solana.benchmark.TopicStats
+	(*CapabilitiesRequest)(nil),             // 74: solana.benchmark.CapabilitiesRequest
+	(*Capabilities)(nil),                    // 75: solana.benchmark.Capabilities
+	(*FeatureFlag)(nil),                     // 76: solana.benchmark.FeatureFlag
+	(*StreamCapability)(nil),                // 77: solana.benchmark.StreamCapability
+	(*BlockTimeRequest)(nil),                // 78: solana.benchmark.BlockTimeRequest
+	(*BlockTimeResponse)(nil),               // 79: solana.benchmark.BlockTimeResponse
+	(*SlotAtTimeRequest)(nil),               // 80: solana.benchmark.SlotAtTimeRequest
+	(*SlotAtTimeResponse)(nil),              // 81: solana.benchmark.SlotAtTimeResponse
+	(*BlockProductionRequest)(nil),          // 82: solana.benchmark.BlockProductionRequest
+	(*BlockProductionStreamRequest)(nil),    // 83: solana.benchmark.BlockProductionStreamRequest
+	(*ValidatorProduction)(nil),             // 84: solana.benchmark.ValidatorProduction
+	(*BlockProduction)(nil),                 // 85: solana.benchmark.BlockProduction
+	(*EpochEventsRequest)(nil),              // 86: solana.benchmark.EpochEventsRequest
+	(*EpochInflation)(nil),                  // 87: solana.benchmark.EpochInflation
+	(*InflationReward)(nil),                 // 88: solana.benchmark.InflationReward
+	(*StakeActivationRequest)(nil),          // 89: solana.benchmark.StakeActivationRequest
+	(*StakeActivationResponse)(nil),         // 90: solana.benchmark.StakeActivationResponse
+	(*InflationRewardRequest)(nil),          // 91: solana.benchmark.InflationRewardRequest
+	(*EpochRewards)(nil),                    // 92: solana.benchmark.EpochRewards
+	(*InflationRewardResponse)(nil),         // 93: solana.benchmark.InflationRewardResponse
+	(*EpochEvent)(nil),                      // 94: solana.benchmark.EpochEvent
+	(*ClusterNodesRequest)(nil),             // 95: solana.benchmark.ClusterNodesRequest
+	(*ClusterNode)(nil),                     // 96: solana.benchmark.ClusterNode
+	(*ClusterNodesResponse)(nil),            // 97: solana.benchmark.ClusterNodesResponse
+	(*ListOwnedAccountsRequest)(nil),        // 98: solana.benchmark.ListOwnedAccountsRequest
+	(*OwnedAccount)(nil),                    // 99: solana.benchmark.OwnedAccount
+	(*ListOwnedAccountsResponse)(nil),       // 100: solana.benchmark.ListOwnedAccountsResponse
+	(*OwnedAccountChangesRequest)(nil),      // 101: solana.benchmark.OwnedAccountChangesRequest
+	(*OwnedAccountEvent)(nil),               // 102: solana.benchmark.OwnedAccountEvent
+	(*AccountClosure)(nil),                  // 103: solana.benchmark.AccountClosure
+	(*SLOStatusRequest)(nil),                // 104: solana.benchmark.SLOStatusRequest
+	(*SLOStatus)(nil),                       // 105: solana.benchmark.SLOStatus
+	(*SLOStatusResponse)(nil),               // 106: solana.benchmark.SLOStatusResponse
+	(*SLOEventsRequest)(nil),                // 107: solana.benchmark.SLOEventsRequest
+	(*SLOEvent)(nil),                        // 108: solana.benchmark.SLOEvent
+	(*AddressHistoryRequest)(nil),           // 109: solana.benchmark.AddressHistoryRequest
+	(*AddressHistoryUpdate)(nil),            // 110: solana.benchmark.AddressHistoryUpdate
+	(*SerializationBenchmarkRequest)(nil),   // 111: solana.benchmark.SerializationBenchmarkRequest
+	(*SerializationBenchmarkResults)(nil),   // 112: solana.benchmark.SerializationBenchmarkResults
+	(*SerializationCost)(nil),               // 113: solana.benchmark.SerializationCost
+	(*SerializedBlock)(nil),                 // 114: solana.benchmark.SerializedBlock
+	(*SerializedTransaction)(nil),           // 115: solana.benchmark.SerializedTransaction
+	(*SerializedInnerInstructions)(nil),     // 116: solana.benchmark.SerializedInnerInstructions
+	(*SerializedInstruction)(nil),           // 117: solana.benchmark.SerializedInstruction
+	(*SerializedTokenBalance)(nil),          // 118: solana.benchmark.SerializedTokenBalance
+	(*SerializedReward)(nil),                // 119: solana.benchmark.SerializedReward
+	(*EncodedBlockRequest)(nil),             // 120: solana.benchmark.EncodedBlockRequest
+	(*EncodedBlock)(nil),                    // 121: solana.benchmark.EncodedBlock
+	(*DualServeBenchmarkRequest)(nil),       // 122: solana.benchmark.DualServeBenchmarkRequest
+	(*DualServeBenchmarkResults)(nil),       // 123: solana.benchmark.DualServeBenchmarkResults
+	(*DualServeCategory)(nil),               // 124: solana.benchmark.DualServeCategory
+	(*DualServeProtocol)(nil),               // 125: solana.benchmark.DualServeProtocol
+	(*StreamBenchmarkRequest)(nil),          // 126: solana.benchmark.StreamBenchmarkRequest
+	(*StreamBenchmarkResults)(nil),          // 127: solana.benchmark.StreamBenchmarkResults
+	(*StreamBenchmarkCategory)(nil),         // 128: solana.benchmark.StreamBenchmarkCategory
+	(*StreamBenchmarkTransport)(nil),        // 129: solana.benchmark.StreamBenchmarkTransport
+	(*TransactionRequest)(nil),              // 130: solana.benchmark.TransactionRequest
+	(*TransactionResponse)(nil),             // 131: solana.benchmark.TransactionResponse
+	(*BlockRequest)(nil),                    // 132: solana.benchmark.BlockRequest
+	(*BlockResponse)(nil),                   // 133: solana.benchmark.BlockResponse
+	(*AccountStreamRequest)(nil),            // 134: solana.benchmark.AccountStreamRequest
+	(*AccountUpdateBatch)(nil),              // 135: solana.benchmark.AccountUpdateBatch
+	(*AccountUpdate)(nil),                   // 136: solana.benchmark.AccountUpdate
+	(*AccountHistoryRequest)(nil),           // 137: solana.benchmark.AccountHistoryRequest
+	(*AccountHistoryResponse)(nil),          // 138: solana.benchmark.AccountHistoryResponse
+	(*AccountAckStreamRequest)(nil),         // 139: solana.benchmark.AccountAckStreamRequest
+	(*AckedAccountUpdate)(nil),              // 140: solana.benchmark.AckedAccountUpdate
+	(*AckSessionHandoffRequest)(nil),        // 141: solana.benchmark.AckSessionHandoffRequest
+	(*AckSessionHandoff)(nil),               // 142: solana.benchmark.AckSessionHandoff
+	(*ProgramAccountsSnapshotRequest)(nil),  // 143: solana.benchmark.ProgramAccountsSnapshotRequest
+	(*ProgramAccountsChunk)(nil),            // 144: solana.benchmark.ProgramAccountsChunk
+	(*CommitmentLatencyRequest)(nil),        // 145: solana.benchmark.CommitmentLatencyRequest
+	(*CommitmentLatencySample)(nil),         // 146: solana.benchmark.CommitmentLatencySample
+	(*TransactionStreamRequest)(nil),        // 147: solana.benchmark.TransactionStreamRequest
+	(*TransactionUpdate)(nil),               // 148: solana.benchmark.TransactionUpdate
+	(*TransactionFailure)(nil),              // 149: solana.benchmark.TransactionFailure
+	(*SwapEvent)(nil),                       // 150: solana.benchmark.SwapEvent
+	(*TokenTransfer)(nil),                   // 151: solana.benchmark.TokenTransfer
+	(*BlockStreamRequest)(nil),              // 152: solana.benchmark.BlockStreamRequest
+	(*BalanceChangeStreamRequest)(nil),      // 153: solana.benchmark.BalanceChangeStreamRequest
+	(*BalanceChange)(nil),                   // 154: solana.benchmark.BalanceChange
+	(*BlockBalanceChanges)(nil),             // 155: solana.benchmark.BlockBalanceChanges
+	(*AlertCondition)(nil),                  // 156: solana.benchmark.AlertCondition
+	(*AlertStreamRequest)(nil),              // 157: solana.benchmark.AlertStreamRequest
+	(*Alert)(nil),                           // 158: solana.benchmark.Alert
+	(*FeeStatsRequest)(nil),                 // 159: solana.benchmark.FeeStatsRequest
+	(*FeePercentiles)(nil),                  // 160: solana.benchmark.FeePercentiles
+	(*FeeStats)(nil),                        // 161: solana.benchmark.FeeStats
+	(*BlockUpdate)(nil),                     // 162: solana.benchmark.BlockUpdate
+	(*ReorgEvent)(nil),                      // 163: solana.benchmark.ReorgEvent
+	(*BenchmarkRequest)(nil),                // 164: solana.benchmark.BenchmarkRequest
+	(*LatencyStats)(nil),                    // 165: solana.benchmark.LatencyStats
+	(*BenchmarkResults)(nil),                // 166: solana.benchmark.BenchmarkResults
+	(*BlockTierBenchmark)(nil),              // 167: solana.benchmark.BlockTierBenchmark
+	(*BenchmarkEnvironment)(nil),            // 168: solana.benchmark.BenchmarkEnvironment
+	(*RuntimeStats)(nil),                    // 169: solana.benchmark.RuntimeStats
+	(*AccountBenchmark)(nil),                // 170: solana.benchmark.AccountBenchmark
+	(*TransactionBenchmark)(nil),            // 171: solana.benchmark.TransactionBenchmark
+	(*BlockBenchmark)(nil),                  // 172: solana.benchmark.BlockBenchmark
+	(*ClusterNodesBenchmark)(nil),           // 173: solana.benchmark.ClusterNodesBenchmark
+	(*BenchmarkSummary)(nil),                // 174: solana.benchmark.BenchmarkSummary
+	(*SignificanceTest)(nil),                // 175: solana.benchmark.SignificanceTest
+	(*BenchmarkJob)(nil),                    // 176: solana.benchmark.BenchmarkJob
+	(*BenchmarkJobRequest)(nil),             // 177: solana.benchmark.BenchmarkJobRequest
+	(*BenchmarkProgress)(nil),               // 178: solana.benchmark.BenchmarkProgress
+	(*BenchmarkJobResult)(nil),              // 179: solana.benchmark.BenchmarkJobResult
+	(*ListBenchmarksRequest)(nil),           // 180: solana.benchmark.ListBenchmarksRequest
+	(*BenchmarkScenario)(nil),               // 181: solana.benchmark.BenchmarkScenario
+	(*ScheduledBenchmarkRun)(nil),           // 182: solana.benchmark.ScheduledBenchmarkRun
+	(*ListBenchmarksResponse)(nil),          // 183: solana.benchmark.ListBenchmarksResponse
+	nil,                                     // 184: solana.benchmark.ClusterNodesResponse.VersionsEntry
+	(*fieldmaskpb.FieldMask)(nil),           // 185: google.protobuf.FieldMask
+}
+var file_proto_solana_benchmark_proto_depIdxs = []int32{
+	185, // 0: solana.benchmark.AccountInfoRequest.field_mask:type_name -> google.protobuf.FieldMask
+	22,  // 1: solana.benchmark.AccountInfoResponse.timing:type_name -> solana.benchmark.TimingBreakdown
+	26,  // 2: solana.benchmark.ConsistentReadResponse.accounts:type_name -> solana.benchmark.ConsistentAccount
+	30,  // 3: solana.benchmark.TokenAccountResponse.mint:type_name -> solana.benchmark.TokenMint
+	31,  // 4: solana.benchmark.TokenAccountResponse.account:type_name -> solana.benchmark.TokenAccountState
+	32,  // 5: solana.benchmark.TokenAccountResponse.extensions:type_name -> solana.benchmark.TokenExtensions
+	0,   // 6: solana.benchmark.TokenAccountState.status:type_name -> solana.benchmark.TokenAccountStatus
+	34,  // 7: solana.benchmark.TokenExtensions.transfer_fee_config:type_name -> solana.benchmark.TransferFeeConfig
+	35,  // 8: solana.benchmark.TokenExtensions.transfer_fee_amount:type_name -> solana.benchmark.TransferFeeAmount
+	36,  // 9: solana.benchmark.TokenExtensions.interest_bearing_config:type_name -> solana.benchmark.InterestBearingConfig
+	37,  // 10: solana.benchmark.TokenExtensions.metadata_pointer:type_name -> solana.benchmark.MetadataPointer
+	33,  // 11: solana.benchmark.TransferFeeConfig.older_transfer_fee:type_name -> solana.benchmark.TransferFee
+	33,  // 12: solana.benchmark.TransferFeeConfig.newer_transfer_fee:type_name -> solana.benchmark.TransferFee
+	40,  // 13: solana.benchmark.NFTsByOwnerResponse.nfts:type_name -> solana.benchmark.NFT
+	41,  // 14: solana.benchmark.NFT.metadata:type_name -> solana.benchmark.NFTMetadata
+	42,  // 15: solana.benchmark.NFTMetadata.creators:type_name -> solana.benchmark.NFTCreator
+	45,  // 16: solana.benchmark.AssetResponse.asset:type_name -> solana.benchmark.Asset
+	45,  // 17: solana.benchmark.AssetsByOwnerResponse.assets:type_name -> solana.benchmark.Asset
+	54,  // 18: solana.benchmark.TransferTransactionRequest.compute_budget:type_name -> solana.benchmark.ComputeBudget
+	54,  // 19: solana.benchmark.TokenTransferTransactionRequest.compute_budget:type_name -> solana.benchmark.ComputeBudget
+	1,   // 20: solana.benchmark.DecodeTransactionRequest.encoding:type_name -> solana.benchmark.TransactionEncoding
+	62,  // 21: solana.benchmark.DecodedTransaction.signatures:type_name -> solana.benchmark.DecodedSignature
+	63,  // 22: solana.benchmark.DecodedTransaction.account_keys:type_name -> solana.benchmark.DecodedAccountKey
+	64,  // 23: solana.benchmark.DecodedTransaction.instructions:type_name -> solana.benchmark.DecodedInstruction
+	65,  // 24: solana.benchmark.DecodedTransaction.address_table_lookups:type_name -> solana.benchmark.DecodedAddressTableLookup
+	70,  // 25: solana.benchmark.UpstreamHealth.websocket:type_name -> solana.benchmark.WebSocketHealth
+	2,   // 26: solana.benchmark.WebSocketHealth.state:type_name -> solana.benchmark.WebSocketState
+	73,  // 27: solana.benchmark.StreamStatsResponse.topics:type_name -> solana.benchmark.TopicStats
+	77,  // 28: solana.benchmark.Capabilities.streams:type_name -> solana.benchmark.StreamCapability
+	76,  // 29: solana.benchmark.Capabilities.features:type_name -> solana.benchmark.FeatureFlag
+	3,   // 30: solana.benchmark.StreamCapability.source:type_name -> solana.benchmark.StreamSource
+	84,  // 31: solana.benchmark.BlockProduction.validators:type_name -> solana.benchmark.ValidatorProduction
+	5,   // 32: solana.benchmark.StakeActivationResponse.state:type_name -> solana.benchmark.StakeActivationState
+	88,  // 33: solana.benchmark.EpochRewards.rewards:type_name -> solana.benchmark.InflationReward
+	92,  // 34: solana.benchmark.InflationRewardResponse.epochs:type_name -> solana.benchmark.EpochRewards
+	4,   // 35: solana.benchmark.EpochEvent.type:type_name -> solana.benchmark.EpochEventType
+	87,  // 36: solana.benchmark.EpochEvent.inflation:type_name -> solana.benchmark.EpochInflation
+	88,  // 37: solana.benchmark.EpochEvent.rewards:type_name -> solana.benchmark.InflationReward
+	96,  // 38: solana.benchmark.ClusterNodesResponse.nodes:type_name -> solana.benchmark.ClusterNode
+	184, // 39: solana.benchmark.ClusterNodesResponse.versions:type_name -> solana.benchmark.ClusterNodesResponse.VersionsEntry
+	99,  // 40: solana.benchmark.ListOwnedAccountsResponse.accounts:type_name -> solana.benchmark.OwnedAccount
+	6,   // 41: solana.benchmark.OwnedAccountEvent.type:type_name -> solana.benchmark.OwnedAccountEventType
+	99,  // 42: solana.benchmark.OwnedAccountEvent.account:type_name -> solana.benchmark.OwnedAccount
+	103, // 43: solana.benchmark.OwnedAccountEvent.closure:type_name -> solana.benchmark.AccountClosure
+	7,   // 44: solana.benchmark.AccountClosure.reason:type_name -> solana.benchmark.AccountClosureReason
+	8,   // 45: solana.benchmark.SLOStatus.source:type_name -> solana.benchmark.SLOSource
+	105, // 46: solana.benchmark.SLOStatusResponse.objectives:type_name -> solana.benchmark.SLOStatus
+	9,   // 47: solana.benchmark.SLOEvent.type:type_name -> solana.benchmark.SLOEventType
+	105, // 48: solana.benchmark.SLOEvent.status:type_name -> solana.benchmark.SLOStatus
+	13,  // 49: solana.benchmark.AddressHistoryRequest.qos:type_name -> solana.benchmark.StreamQoS
+	148, // 50: solana.benchmark.AddressHistoryUpdate.transaction:type_name -> solana.benchmark.TransactionUpdate
+	113, // 51: solana.benchmark.SerializationBenchmarkResults.protobuf:type_name -> solana.benchmark.SerializationCost
+	113, // 52: solana.benchmark.SerializationBenchmarkResults.json:type_name -> solana.benchmark.SerializationCost
+	113, // 53: solana.benchmark.SerializationBenchmarkResults.flatbuffers:type_name -> solana.benchmark.SerializationCost
+	169, // 54: solana.benchmark.SerializationCost.encode_runtime:type_name -> solana.benchmark.RuntimeStats
+	169, // 55: solana.benchmark.SerializationCost.decode_runtime:type_name -> solana.benchmark.RuntimeStats
+	115, // 56: solana.benchmark.SerializedBlock.transactions:type_name -> solana.benchmark.SerializedTransaction
+	119, // 57: solana.benchmark.SerializedBlock.rewards:type_name -> solana.benchmark.SerializedReward
+	116, // 58: solana.benchmark.SerializedTransaction.inner_instructions:type_name -> solana.benchmark.SerializedInnerInstructions
+	118, // 59: solana.benchmark.SerializedTransaction.pre_token_balances:type_name -> solana.benchmark.SerializedTokenBalance
+	118, // 60: solana.benchmark.SerializedTransaction.post_token_balances:type_name -> solana.benchmark.SerializedTokenBalance
+	119, // 61: solana.benchmark.SerializedTransaction.rewards:type_name -> solana.benchmark.SerializedReward
+	117, // 62: solana.benchmark.SerializedInnerInstructions.instructions:type_name -> solana.benchmark.SerializedInstruction
+	10,  // 63: solana.benchmark.EncodedBlockRequest.encoding:type_name -> solana.benchmark.BlockEncoding
+	10,  // 64: solana.benchmark.EncodedBlock.encoding:type_name -> solana.benchmark.BlockEncoding
+	124, // 65: solana.benchmark.DualServeBenchmarkResults.account:type_name -> solana.benchmark.DualServeCategory
+	124, // 66: solana.benchmark.DualServeBenchmarkResults.transaction:type_name -> solana.benchmark.DualServeCategory
+	124, // 67: solana.benchmark.DualServeBenchmarkResults.block:type_name -> solana.benchmark.DualServeCategory
+	125, // 68: solana.benchmark.DualServeCategory.grpc:type_name -> solana.benchmark.DualServeProtocol
+	125, // 69: solana.benchmark.DualServeCategory.json:type_name -> solana.benchmark.DualServeProtocol
+	11,  // 70: solana.benchmark.StreamBenchmarkRequest.kinds:type_name -> solana.benchmark.StreamBenchmarkKind
+	128, // 71: solana.benchmark.StreamBenchmarkResults.categories:type_name -> solana.benchmark.StreamBenchmarkCategory
+	11,  // 72: solana.benchmark.StreamBenchmarkCategory.kind:type_name -> solana.benchmark.StreamBenchmarkKind
+	129, // 73: solana.benchmark.StreamBenchmarkCategory.grpc:type_name -> solana.benchmark.StreamBenchmarkTransport
+	129, // 74: solana.benchmark.StreamBenchmarkCategory.websocket:type_name -> solana.benchmark.StreamBenchmarkTransport
+	185, // 75: solana.benchmark.TransactionRequest.field_mask:type_name -> google.protobuf.FieldMask
+	22,  // 76: solana.benchmark.TransactionResponse.timing:type_name -> solana.benchmark.TimingBreakdown
+	185, // 77: solana.benchmark.BlockRequest.field_mask:type_name -> google.protobuf.FieldMask
+	12,  // 78: solana.benchmark.BlockRequest.detail:type_name -> solana.benchmark.BlockDetail
+	22,  // 79: solana.benchmark.BlockResponse.timing:type_name -> solana.benchmark.TimingBreakdown
+	13,  // 80: solana.benchmark.AccountStreamRequest.qos:type_name -> solana.benchmark.StreamQoS
+	185, // 81: solana.benchmark.AccountStreamRequest.field_mask:type_name -> google.protobuf.FieldMask
+	136, // 82: solana.benchmark.AccountUpdateBatch.updates:type_name -> solana.benchmark.AccountUpdate
+	22,  // 83: solana.benchmark.AccountUpdate.timing:type_name -> solana.benchmark.TimingBreakdown
+	136, // 84: solana.benchmark.AccountHistoryResponse.states:type_name -> solana.benchmark.AccountUpdate
+	134, // 85: solana.benchmark.AccountAckStreamRequest.subscription:type_name -> solana.benchmark.AccountStreamRequest
+	136, // 86: solana.benchmark.AckedAccountUpdate.update:type_name -> solana.benchmark.AccountUpdate
+	134, // 87: solana.benchmark.AckSessionHandoff.subscription:type_name -> solana.benchmark.AccountStreamRequest
+	140, // 88: solana.benchmark.AckSessionHandoff.pending:type_name -> solana.benchmark.AckedAccountUpdate
+	13,  // 89: solana.benchmark.ProgramAccountsSnapshotRequest.qos:type_name -> solana.benchmark.StreamQoS
+	14,  // 90: solana.benchmark.ProgramAccountsSnapshotRequest.change_detection:type_name -> solana.benchmark.PollChangeDetection
+	136, // 91: solana.benchmark.ProgramAccountsChunk.accounts:type_name -> solana.benchmark.AccountUpdate
+	13,  // 92: solana.benchmark.TransactionStreamRequest.qos:type_name -> solana.benchmark.StreamQoS
+	185, // 93: solana.benchmark.TransactionStreamRequest.field_mask:type_name -> google.protobuf.FieldMask
+	151, // 94: solana.benchmark.TransactionUpdate.token_transfers:type_name -> solana.benchmark.TokenTransfer
+	150, // 95: solana.benchmark.TransactionUpdate.swaps:type_name -> solana.benchmark.SwapEvent
+	22,  // 96: solana.benchmark.TransactionUpdate.timing:type_name -> solana.benchmark.TimingBreakdown
+	149, // 97: solana.benchmark.TransactionUpdate.failure:type_name -> solana.benchmark.TransactionFailure
+	15,  // 98: solana.benchmark.TransactionFailure.kind:type_name -> solana.benchmark.TransactionFailureKind
+	13,  // 99: solana.benchmark.BlockStreamRequest.qos:type_name -> solana.benchmark.StreamQoS
+	185, // 100: solana.benchmark.BlockStreamRequest.field_mask:type_name -> google.protobuf.FieldMask
+	13,  // 101: solana.benchmark.BalanceChangeStreamRequest.qos:type_name -> solana.benchmark.StreamQoS
+	17,  // 102: solana.benchmark.BlockBalanceChanges.status:type_name -> solana.benchmark.SlotStatus
+	154, // 103: solana.benchmark.BlockBalanceChanges.changes:type_name -> solana.benchmark.BalanceChange
+	16,  // 104: solana.benchmark.AlertCondition.type:type_name -> solana.benchmark.AlertConditionType
+	156, // 105: solana.benchmark.AlertStreamRequest.conditions:type_name -> solana.benchmark.AlertCondition
+	13,  // 106: solana.benchmark.AlertStreamRequest.qos:type_name -> solana.benchmark.StreamQoS
+	16,  // 107: solana.benchmark.Alert.type:type_name -> solana.benchmark.AlertConditionType
+	13,  // 108: solana.benchmark.FeeStatsRequest.qos:type_name -> solana.benchmark.StreamQoS
+	160, // 109: solana.benchmark.FeeStats.priority_fee_lamports:type_name -> solana.benchmark.FeePercentiles
+	160, // 110: solana.benchmark.FeeStats.compute_unit_price:type_name -> solana.benchmark.FeePercentiles
+	17,  // 111: solana.benchmark.BlockUpdate.status:type_name -> solana.benchmark.SlotStatus
+	163, // 112: solana.benchmark.BlockUpdate.reorg:type_name -> solana.benchmark.ReorgEvent
+	22,  // 113: solana.benchmark.BlockUpdate.timing:type_name -> solana.benchmark.TimingBreakdown
+	18,  // 114: solana.benchmark.BenchmarkRequest.outlier_handling:type_name -> solana.benchmark.OutlierHandling
+	12,  // 115: solana.benchmark.BenchmarkRequest.block_details:type_name -> solana.benchmark.BlockDetail
+	170, // 116: solana.benchmark.BenchmarkResults.account_grpc:type_name -> solana.benchmark.AccountBenchmark
+	170, // 117: solana.benchmark.BenchmarkResults.account_jsonrpc:type_name -> solana.benchmark.AccountBenchmark
+	171, // 118: solana.benchmark.BenchmarkResults.transaction_grpc:type_name -> solana.benchmark.TransactionBenchmark
+	171, // 119: solana.benchmark.BenchmarkResults.transaction_jsonrpc:type_name -> solana.benchmark.TransactionBenchmark
+	172, // 120: solana.benchmark.BenchmarkResults.block_grpc:type_name -> solana.benchmark.BlockBenchmark
+	172, // 121: solana.benchmark.BenchmarkResults.block_jsonrpc:type_name -> solana.benchmark.BlockBenchmark
+	174, // 122: solana.benchmark.BenchmarkResults.summary:type_name -> solana.benchmark.BenchmarkSummary
+	168, // 123: solana.benchmark.BenchmarkResults.environment:type_name -> solana.benchmark.BenchmarkEnvironment
+	167, // 124: solana.benchmark.BenchmarkResults.block_tiers:type_name -> solana.benchmark.BlockTierBenchmark
+	173, // 125: solana.benchmark.BenchmarkResults.cluster_nodes_grpc:type_name -> solana.benchmark.ClusterNodesBenchmark
+	173, // 126: solana.benchmark.BenchmarkResults.cluster_nodes_jsonrpc:type_name -> solana.benchmark.ClusterNodesBenchmark
+	12,  // 127: solana.benchmark.BlockTierBenchmark.detail:type_name -> solana.benchmark.BlockDetail
+	172, // 128: solana.benchmark.BlockTierBenchmark.grpc:type_name -> solana.benchmark.BlockBenchmark
+	172, // 129: solana.benchmark.BlockTierBenchmark.jsonrpc:type_name -> solana.benchmark.BlockBenchmark
+	165, // 130: solana.benchmark.AccountBenchmark.adjusted:type_name -> solana.benchmark.LatencyStats
+	169, // 131: solana.benchmark.AccountBenchmark.runtime:type_name -> solana.benchmark.RuntimeStats
+	165, // 132: solana.benchmark.AccountBenchmark.distribution:type_name -> solana.benchmark.LatencyStats
+	165, // 133: solana.benchmark.TransactionBenchmark.adjusted:type_name -> solana.benchmark.LatencyStats
+	169, // 134: solana.benchmark.TransactionBenchmark.runtime:type_name -> solana.benchmark.RuntimeStats
+	165, // 135: solana.benchmark.TransactionBenchmark.distribution:type_name -> solana.benchmark.LatencyStats
+	165, // 136: solana.benchmark.BlockBenchmark.adjusted:type_name -> solana.benchmark.LatencyStats
+	169, // 137: solana.benchmark.BlockBenchmark.runtime:type_name -> solana.benchmark.RuntimeStats
+	165, // 138: solana.benchmark.BlockBenchmark.distribution:type_name -> solana.benchmark.LatencyStats
+	165, // 139: solana.benchmark.ClusterNodesBenchmark.adjusted:type_name -> solana.benchmark.LatencyStats
+	169, // 140: solana.benchmark.ClusterNodesBenchmark.runtime:type_name -> solana.benchmark.RuntimeStats
+	165, // 141: solana.benchmark.ClusterNodesBenchmark.distribution:type_name -> solana.benchmark.LatencyStats
+	175, // 142: solana.benchmark.BenchmarkSummary.significance:type_name -> solana.benchmark.SignificanceTest
+	19,  // 143: solana.benchmark.BenchmarkJob.state:type_name -> solana.benchmark.BenchmarkJobState
+	19,  // 144: solana.benchmark.BenchmarkProgress.state:type_name -> solana.benchmark.BenchmarkJobState
+	19,  // 145: solana.benchmark.BenchmarkJobResult.state:type_name -> solana.benchmark.BenchmarkJobState
+	166, // 146: solana.benchmark.BenchmarkJobResult.results:type_name -> solana.benchmark.BenchmarkResults
+	164, // 147: solana.benchmark.BenchmarkJobResult.request:type_name -> solana.benchmark.BenchmarkRequest
+	164, // 148: solana.benchmark.BenchmarkScenario.request:type_name -> solana.benchmark.BenchmarkRequest
+	19,  // 149: solana.benchmark.ScheduledBenchmarkRun.state:type_name -> solana.benchmark.BenchmarkJobState
+	166, // 150: solana.benchmark.ScheduledBenchmarkRun.results:type_name -> solana.benchmark.BenchmarkResults
+	182, // 151: solana.benchmark.ListBenchmarksResponse.runs:type_name -> solana.benchmark.ScheduledBenchmarkRun
+	181, // 152: solana.benchmark.ListBenchmarksResponse.scenarios:type_name -> solana.benchmark.BenchmarkScenario
+	20,  // 153: solana.benchmark.BenchmarkService.GetAccountInfo:input_type -> solana.benchmark.AccountInfoRequest
+	23,  // 154: solana.benchmark.BenchmarkService.GetNonceAccount:input_type -> solana.benchmark.NonceAccountRequest
+	25,  // 155: solana.benchmark.BenchmarkService.ReadConsistent:input_type -> solana.benchmark.ConsistentReadRequest
+	28,  // 156: solana.benchmark.BenchmarkService.GetTokenAccount:input_type -> solana.benchmark.TokenAccountRequest
+	38,  // 157: solana.benchmark.BenchmarkService.GetNFTsByOwner:input_type -> solana.benchmark.NFTsByOwnerRequest
+	43,  // 158: solana.benchmark.BenchmarkService.GetAsset:input_type -> solana.benchmark.AssetRequest
+	44,  // 159: solana.benchmark.BenchmarkService.GetAssetsByOwner:input_type -> solana.benchmark.AssetsByOwnerRequest
+	48,  // 160: solana.benchmark.BenchmarkService.GetAssetProof:input_type -> solana.benchmark.AssetProofRequest
+	55,  // 161: solana.benchmark.BenchmarkService.BuildTransferTransaction:input_type -> solana.benchmark.TransferTransactionRequest
+	56,  // 162: solana.benchmark.BenchmarkService.BuildTokenTransferTransaction:input_type -> solana.benchmark.TokenTransferTransactionRequest
+	130, // 163: solana.benchmark.BenchmarkService.GetTransaction:input_type -> solana.benchmark.TransactionRequest
+	132, // 164: solana.benchmark.BenchmarkService.GetBlock:input_type -> solana.benchmark.BlockRequest
+	134, // 165: solana.benchmark.BenchmarkService.StreamAccountUpdates:input_type -> solana.benchmark.AccountStreamRequest
+	134, // 166: solana.benchmark.BenchmarkService.StreamAccountUpdateBatches:input_type -> solana.benchmark.AccountStreamRequest
+	139, // 167: solana.benchmark.BenchmarkService.StreamAccountUpdatesWithAck:input_type -> solana.benchmark.AccountAckStreamRequest
+	143, // 168: solana.benchmark.BenchmarkService.StreamProgramAccountsSnapshot:input_type -> solana.benchmark.ProgramAccountsSnapshotRequest
+	145, // 169: solana.benchmark.BenchmarkService.MeasureCommitmentLatency:input_type -> solana.benchmark.CommitmentLatencyRequest
+	147, // 170: solana.benchmark.BenchmarkService.StreamTransactions:input_type -> solana.benchmark.TransactionStreamRequest
+	152, // 171: solana.benchmark.BenchmarkService.StreamBlocks:input_type -> solana.benchmark.BlockStreamRequest
+	153, // 172: solana.benchmark.BenchmarkService.StreamBalanceChanges:input_type -> solana.benchmark.BalanceChangeStreamRequest
+	157, // 173: solana.benchmark.BenchmarkService.StreamAlerts:input_type -> solana.benchmark.AlertStreamRequest
+	159, // 174: solana.benchmark.BenchmarkService.StreamFeeStats:input_type -> solana.benchmark.FeeStatsRequest
+	164, // 175: solana.benchmark.BenchmarkService.RunBenchmark:input_type -> solana.benchmark.BenchmarkRequest
+	164, // 176: solana.benchmark.BenchmarkService.StartBenchmark:input_type -> solana.benchmark.BenchmarkRequest
+	177, // 177: solana.benchmark.BenchmarkService.StreamBenchmarkProgress:input_type -> solana.benchmark.BenchmarkJobRequest
+	177, // 178: solana.benchmark.BenchmarkService.GetBenchmarkResult:input_type -> solana.benchmark.BenchmarkJobRequest
+	177, // 179: solana.benchmark.BenchmarkService.CancelBenchmark:input_type -> solana.benchmark.BenchmarkJobRequest
+	137, // 180: solana.benchmark.BenchmarkService.GetAccountHistory:input_type -> solana.benchmark.AccountHistoryRequest
+	68,  // 181: solana.benchmark.BenchmarkService.GetUpstreamHealth:input_type -> solana.benchmark.UpstreamHealthRequest
+	71,  // 182: solana.benchmark.BenchmarkService.GetStreamStats:input_type -> solana.benchmark.StreamStatsRequest
+	74,  // 183: solana.benchmark.BenchmarkService.GetCapabilities:input_type -> solana.benchmark.CapabilitiesRequest
+	78,  // 184: solana.benchmark.BenchmarkService.GetBlockTime:input_type -> solana.benchmark.BlockTimeRequest
+	80,  // 185: solana.benchmark.BenchmarkService.EstimateSlotAtTime:input_type -> solana.benchmark.SlotAtTimeRequest
+	109, // 186: solana.benchmark.BenchmarkService.StreamAddressHistory:input_type -> solana.benchmark.AddressHistoryRequest
+	141, // 187: solana.benchmark.BenchmarkService.HandoffAckSession:input_type -> solana.benchmark.AckSessionHandoffRequest
+	111, // 188: solana.benchmark.BenchmarkService.BenchmarkSerialization:input_type -> solana.benchmark.SerializationBenchmarkRequest
+	120, // 189: solana.benchmark.BenchmarkService.GetEncodedBlock:input_type -> solana.benchmark.EncodedBlockRequest
+	122, // 190: solana.benchmark.BenchmarkService.BenchmarkDualServe:input_type -> solana.benchmark.DualServeBenchmarkRequest
+	126, // 191: solana.benchmark.BenchmarkService.StreamBenchmark:input_type -> solana.benchmark.StreamBenchmarkRequest
+	66,  // 192: solana.benchmark.BenchmarkService.GetCurrentSlot:input_type -> solana.benchmark.CurrentSlotRequest
+	82,  // 193: solana.benchmark.BenchmarkService.GetBlockProduction:input_type -> solana.benchmark.BlockProductionRequest
+	83,  // 194: solana.benchmark.BenchmarkService.StreamBlockProduction:input_type -> solana.benchmark.BlockProductionStreamRequest
+	95,  // 195: solana.benchmark.BenchmarkService.GetClusterNodes:input_type -> solana.benchmark.ClusterNodesRequest
+	86,  // 196: solana.benchmark.BenchmarkService.StreamEpochEvents:input_type -> solana.benchmark.EpochEventsRequest
+	89,  // 197: solana.benchmark.BenchmarkService.GetStakeActivation:input_type -> solana.benchmark.StakeActivationRequest
+	91,  // 198: solana.benchmark.BenchmarkService.GetInflationReward:input_type -> solana.benchmark.InflationRewardRequest
+	98,  // 199: solana.benchmark.BenchmarkService.ListOwnedAccounts:input_type -> solana.benchmark.ListOwnedAccountsRequest
+	101, // 200: solana.benchmark.BenchmarkService.StreamOwnedAccountChanges:input_type -> solana.benchmark.OwnedAccountChangesRequest
+	104, // 201: solana.benchmark.BenchmarkService.GetSLOStatus:input_type -> solana.benchmark.SLOStatusRequest
+	107, // 202: solana.benchmark.BenchmarkService.StreamSLOEvents:input_type -> solana.benchmark.SLOEventsRequest
+	180, // 203: solana.benchmark.BenchmarkService.ListBenchmarks:input_type -> solana.benchmark.ListBenchmarksRequest
+	50,  // 204: solana.benchmark.UtilsService.FindProgramAddress:input_type -> solana.benchmark.FindProgramAddressRequest
+	51,  // 205: solana.benchmark.UtilsService.CreateWithSeed:input_type -> solana.benchmark.CreateWithSeedRequest
+	52,  // 206: solana.benchmark.UtilsService.GetAssociatedTokenAddress:input_type -> solana.benchmark.AssociatedTokenAddressRequest
+	58,  // 207: solana.benchmark.UtilsService.VerifySignature:input_type -> solana.benchmark.VerifySignatureRequest
+	60,  // 208: solana.benchmark.UtilsService.DecodeTransaction:input_type -> solana.benchmark.DecodeTransactionRequest
+	21,  // 209: solana.benchmark.BenchmarkService.GetAccountInfo:output_type -> solana.benchmark.AccountInfoResponse
+	24,  // 210: solana.benchmark.BenchmarkService.GetNonceAccount:output_type -> solana.benchmark.NonceAccountResponse
+	27,  // 211: solana.benchmark.BenchmarkService.ReadConsistent:output_type -> solana.benchmark.ConsistentReadResponse
+	29,  // 212: solana.benchmark.BenchmarkService.GetTokenAccount:output_type -> solana.benchmark.TokenAccountResponse
+	39,  // 213: solana.benchmark.BenchmarkService.GetNFTsByOwner:output_type -> solana.benchmark.NFTsByOwnerResponse
+	46,  // 214: solana.benchmark.BenchmarkService.GetAsset:output_type -> solana.benchmark.AssetResponse
+	47,  // 215: solana.benchmark.BenchmarkService.GetAssetsByOwner:output_type -> solana.benchmark.AssetsByOwnerResponse
+	49,  // 216: solana.benchmark.BenchmarkService.GetAssetProof:output_type -> solana.benchmark.AssetProofResponse
+	57,  // 217: solana.benchmark.BenchmarkService.BuildTransferTransaction:output_type -> solana.benchmark.BuiltTransaction
+	57,  // 218: solana.benchmark.BenchmarkService.BuildTokenTransferTransaction:output_type -> solana.benchmark.BuiltTransaction
+	131, // 219: solana.benchmark.BenchmarkService.GetTransaction:output_type -> solana.benchmark.TransactionResponse
+	133, // 220: solana.benchmark.BenchmarkService.GetBlock:output_type -> solana.benchmark.BlockResponse
+	136, // 221: solana.benchmark.BenchmarkService.StreamAccountUpdates:output_type -> solana.benchmark.AccountUpdate
+	135, // 222: solana.benchmark.BenchmarkService.StreamAccountUpdateBatches:output_type -> solana.benchmark.AccountUpdateBatch
+	140, // 223: solana.benchmark.BenchmarkService.StreamAccountUpdatesWithAck:output_type -> solana.benchmark.AckedAccountUpdate
+	144, // 224: solana.benchmark.BenchmarkService.StreamProgramAccountsSnapshot:output_type -> solana.benchmark.ProgramAccountsChunk
+	146, // 225: solana.benchmark.BenchmarkService.MeasureCommitmentLatency:output_type -> solana.benchmark.CommitmentLatencySample
+	148, // 226: solana.benchmark.BenchmarkService.StreamTransactions:output_type -> solana.benchmark.TransactionUpdate
+	162, // 227: solana.benchmark.BenchmarkService.StreamBlocks:output_type -> solana.benchmark.BlockUpdate
+	155, // 228: solana.benchmark.BenchmarkService.StreamBalanceChanges:output_type -> solana.benchmark.BlockBalanceChanges
+	158, // 229: solana.benchmark.BenchmarkService.StreamAlerts:output_type -> solana.benchmark.Alert
+	161, // 230: solana.benchmark.BenchmarkService.StreamFeeStats:output_type -> solana.benchmark.FeeStats
+	166, // 231: solana.benchmark.BenchmarkService.RunBenchmark:output_type -> solana.benchmark.BenchmarkResults
+	176, // 232: solana.benchmark.BenchmarkService.StartBenchmark:output_type -> solana.benchmark.BenchmarkJob
+	178, // 233: solana.benchmark.BenchmarkService.StreamBenchmarkProgress:output_type -> solana.benchmark.BenchmarkProgress
+	179, // 234: solana.benchmark.BenchmarkService.GetBenchmarkResult:output_type -> solana.benchmark.BenchmarkJobResult
+	176, // 235: solana.benchmark.BenchmarkService.CancelBenchmark:output_type -> solana.benchmark.BenchmarkJob
+	138, // 236: solana.benchmark.BenchmarkService.GetAccountHistory:output_type -> solana.benchmark.AccountHistoryResponse
+	69,  // 237: solana.benchmark.BenchmarkService.GetUpstreamHealth:output_type -> solana.benchmark.UpstreamHealth
+	72,  // 238: solana.benchmark.BenchmarkService.GetStreamStats:output_type -> solana.benchmark.StreamStatsResponse
+	75,  // 239: solana.benchmark.BenchmarkService.GetCapabilities:output_type -> solana.benchmark.Capabilities
+	79,  // 240: solana.benchmark.BenchmarkService.GetBlockTime:output_type -> solana.benchmark.BlockTimeResponse
+	81,  // 241: solana.benchmark.BenchmarkService.EstimateSlotAtTime:output_type -> solana.benchmark.SlotAtTimeResponse
+	110, // 242: solana.benchmark.BenchmarkService.StreamAddressHistory:output_type -> solana.benchmark.AddressHistoryUpdate
+	142, // 243: solana.benchmark.BenchmarkService.HandoffAckSession:output_type -> solana.benchmark.AckSessionHandoff
+	112, // 244: solana.benchmark.BenchmarkService.BenchmarkSerialization:output_type -> solana.benchmark.SerializationBenchmarkResults
+	121, // 245: solana.benchmark.BenchmarkService.GetEncodedBlock:output_type -> solana.benchmark.EncodedBlock
+	123, // 246: solana.benchmark.BenchmarkService.BenchmarkDualServe:output_type -> solana.benchmark.DualServeBenchmarkResults
+	127, // 247: solana.benchmark.BenchmarkService.StreamBenchmark:output_type -> solana.benchmark.StreamBenchmarkResults
+	67,  // 248: solana.benchmark.BenchmarkService.GetCurrentSlot:output_type -> solana.benchmark.CurrentSlot
+	85,  // 249: solana.benchmark.BenchmarkService.GetBlockProduction:output_type -> solana.benchmark.BlockProduction
+	85,  // 250: solana.benchmark.BenchmarkService.StreamBlockProduction:output_type -> solana.benchmark.BlockProduction
+	97,  // 251: solana.benchmark.BenchmarkService.GetClusterNodes:output_type -> solana.benchmark.ClusterNodesResponse
+	94,  // 252: solana.benchmark.BenchmarkService.StreamEpochEvents:output_type -> solana.benchmark.EpochEvent
+	90,  // 253: solana.benchmark.BenchmarkService.GetStakeActivation:output_type -> solana.benchmark.StakeActivationResponse
+	93,  // 254: solana.benchmark.BenchmarkService.GetInflationReward:output_type -> solana.benchmark.InflationRewardResponse
+	100, // 255: solana.benchmark.BenchmarkService.ListOwnedAccounts:output_type -> solana.benchmark.ListOwnedAccountsResponse
+	102, // 256: solana.benchmark.BenchmarkService.StreamOwnedAccountChanges:output_type -> solana.benchmark.OwnedAccountEvent
+	106, // 257: solana.benchmark.BenchmarkService.GetSLOStatus:output_type -> solana.benchmark.SLOStatusResponse
+	108, // 258: solana.benchmark.BenchmarkService.StreamSLOEvents:output_type -> solana.benchmark.SLOEvent
+	183, // 259: solana.benchmark.BenchmarkService.ListBenchmarks:output_type -> solana.benchmark.ListBenchmarksResponse
+	53,  // 260: solana.benchmark.UtilsService.FindProgramAddress:output_type -> solana.benchmark.DerivedAddress
+	53,  // 261: solana.benchmark.UtilsService.CreateWithSeed:output_type -> solana.benchmark.DerivedAddress
+	53,  // 262: solana.benchmark.UtilsService.GetAssociatedTokenAddress:output_type -> solana.benchmark.DerivedAddress
+	59,  // 263: solana.benchmark.UtilsService.VerifySignature:output_type -> solana.benchmark.VerifySignatureResponse
+	61,  // 264: solana.benchmark.UtilsService.DecodeTransaction:output_type -> solana.benchmark.DecodedTransaction
+	209, // [209:265] is the sub-list for method output_type
+	153, // [153:209] is the sub-list for method input_type
+	153, // [153:153] is the sub-list for extension type_name
+	153, // [153:153] is the sub-list for extension extendee
+	0,   // [0:153] is the sub-list for field type_name
+}
+
+func init() { file_proto_solana_benchmark_proto_init() }
+func file_proto_solana_benchmark_proto_init() {
+	if File_proto_solana_benchmark_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_solana_benchmark_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimingBreakdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NonceAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NonceAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsistentReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsistentAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsistentReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenMint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenAccountState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenExtensions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferFeeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferFeeAmount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterestBearingConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataPointer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NFTsByOwnerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NFTsByOwnerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NFT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NFTMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NFTCreator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetsByOwnerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Asset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetsByOwnerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindProgramAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWithSeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssociatedTokenAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DerivedAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComputeBudget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenTransferTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifySignatureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifySignatureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedAccountKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedInstruction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedAddressTableLookup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentSlotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebSocketHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapabilitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Capabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeatureFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamCapability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockTimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockTimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlotAtTimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlotAtTimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockProductionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockProductionStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorProduction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockProduction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochInflation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeActivationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeActivationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationRewardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochRewards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationRewardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterNodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterNodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOwnedAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OwnedAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOwnedAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OwnedAccountChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OwnedAccountEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountClosure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLOStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLOStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLOStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLOEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLOEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressHistoryUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerializationBenchmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerializationBenchmarkResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerializationCost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerializedBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerializedTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerializedInnerInstructions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerializedInstruction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerializedTokenBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerializedReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodedBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodedBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DualServeBenchmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DualServeBenchmarkResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DualServeCategory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DualServeProtocol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBenchmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBenchmarkResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBenchmarkCategory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBenchmarkTransport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountUpdateBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountAckStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckedAccountUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckSessionHandoffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckSessionHandoff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgramAccountsSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgramAccountsChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1723,8 +19984,8 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountInfoResponse); i {
+		file_proto_solana_benchmark_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitmentLatencyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1735,8 +19996,8 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionRequest); i {
+		file_proto_solana_benchmark_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitmentLatencySample); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1747,8 +20008,8 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionResponse); i {
+		file_proto_solana_benchmark_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1759,8 +20020,8 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockRequest); i {
+		file_proto_solana_benchmark_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1771,8 +20032,8 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockResponse); i {
+		file_proto_solana_benchmark_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionFailure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1783,8 +20044,8 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountStreamRequest); i {
+		file_proto_solana_benchmark_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1795,8 +20056,8 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountUpdate); i {
+		file_proto_solana_benchmark_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1807,8 +20068,8 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionStreamRequest); i {
+		file_proto_solana_benchmark_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1819,8 +20080,8 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionUpdate); i {
+		file_proto_solana_benchmark_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChangeStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1831,8 +20092,8 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockStreamRequest); i {
+		file_proto_solana_benchmark_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1843,7 +20104,91 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_solana_benchmark_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockBalanceChanges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlertCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlertStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeePercentiles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockUpdate); i {
 			case 0:
 				return &v.state
@@ -1855,7 +20200,19 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_solana_benchmark_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorgEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BenchmarkRequest); i {
 			case 0:
 				return &v.state
@@ -1867,7 +20224,19 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_solana_benchmark_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatencyStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BenchmarkResults); i {
 			case 0:
 				return &v.state
@@ -1879,7 +20248,43 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_solana_benchmark_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockTierBenchmark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkEnvironment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccountBenchmark); i {
 			case 0:
 				return &v.state
@@ -1891,7 +20296,7 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_solana_benchmark_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransactionBenchmark); i {
 			case 0:
 				return &v.state
@@ -1903,7 +20308,7 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_solana_benchmark_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockBenchmark); i {
 			case 0:
 				return &v.state
@@ -1915,7 +20320,19 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
-		file_proto_solana_benchmark_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_solana_benchmark_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterNodesBenchmark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BenchmarkSummary); i {
 			case 0:
 				return &v.state
@@ -1927,19 +20344,128 @@ func file_proto_solana_benchmark_proto_init() {
 				return nil
 			}
 		}
+		file_proto_solana_benchmark_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignificanceTest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkJobResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBenchmarksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkScenario); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledBenchmarkRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_solana_benchmark_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBenchmarksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_solana_benchmark_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      20,
+			NumMessages:   165,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_solana_benchmark_proto_goTypes,
 		DependencyIndexes: file_proto_solana_benchmark_proto_depIdxs,
+		EnumInfos:         file_proto_solana_benchmark_proto_enumTypes,
 		MessageInfos:      file_proto_solana_benchmark_proto_msgTypes,
 	}.Build()
 	File_proto_solana_benchmark_proto = out.File
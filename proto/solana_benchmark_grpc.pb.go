@@ -19,13 +19,57 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BenchmarkService_GetAccountInfo_FullMethodName       = "/solana.benchmark.BenchmarkService/GetAccountInfo"
-	BenchmarkService_GetTransaction_FullMethodName       = "/solana.benchmark.BenchmarkService/GetTransaction"
-	BenchmarkService_GetBlock_FullMethodName             = "/solana.benchmark.BenchmarkService/GetBlock"
-	BenchmarkService_StreamAccountUpdates_FullMethodName = "/solana.benchmark.BenchmarkService/StreamAccountUpdates"
-	BenchmarkService_StreamTransactions_FullMethodName   = "/solana.benchmark.BenchmarkService/StreamTransactions"
-	BenchmarkService_StreamBlocks_FullMethodName         = "/solana.benchmark.BenchmarkService/StreamBlocks"
-	BenchmarkService_RunBenchmark_FullMethodName         = "/solana.benchmark.BenchmarkService/RunBenchmark"
+	BenchmarkService_GetAccountInfo_FullMethodName                = "/solana.benchmark.BenchmarkService/GetAccountInfo"
+	BenchmarkService_GetNonceAccount_FullMethodName               = "/solana.benchmark.BenchmarkService/GetNonceAccount"
+	BenchmarkService_ReadConsistent_FullMethodName                = "/solana.benchmark.BenchmarkService/ReadConsistent"
+	BenchmarkService_GetTokenAccount_FullMethodName               = "/solana.benchmark.BenchmarkService/GetTokenAccount"
+	BenchmarkService_GetNFTsByOwner_FullMethodName                = "/solana.benchmark.BenchmarkService/GetNFTsByOwner"
+	BenchmarkService_GetAsset_FullMethodName                      = "/solana.benchmark.BenchmarkService/GetAsset"
+	BenchmarkService_GetAssetsByOwner_FullMethodName              = "/solana.benchmark.BenchmarkService/GetAssetsByOwner"
+	BenchmarkService_GetAssetProof_FullMethodName                 = "/solana.benchmark.BenchmarkService/GetAssetProof"
+	BenchmarkService_BuildTransferTransaction_FullMethodName      = "/solana.benchmark.BenchmarkService/BuildTransferTransaction"
+	BenchmarkService_BuildTokenTransferTransaction_FullMethodName = "/solana.benchmark.BenchmarkService/BuildTokenTransferTransaction"
+	BenchmarkService_GetTransaction_FullMethodName                = "/solana.benchmark.BenchmarkService/GetTransaction"
+	BenchmarkService_GetBlock_FullMethodName                      = "/solana.benchmark.BenchmarkService/GetBlock"
+	BenchmarkService_StreamAccountUpdates_FullMethodName          = "/solana.benchmark.BenchmarkService/StreamAccountUpdates"
+	BenchmarkService_StreamAccountUpdateBatches_FullMethodName    = "/solana.benchmark.BenchmarkService/StreamAccountUpdateBatches"
+	BenchmarkService_StreamAccountUpdatesWithAck_FullMethodName   = "/solana.benchmark.BenchmarkService/StreamAccountUpdatesWithAck"
+	BenchmarkService_StreamProgramAccountsSnapshot_FullMethodName = "/solana.benchmark.BenchmarkService/StreamProgramAccountsSnapshot"
+	BenchmarkService_MeasureCommitmentLatency_FullMethodName      = "/solana.benchmark.BenchmarkService/MeasureCommitmentLatency"
+	BenchmarkService_StreamTransactions_FullMethodName            = "/solana.benchmark.BenchmarkService/StreamTransactions"
+	BenchmarkService_StreamBlocks_FullMethodName                  = "/solana.benchmark.BenchmarkService/StreamBlocks"
+	BenchmarkService_StreamBalanceChanges_FullMethodName          = "/solana.benchmark.BenchmarkService/StreamBalanceChanges"
+	BenchmarkService_StreamAlerts_FullMethodName                  = "/solana.benchmark.BenchmarkService/StreamAlerts"
+	BenchmarkService_StreamFeeStats_FullMethodName                = "/solana.benchmark.BenchmarkService/StreamFeeStats"
+	BenchmarkService_RunBenchmark_FullMethodName                  = "/solana.benchmark.BenchmarkService/RunBenchmark"
+	BenchmarkService_StartBenchmark_FullMethodName                = "/solana.benchmark.BenchmarkService/StartBenchmark"
+	BenchmarkService_StreamBenchmarkProgress_FullMethodName       = "/solana.benchmark.BenchmarkService/StreamBenchmarkProgress"
+	BenchmarkService_GetBenchmarkResult_FullMethodName            = "/solana.benchmark.BenchmarkService/GetBenchmarkResult"
+	BenchmarkService_CancelBenchmark_FullMethodName               = "/solana.benchmark.BenchmarkService/CancelBenchmark"
+	BenchmarkService_GetAccountHistory_FullMethodName             = "/solana.benchmark.BenchmarkService/GetAccountHistory"
+	BenchmarkService_GetUpstreamHealth_FullMethodName             = "/solana.benchmark.BenchmarkService/GetUpstreamHealth"
+	BenchmarkService_GetStreamStats_FullMethodName                = "/solana.benchmark.BenchmarkService/GetStreamStats"
+	BenchmarkService_GetCapabilities_FullMethodName               = "/solana.benchmark.BenchmarkService/GetCapabilities"
+	BenchmarkService_GetBlockTime_FullMethodName                  = "/solana.benchmark.BenchmarkService/GetBlockTime"
+	BenchmarkService_EstimateSlotAtTime_FullMethodName            = "/solana.benchmark.BenchmarkService/EstimateSlotAtTime"
+	BenchmarkService_StreamAddressHistory_FullMethodName          = "/solana.benchmark.BenchmarkService/StreamAddressHistory"
+	BenchmarkService_HandoffAckSession_FullMethodName             = "/solana.benchmark.BenchmarkService/HandoffAckSession"
+	BenchmarkService_BenchmarkSerialization_FullMethodName        = "/solana.benchmark.BenchmarkService/BenchmarkSerialization"
+	BenchmarkService_GetEncodedBlock_FullMethodName               = "/solana.benchmark.BenchmarkService/GetEncodedBlock"
+	BenchmarkService_BenchmarkDualServe_FullMethodName            = "/solana.benchmark.BenchmarkService/BenchmarkDualServe"
+	BenchmarkService_StreamBenchmark_FullMethodName               = "/solana.benchmark.BenchmarkService/StreamBenchmark"
+	BenchmarkService_GetCurrentSlot_FullMethodName                = "/solana.benchmark.BenchmarkService/GetCurrentSlot"
+	BenchmarkService_GetBlockProduction_FullMethodName            = "/solana.benchmark.BenchmarkService/GetBlockProduction"
+	BenchmarkService_StreamBlockProduction_FullMethodName         = "/solana.benchmark.BenchmarkService/StreamBlockProduction"
+	BenchmarkService_GetClusterNodes_FullMethodName               = "/solana.benchmark.BenchmarkService/GetClusterNodes"
+	BenchmarkService_StreamEpochEvents_FullMethodName             = "/solana.benchmark.BenchmarkService/StreamEpochEvents"
+	BenchmarkService_GetStakeActivation_FullMethodName            = "/solana.benchmark.BenchmarkService/GetStakeActivation"
+	BenchmarkService_GetInflationReward_FullMethodName            = "/solana.benchmark.BenchmarkService/GetInflationReward"
+	BenchmarkService_ListOwnedAccounts_FullMethodName             = "/solana.benchmark.BenchmarkService/ListOwnedAccounts"
+	BenchmarkService_StreamOwnedAccountChanges_FullMethodName     = "/solana.benchmark.BenchmarkService/StreamOwnedAccountChanges"
+	BenchmarkService_GetSLOStatus_FullMethodName                  = "/solana.benchmark.BenchmarkService/GetSLOStatus"
+	BenchmarkService_StreamSLOEvents_FullMethodName               = "/solana.benchmark.BenchmarkService/StreamSLOEvents"
+	BenchmarkService_ListBenchmarks_FullMethodName                = "/solana.benchmark.BenchmarkService/ListBenchmarks"
 )
 
 // BenchmarkServiceClient is the client API for BenchmarkService service.
@@ -34,18 +78,154 @@ const (
 type BenchmarkServiceClient interface {
 	// GetAccountInfo benchmarks retrieving account information
 	GetAccountInfo(ctx context.Context, in *AccountInfoRequest, opts ...grpc.CallOption) (*AccountInfoResponse, error)
+	// GetNonceAccount returns the decoded state of a durable nonce account
+	GetNonceAccount(ctx context.Context, in *NonceAccountRequest, opts ...grpc.CallOption) (*NonceAccountResponse, error)
+	// ReadConsistent reads several accounts as of one context slot
+	ReadConsistent(ctx context.Context, in *ConsistentReadRequest, opts ...grpc.CallOption) (*ConsistentReadResponse, error)
+	// GetTokenAccount returns the decoded state of an SPL Token or Token-2022
+	// mint or token account, including Token-2022 extensions
+	GetTokenAccount(ctx context.Context, in *TokenAccountRequest, opts ...grpc.CallOption) (*TokenAccountResponse, error)
+	// GetNFTsByOwner lists the NFTs held by an owner with their Metaplex metadata
+	GetNFTsByOwner(ctx context.Context, in *NFTsByOwnerRequest, opts ...grpc.CallOption) (*NFTsByOwnerResponse, error)
+	// GetAsset proxies the Digital Asset Standard getAsset method, for
+	// upstreams that support it
+	GetAsset(ctx context.Context, in *AssetRequest, opts ...grpc.CallOption) (*AssetResponse, error)
+	// GetAssetsByOwner proxies the Digital Asset Standard getAssetsByOwner
+	// method, for upstreams that support it
+	GetAssetsByOwner(ctx context.Context, in *AssetsByOwnerRequest, opts ...grpc.CallOption) (*AssetsByOwnerResponse, error)
+	// GetAssetProof fetches the Merkle proof of a compressed asset through the DAS API
+	GetAssetProof(ctx context.Context, in *AssetProofRequest, opts ...grpc.CallOption) (*AssetProofResponse, error)
+	// BuildTransferTransaction assembles an unsigned SOL transfer for
+	// client-side signing
+	BuildTransferTransaction(ctx context.Context, in *TransferTransactionRequest, opts ...grpc.CallOption) (*BuiltTransaction, error)
+	// BuildTokenTransferTransaction assembles an unsigned SPL Token or
+	// Token-2022 transfer between associated token accounts for client-side signing
+	BuildTokenTransferTransaction(ctx context.Context, in *TokenTransferTransactionRequest, opts ...grpc.CallOption) (*BuiltTransaction, error)
 	// GetTransaction benchmarks retrieving transaction information
 	GetTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	// GetBlock benchmarks retrieving block information
 	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// StreamAccountUpdates streams account updates in real-time
 	StreamAccountUpdates(ctx context.Context, in *AccountStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamAccountUpdatesClient, error)
+	// StreamAccountUpdateBatches streams the same account updates, coalescing
+	// them into batches of up to batch_max_messages
+	StreamAccountUpdateBatches(ctx context.Context, in *AccountStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamAccountUpdateBatchesClient, error)
+	// StreamAccountUpdatesWithAck streams account updates with client acknowledgements,
+	// redelivering unacknowledged updates when a session is resumed
+	StreamAccountUpdatesWithAck(ctx context.Context, opts ...grpc.CallOption) (BenchmarkService_StreamAccountUpdatesWithAckClient, error)
+	// StreamProgramAccountsSnapshot streams every account owned by a program in chunks,
+	// optionally continuing with live updates once the snapshot is complete
+	StreamProgramAccountsSnapshot(ctx context.Context, in *ProgramAccountsSnapshotRequest, opts ...grpc.CallOption) (BenchmarkService_StreamProgramAccountsSnapshotClient, error)
+	// MeasureCommitmentLatency subscribes to an account at processed, confirmed and
+	// finalized commitment and streams the per-slot delay between the levels
+	MeasureCommitmentLatency(ctx context.Context, in *CommitmentLatencyRequest, opts ...grpc.CallOption) (BenchmarkService_MeasureCommitmentLatencyClient, error)
 	// StreamTransactions streams transactions in real-time
 	StreamTransactions(ctx context.Context, in *TransactionStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamTransactionsClient, error)
 	// StreamBlocks streams blocks in real-time
 	StreamBlocks(ctx context.Context, in *BlockStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamBlocksClient, error)
-	// RunBenchmark runs a comprehensive benchmark suite and returns results
+	// StreamBalanceChanges streams, per block, the aggregated lamport and token
+	// balance changes of a set of watched accounts
+	StreamBalanceChanges(ctx context.Context, in *BalanceChangeStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamBalanceChangesClient, error)
+	// StreamAlerts streams the transactions that match any of a set of alert
+	// conditions
+	StreamAlerts(ctx context.Context, in *AlertStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamAlertsClient, error)
+	// StreamFeeStats streams priority fee and compute unit price percentiles of
+	// the transactions included in each window of slots
+	StreamFeeStats(ctx context.Context, in *FeeStatsRequest, opts ...grpc.CallOption) (BenchmarkService_StreamFeeStatsClient, error)
+	// RunBenchmark runs a comprehensive benchmark suite and returns results.
+	// Deprecated: long runs can exceed the call deadline; use StartBenchmark.
 	RunBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResults, error)
+	// StartBenchmark starts a benchmark suite in the background and returns its job
+	StartBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkJob, error)
+	// StreamBenchmarkProgress streams per-request progress of a benchmark job
+	// until it finishes
+	StreamBenchmarkProgress(ctx context.Context, in *BenchmarkJobRequest, opts ...grpc.CallOption) (BenchmarkService_StreamBenchmarkProgressClient, error)
+	// GetBenchmarkResult returns the state of a benchmark job and its results once finished
+	GetBenchmarkResult(ctx context.Context, in *BenchmarkJobRequest, opts ...grpc.CallOption) (*BenchmarkJobResult, error)
+	// CancelBenchmark stops a running benchmark job
+	CancelBenchmark(ctx context.Context, in *BenchmarkJobRequest, opts ...grpc.CallOption) (*BenchmarkJob, error)
+	// GetAccountHistory returns the recorded states of a watched account over a
+	// slot range
+	GetAccountHistory(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (*AccountHistoryResponse, error)
+	// GetUpstreamHealth returns the state of the upstream connections
+	GetUpstreamHealth(ctx context.Context, in *UpstreamHealthRequest, opts ...grpc.CallOption) (*UpstreamHealth, error)
+	// GetStreamStats returns the subscribers and queue depths of the topics
+	// fanning upstream data out to streams
+	GetStreamStats(ctx context.Context, in *StreamStatsRequest, opts ...grpc.CallOption) (*StreamStatsResponse, error)
+	// GetCapabilities lists the features this deployment supports, so clients
+	// can adapt instead of failing at runtime
+	GetCapabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*Capabilities, error)
+	// GetBlockTime returns the estimated production time of a block, as
+	// recorded by the cluster
+	GetBlockTime(ctx context.Context, in *BlockTimeRequest, opts ...grpc.CallOption) (*BlockTimeResponse, error)
+	// EstimateSlotAtTime estimates the slot produced at a time, past or future,
+	// from recent slot timing and the epoch schedule
+	EstimateSlotAtTime(ctx context.Context, in *SlotAtTimeRequest, opts ...grpc.CallOption) (*SlotAtTimeResponse, error)
+	// StreamAddressHistory streams the transactions of an address oldest first,
+	// from a cursor or as far back as the limit allows, optionally continuing
+	// with its new transactions
+	StreamAddressHistory(ctx context.Context, in *AddressHistoryRequest, opts ...grpc.CallOption) (BenchmarkService_StreamAddressHistoryClient, error)
+	// HandoffAckSession moves a detached acknowledged stream session to the
+	// calling replica of a cluster, for a client resuming it there. The session
+	// is removed from this replica.
+	HandoffAckSession(ctx context.Context, in *AckSessionHandoffRequest, opts ...grpc.CallOption) (*AckSessionHandoff, error)
+	// BenchmarkSerialization fetches a block once, then encodes and decodes it
+	// repeatedly as protobuf and as JSON-RPC JSON on the server, measuring
+	// serialization cost apart from the network
+	BenchmarkSerialization(ctx context.Context, in *SerializationBenchmarkRequest, opts ...grpc.CallOption) (*SerializationBenchmarkResults, error)
+	// GetEncodedBlock returns a block with full transactions encoded as a
+	// SerializedBlock or, experimentally, as a FlatBuffers block read in place
+	// by the client (proto/block.fbs), carried as gRPC bytes either way
+	GetEncodedBlock(ctx context.Context, in *EncodedBlockRequest, opts ...grpc.CallOption) (*EncodedBlock, error)
+	// BenchmarkDualServe fetches the requested accounts, transactions and
+	// blocks once, then serves them from this process over both gRPC and JSON
+	// over HTTP and calls each repeatedly over loopback, so the comparison
+	// measures the protocol stacks alone, without upstream variance
+	BenchmarkDualServe(ctx context.Context, in *DualServeBenchmarkRequest, opts ...grpc.CallOption) (*DualServeBenchmarkResults, error)
+	// StreamBenchmark subscribes to the same account, slot and block updates
+	// through this server's gRPC streams, over loopback, and through a native
+	// WebSocket connection to the upstream for a duration, and reports each
+	// transport's message rate, delivery latency and dropped updates
+	StreamBenchmark(ctx context.Context, in *StreamBenchmarkRequest, opts ...grpc.CallOption) (*StreamBenchmarkResults, error)
+	// GetCurrentSlot returns the latest slot the server has seen, from the slot
+	// subscription its slot clock keeps, without an upstream call while the
+	// clock is live
+	GetCurrentSlot(ctx context.Context, in *CurrentSlotRequest, opts ...grpc.CallOption) (*CurrentSlot, error)
+	// GetBlockProduction returns the leader slots and produced blocks of each
+	// validator over a slot range of the current or previous epoch
+	GetBlockProduction(ctx context.Context, in *BlockProductionRequest, opts ...grpc.CallOption) (*BlockProduction, error)
+	// StreamBlockProduction streams the block production of the current epoch
+	// so far at an interval, and a final summary of each epoch as it ends
+	StreamBlockProduction(ctx context.Context, in *BlockProductionStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamBlockProductionClient, error)
+	// GetClusterNodes returns the nodes gossiping in the cluster, optionally
+	// filtered by software version or feature set
+	GetClusterNodes(ctx context.Context, in *ClusterNodesRequest, opts ...grpc.CallOption) (*ClusterNodesResponse, error)
+	// StreamEpochEvents streams an event at every epoch boundary, followed by
+	// the new epoch's inflation and the ended epoch's rewards of watched
+	// addresses once the upstream reports them
+	StreamEpochEvents(ctx context.Context, in *EpochEventsRequest, opts ...grpc.CallOption) (BenchmarkService_StreamEpochEventsClient, error)
+	// GetStakeActivation returns the activation state and active and inactive
+	// stake of a stake account in an epoch
+	GetStakeActivation(ctx context.Context, in *StakeActivationRequest, opts ...grpc.CallOption) (*StakeActivationResponse, error)
+	// GetInflationReward returns the inflation rewards of addresses for each
+	// epoch of a range, fetched from the upstream in one batch
+	GetInflationReward(ctx context.Context, in *InflationRewardRequest, opts ...grpc.CallOption) (*InflationRewardResponse, error)
+	// ListOwnedAccounts pages through the accounts of an owner from a set the
+	// server materializes with getProgramAccounts and keeps current with
+	// programSubscribe
+	ListOwnedAccounts(ctx context.Context, in *ListOwnedAccountsRequest, opts ...grpc.CallOption) (*ListOwnedAccountsResponse, error)
+	// StreamOwnedAccountChanges streams the accounts of an owner as they are
+	// created, updated and closed, optionally after the accounts it has
+	StreamOwnedAccountChanges(ctx context.Context, in *OwnedAccountChangesRequest, opts ...grpc.CallOption) (BenchmarkService_StreamOwnedAccountChangesClient, error)
+	// GetSLOStatus returns the state of the latency objectives configured on
+	// the server, as of their last evaluation
+	GetSLOStatus(ctx context.Context, in *SLOStatusRequest, opts ...grpc.CallOption) (*SLOStatusResponse, error)
+	// StreamSLOEvents streams an event whenever a latency objective is
+	// violated or recovers, for operators alerting on them
+	StreamSLOEvents(ctx context.Context, in *SLOEventsRequest, opts ...grpc.CallOption) (BenchmarkService_StreamSLOEventsClient, error)
+	// ListBenchmarks lists the results of the benchmark scenarios the server
+	// runs on a schedule, newest first, and when each scenario runs next
+	ListBenchmarks(ctx context.Context, in *ListBenchmarksRequest, opts ...grpc.CallOption) (*ListBenchmarksResponse, error)
 }
 
 type benchmarkServiceClient struct {
@@ -65,6 +245,87 @@ func (c *benchmarkServiceClient) GetAccountInfo(ctx context.Context, in *Account
 	return out, nil
 }
 
+func (c *benchmarkServiceClient) GetNonceAccount(ctx context.Context, in *NonceAccountRequest, opts ...grpc.CallOption) (*NonceAccountResponse, error) {
+	out := new(NonceAccountResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetNonceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) ReadConsistent(ctx context.Context, in *ConsistentReadRequest, opts ...grpc.CallOption) (*ConsistentReadResponse, error) {
+	out := new(ConsistentReadResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_ReadConsistent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetTokenAccount(ctx context.Context, in *TokenAccountRequest, opts ...grpc.CallOption) (*TokenAccountResponse, error) {
+	out := new(TokenAccountResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetTokenAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetNFTsByOwner(ctx context.Context, in *NFTsByOwnerRequest, opts ...grpc.CallOption) (*NFTsByOwnerResponse, error) {
+	out := new(NFTsByOwnerResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetNFTsByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetAsset(ctx context.Context, in *AssetRequest, opts ...grpc.CallOption) (*AssetResponse, error) {
+	out := new(AssetResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetAssetsByOwner(ctx context.Context, in *AssetsByOwnerRequest, opts ...grpc.CallOption) (*AssetsByOwnerResponse, error) {
+	out := new(AssetsByOwnerResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetAssetsByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetAssetProof(ctx context.Context, in *AssetProofRequest, opts ...grpc.CallOption) (*AssetProofResponse, error) {
+	out := new(AssetProofResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetAssetProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) BuildTransferTransaction(ctx context.Context, in *TransferTransactionRequest, opts ...grpc.CallOption) (*BuiltTransaction, error) {
+	out := new(BuiltTransaction)
+	err := c.cc.Invoke(ctx, BenchmarkService_BuildTransferTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) BuildTokenTransferTransaction(ctx context.Context, in *TokenTransferTransactionRequest, opts ...grpc.CallOption) (*BuiltTransaction, error) {
+	out := new(BuiltTransaction)
+	err := c.cc.Invoke(ctx, BenchmarkService_BuildTokenTransferTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *benchmarkServiceClient) GetTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
 	out := new(TransactionResponse)
 	err := c.cc.Invoke(ctx, BenchmarkService_GetTransaction_FullMethodName, in, out, opts...)
@@ -115,8 +376,135 @@ func (x *benchmarkServiceStreamAccountUpdatesClient) Recv() (*AccountUpdate, err
 	return m, nil
 }
 
+func (c *benchmarkServiceClient) StreamAccountUpdateBatches(ctx context.Context, in *AccountStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamAccountUpdateBatchesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[1], BenchmarkService_StreamAccountUpdateBatches_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceStreamAccountUpdateBatchesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BenchmarkService_StreamAccountUpdateBatchesClient interface {
+	Recv() (*AccountUpdateBatch, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceStreamAccountUpdateBatchesClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceStreamAccountUpdateBatchesClient) Recv() (*AccountUpdateBatch, error) {
+	m := new(AccountUpdateBatch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) StreamAccountUpdatesWithAck(ctx context.Context, opts ...grpc.CallOption) (BenchmarkService_StreamAccountUpdatesWithAckClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[2], BenchmarkService_StreamAccountUpdatesWithAck_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceStreamAccountUpdatesWithAckClient{stream}
+	return x, nil
+}
+
+type BenchmarkService_StreamAccountUpdatesWithAckClient interface {
+	Send(*AccountAckStreamRequest) error
+	Recv() (*AckedAccountUpdate, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceStreamAccountUpdatesWithAckClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceStreamAccountUpdatesWithAckClient) Send(m *AccountAckStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *benchmarkServiceStreamAccountUpdatesWithAckClient) Recv() (*AckedAccountUpdate, error) {
+	m := new(AckedAccountUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) StreamProgramAccountsSnapshot(ctx context.Context, in *ProgramAccountsSnapshotRequest, opts ...grpc.CallOption) (BenchmarkService_StreamProgramAccountsSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[3], BenchmarkService_StreamProgramAccountsSnapshot_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceStreamProgramAccountsSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BenchmarkService_StreamProgramAccountsSnapshotClient interface {
+	Recv() (*ProgramAccountsChunk, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceStreamProgramAccountsSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceStreamProgramAccountsSnapshotClient) Recv() (*ProgramAccountsChunk, error) {
+	m := new(ProgramAccountsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) MeasureCommitmentLatency(ctx context.Context, in *CommitmentLatencyRequest, opts ...grpc.CallOption) (BenchmarkService_MeasureCommitmentLatencyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[4], BenchmarkService_MeasureCommitmentLatency_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceMeasureCommitmentLatencyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BenchmarkService_MeasureCommitmentLatencyClient interface {
+	Recv() (*CommitmentLatencySample, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceMeasureCommitmentLatencyClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceMeasureCommitmentLatencyClient) Recv() (*CommitmentLatencySample, error) {
+	m := new(CommitmentLatencySample)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *benchmarkServiceClient) StreamTransactions(ctx context.Context, in *TransactionStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamTransactionsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[1], BenchmarkService_StreamTransactions_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[5], BenchmarkService_StreamTransactions_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +536,7 @@ func (x *benchmarkServiceStreamTransactionsClient) Recv() (*TransactionUpdate, e
 }
 
 func (c *benchmarkServiceClient) StreamBlocks(ctx context.Context, in *BlockStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamBlocksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[2], BenchmarkService_StreamBlocks_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[6], BenchmarkService_StreamBlocks_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -179,205 +567,1795 @@ func (x *benchmarkServiceStreamBlocksClient) Recv() (*BlockUpdate, error) {
 	return m, nil
 }
 
-func (c *benchmarkServiceClient) RunBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResults, error) {
-	out := new(BenchmarkResults)
-	err := c.cc.Invoke(ctx, BenchmarkService_RunBenchmark_FullMethodName, in, out, opts...)
+func (c *benchmarkServiceClient) StreamBalanceChanges(ctx context.Context, in *BalanceChangeStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamBalanceChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[7], BenchmarkService_StreamBalanceChanges_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &benchmarkServiceStreamBalanceChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// BenchmarkServiceServer is the server API for BenchmarkService service.
-// All implementations must embed UnimplementedBenchmarkServiceServer
-// for forward compatibility
-type BenchmarkServiceServer interface {
-	// GetAccountInfo benchmarks retrieving account information
-	GetAccountInfo(context.Context, *AccountInfoRequest) (*AccountInfoResponse, error)
-	// GetTransaction benchmarks retrieving transaction information
-	GetTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error)
-	// GetBlock benchmarks retrieving block information
-	GetBlock(context.Context, *BlockRequest) (*BlockResponse, error)
-	// StreamAccountUpdates streams account updates in real-time
-	StreamAccountUpdates(*AccountStreamRequest, BenchmarkService_StreamAccountUpdatesServer) error
-	// StreamTransactions streams transactions in real-time
-	StreamTransactions(*TransactionStreamRequest, BenchmarkService_StreamTransactionsServer) error
-	// StreamBlocks streams blocks in real-time
-	StreamBlocks(*BlockStreamRequest, BenchmarkService_StreamBlocksServer) error
-	// RunBenchmark runs a comprehensive benchmark suite and returns results
-	RunBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkResults, error)
-	mustEmbedUnimplementedBenchmarkServiceServer()
+type BenchmarkService_StreamBalanceChangesClient interface {
+	Recv() (*BlockBalanceChanges, error)
+	grpc.ClientStream
 }
 
-// UnimplementedBenchmarkServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedBenchmarkServiceServer struct {
+type benchmarkServiceStreamBalanceChangesClient struct {
+	grpc.ClientStream
 }
 
-func (UnimplementedBenchmarkServiceServer) GetAccountInfo(context.Context, *AccountInfoRequest) (*AccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
-}
-func (UnimplementedBenchmarkServiceServer) GetTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
-}
-func (UnimplementedBenchmarkServiceServer) GetBlock(context.Context, *BlockRequest) (*BlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
-}
-func (UnimplementedBenchmarkServiceServer) StreamAccountUpdates(*AccountStreamRequest, BenchmarkService_StreamAccountUpdatesServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamAccountUpdates not implemented")
-}
-func (UnimplementedBenchmarkServiceServer) StreamTransactions(*TransactionStreamRequest, BenchmarkService_StreamTransactionsServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamTransactions not implemented")
-}
-func (UnimplementedBenchmarkServiceServer) StreamBlocks(*BlockStreamRequest, BenchmarkService_StreamBlocksServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamBlocks not implemented")
+func (x *benchmarkServiceStreamBalanceChangesClient) Recv() (*BlockBalanceChanges, error) {
+	m := new(BlockBalanceChanges)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedBenchmarkServiceServer) RunBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkResults, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunBenchmark not implemented")
+
+func (c *benchmarkServiceClient) StreamAlerts(ctx context.Context, in *AlertStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamAlertsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[8], BenchmarkService_StreamAlerts_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceStreamAlertsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedBenchmarkServiceServer) mustEmbedUnimplementedBenchmarkServiceServer() {}
 
-// UnsafeBenchmarkServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BenchmarkServiceServer will
-// result in compilation errors.
-type UnsafeBenchmarkServiceServer interface {
-	mustEmbedUnimplementedBenchmarkServiceServer()
+type BenchmarkService_StreamAlertsClient interface {
+	Recv() (*Alert, error)
+	grpc.ClientStream
 }
 
-func RegisterBenchmarkServiceServer(s grpc.ServiceRegistrar, srv BenchmarkServiceServer) {
-	s.RegisterService(&BenchmarkService_ServiceDesc, srv)
+type benchmarkServiceStreamAlertsClient struct {
+	grpc.ClientStream
 }
 
-func _BenchmarkService_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountInfoRequest)
-	if err := dec(in); err != nil {
+func (x *benchmarkServiceStreamAlertsClient) Recv() (*Alert, error) {
+	m := new(Alert)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(BenchmarkServiceServer).GetAccountInfo(ctx, in)
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) StreamFeeStats(ctx context.Context, in *FeeStatsRequest, opts ...grpc.CallOption) (BenchmarkService_StreamFeeStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[9], BenchmarkService_StreamFeeStats_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: BenchmarkService_GetAccountInfo_FullMethodName,
+	x := &benchmarkServiceStreamFeeStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BenchmarkServiceServer).GetAccountInfo(ctx, req.(*AccountInfoRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _BenchmarkService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
-	if err := dec(in); err != nil {
+type BenchmarkService_StreamFeeStatsClient interface {
+	Recv() (*FeeStats, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceStreamFeeStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceStreamFeeStatsClient) Recv() (*FeeStats, error) {
+	m := new(FeeStats)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) RunBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResults, error) {
+	out := new(BenchmarkResults)
+	err := c.cc.Invoke(ctx, BenchmarkService_RunBenchmark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) StartBenchmark(ctx context.Context, in *BenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkJob, error) {
+	out := new(BenchmarkJob)
+	err := c.cc.Invoke(ctx, BenchmarkService_StartBenchmark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) StreamBenchmarkProgress(ctx context.Context, in *BenchmarkJobRequest, opts ...grpc.CallOption) (BenchmarkService_StreamBenchmarkProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[10], BenchmarkService_StreamBenchmarkProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceStreamBenchmarkProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BenchmarkService_StreamBenchmarkProgressClient interface {
+	Recv() (*BenchmarkProgress, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceStreamBenchmarkProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceStreamBenchmarkProgressClient) Recv() (*BenchmarkProgress, error) {
+	m := new(BenchmarkProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) GetBenchmarkResult(ctx context.Context, in *BenchmarkJobRequest, opts ...grpc.CallOption) (*BenchmarkJobResult, error) {
+	out := new(BenchmarkJobResult)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetBenchmarkResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) CancelBenchmark(ctx context.Context, in *BenchmarkJobRequest, opts ...grpc.CallOption) (*BenchmarkJob, error) {
+	out := new(BenchmarkJob)
+	err := c.cc.Invoke(ctx, BenchmarkService_CancelBenchmark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetAccountHistory(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (*AccountHistoryResponse, error) {
+	out := new(AccountHistoryResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetAccountHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetUpstreamHealth(ctx context.Context, in *UpstreamHealthRequest, opts ...grpc.CallOption) (*UpstreamHealth, error) {
+	out := new(UpstreamHealth)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetUpstreamHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetStreamStats(ctx context.Context, in *StreamStatsRequest, opts ...grpc.CallOption) (*StreamStatsResponse, error) {
+	out := new(StreamStatsResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetStreamStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetCapabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*Capabilities, error) {
+	out := new(Capabilities)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetCapabilities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetBlockTime(ctx context.Context, in *BlockTimeRequest, opts ...grpc.CallOption) (*BlockTimeResponse, error) {
+	out := new(BlockTimeResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetBlockTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) EstimateSlotAtTime(ctx context.Context, in *SlotAtTimeRequest, opts ...grpc.CallOption) (*SlotAtTimeResponse, error) {
+	out := new(SlotAtTimeResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_EstimateSlotAtTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) StreamAddressHistory(ctx context.Context, in *AddressHistoryRequest, opts ...grpc.CallOption) (BenchmarkService_StreamAddressHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[11], BenchmarkService_StreamAddressHistory_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceStreamAddressHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BenchmarkService_StreamAddressHistoryClient interface {
+	Recv() (*AddressHistoryUpdate, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceStreamAddressHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceStreamAddressHistoryClient) Recv() (*AddressHistoryUpdate, error) {
+	m := new(AddressHistoryUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) HandoffAckSession(ctx context.Context, in *AckSessionHandoffRequest, opts ...grpc.CallOption) (*AckSessionHandoff, error) {
+	out := new(AckSessionHandoff)
+	err := c.cc.Invoke(ctx, BenchmarkService_HandoffAckSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) BenchmarkSerialization(ctx context.Context, in *SerializationBenchmarkRequest, opts ...grpc.CallOption) (*SerializationBenchmarkResults, error) {
+	out := new(SerializationBenchmarkResults)
+	err := c.cc.Invoke(ctx, BenchmarkService_BenchmarkSerialization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetEncodedBlock(ctx context.Context, in *EncodedBlockRequest, opts ...grpc.CallOption) (*EncodedBlock, error) {
+	out := new(EncodedBlock)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetEncodedBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) BenchmarkDualServe(ctx context.Context, in *DualServeBenchmarkRequest, opts ...grpc.CallOption) (*DualServeBenchmarkResults, error) {
+	out := new(DualServeBenchmarkResults)
+	err := c.cc.Invoke(ctx, BenchmarkService_BenchmarkDualServe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) StreamBenchmark(ctx context.Context, in *StreamBenchmarkRequest, opts ...grpc.CallOption) (*StreamBenchmarkResults, error) {
+	out := new(StreamBenchmarkResults)
+	err := c.cc.Invoke(ctx, BenchmarkService_StreamBenchmark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetCurrentSlot(ctx context.Context, in *CurrentSlotRequest, opts ...grpc.CallOption) (*CurrentSlot, error) {
+	out := new(CurrentSlot)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetCurrentSlot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetBlockProduction(ctx context.Context, in *BlockProductionRequest, opts ...grpc.CallOption) (*BlockProduction, error) {
+	out := new(BlockProduction)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetBlockProduction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) StreamBlockProduction(ctx context.Context, in *BlockProductionStreamRequest, opts ...grpc.CallOption) (BenchmarkService_StreamBlockProductionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[12], BenchmarkService_StreamBlockProduction_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceStreamBlockProductionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BenchmarkService_StreamBlockProductionClient interface {
+	Recv() (*BlockProduction, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceStreamBlockProductionClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceStreamBlockProductionClient) Recv() (*BlockProduction, error) {
+	m := new(BlockProduction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) GetClusterNodes(ctx context.Context, in *ClusterNodesRequest, opts ...grpc.CallOption) (*ClusterNodesResponse, error) {
+	out := new(ClusterNodesResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetClusterNodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) StreamEpochEvents(ctx context.Context, in *EpochEventsRequest, opts ...grpc.CallOption) (BenchmarkService_StreamEpochEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[13], BenchmarkService_StreamEpochEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceStreamEpochEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BenchmarkService_StreamEpochEventsClient interface {
+	Recv() (*EpochEvent, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceStreamEpochEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceStreamEpochEventsClient) Recv() (*EpochEvent, error) {
+	m := new(EpochEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) GetStakeActivation(ctx context.Context, in *StakeActivationRequest, opts ...grpc.CallOption) (*StakeActivationResponse, error) {
+	out := new(StakeActivationResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetStakeActivation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetInflationReward(ctx context.Context, in *InflationRewardRequest, opts ...grpc.CallOption) (*InflationRewardResponse, error) {
+	out := new(InflationRewardResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetInflationReward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) ListOwnedAccounts(ctx context.Context, in *ListOwnedAccountsRequest, opts ...grpc.CallOption) (*ListOwnedAccountsResponse, error) {
+	out := new(ListOwnedAccountsResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_ListOwnedAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) StreamOwnedAccountChanges(ctx context.Context, in *OwnedAccountChangesRequest, opts ...grpc.CallOption) (BenchmarkService_StreamOwnedAccountChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[14], BenchmarkService_StreamOwnedAccountChanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceStreamOwnedAccountChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BenchmarkService_StreamOwnedAccountChangesClient interface {
+	Recv() (*OwnedAccountEvent, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceStreamOwnedAccountChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceStreamOwnedAccountChangesClient) Recv() (*OwnedAccountEvent, error) {
+	m := new(OwnedAccountEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) GetSLOStatus(ctx context.Context, in *SLOStatusRequest, opts ...grpc.CallOption) (*SLOStatusResponse, error) {
+	out := new(SLOStatusResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetSLOStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) StreamSLOEvents(ctx context.Context, in *SLOEventsRequest, opts ...grpc.CallOption) (BenchmarkService_StreamSLOEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[15], BenchmarkService_StreamSLOEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &benchmarkServiceStreamSLOEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BenchmarkService_StreamSLOEventsClient interface {
+	Recv() (*SLOEvent, error)
+	grpc.ClientStream
+}
+
+type benchmarkServiceStreamSLOEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *benchmarkServiceStreamSLOEventsClient) Recv() (*SLOEvent, error) {
+	m := new(SLOEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *benchmarkServiceClient) ListBenchmarks(ctx context.Context, in *ListBenchmarksRequest, opts ...grpc.CallOption) (*ListBenchmarksResponse, error) {
+	out := new(ListBenchmarksResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_ListBenchmarks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BenchmarkServiceServer is the server API for BenchmarkService service.
+// All implementations must embed UnimplementedBenchmarkServiceServer
+// for forward compatibility
+type BenchmarkServiceServer interface {
+	// GetAccountInfo benchmarks retrieving account information
+	GetAccountInfo(context.Context, *AccountInfoRequest) (*AccountInfoResponse, error)
+	// GetNonceAccount returns the decoded state of a durable nonce account
+	GetNonceAccount(context.Context, *NonceAccountRequest) (*NonceAccountResponse, error)
+	// ReadConsistent reads several accounts as of one context slot
+	ReadConsistent(context.Context, *ConsistentReadRequest) (*ConsistentReadResponse, error)
+	// GetTokenAccount returns the decoded state of an SPL Token or Token-2022
+	// mint or token account, including Token-2022 extensions
+	GetTokenAccount(context.Context, *TokenAccountRequest) (*TokenAccountResponse, error)
+	// GetNFTsByOwner lists the NFTs held by an owner with their Metaplex metadata
+	GetNFTsByOwner(context.Context, *NFTsByOwnerRequest) (*NFTsByOwnerResponse, error)
+	// GetAsset proxies the Digital Asset Standard getAsset method, for
+	// upstreams that support it
+	GetAsset(context.Context, *AssetRequest) (*AssetResponse, error)
+	// GetAssetsByOwner proxies the Digital Asset Standard getAssetsByOwner
+	// method, for upstreams that support it
+	GetAssetsByOwner(context.Context, *AssetsByOwnerRequest) (*AssetsByOwnerResponse, error)
+	// GetAssetProof fetches the Merkle proof of a compressed asset through the DAS API
+	GetAssetProof(context.Context, *AssetProofRequest) (*AssetProofResponse, error)
+	// BuildTransferTransaction assembles an unsigned SOL transfer for
+	// client-side signing
+	BuildTransferTransaction(context.Context, *TransferTransactionRequest) (*BuiltTransaction, error)
+	// BuildTokenTransferTransaction assembles an unsigned SPL Token or
+	// Token-2022 transfer between associated token accounts for client-side signing
+	BuildTokenTransferTransaction(context.Context, *TokenTransferTransactionRequest) (*BuiltTransaction, error)
+	// GetTransaction benchmarks retrieving transaction information
+	GetTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error)
+	// GetBlock benchmarks retrieving block information
+	GetBlock(context.Context, *BlockRequest) (*BlockResponse, error)
+	// StreamAccountUpdates streams account updates in real-time
+	StreamAccountUpdates(*AccountStreamRequest, BenchmarkService_StreamAccountUpdatesServer) error
+	// StreamAccountUpdateBatches streams the same account updates, coalescing
+	// them into batches of up to batch_max_messages
+	StreamAccountUpdateBatches(*AccountStreamRequest, BenchmarkService_StreamAccountUpdateBatchesServer) error
+	// StreamAccountUpdatesWithAck streams account updates with client acknowledgements,
+	// redelivering unacknowledged updates when a session is resumed
+	StreamAccountUpdatesWithAck(BenchmarkService_StreamAccountUpdatesWithAckServer) error
+	// StreamProgramAccountsSnapshot streams every account owned by a program in chunks,
+	// optionally continuing with live updates once the snapshot is complete
+	StreamProgramAccountsSnapshot(*ProgramAccountsSnapshotRequest, BenchmarkService_StreamProgramAccountsSnapshotServer) error
+	// MeasureCommitmentLatency subscribes to an account at processed, confirmed and
+	// finalized commitment and streams the per-slot delay between the levels
+	MeasureCommitmentLatency(*CommitmentLatencyRequest, BenchmarkService_MeasureCommitmentLatencyServer) error
+	// StreamTransactions streams transactions in real-time
+	StreamTransactions(*TransactionStreamRequest, BenchmarkService_StreamTransactionsServer) error
+	// StreamBlocks streams blocks in real-time
+	StreamBlocks(*BlockStreamRequest, BenchmarkService_StreamBlocksServer) error
+	// StreamBalanceChanges streams, per block, the aggregated lamport and token
+	// balance changes of a set of watched accounts
+	StreamBalanceChanges(*BalanceChangeStreamRequest, BenchmarkService_StreamBalanceChangesServer) error
+	// StreamAlerts streams the transactions that match any of a set of alert
+	// conditions
+	StreamAlerts(*AlertStreamRequest, BenchmarkService_StreamAlertsServer) error
+	// StreamFeeStats streams priority fee and compute unit price percentiles of
+	// the transactions included in each window of slots
+	StreamFeeStats(*FeeStatsRequest, BenchmarkService_StreamFeeStatsServer) error
+	// RunBenchmark runs a comprehensive benchmark suite and returns results.
+	// Deprecated: long runs can exceed the call deadline; use StartBenchmark.
+	RunBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkResults, error)
+	// StartBenchmark starts a benchmark suite in the background and returns its job
+	StartBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkJob, error)
+	// StreamBenchmarkProgress streams per-request progress of a benchmark job
+	// until it finishes
+	StreamBenchmarkProgress(*BenchmarkJobRequest, BenchmarkService_StreamBenchmarkProgressServer) error
+	// GetBenchmarkResult returns the state of a benchmark job and its results once finished
+	GetBenchmarkResult(context.Context, *BenchmarkJobRequest) (*BenchmarkJobResult, error)
+	// CancelBenchmark stops a running benchmark job
+	CancelBenchmark(context.Context, *BenchmarkJobRequest) (*BenchmarkJob, error)
+	// GetAccountHistory returns the recorded states of a watched account over a
+	// slot range
+	GetAccountHistory(context.Context, *AccountHistoryRequest) (*AccountHistoryResponse, error)
+	// GetUpstreamHealth returns the state of the upstream connections
+	GetUpstreamHealth(context.Context, *UpstreamHealthRequest) (*UpstreamHealth, error)
+	// GetStreamStats returns the subscribers and queue depths of the topics
+	// fanning upstream data out to streams
+	GetStreamStats(context.Context, *StreamStatsRequest) (*StreamStatsResponse, error)
+	// GetCapabilities lists the features this deployment supports, so clients
+	// can adapt instead of failing at runtime
+	GetCapabilities(context.Context, *CapabilitiesRequest) (*Capabilities, error)
+	// GetBlockTime returns the estimated production time of a block, as
+	// recorded by the cluster
+	GetBlockTime(context.Context, *BlockTimeRequest) (*BlockTimeResponse, error)
+	// EstimateSlotAtTime estimates the slot produced at a time, past or future,
+	// from recent slot timing and the epoch schedule
+	EstimateSlotAtTime(context.Context, *SlotAtTimeRequest) (*SlotAtTimeResponse, error)
+	// StreamAddressHistory streams the transactions of an address oldest first,
+	// from a cursor or as far back as the limit allows, optionally continuing
+	// with its new transactions
+	StreamAddressHistory(*AddressHistoryRequest, BenchmarkService_StreamAddressHistoryServer) error
+	// HandoffAckSession moves a detached acknowledged stream session to the
+	// calling replica of a cluster, for a client resuming it there. The session
+	// is removed from this replica.
+	HandoffAckSession(context.Context, *AckSessionHandoffRequest) (*AckSessionHandoff, error)
+	// BenchmarkSerialization fetches a block once, then encodes and decodes it
+	// repeatedly as protobuf and as JSON-RPC JSON on the server, measuring
+	// serialization cost apart from the network
+	BenchmarkSerialization(context.Context, *SerializationBenchmarkRequest) (*SerializationBenchmarkResults, error)
+	// GetEncodedBlock returns a block with full transactions encoded as a
+	// SerializedBlock or, experimentally, as a FlatBuffers block read in place
+	// by the client (proto/block.fbs), carried as gRPC bytes either way
+	GetEncodedBlock(context.Context, *EncodedBlockRequest) (*EncodedBlock, error)
+	// BenchmarkDualServe fetches the requested accounts, transactions and
+	// blocks once, then serves them from this process over both gRPC and JSON
+	// over HTTP and calls each repeatedly over loopback, so the comparison
+	// measures the protocol stacks alone, without upstream variance
+	BenchmarkDualServe(context.Context, *DualServeBenchmarkRequest) (*DualServeBenchmarkResults, error)
+	// StreamBenchmark subscribes to the same account, slot and block updates
+	// through this server's gRPC streams, over loopback, and through a native
+	// WebSocket connection to the upstream for a duration, and reports each
+	// transport's message rate, delivery latency and dropped updates
+	StreamBenchmark(context.Context, *StreamBenchmarkRequest) (*StreamBenchmarkResults, error)
+	// GetCurrentSlot returns the latest slot the server has seen, from the slot
+	// subscription its slot clock keeps, without an upstream call while the
+	// clock is live
+	GetCurrentSlot(context.Context, *CurrentSlotRequest) (*CurrentSlot, error)
+	// GetBlockProduction returns the leader slots and produced blocks of each
+	// validator over a slot range of the current or previous epoch
+	GetBlockProduction(context.Context, *BlockProductionRequest) (*BlockProduction, error)
+	// StreamBlockProduction streams the block production of the current epoch
+	// so far at an interval, and a final summary of each epoch as it ends
+	StreamBlockProduction(*BlockProductionStreamRequest, BenchmarkService_StreamBlockProductionServer) error
+	// GetClusterNodes returns the nodes gossiping in the cluster, optionally
+	// filtered by software version or feature set
+	GetClusterNodes(context.Context, *ClusterNodesRequest) (*ClusterNodesResponse, error)
+	// StreamEpochEvents streams an event at every epoch boundary, followed by
+	// the new epoch's inflation and the ended epoch's rewards of watched
+	// addresses once the upstream reports them
+	StreamEpochEvents(*EpochEventsRequest, BenchmarkService_StreamEpochEventsServer) error
+	// GetStakeActivation returns the activation state and active and inactive
+	// stake of a stake account in an epoch
+	GetStakeActivation(context.Context, *StakeActivationRequest) (*StakeActivationResponse, error)
+	// GetInflationReward returns the inflation rewards of addresses for each
+	// epoch of a range, fetched from the upstream in one batch
+	GetInflationReward(context.Context, *InflationRewardRequest) (*InflationRewardResponse, error)
+	// ListOwnedAccounts pages through the accounts of an owner from a set the
+	// server materializes with getProgramAccounts and keeps current with
+	// programSubscribe
+	ListOwnedAccounts(context.Context, *ListOwnedAccountsRequest) (*ListOwnedAccountsResponse, error)
+	// StreamOwnedAccountChanges streams the accounts of an owner as they are
+	// created, updated and closed, optionally after the accounts it has
+	StreamOwnedAccountChanges(*OwnedAccountChangesRequest, BenchmarkService_StreamOwnedAccountChangesServer) error
+	// GetSLOStatus returns the state of the latency objectives configured on
+	// the server, as of their last evaluation
+	GetSLOStatus(context.Context, *SLOStatusRequest) (*SLOStatusResponse, error)
+	// StreamSLOEvents streams an event whenever a latency objective is
+	// violated or recovers, for operators alerting on them
+	StreamSLOEvents(*SLOEventsRequest, BenchmarkService_StreamSLOEventsServer) error
+	// ListBenchmarks lists the results of the benchmark scenarios the server
+	// runs on a schedule, newest first, and when each scenario runs next
+	ListBenchmarks(context.Context, *ListBenchmarksRequest) (*ListBenchmarksResponse, error)
+	mustEmbedUnimplementedBenchmarkServiceServer()
+}
+
+// UnimplementedBenchmarkServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBenchmarkServiceServer struct {
+}
+
+func (UnimplementedBenchmarkServiceServer) GetAccountInfo(context.Context, *AccountInfoRequest) (*AccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetNonceAccount(context.Context, *NonceAccountRequest) (*NonceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNonceAccount not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) ReadConsistent(context.Context, *ConsistentReadRequest) (*ConsistentReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadConsistent not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetTokenAccount(context.Context, *TokenAccountRequest) (*TokenAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenAccount not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetNFTsByOwner(context.Context, *NFTsByOwnerRequest) (*NFTsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNFTsByOwner not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetAsset(context.Context, *AssetRequest) (*AssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAsset not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetAssetsByOwner(context.Context, *AssetsByOwnerRequest) (*AssetsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetsByOwner not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetAssetProof(context.Context, *AssetProofRequest) (*AssetProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetProof not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) BuildTransferTransaction(context.Context, *TransferTransactionRequest) (*BuiltTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildTransferTransaction not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) BuildTokenTransferTransaction(context.Context, *TokenTransferTransactionRequest) (*BuiltTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildTokenTransferTransaction not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetBlock(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamAccountUpdates(*AccountStreamRequest, BenchmarkService_StreamAccountUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAccountUpdates not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamAccountUpdateBatches(*AccountStreamRequest, BenchmarkService_StreamAccountUpdateBatchesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAccountUpdateBatches not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamAccountUpdatesWithAck(BenchmarkService_StreamAccountUpdatesWithAckServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAccountUpdatesWithAck not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamProgramAccountsSnapshot(*ProgramAccountsSnapshotRequest, BenchmarkService_StreamProgramAccountsSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProgramAccountsSnapshot not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) MeasureCommitmentLatency(*CommitmentLatencyRequest, BenchmarkService_MeasureCommitmentLatencyServer) error {
+	return status.Errorf(codes.Unimplemented, "method MeasureCommitmentLatency not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamTransactions(*TransactionStreamRequest, BenchmarkService_StreamTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTransactions not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamBlocks(*BlockStreamRequest, BenchmarkService_StreamBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlocks not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamBalanceChanges(*BalanceChangeStreamRequest, BenchmarkService_StreamBalanceChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBalanceChanges not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamAlerts(*AlertStreamRequest, BenchmarkService_StreamAlertsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAlerts not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamFeeStats(*FeeStatsRequest, BenchmarkService_StreamFeeStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFeeStats not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) RunBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunBenchmark not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StartBenchmark(context.Context, *BenchmarkRequest) (*BenchmarkJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBenchmark not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamBenchmarkProgress(*BenchmarkJobRequest, BenchmarkService_StreamBenchmarkProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBenchmarkProgress not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetBenchmarkResult(context.Context, *BenchmarkJobRequest) (*BenchmarkJobResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBenchmarkResult not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) CancelBenchmark(context.Context, *BenchmarkJobRequest) (*BenchmarkJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBenchmark not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetAccountHistory(context.Context, *AccountHistoryRequest) (*AccountHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountHistory not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetUpstreamHealth(context.Context, *UpstreamHealthRequest) (*UpstreamHealth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpstreamHealth not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetStreamStats(context.Context, *StreamStatsRequest) (*StreamStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStreamStats not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetCapabilities(context.Context, *CapabilitiesRequest) (*Capabilities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetBlockTime(context.Context, *BlockTimeRequest) (*BlockTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockTime not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) EstimateSlotAtTime(context.Context, *SlotAtTimeRequest) (*SlotAtTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSlotAtTime not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamAddressHistory(*AddressHistoryRequest, BenchmarkService_StreamAddressHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAddressHistory not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) HandoffAckSession(context.Context, *AckSessionHandoffRequest) (*AckSessionHandoff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandoffAckSession not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) BenchmarkSerialization(context.Context, *SerializationBenchmarkRequest) (*SerializationBenchmarkResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BenchmarkSerialization not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetEncodedBlock(context.Context, *EncodedBlockRequest) (*EncodedBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEncodedBlock not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) BenchmarkDualServe(context.Context, *DualServeBenchmarkRequest) (*DualServeBenchmarkResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BenchmarkDualServe not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamBenchmark(context.Context, *StreamBenchmarkRequest) (*StreamBenchmarkResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamBenchmark not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetCurrentSlot(context.Context, *CurrentSlotRequest) (*CurrentSlot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentSlot not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetBlockProduction(context.Context, *BlockProductionRequest) (*BlockProduction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockProduction not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamBlockProduction(*BlockProductionStreamRequest, BenchmarkService_StreamBlockProductionServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlockProduction not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetClusterNodes(context.Context, *ClusterNodesRequest) (*ClusterNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterNodes not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamEpochEvents(*EpochEventsRequest, BenchmarkService_StreamEpochEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEpochEvents not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetStakeActivation(context.Context, *StakeActivationRequest) (*StakeActivationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakeActivation not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetInflationReward(context.Context, *InflationRewardRequest) (*InflationRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInflationReward not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) ListOwnedAccounts(context.Context, *ListOwnedAccountsRequest) (*ListOwnedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOwnedAccounts not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamOwnedAccountChanges(*OwnedAccountChangesRequest, BenchmarkService_StreamOwnedAccountChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOwnedAccountChanges not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetSLOStatus(context.Context, *SLOStatusRequest) (*SLOStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSLOStatus not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) StreamSLOEvents(*SLOEventsRequest, BenchmarkService_StreamSLOEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSLOEvents not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) ListBenchmarks(context.Context, *ListBenchmarksRequest) (*ListBenchmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBenchmarks not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) mustEmbedUnimplementedBenchmarkServiceServer() {}
+
+// UnsafeBenchmarkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BenchmarkServiceServer will
+// result in compilation errors.
+type UnsafeBenchmarkServiceServer interface {
+	mustEmbedUnimplementedBenchmarkServiceServer()
+}
+
+func RegisterBenchmarkServiceServer(s grpc.ServiceRegistrar, srv BenchmarkServiceServer) {
+	s.RegisterService(&BenchmarkService_ServiceDesc, srv)
+}
+
+func _BenchmarkService_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetAccountInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetAccountInfo(ctx, req.(*AccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetNonceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetNonceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetNonceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetNonceAccount(ctx, req.(*NonceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_ReadConsistent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsistentReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).ReadConsistent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_ReadConsistent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).ReadConsistent(ctx, req.(*ConsistentReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetTokenAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetTokenAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetTokenAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetTokenAccount(ctx, req.(*TokenAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetNFTsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NFTsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetNFTsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetNFTsByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetNFTsByOwner(ctx, req.(*NFTsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetAsset(ctx, req.(*AssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetAssetsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetAssetsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetAssetsByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetAssetsByOwner(ctx, req.(*AssetsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetAssetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetAssetProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetAssetProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetAssetProof(ctx, req.(*AssetProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_BuildTransferTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).BuildTransferTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_BuildTransferTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).BuildTransferTransaction(ctx, req.(*TransferTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_BuildTokenTransferTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenTransferTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).BuildTokenTransferTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_BuildTokenTransferTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).BuildTokenTransferTransaction(ctx, req.(*TokenTransferTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
 		return srv.(BenchmarkServiceServer).GetTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BenchmarkService_GetTransaction_FullMethodName,
+		FullMethod: BenchmarkService_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_StreamAccountUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamAccountUpdates(m, &benchmarkServiceStreamAccountUpdatesServer{stream})
+}
+
+type BenchmarkService_StreamAccountUpdatesServer interface {
+	Send(*AccountUpdate) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamAccountUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamAccountUpdatesServer) Send(m *AccountUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_StreamAccountUpdateBatches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamAccountUpdateBatches(m, &benchmarkServiceStreamAccountUpdateBatchesServer{stream})
+}
+
+type BenchmarkService_StreamAccountUpdateBatchesServer interface {
+	Send(*AccountUpdateBatch) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamAccountUpdateBatchesServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamAccountUpdateBatchesServer) Send(m *AccountUpdateBatch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_StreamAccountUpdatesWithAck_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BenchmarkServiceServer).StreamAccountUpdatesWithAck(&benchmarkServiceStreamAccountUpdatesWithAckServer{stream})
+}
+
+type BenchmarkService_StreamAccountUpdatesWithAckServer interface {
+	Send(*AckedAccountUpdate) error
+	Recv() (*AccountAckStreamRequest, error)
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamAccountUpdatesWithAckServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamAccountUpdatesWithAckServer) Send(m *AckedAccountUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *benchmarkServiceStreamAccountUpdatesWithAckServer) Recv() (*AccountAckStreamRequest, error) {
+	m := new(AccountAckStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BenchmarkService_StreamProgramAccountsSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProgramAccountsSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamProgramAccountsSnapshot(m, &benchmarkServiceStreamProgramAccountsSnapshotServer{stream})
+}
+
+type BenchmarkService_StreamProgramAccountsSnapshotServer interface {
+	Send(*ProgramAccountsChunk) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamProgramAccountsSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamProgramAccountsSnapshotServer) Send(m *ProgramAccountsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_MeasureCommitmentLatency_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CommitmentLatencyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).MeasureCommitmentLatency(m, &benchmarkServiceMeasureCommitmentLatencyServer{stream})
+}
+
+type BenchmarkService_MeasureCommitmentLatencyServer interface {
+	Send(*CommitmentLatencySample) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceMeasureCommitmentLatencyServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceMeasureCommitmentLatencyServer) Send(m *CommitmentLatencySample) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_StreamTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamTransactions(m, &benchmarkServiceStreamTransactionsServer{stream})
+}
+
+type BenchmarkService_StreamTransactionsServer interface {
+	Send(*TransactionUpdate) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamTransactionsServer) Send(m *TransactionUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamBlocks(m, &benchmarkServiceStreamBlocksServer{stream})
+}
+
+type BenchmarkService_StreamBlocksServer interface {
+	Send(*BlockUpdate) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamBlocksServer) Send(m *BlockUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_StreamBalanceChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BalanceChangeStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamBalanceChanges(m, &benchmarkServiceStreamBalanceChangesServer{stream})
+}
+
+type BenchmarkService_StreamBalanceChangesServer interface {
+	Send(*BlockBalanceChanges) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamBalanceChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamBalanceChangesServer) Send(m *BlockBalanceChanges) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_StreamAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AlertStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamAlerts(m, &benchmarkServiceStreamAlertsServer{stream})
+}
+
+type BenchmarkService_StreamAlertsServer interface {
+	Send(*Alert) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamAlertsServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamAlertsServer) Send(m *Alert) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_StreamFeeStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FeeStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamFeeStats(m, &benchmarkServiceStreamFeeStatsServer{stream})
+}
+
+type BenchmarkService_StreamFeeStatsServer interface {
+	Send(*FeeStats) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamFeeStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamFeeStatsServer) Send(m *FeeStats) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_RunBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).RunBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_RunBenchmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).RunBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_StartBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).StartBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_StartBenchmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).StartBenchmark(ctx, req.(*BenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_StreamBenchmarkProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BenchmarkJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamBenchmarkProgress(m, &benchmarkServiceStreamBenchmarkProgressServer{stream})
+}
+
+type BenchmarkService_StreamBenchmarkProgressServer interface {
+	Send(*BenchmarkProgress) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamBenchmarkProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamBenchmarkProgressServer) Send(m *BenchmarkProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_GetBenchmarkResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetBenchmarkResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetBenchmarkResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetBenchmarkResult(ctx, req.(*BenchmarkJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_CancelBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).CancelBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_CancelBenchmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).CancelBenchmark(ctx, req.(*BenchmarkJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetAccountHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetAccountHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetAccountHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BenchmarkServiceServer).GetTransaction(ctx, req.(*TransactionRequest))
+		return srv.(BenchmarkServiceServer).GetAccountHistory(ctx, req.(*AccountHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BenchmarkService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
+func _BenchmarkService_GetUpstreamHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpstreamHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BenchmarkServiceServer).GetBlock(ctx, in)
+		return srv.(BenchmarkServiceServer).GetUpstreamHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BenchmarkService_GetBlock_FullMethodName,
+		FullMethod: BenchmarkService_GetUpstreamHealth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BenchmarkServiceServer).GetBlock(ctx, req.(*BlockRequest))
+		return srv.(BenchmarkServiceServer).GetUpstreamHealth(ctx, req.(*UpstreamHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BenchmarkService_StreamAccountUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(AccountStreamRequest)
+func _BenchmarkService_GetStreamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetStreamStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetStreamStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetStreamStats(ctx, req.(*StreamStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetCapabilities(ctx, req.(*CapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetBlockTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetBlockTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetBlockTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetBlockTime(ctx, req.(*BlockTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_EstimateSlotAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotAtTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).EstimateSlotAtTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_EstimateSlotAtTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).EstimateSlotAtTime(ctx, req.(*SlotAtTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_StreamAddressHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AddressHistoryRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(BenchmarkServiceServer).StreamAccountUpdates(m, &benchmarkServiceStreamAccountUpdatesServer{stream})
+	return srv.(BenchmarkServiceServer).StreamAddressHistory(m, &benchmarkServiceStreamAddressHistoryServer{stream})
 }
 
-type BenchmarkService_StreamAccountUpdatesServer interface {
-	Send(*AccountUpdate) error
+type BenchmarkService_StreamAddressHistoryServer interface {
+	Send(*AddressHistoryUpdate) error
 	grpc.ServerStream
 }
 
-type benchmarkServiceStreamAccountUpdatesServer struct {
+type benchmarkServiceStreamAddressHistoryServer struct {
 	grpc.ServerStream
 }
 
-func (x *benchmarkServiceStreamAccountUpdatesServer) Send(m *AccountUpdate) error {
+func (x *benchmarkServiceStreamAddressHistoryServer) Send(m *AddressHistoryUpdate) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _BenchmarkService_StreamTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(TransactionStreamRequest)
+func _BenchmarkService_HandoffAckSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckSessionHandoffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).HandoffAckSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_HandoffAckSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).HandoffAckSession(ctx, req.(*AckSessionHandoffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_BenchmarkSerialization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SerializationBenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).BenchmarkSerialization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_BenchmarkSerialization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).BenchmarkSerialization(ctx, req.(*SerializationBenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetEncodedBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodedBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetEncodedBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetEncodedBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetEncodedBlock(ctx, req.(*EncodedBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_BenchmarkDualServe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DualServeBenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).BenchmarkDualServe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_BenchmarkDualServe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).BenchmarkDualServe(ctx, req.(*DualServeBenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_StreamBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamBenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).StreamBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_StreamBenchmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).StreamBenchmark(ctx, req.(*StreamBenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetCurrentSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrentSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetCurrentSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetCurrentSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetCurrentSlot(ctx, req.(*CurrentSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetBlockProduction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockProductionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetBlockProduction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetBlockProduction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetBlockProduction(ctx, req.(*BlockProductionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_StreamBlockProduction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockProductionStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(BenchmarkServiceServer).StreamTransactions(m, &benchmarkServiceStreamTransactionsServer{stream})
+	return srv.(BenchmarkServiceServer).StreamBlockProduction(m, &benchmarkServiceStreamBlockProductionServer{stream})
 }
 
-type BenchmarkService_StreamTransactionsServer interface {
-	Send(*TransactionUpdate) error
+type BenchmarkService_StreamBlockProductionServer interface {
+	Send(*BlockProduction) error
 	grpc.ServerStream
 }
 
-type benchmarkServiceStreamTransactionsServer struct {
+type benchmarkServiceStreamBlockProductionServer struct {
 	grpc.ServerStream
 }
 
-func (x *benchmarkServiceStreamTransactionsServer) Send(m *TransactionUpdate) error {
+func (x *benchmarkServiceStreamBlockProductionServer) Send(m *BlockProduction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_GetClusterNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetClusterNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetClusterNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetClusterNodes(ctx, req.(*ClusterNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_StreamEpochEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EpochEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamEpochEvents(m, &benchmarkServiceStreamEpochEventsServer{stream})
+}
+
+type BenchmarkService_StreamEpochEventsServer interface {
+	Send(*EpochEvent) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamEpochEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamEpochEventsServer) Send(m *EpochEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BenchmarkService_GetStakeActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StakeActivationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetStakeActivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetStakeActivation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetStakeActivation(ctx, req.(*StakeActivationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetInflationReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InflationRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetInflationReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetInflationReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetInflationReward(ctx, req.(*InflationRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_ListOwnedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOwnedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).ListOwnedAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_ListOwnedAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).ListOwnedAccounts(ctx, req.(*ListOwnedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_StreamOwnedAccountChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OwnedAccountChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).StreamOwnedAccountChanges(m, &benchmarkServiceStreamOwnedAccountChangesServer{stream})
+}
+
+type BenchmarkService_StreamOwnedAccountChangesServer interface {
+	Send(*OwnedAccountEvent) error
+	grpc.ServerStream
+}
+
+type benchmarkServiceStreamOwnedAccountChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *benchmarkServiceStreamOwnedAccountChangesServer) Send(m *OwnedAccountEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _BenchmarkService_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(BlockStreamRequest)
+func _BenchmarkService_GetSLOStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SLOStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetSLOStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetSLOStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetSLOStatus(ctx, req.(*SLOStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_StreamSLOEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SLOEventsRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(BenchmarkServiceServer).StreamBlocks(m, &benchmarkServiceStreamBlocksServer{stream})
+	return srv.(BenchmarkServiceServer).StreamSLOEvents(m, &benchmarkServiceStreamSLOEventsServer{stream})
 }
 
-type BenchmarkService_StreamBlocksServer interface {
-	Send(*BlockUpdate) error
+type BenchmarkService_StreamSLOEventsServer interface {
+	Send(*SLOEvent) error
 	grpc.ServerStream
 }
 
-type benchmarkServiceStreamBlocksServer struct {
+type benchmarkServiceStreamSLOEventsServer struct {
 	grpc.ServerStream
 }
 
-func (x *benchmarkServiceStreamBlocksServer) Send(m *BlockUpdate) error {
+func (x *benchmarkServiceStreamSLOEventsServer) Send(m *SLOEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _BenchmarkService_RunBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BenchmarkRequest)
+func _BenchmarkService_ListBenchmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBenchmarksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BenchmarkServiceServer).RunBenchmark(ctx, in)
+		return srv.(BenchmarkServiceServer).ListBenchmarks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BenchmarkService_RunBenchmark_FullMethodName,
+		FullMethod: BenchmarkService_ListBenchmarks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BenchmarkServiceServer).RunBenchmark(ctx, req.(*BenchmarkRequest))
+		return srv.(BenchmarkServiceServer).ListBenchmarks(ctx, req.(*ListBenchmarksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -393,6 +2371,42 @@ var BenchmarkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccountInfo",
 			Handler:    _BenchmarkService_GetAccountInfo_Handler,
 		},
+		{
+			MethodName: "GetNonceAccount",
+			Handler:    _BenchmarkService_GetNonceAccount_Handler,
+		},
+		{
+			MethodName: "ReadConsistent",
+			Handler:    _BenchmarkService_ReadConsistent_Handler,
+		},
+		{
+			MethodName: "GetTokenAccount",
+			Handler:    _BenchmarkService_GetTokenAccount_Handler,
+		},
+		{
+			MethodName: "GetNFTsByOwner",
+			Handler:    _BenchmarkService_GetNFTsByOwner_Handler,
+		},
+		{
+			MethodName: "GetAsset",
+			Handler:    _BenchmarkService_GetAsset_Handler,
+		},
+		{
+			MethodName: "GetAssetsByOwner",
+			Handler:    _BenchmarkService_GetAssetsByOwner_Handler,
+		},
+		{
+			MethodName: "GetAssetProof",
+			Handler:    _BenchmarkService_GetAssetProof_Handler,
+		},
+		{
+			MethodName: "BuildTransferTransaction",
+			Handler:    _BenchmarkService_BuildTransferTransaction_Handler,
+		},
+		{
+			MethodName: "BuildTokenTransferTransaction",
+			Handler:    _BenchmarkService_BuildTokenTransferTransaction_Handler,
+		},
 		{
 			MethodName: "GetTransaction",
 			Handler:    _BenchmarkService_GetTransaction_Handler,
@@ -405,6 +2419,94 @@ var BenchmarkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunBenchmark",
 			Handler:    _BenchmarkService_RunBenchmark_Handler,
 		},
+		{
+			MethodName: "StartBenchmark",
+			Handler:    _BenchmarkService_StartBenchmark_Handler,
+		},
+		{
+			MethodName: "GetBenchmarkResult",
+			Handler:    _BenchmarkService_GetBenchmarkResult_Handler,
+		},
+		{
+			MethodName: "CancelBenchmark",
+			Handler:    _BenchmarkService_CancelBenchmark_Handler,
+		},
+		{
+			MethodName: "GetAccountHistory",
+			Handler:    _BenchmarkService_GetAccountHistory_Handler,
+		},
+		{
+			MethodName: "GetUpstreamHealth",
+			Handler:    _BenchmarkService_GetUpstreamHealth_Handler,
+		},
+		{
+			MethodName: "GetStreamStats",
+			Handler:    _BenchmarkService_GetStreamStats_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _BenchmarkService_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "GetBlockTime",
+			Handler:    _BenchmarkService_GetBlockTime_Handler,
+		},
+		{
+			MethodName: "EstimateSlotAtTime",
+			Handler:    _BenchmarkService_EstimateSlotAtTime_Handler,
+		},
+		{
+			MethodName: "HandoffAckSession",
+			Handler:    _BenchmarkService_HandoffAckSession_Handler,
+		},
+		{
+			MethodName: "BenchmarkSerialization",
+			Handler:    _BenchmarkService_BenchmarkSerialization_Handler,
+		},
+		{
+			MethodName: "GetEncodedBlock",
+			Handler:    _BenchmarkService_GetEncodedBlock_Handler,
+		},
+		{
+			MethodName: "BenchmarkDualServe",
+			Handler:    _BenchmarkService_BenchmarkDualServe_Handler,
+		},
+		{
+			MethodName: "StreamBenchmark",
+			Handler:    _BenchmarkService_StreamBenchmark_Handler,
+		},
+		{
+			MethodName: "GetCurrentSlot",
+			Handler:    _BenchmarkService_GetCurrentSlot_Handler,
+		},
+		{
+			MethodName: "GetBlockProduction",
+			Handler:    _BenchmarkService_GetBlockProduction_Handler,
+		},
+		{
+			MethodName: "GetClusterNodes",
+			Handler:    _BenchmarkService_GetClusterNodes_Handler,
+		},
+		{
+			MethodName: "GetStakeActivation",
+			Handler:    _BenchmarkService_GetStakeActivation_Handler,
+		},
+		{
+			MethodName: "GetInflationReward",
+			Handler:    _BenchmarkService_GetInflationReward_Handler,
+		},
+		{
+			MethodName: "ListOwnedAccounts",
+			Handler:    _BenchmarkService_ListOwnedAccounts_Handler,
+		},
+		{
+			MethodName: "GetSLOStatus",
+			Handler:    _BenchmarkService_GetSLOStatus_Handler,
+		},
+		{
+			MethodName: "ListBenchmarks",
+			Handler:    _BenchmarkService_ListBenchmarks_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -412,6 +2514,27 @@ var BenchmarkService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _BenchmarkService_StreamAccountUpdates_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamAccountUpdateBatches",
+			Handler:       _BenchmarkService_StreamAccountUpdateBatches_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAccountUpdatesWithAck",
+			Handler:       _BenchmarkService_StreamAccountUpdatesWithAck_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamProgramAccountsSnapshot",
+			Handler:       _BenchmarkService_StreamProgramAccountsSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MeasureCommitmentLatency",
+			Handler:       _BenchmarkService_MeasureCommitmentLatency_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StreamTransactions",
 			Handler:       _BenchmarkService_StreamTransactions_Handler,
@@ -422,6 +2545,305 @@ var BenchmarkService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _BenchmarkService_StreamBlocks_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamBalanceChanges",
+			Handler:       _BenchmarkService_StreamBalanceChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAlerts",
+			Handler:       _BenchmarkService_StreamAlerts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamFeeStats",
+			Handler:       _BenchmarkService_StreamFeeStats_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBenchmarkProgress",
+			Handler:       _BenchmarkService_StreamBenchmarkProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAddressHistory",
+			Handler:       _BenchmarkService_StreamAddressHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBlockProduction",
+			Handler:       _BenchmarkService_StreamBlockProduction_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEpochEvents",
+			Handler:       _BenchmarkService_StreamEpochEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamOwnedAccountChanges",
+			Handler:       _BenchmarkService_StreamOwnedAccountChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamSLOEvents",
+			Handler:       _BenchmarkService_StreamSLOEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/solana_benchmark.proto",
+}
+
+const (
+	UtilsService_FindProgramAddress_FullMethodName        = "/solana.benchmark.UtilsService/FindProgramAddress"
+	UtilsService_CreateWithSeed_FullMethodName            = "/solana.benchmark.UtilsService/CreateWithSeed"
+	UtilsService_GetAssociatedTokenAddress_FullMethodName = "/solana.benchmark.UtilsService/GetAssociatedTokenAddress"
+	UtilsService_VerifySignature_FullMethodName           = "/solana.benchmark.UtilsService/VerifySignature"
+	UtilsService_DecodeTransaction_FullMethodName         = "/solana.benchmark.UtilsService/DecodeTransaction"
+)
+
+// UtilsServiceClient is the client API for UtilsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UtilsServiceClient interface {
+	// FindProgramAddress derives the program-derived address (PDA) of seeds and
+	// its bump seed
+	FindProgramAddress(ctx context.Context, in *FindProgramAddressRequest, opts ...grpc.CallOption) (*DerivedAddress, error)
+	// CreateWithSeed derives the address of an account created with a seed
+	CreateWithSeed(ctx context.Context, in *CreateWithSeedRequest, opts ...grpc.CallOption) (*DerivedAddress, error)
+	// GetAssociatedTokenAddress derives the associated token account of a
+	// wallet for a mint
+	GetAssociatedTokenAddress(ctx context.Context, in *AssociatedTokenAddressRequest, opts ...grpc.CallOption) (*DerivedAddress, error)
+	// VerifySignature verifies an ed25519 signature of a message
+	VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*VerifySignatureResponse, error)
+	// DecodeTransaction decodes a serialized transaction into its message and
+	// verifies its signatures
+	DecodeTransaction(ctx context.Context, in *DecodeTransactionRequest, opts ...grpc.CallOption) (*DecodedTransaction, error)
+}
+
+type utilsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUtilsServiceClient(cc grpc.ClientConnInterface) UtilsServiceClient {
+	return &utilsServiceClient{cc}
+}
+
+func (c *utilsServiceClient) FindProgramAddress(ctx context.Context, in *FindProgramAddressRequest, opts ...grpc.CallOption) (*DerivedAddress, error) {
+	out := new(DerivedAddress)
+	err := c.cc.Invoke(ctx, UtilsService_FindProgramAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *utilsServiceClient) CreateWithSeed(ctx context.Context, in *CreateWithSeedRequest, opts ...grpc.CallOption) (*DerivedAddress, error) {
+	out := new(DerivedAddress)
+	err := c.cc.Invoke(ctx, UtilsService_CreateWithSeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *utilsServiceClient) GetAssociatedTokenAddress(ctx context.Context, in *AssociatedTokenAddressRequest, opts ...grpc.CallOption) (*DerivedAddress, error) {
+	out := new(DerivedAddress)
+	err := c.cc.Invoke(ctx, UtilsService_GetAssociatedTokenAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *utilsServiceClient) VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*VerifySignatureResponse, error) {
+	out := new(VerifySignatureResponse)
+	err := c.cc.Invoke(ctx, UtilsService_VerifySignature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *utilsServiceClient) DecodeTransaction(ctx context.Context, in *DecodeTransactionRequest, opts ...grpc.CallOption) (*DecodedTransaction, error) {
+	out := new(DecodedTransaction)
+	err := c.cc.Invoke(ctx, UtilsService_DecodeTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UtilsServiceServer is the server API for UtilsService service.
+// All implementations must embed UnimplementedUtilsServiceServer
+// for forward compatibility
+type UtilsServiceServer interface {
+	// FindProgramAddress derives the program-derived address (PDA) of seeds and
+	// its bump seed
+	FindProgramAddress(context.Context, *FindProgramAddressRequest) (*DerivedAddress, error)
+	// CreateWithSeed derives the address of an account created with a seed
+	CreateWithSeed(context.Context, *CreateWithSeedRequest) (*DerivedAddress, error)
+	// GetAssociatedTokenAddress derives the associated token account of a
+	// wallet for a mint
+	GetAssociatedTokenAddress(context.Context, *AssociatedTokenAddressRequest) (*DerivedAddress, error)
+	// VerifySignature verifies an ed25519 signature of a message
+	VerifySignature(context.Context, *VerifySignatureRequest) (*VerifySignatureResponse, error)
+	// DecodeTransaction decodes a serialized transaction into its message and
+	// verifies its signatures
+	DecodeTransaction(context.Context, *DecodeTransactionRequest) (*DecodedTransaction, error)
+	mustEmbedUnimplementedUtilsServiceServer()
+}
+
+// UnimplementedUtilsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUtilsServiceServer struct {
+}
+
+func (UnimplementedUtilsServiceServer) FindProgramAddress(context.Context, *FindProgramAddressRequest) (*DerivedAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindProgramAddress not implemented")
+}
+func (UnimplementedUtilsServiceServer) CreateWithSeed(context.Context, *CreateWithSeedRequest) (*DerivedAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWithSeed not implemented")
+}
+func (UnimplementedUtilsServiceServer) GetAssociatedTokenAddress(context.Context, *AssociatedTokenAddressRequest) (*DerivedAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssociatedTokenAddress not implemented")
+}
+func (UnimplementedUtilsServiceServer) VerifySignature(context.Context, *VerifySignatureRequest) (*VerifySignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySignature not implemented")
+}
+func (UnimplementedUtilsServiceServer) DecodeTransaction(context.Context, *DecodeTransactionRequest) (*DecodedTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeTransaction not implemented")
+}
+func (UnimplementedUtilsServiceServer) mustEmbedUnimplementedUtilsServiceServer() {}
+
+// UnsafeUtilsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UtilsServiceServer will
+// result in compilation errors.
+type UnsafeUtilsServiceServer interface {
+	mustEmbedUnimplementedUtilsServiceServer()
+}
+
+func RegisterUtilsServiceServer(s grpc.ServiceRegistrar, srv UtilsServiceServer) {
+	s.RegisterService(&UtilsService_ServiceDesc, srv)
+}
+
+func _UtilsService_FindProgramAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindProgramAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UtilsServiceServer).FindProgramAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UtilsService_FindProgramAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UtilsServiceServer).FindProgramAddress(ctx, req.(*FindProgramAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UtilsService_CreateWithSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWithSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UtilsServiceServer).CreateWithSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UtilsService_CreateWithSeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UtilsServiceServer).CreateWithSeed(ctx, req.(*CreateWithSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UtilsService_GetAssociatedTokenAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssociatedTokenAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UtilsServiceServer).GetAssociatedTokenAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UtilsService_GetAssociatedTokenAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UtilsServiceServer).GetAssociatedTokenAddress(ctx, req.(*AssociatedTokenAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UtilsService_VerifySignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UtilsServiceServer).VerifySignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UtilsService_VerifySignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UtilsServiceServer).VerifySignature(ctx, req.(*VerifySignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UtilsService_DecodeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UtilsServiceServer).DecodeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UtilsService_DecodeTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UtilsServiceServer).DecodeTransaction(ctx, req.(*DecodeTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UtilsService_ServiceDesc is the grpc.ServiceDesc for UtilsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UtilsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "solana.benchmark.UtilsService",
+	HandlerType: (*UtilsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindProgramAddress",
+			Handler:    _UtilsService_FindProgramAddress_Handler,
+		},
+		{
+			MethodName: "CreateWithSeed",
+			Handler:    _UtilsService_CreateWithSeed_Handler,
+		},
+		{
+			MethodName: "GetAssociatedTokenAddress",
+			Handler:    _UtilsService_GetAssociatedTokenAddress_Handler,
+		},
+		{
+			MethodName: "VerifySignature",
+			Handler:    _UtilsService_VerifySignature_Handler,
+		},
+		{
+			MethodName: "DecodeTransaction",
+			Handler:    _UtilsService_DecodeTransaction_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/solana_benchmark.proto",
 }
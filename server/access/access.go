@@ -0,0 +1,174 @@
+// Package access filters callers by IP address, as the first line of
+// protection for a server exposed to the internet.
+package access
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/server/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Filter admits callers by CIDR rules. A caller matching a deny rule is
+// rejected; otherwise, if there are allow rules, it must match one of them.
+type Filter struct {
+	allow []netip.Prefix
+	deny  []netip.Prefix
+
+	mu sync.Mutex
+	// rejected counts rejected calls by the reason they were rejected for:
+	// the deny rule matched, or "not allowed"
+	rejected map[string]uint64
+}
+
+// New parses allow and deny rules, each a CIDR such as 10.0.0.0/8 or a
+// single address
+func New(allow, deny []string) (*Filter, error) {
+	f := &Filter{rejected: make(map[string]uint64)}
+	var err error
+	if f.allow, err = parseRules(allow); err != nil {
+		return nil, err
+	}
+	if f.deny, err = parseRules(deny); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func parseRules(rules []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(rules))
+	for _, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(rule); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(rule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid access rule %q: not a CIDR or IP address", rule)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// Enabled reports whether the filter has any rules
+func (f *Filter) Enabled() bool {
+	return len(f.allow) > 0 || len(f.deny) > 0
+}
+
+// UnaryInterceptor rejects unary calls from filtered callers
+func (f *Filter) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := f.check(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects streaming calls from filtered callers
+func (f *Filter) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := f.check(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// check rejects a call with PERMISSION_DENIED unless its caller is admitted.
+// Callers without an IP address, such as in-process connections, only pass
+// when there are no allow rules.
+func (f *Filter) check(ctx context.Context) error {
+	addr, ok := callerAddr(ctx)
+	if ok {
+		for _, prefix := range f.deny {
+			if prefix.Contains(addr) {
+				f.reject(ctx, "denied by " + prefix.String())
+				return status.Error(codes.PermissionDenied, "caller address is denied")
+			}
+		}
+	}
+	if len(f.allow) == 0 {
+		return nil
+	}
+	if ok {
+		for _, prefix := range f.allow {
+			if prefix.Contains(addr) {
+				return nil
+			}
+		}
+	}
+	f.reject(ctx, "not allowed")
+	return status.Error(codes.PermissionDenied, "caller address is not allowed")
+}
+
+func (f *Filter) reject(ctx context.Context, reason string) {
+	metrics.AccessRejected.Inc(reason, metrics.Tenant(ctx))
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rejected[reason]++
+}
+
+// Rejected returns the number of rejected calls by reason since the last
+// call, resetting the counts
+func (f *Filter) Rejected() map[string]uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	rejected := f.rejected
+	f.rejected = make(map[string]uint64)
+	return rejected
+}
+
+// Report logs the calls rejected in each interval until ctx is done, rather
+// than one line per rejected call
+func (f *Filter) Report(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		rejected := f.Rejected()
+		reasons := make([]string, 0, len(rejected))
+		for reason := range rejected {
+			reasons = append(reasons, reason)
+		}
+		sort.Strings(reasons)
+		for _, reason := range reasons {
+			log.Printf("Rejected %d calls in the last %v: %s", rejected[reason], interval, reason)
+		}
+	}
+}
+
+// callerAddr returns the IP address of the caller, if it has one
+func callerAddr(ctx context.Context) (netip.Addr, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return netip.Addr{}, false
+	}
+	host := p.Addr.String()
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
@@ -0,0 +1,169 @@
+// Package audit records every call the server handles to an append-only log,
+// so the use of an upstream RPC key shared through the server can be traced
+// back to its callers.
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// maxParamsLength caps the request summary recorded, so calls carrying large
+// payloads such as serialized transactions do not bloat the log
+const maxParamsLength = 512
+
+// apiKeyHeader is the metadata key callers identify themselves with
+const apiKeyHeader = "x-api-key"
+
+// Record is one audited call
+type Record struct {
+	// Time is when the call started
+	Time   time.Time `json:"time"`
+	Method string    `json:"method"`
+	// Params summarizes the request message, or the first message of a
+	// client stream, as truncated JSON
+	Params string `json:"params,omitempty"`
+	// APIKey is a fingerprint of the caller's x-api-key, never the key itself
+	APIKey string `json:"api_key,omitempty"`
+	Peer   string `json:"peer"`
+	// DurationMs is how long the call took; for streams, how long they were open
+	DurationMs int64  `json:"duration_ms"`
+	Code       string `json:"code"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Log appends a JSON line per call to a file
+type Log struct {
+	mu      sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// Open opens the audit log at path for appending, creating it if needed
+func Open(path string) (*Log, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %v", err)
+	}
+	return &Log{file: file, encoder: json.NewEncoder(file)}, nil
+}
+
+// Close closes the log file
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// UnaryInterceptor records every unary call
+func (l *Log) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	l.record(ctx, info.FullMethod, summarize(req), start, err)
+	return resp, err
+}
+
+// StreamInterceptor records every streaming call once it ends. It is meant to
+// run first, so calls rejected by later interceptors are recorded as well.
+func (l *Log) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	stream := &auditedStream{ServerStream: ss}
+	err := handler(srv, stream)
+	l.record(ss.Context(), info.FullMethod, stream.params, start, err)
+	return err
+}
+
+// auditedStream captures the summary of the first message received
+type auditedStream struct {
+	grpc.ServerStream
+	received bool
+	params   string
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && !s.received {
+		s.received = true
+		s.params = summarize(m)
+	}
+	return err
+}
+
+// record writes the record of a call. Failing to write is logged rather than
+// failing the call.
+func (l *Log) record(ctx context.Context, method, params string, start time.Time, err error) {
+	record := Record{
+		Time:       start.UTC(),
+		Method:     method,
+		Params:     params,
+		APIKey:     apiKeyFingerprint(ctx),
+		Peer:       peerAddress(ctx),
+		DurationMs: time.Since(start).Milliseconds(),
+		Code:       status.Code(err).String(),
+	}
+	if err != nil {
+		record.Error = status.Convert(err).Message()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.encoder.Encode(record); err != nil {
+		log.Printf("Failed to write audit record of %s: %v", method, err)
+	}
+}
+
+// summarize renders a request message as JSON, truncated to maxParamsLength
+func summarize(m interface{}) string {
+	message, ok := m.(protobuf.Message)
+	if !ok {
+		return ""
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return ""
+	}
+	if len(data) > maxParamsLength {
+		return string(data[:maxParamsLength]) + "..."
+	}
+	return string(data)
+}
+
+// apiKeyFingerprint identifies the caller's API key by a prefix of its
+// SHA-256 hash, so the log can attribute calls without leaking keys
+func apiKeyFingerprint(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(apiKeyHeader)
+	if len(keys) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(keys[0]))
+	return "sha256:" + hex.EncodeToString(sum[:8])
+}
+
+// peerAddress returns the caller's IP address
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	address := p.Addr.String()
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
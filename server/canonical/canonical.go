@@ -0,0 +1,230 @@
+// Package canonical serializes messages canonically, so that replicas serving
+// the same data produce the same bytes, and hashes the content of the blocks
+// and transactions they serve.
+package canonical
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ContentHashField is the field messages carry their content hash in
+const ContentHashField = "content_hash"
+
+// localFields are the top-level fields a content hash leaves out: the hash
+// itself, and the timings, receive times and slot clocks that differ between
+// replicas serving the same data
+var localFields = map[protoreflect.Name]bool{
+	ContentHashField:   true,
+	"response_time_ms": true,
+	"server_slot":      true,
+	"timing":           true,
+	"timestamp":        true,
+}
+
+// Marshal returns the canonical encoding of a message, a valid protobuf
+// encoding fully determined by the message's content and field numbers, so
+// that any implementation can reproduce it:
+//   - set fields are written in field number order, fields holding their
+//     default value in proto3 are left out, and unknown fields are dropped;
+//   - repeated scalars are packed unless declared otherwise, and repeated
+//     elements keep their order;
+//   - map entries are sorted by key, numerically or by bytes, and always
+//     carry both their key and value;
+//   - nested messages are encoded the same way, and varints use the fewest
+//     bytes.
+//
+// Unlike the library's deterministic marshalling, which only promises stable
+// bytes from one build of one implementation, the encoding does not change
+// between protobuf versions or languages.
+func Marshal(m protobuf.Message) ([]byte, error) {
+	return appendMessage(nil, m.ProtoReflect())
+}
+
+func appendMessage(b []byte, msg protoreflect.Message) ([]byte, error) {
+	var fds []protoreflect.FieldDescriptor
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fds = append(fds, fd)
+		return true
+	})
+	sort.Slice(fds, func(i, j int) bool { return fds[i].Number() < fds[j].Number() })
+
+	var err error
+	for _, fd := range fds {
+		if b, err = appendField(b, fd, msg.Get(fd)); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func appendField(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	var err error
+	switch {
+	case fd.IsMap():
+		return appendMap(b, fd, v.Map())
+	case fd.IsList() && fd.IsPacked():
+		list := v.List()
+		var packed []byte
+		for i := 0; i < list.Len(); i++ {
+			if packed, err = appendValue(packed, fd, list.Get(i)); err != nil {
+				return nil, err
+			}
+		}
+		b = protowire.AppendTag(b, fd.Number(), protowire.BytesType)
+		return protowire.AppendBytes(b, packed), nil
+	case fd.IsList():
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			if b, err = appendTagged(b, fd, list.Get(i)); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	return appendTagged(b, fd, v)
+}
+
+// appendMap writes one entry per key, sorted, as a message whose key is field
+// 1 and value field 2
+func appendMap(b []byte, fd protoreflect.FieldDescriptor, m protoreflect.Map) ([]byte, error) {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool { return lessMapKey(keys[i], keys[j]) })
+
+	keyFD, valueFD := fd.MapKey(), fd.MapValue()
+	for _, key := range keys {
+		entry, err := appendTagged(nil, keyFD, key.Value())
+		if err != nil {
+			return nil, err
+		}
+		if entry, err = appendTagged(entry, valueFD, m.Get(key)); err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, fd.Number(), protowire.BytesType)
+		b = protowire.AppendBytes(b, entry)
+	}
+	return b, nil
+}
+
+// lessMapKey orders map keys of the same kind: false before true, integers
+// numerically and strings by their UTF-8 bytes
+func lessMapKey(a, b protoreflect.MapKey) bool {
+	switch a.Interface().(type) {
+	case bool:
+		return !a.Bool() && b.Bool()
+	case int32, int64:
+		return a.Int() < b.Int()
+	case uint32, uint64:
+		return a.Uint() < b.Uint()
+	}
+	return a.String() < b.String()
+}
+
+func appendTagged(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	if fd.Kind() == protoreflect.GroupKind {
+		var err error
+		b = protowire.AppendTag(b, fd.Number(), protowire.StartGroupType)
+		if b, err = appendMessage(b, v.Message()); err != nil {
+			return nil, err
+		}
+		return protowire.AppendTag(b, fd.Number(), protowire.EndGroupType), nil
+	}
+	b = protowire.AppendTag(b, fd.Number(), wireType(fd.Kind()))
+	return appendValue(b, fd, v)
+}
+
+func wireType(kind protoreflect.Kind) protowire.Type {
+	switch kind {
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return protowire.Fixed32Type
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return protowire.Fixed64Type
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind:
+		return protowire.BytesType
+	}
+	return protowire.VarintType
+}
+
+// appendValue writes a value without its tag
+func appendValue(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protowire.AppendVarint(b, protowire.EncodeBool(v.Bool())), nil
+	case protoreflect.EnumKind:
+		return protowire.AppendVarint(b, uint64(v.Enum())), nil
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return protowire.AppendVarint(b, uint64(v.Int())), nil
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return protowire.AppendVarint(b, v.Uint()), nil
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return protowire.AppendVarint(b, protowire.EncodeZigZag(v.Int())), nil
+	case protoreflect.Fixed32Kind:
+		return protowire.AppendFixed32(b, uint32(v.Uint())), nil
+	case protoreflect.Sfixed32Kind:
+		return protowire.AppendFixed32(b, uint32(v.Int())), nil
+	case protoreflect.FloatKind:
+		return protowire.AppendFixed32(b, math.Float32bits(float32(v.Float()))), nil
+	case protoreflect.Fixed64Kind:
+		return protowire.AppendFixed64(b, v.Uint()), nil
+	case protoreflect.Sfixed64Kind:
+		return protowire.AppendFixed64(b, uint64(v.Int())), nil
+	case protoreflect.DoubleKind:
+		return protowire.AppendFixed64(b, math.Float64bits(v.Float())), nil
+	case protoreflect.StringKind:
+		return protowire.AppendString(b, v.String()), nil
+	case protoreflect.BytesKind:
+		return protowire.AppendBytes(b, v.Bytes()), nil
+	case protoreflect.MessageKind:
+		nested, err := appendMessage(nil, v.Message())
+		if err != nil {
+			return nil, err
+		}
+		return protowire.AppendBytes(b, nested), nil
+	}
+	return nil, fmt.Errorf("canonical: unsupported field kind %v of %s", fd.Kind(), fd.FullName())
+}
+
+// ContentHash returns the SHA-256 hash of a message's canonical encoding,
+// without its replica-local fields. Two consumers holding the same hash
+// received the same data, whichever replicas served it.
+func ContentHash(m protobuf.Message) ([]byte, error) {
+	content := protobuf.Clone(m)
+	msg := content.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); localFields[fd.Name()] {
+			msg.Clear(fd)
+		}
+	}
+	data, err := Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+// SetContentHash sets a message's content hash, if it has a content hash field
+func SetContentHash(m protobuf.Message) error {
+	msg := m.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName(ContentHashField)
+	if fd == nil {
+		return nil
+	}
+	hash, err := ContentHash(m)
+	if err != nil {
+		return err
+	}
+	msg.Set(fd, protoreflect.ValueOfBytes(hash))
+	return nil
+}
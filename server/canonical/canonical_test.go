@@ -0,0 +1,66 @@
+package canonical
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// TestMarshal expects the encoding spelled out on Marshal, byte for byte
+func TestMarshal(t *testing.T) {
+	update := &proto.BlockUpdate{
+		Slot:  5,
+		Reorg: &proto.ReorgEvent{AbandonedSlots: []uint64{7, 300}},
+	}
+	// Unknown fields are dropped
+	unknown := protowire.AppendTag(nil, 99, protowire.VarintType)
+	update.ProtoReflect().SetUnknown(protowire.AppendVarint(unknown, 1))
+
+	tests := []struct {
+		name string
+		msg  protobuf.Message
+		want string
+	}{
+		{
+			name: "packed and nested",
+			msg:  update,
+			// slot; reorg holding the packed abandoned slots
+			want: "0805" + "4205" + "0a0307ac02",
+		},
+		{
+			name: "sorted map",
+			msg: &proto.ClusterNodesResponse{
+				TotalNodes: 3,
+				Versions:   map[string]uint32{"c": 0, "b": 2, "a": 1},
+			},
+			// total_nodes; an entry per key in order, zero values included
+			want: "1003" + "1a050a01611001" + "1a050a01621002" + "1a050a01631000",
+		},
+		{
+			name: "defaults",
+			msg:  &proto.BlockUpdate{},
+			want: "",
+		},
+	}
+	for _, test := range tests {
+		data, err := Marshal(test.msg)
+		if err != nil {
+			t.Fatalf("%s: Marshal: %v", test.name, err)
+		}
+		if got := hex.EncodeToString(data); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.name, got, test.want)
+		}
+
+		decoded := test.msg.ProtoReflect().New().Interface()
+		if err := protobuf.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("%s: Unmarshal: %v", test.name, err)
+		}
+		test.msg.ProtoReflect().SetUnknown(nil)
+		if !protobuf.Equal(decoded, test.msg) {
+			t.Errorf("%s: decoded %v, want %v", test.name, decoded, test.msg)
+		}
+	}
+}
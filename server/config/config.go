@@ -0,0 +1,349 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+)
+
+// Config represents the server configuration file
+type Config struct {
+	Upstream    Upstream     `json:"upstream"`
+	Yellowstone *Yellowstone `json:"yellowstone,omitempty"`
+	Benchmarks  Benchmarks   `json:"benchmarks"`
+	Streams     Streams      `json:"streams"`
+	History     *History     `json:"history,omitempty"`
+	// AuditLog is the file every call is recorded to; empty disables auditing
+	AuditLog string  `json:"audit_log,omitempty"`
+	Access   *Access `json:"access,omitempty"`
+	// Signing signs every response when set
+	Signing *Signing `json:"signing,omitempty"`
+	// Cluster lets clients resume acknowledged streams on any replica
+	Cluster *Cluster `json:"cluster,omitempty"`
+	// TLS serves gRPC over TLS when set
+	TLS *TLS `json:"tls,omitempty"`
+	// Features enables or disables subsystems by feature flag name; unset
+	// flags keep their defaults
+	Features map[string]bool `json:"features,omitempty"`
+	// SLOs are latency objectives the server evaluates from its own metrics
+	SLOs []SLO `json:"slos,omitempty"`
+}
+
+// Upstream configures the Solana node the server proxies to
+type Upstream struct {
+	RPCEndpoint string `json:"rpc_endpoint"`
+	// WSEndpoint is derived from RPCEndpoint if empty; "none" declares an
+	// upstream without WebSocket support
+	WSEndpoint string         `json:"ws_endpoint,omitempty"`
+	Auth       *upstream.Auth `json:"auth,omitempty"`
+	Timeouts   *Timeouts      `json:"timeouts,omitempty"`
+	// Concurrency bounds the JSON-RPC calls in flight upstream
+	Concurrency *Concurrency `json:"concurrency,omitempty"`
+	// WSFallbackPollInterval is how often streams that subscribe to the
+	// WebSocket upstream poll instead while it is unavailable, as a Go
+	// duration such as "2s". "0s" disables the fallback.
+	WSFallbackPollInterval string `json:"ws_fallback_poll_interval,omitempty"`
+}
+
+// Timeouts bounds upstream JSON-RPC calls. Durations are Go durations such as
+// "10s"; unset values keep the server defaults.
+type Timeouts struct {
+	// Default bounds calls to methods not listed in Methods
+	Default string `json:"default,omitempty"`
+	// Methods bounds calls per JSON-RPC method, such as getBlock
+	Methods map[string]string `json:"methods,omitempty"`
+	// Margin is kept free before the deadline of the gRPC call being served
+	Margin string `json:"margin,omitempty"`
+}
+
+// Concurrency bounds upstream JSON-RPC calls in flight; excess calls queue.
+// Unset values keep the server defaults.
+type Concurrency struct {
+	// MaxCalls bounds calls to all endpoints; 0 is unlimited
+	MaxCalls *int `json:"max_calls,omitempty"`
+	// MaxCallsPerEndpoint bounds calls to one endpoint; 0 is unlimited
+	MaxCallsPerEndpoint *int `json:"max_calls_per_endpoint,omitempty"`
+	// QueueTimeout is how long a call waits for a slot before failing, as a
+	// Go duration such as "5s"
+	QueueTimeout string `json:"queue_timeout,omitempty"`
+}
+
+// Yellowstone configures an upstream Yellowstone subscription that account
+// streams are served from instead of polling the RPC upstream
+type Yellowstone struct {
+	Endpoint   string `json:"endpoint"`
+	Token      string `json:"token,omitempty"`
+	TokenEnv   string `json:"token_env,omitempty"`
+	Commitment string `json:"commitment,omitempty"`
+}
+
+// Benchmarks configures how benchmark runs are scheduled
+type Benchmarks struct {
+	// MaxConcurrent is the number of benchmark runs allowed at once; later
+	// runs queue. Defaults to 1, since concurrent runs skew each other.
+	MaxConcurrent int `json:"max_concurrent,omitempty"`
+	// CacheTTL is how long completed results are reused for identical
+	// requests, as a Go duration such as "5m". "0s" disables the cache.
+	CacheTTL string `json:"cache_ttl,omitempty"`
+	// Limits caps benchmark requests; unset fields keep the server defaults
+	Limits BenchmarkLimits `json:"limits"`
+	// APIKeyLimits replaces Limits for requests carrying one of these keys in
+	// the x-api-key metadata; unset fields fall back to Limits
+	APIKeyLimits map[string]BenchmarkLimits `json:"api_key_limits,omitempty"`
+	// Region is the region the server runs in, recorded with benchmark results
+	Region string `json:"region,omitempty"`
+	// Scheduled runs benchmark scenarios on a schedule, keeping their results
+	// for ListBenchmarks
+	Scheduled *ScheduledBenchmarks `json:"scheduled,omitempty"`
+}
+
+// ScheduledBenchmarks configures the benchmark scenarios run on a schedule
+type ScheduledBenchmarks struct {
+	// HistoryPath is the append-only log the results of the runs are
+	// persisted to. Empty keeps them beside the account history log when
+	// there is one, and in memory only otherwise.
+	HistoryPath string `json:"history_path,omitempty"`
+	// MaxRuns is how many of the newest runs of each scenario are kept;
+	// defaults to 1000, and -1 keeps every run
+	MaxRuns int `json:"max_runs,omitempty"`
+	// MaxAge drops runs that finished longer ago, as a Go duration such as
+	// "720h"; unset keeps runs of any age
+	MaxAge    string              `json:"max_age,omitempty"`
+	Scenarios []BenchmarkScenario `json:"scenarios"`
+}
+
+// BenchmarkScenario is a benchmark request run on a schedule
+type BenchmarkScenario struct {
+	Name string `json:"name"`
+	// Schedule is a cron expression of five fields (minute, hour, day of
+	// month, month, day of week) in UTC, such as "*/30 * * * *", a descriptor
+	// such as "@hourly", or "@every" followed by a Go duration
+	Schedule string `json:"schedule"`
+	// Request is the BenchmarkRequest to run in its proto JSON form, such as
+	// {"iterations": 10, "testAccounts": [...], "runGrpcTests": true}
+	Request json.RawMessage `json:"request"`
+	// Alert, when set, notifies a webhook of runs that regress
+	Alert *BenchmarkAlert `json:"alert,omitempty"`
+}
+
+// BenchmarkAlert posts to a webhook when the average latency of a benchmark
+// and transport in a run exceeds its average over the previous runs
+type BenchmarkAlert struct {
+	WebhookURL string `json:"webhook_url"`
+	// Format is json, the default, to post the comparison as JSON, or slack
+	// to post a Slack incoming webhook message
+	Format string `json:"format,omitempty"`
+	// BaselineRuns is how many previous runs the baseline averages; defaults
+	// to 10. Runs are not compared until MinBaselineRuns, defaulting to 3,
+	// have completed.
+	BaselineRuns    int `json:"baseline_runs,omitempty"`
+	MinBaselineRuns int `json:"min_baseline_runs,omitempty"`
+	// MaxIncrease is the fraction a latency may exceed its baseline by, such
+	// as 0.5, the default, for 50% slower
+	MaxIncrease float64 `json:"max_increase,omitempty"`
+	// MinIncrease ignores smaller regressions, as a Go duration such as "20ms"
+	MinIncrease string `json:"min_increase,omitempty"`
+}
+
+// BenchmarkLimits caps what benchmark requests may ask of the upstream
+type BenchmarkLimits struct {
+	MaxIterations uint32 `json:"max_iterations,omitempty"`
+	MaxTargets    int    `json:"max_targets,omitempty"`
+	MaxConcurrent int    `json:"max_concurrent,omitempty"`
+	// MaxDuration is a Go duration such as "10m"
+	MaxDuration string `json:"max_duration,omitempty"`
+}
+
+// Streams caps the streams open at once. Unset values keep the server defaults.
+type Streams struct {
+	// MaxStreams caps the streams of the whole server
+	MaxStreams int `json:"max_streams,omitempty"`
+	// MaxPerPeer caps the streams of one client IP address
+	MaxPerPeer int `json:"max_per_peer,omitempty"`
+	// APIKeyMaxStreams caps the streams of requests carrying one of these keys
+	// in the x-api-key metadata instead of MaxPerPeer; 0 means unlimited
+	APIKeyMaxStreams map[string]int `json:"api_key_max_streams,omitempty"`
+	// StalledSendTimeout reaps streams whose client has not read a message
+	// for this long, as a Go duration such as "1m". "0s" disables it.
+	StalledSendTimeout string `json:"stalled_send_timeout,omitempty"`
+	// IdleTimeout reaps streams that have neither sent nor received a message
+	// for this long. "0s" disables it.
+	IdleTimeout string `json:"idle_timeout,omitempty"`
+}
+
+// Access filters callers by IP address. Rules are CIDRs such as
+// "10.0.0.0/8" or single addresses.
+type Access struct {
+	// Allow, when set, admits only callers matching one of its rules
+	Allow []string `json:"allow,omitempty"`
+	// Deny rejects matching callers, even if they are allowed
+	Deny []string `json:"deny,omitempty"`
+}
+
+// Cluster lists the replicas acknowledged stream sessions are handed off
+// between, as gRPC addresses such as "10.0.0.2:50051". The list may include
+// the server itself, so every replica can share one.
+type Cluster struct {
+	Peers []string `json:"peers"`
+}
+
+// TLS configures the certificate the gRPC server presents. Files are PEM.
+// With MTLS, callers must present a certificate issued by a CA in CAFile;
+// without it, a certificate a caller presents is verified against CAFile but
+// not required. Cluster peers are dialed over TLS too, verified against
+// CAFile or the system roots, and with MTLS the server presents its own
+// certificate to them, which must then allow client authentication.
+type TLS struct {
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+	CAFile   string `json:"ca_file,omitempty"`
+	MTLS     bool   `json:"mtls,omitempty"`
+}
+
+// ServerConfig returns the TLS config of the gRPC server
+func (t *TLS) ServerConfig() (*tls.Config, error) {
+	cert, err := t.certificate()
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	if t.MTLS && t.CAFile == "" {
+		return nil, fmt.Errorf("mtls requires ca_file")
+	}
+	if t.CAFile != "" {
+		if cfg.ClientCAs, err = t.caPool(); err != nil {
+			return nil, err
+		}
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+		if t.MTLS {
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return cfg, nil
+}
+
+// PeerConfig returns the TLS config cluster peers are dialed with
+func (t *TLS) PeerConfig() (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if t.CAFile != "" {
+		pool, err := t.caPool()
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+	if t.MTLS {
+		cert, err := t.certificate()
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+func (t *TLS) certificate() (tls.Certificate, error) {
+	if t.CertFile == "" || t.KeyFile == "" {
+		return tls.Certificate{}, fmt.Errorf("tls requires cert_file and key_file")
+	}
+	cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to load certificate: %v", err)
+	}
+	return cert, nil
+}
+
+func (t *TLS) caPool() (*x509.CertPool, error) {
+	data, err := os.ReadFile(t.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", t.CAFile)
+	}
+	return pool, nil
+}
+
+// Signing configures the ed25519 key responses are signed with, read from a
+// solana-keygen keypair file or, as a base58 private key, from an
+// environment variable
+type Signing struct {
+	KeyPath string `json:"key_path,omitempty"`
+	KeyEnv  string `json:"key_env,omitempty"`
+}
+
+// Key returns the signing key
+func (s *Signing) Key() (solana.PrivateKey, error) {
+	if s.KeyEnv != "" {
+		value := os.Getenv(s.KeyEnv)
+		if value == "" {
+			return nil, fmt.Errorf("signing key environment variable %s is not set", s.KeyEnv)
+		}
+		return solana.PrivateKeyFromBase58(value)
+	}
+	if s.KeyPath == "" {
+		return nil, fmt.Errorf("signing requires key_path or key_env")
+	}
+	return solana.PrivateKeyFromSolanaKeygenFile(s.KeyPath)
+}
+
+// History configures recording of the states of watched accounts
+type History struct {
+	// Path is the append-only log states are persisted to; empty keeps the
+	// history in memory only
+	Path       string   `json:"path,omitempty"`
+	Accounts   []string `json:"accounts"`
+	Commitment string   `json:"commitment,omitempty"`
+}
+
+// SLO is a latency objective: a percentile of a method's latency over a
+// trailing window must stay under a threshold. Durations are Go durations
+// such as "150ms".
+type SLO struct {
+	// Name identifies the objective; defaults to one built from the others
+	Name string `json:"name,omitempty"`
+	// Method is a BenchmarkService method such as GetAccountInfo or a full
+	// gRPC method name, or a JSON-RPC method such as getBlock for upstream
+	// objectives
+	Method string `json:"method"`
+	// Source is handler, the default, for the latency of the calls served,
+	// or upstream for that of the JSON-RPC calls made
+	Source string `json:"source,omitempty"`
+	// Percentile is such as 99 for p99
+	Percentile float64 `json:"percentile"`
+	Threshold  string  `json:"threshold"`
+	// Window defaults to "5m"
+	Window string `json:"window,omitempty"`
+}
+
+// XToken returns the x-token to send, read from TokenEnv when set
+func (y *Yellowstone) XToken() (string, error) {
+	if y.TokenEnv != "" {
+		token := os.Getenv(y.TokenEnv)
+		if token == "" {
+			return "", fmt.Errorf("yellowstone token environment variable %s is not set", y.TokenEnv)
+		}
+		return token, nil
+	}
+	return y.Token, nil
+}
+
+// Load reads a JSON configuration file
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %v", err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %v", path, err)
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,86 @@
+// Package features gates the server's subsystems behind flags that are set per
+// deployment, so a risky feature can be rolled out, or switched off, without
+// a new build.
+package features
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Flag names a gated subsystem
+type Flag string
+
+const (
+	// YellowstoneGateway serves account streams from a configured Yellowstone
+	// gateway instead of polling
+	YellowstoneGateway Flag = "yellowstone_gateway"
+	// BenchmarkCache reuses completed benchmark results for identical requests
+	BenchmarkCache Flag = "benchmark_cache"
+	// AccountHistory records the states of watched accounts
+	AccountHistory Flag = "account_history"
+	// WebSocketStreams serves the streams built on upstream WebSocket
+	// subscriptions: live program account updates, owned account sets and
+	// commitment latency. Account streams poll instead when it is disabled,
+	// and the upstream WebSocket connection is not monitored.
+	WebSocketStreams Flag = "websocket_streams"
+	// BlockStreams serves the streams built on the block feeds: blocks,
+	// transactions, balance changes, alerts and fee stats
+	BlockStreams Flag = "block_streams"
+	// YellowstoneAdapter serves the Yellowstone geyser API when it is
+	// requested with --yellowstone-adapter
+	YellowstoneAdapter Flag = "yellowstone_adapter"
+)
+
+// defaults are the flags and whether they are enabled unless configured
+var defaults = map[Flag]bool{
+	YellowstoneGateway: true,
+	BenchmarkCache:     true,
+	AccountHistory:     true,
+	WebSocketStreams:   true,
+	BlockStreams:       true,
+	YellowstoneAdapter: true,
+}
+
+// Set is the state of every flag. It is not changed once created.
+type Set struct {
+	enabled map[Flag]bool
+}
+
+// Defaults returns a set with every flag at its default
+func Defaults() *Set {
+	set, _ := New(nil)
+	return set
+}
+
+// New returns a set with the given flags overriding their defaults. Unknown
+// flags are an error, so a typo does not silently leave a feature on.
+func New(overrides map[string]bool) (*Set, error) {
+	enabled := make(map[Flag]bool, len(defaults))
+	for flag, on := range defaults {
+		enabled[flag] = on
+	}
+	for name, on := range overrides {
+		flag := Flag(name)
+		if _, ok := defaults[flag]; !ok {
+			return nil, fmt.Errorf("unknown feature flag %q", name)
+		}
+		enabled[flag] = on
+	}
+	return &Set{enabled: enabled}, nil
+}
+
+// Enabled reports whether a flag is enabled
+func (s *Set) Enabled(flag Flag) bool {
+	return s.enabled[flag]
+}
+
+// Flags returns every flag, sorted by name
+func (s *Set) Flags() []Flag {
+	flags := make([]Flag, 0, len(s.enabled))
+	for flag := range s.enabled {
+		flags = append(flags, flag)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i] < flags[j] })
+	return flags
+}
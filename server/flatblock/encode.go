@@ -0,0 +1,239 @@
+// Package flatblock encodes blocks as FlatBuffers, in the layout of
+// proto/block.fbs, as an experimental alternative to the SerializedBlock
+// protobuf encoding. Readers access the fields of an encoded block in place,
+// so consuming one allocates nothing.
+package flatblock
+
+import (
+	"bytes"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+)
+
+// Field indices of each table, in the order of proto/block.fbs
+const (
+	blockSlot = iota
+	blockBlockhash
+	blockPreviousBlockhash
+	blockParentSlot
+	blockBlockTime
+	blockBlockHeight
+	blockTransactions
+	blockRewards
+	blockFields
+)
+
+const (
+	txTransaction = iota
+	txErr
+	txFee
+	txPreBalances
+	txPostBalances
+	txInnerInstructions
+	txPreTokenBalances
+	txPostTokenBalances
+	txLogMessages
+	txLoadedWritable
+	txLoadedReadonly
+	txRewards
+	txFields
+)
+
+const (
+	innerIndex = iota
+	innerInstructions
+	innerFields
+)
+
+const (
+	instructionProgramIDIndex = iota
+	instructionAccounts
+	instructionData
+	instructionFields
+)
+
+const (
+	tokenAccountIndex = iota
+	tokenMint
+	tokenOwner
+	tokenAmount
+	tokenDecimals
+	tokenFields
+)
+
+const (
+	rewardPubkey = iota
+	rewardLamports
+	rewardPostBalance
+	rewardType
+	rewardFields
+)
+
+// Encode encodes a block. Empty fields are left out of the buffer, as
+// protobuf leaves them out of the wire.
+func Encode(block *proto.SerializedBlock) []byte {
+	b := flatbuffers.NewBuilder(protoSize(block))
+
+	transactions := make([]flatbuffers.UOffsetT, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		transactions[i] = encodeTransaction(b, tx)
+	}
+	blockhash := byteVector(b, block.Blockhash)
+	previousBlockhash := byteVector(b, block.PreviousBlockhash)
+	transactionVector := tableVector(b, transactions)
+	rewards := encodeRewards(b, block.Rewards)
+
+	b.StartObject(blockFields)
+	b.PrependUint64Slot(blockSlot, block.Slot, 0)
+	b.PrependUOffsetTSlot(blockBlockhash, blockhash, 0)
+	b.PrependUOffsetTSlot(blockPreviousBlockhash, previousBlockhash, 0)
+	b.PrependUint64Slot(blockParentSlot, block.ParentSlot, 0)
+	b.PrependInt64Slot(blockBlockTime, block.BlockTime, 0)
+	b.PrependUint64Slot(blockBlockHeight, block.BlockHeight, 0)
+	b.PrependUOffsetTSlot(blockTransactions, transactionVector, 0)
+	b.PrependUOffsetTSlot(blockRewards, rewards, 0)
+	b.Finish(b.EndObject())
+	return b.FinishedBytes()
+}
+
+// protoSize is the initial size of a block's buffer, which grows as needed
+func protoSize(block *proto.SerializedBlock) int {
+	size := 1024
+	for _, tx := range block.Transactions {
+		size += 2 * len(tx.Transaction)
+	}
+	return size
+}
+
+func encodeTransaction(b *flatbuffers.Builder, tx *proto.SerializedTransaction) flatbuffers.UOffsetT {
+	inner := make([]flatbuffers.UOffsetT, len(tx.InnerInstructions))
+	for i, instructions := range tx.InnerInstructions {
+		inner[i] = encodeInnerInstructions(b, instructions)
+	}
+	transaction := byteVector(b, tx.Transaction)
+	var txErrString flatbuffers.UOffsetT
+	if tx.Err != "" {
+		txErrString = b.CreateString(tx.Err)
+	}
+	preBalances := uint64Vector(b, tx.PreBalances)
+	postBalances := uint64Vector(b, tx.PostBalances)
+	innerVector := tableVector(b, inner)
+	preTokenBalances := encodeTokenBalances(b, tx.PreTokenBalances)
+	postTokenBalances := encodeTokenBalances(b, tx.PostTokenBalances)
+	logMessages := stringVector(b, tx.LogMessages)
+	loadedWritable := byteVector(b, bytes.Join(tx.LoadedWritable, nil))
+	loadedReadonly := byteVector(b, bytes.Join(tx.LoadedReadonly, nil))
+	rewards := encodeRewards(b, tx.Rewards)
+
+	b.StartObject(txFields)
+	b.PrependUOffsetTSlot(txTransaction, transaction, 0)
+	b.PrependUOffsetTSlot(txErr, txErrString, 0)
+	b.PrependUint64Slot(txFee, tx.Fee, 0)
+	b.PrependUOffsetTSlot(txPreBalances, preBalances, 0)
+	b.PrependUOffsetTSlot(txPostBalances, postBalances, 0)
+	b.PrependUOffsetTSlot(txInnerInstructions, innerVector, 0)
+	b.PrependUOffsetTSlot(txPreTokenBalances, preTokenBalances, 0)
+	b.PrependUOffsetTSlot(txPostTokenBalances, postTokenBalances, 0)
+	b.PrependUOffsetTSlot(txLogMessages, logMessages, 0)
+	b.PrependUOffsetTSlot(txLoadedWritable, loadedWritable, 0)
+	b.PrependUOffsetTSlot(txLoadedReadonly, loadedReadonly, 0)
+	b.PrependUOffsetTSlot(txRewards, rewards, 0)
+	return b.EndObject()
+}
+
+func encodeInnerInstructions(b *flatbuffers.Builder, inner *proto.SerializedInnerInstructions) flatbuffers.UOffsetT {
+	instructions := make([]flatbuffers.UOffsetT, len(inner.Instructions))
+	for i, instruction := range inner.Instructions {
+		accounts := byteVector(b, instruction.Accounts)
+		data := byteVector(b, instruction.Data)
+		b.StartObject(instructionFields)
+		b.PrependUint32Slot(instructionProgramIDIndex, instruction.ProgramIdIndex, 0)
+		b.PrependUOffsetTSlot(instructionAccounts, accounts, 0)
+		b.PrependUOffsetTSlot(instructionData, data, 0)
+		instructions[i] = b.EndObject()
+	}
+	instructionVector := tableVector(b, instructions)
+
+	b.StartObject(innerFields)
+	b.PrependUint32Slot(innerIndex, inner.Index, 0)
+	b.PrependUOffsetTSlot(innerInstructions, instructionVector, 0)
+	return b.EndObject()
+}
+
+func encodeTokenBalances(b *flatbuffers.Builder, balances []*proto.SerializedTokenBalance) flatbuffers.UOffsetT {
+	offsets := make([]flatbuffers.UOffsetT, len(balances))
+	for i, balance := range balances {
+		mint := byteVector(b, balance.Mint)
+		owner := byteVector(b, balance.Owner)
+		var amount flatbuffers.UOffsetT
+		if balance.Amount != "" {
+			amount = b.CreateString(balance.Amount)
+		}
+		b.StartObject(tokenFields)
+		b.PrependUint32Slot(tokenAccountIndex, balance.AccountIndex, 0)
+		b.PrependUOffsetTSlot(tokenMint, mint, 0)
+		b.PrependUOffsetTSlot(tokenOwner, owner, 0)
+		b.PrependUOffsetTSlot(tokenAmount, amount, 0)
+		b.PrependUint32Slot(tokenDecimals, balance.Decimals, 0)
+		offsets[i] = b.EndObject()
+	}
+	return tableVector(b, offsets)
+}
+
+func encodeRewards(b *flatbuffers.Builder, rewards []*proto.SerializedReward) flatbuffers.UOffsetT {
+	offsets := make([]flatbuffers.UOffsetT, len(rewards))
+	for i, reward := range rewards {
+		pubkey := byteVector(b, reward.Pubkey)
+		var kind flatbuffers.UOffsetT
+		if reward.RewardType != "" {
+			kind = b.CreateString(reward.RewardType)
+		}
+		b.StartObject(rewardFields)
+		b.PrependUOffsetTSlot(rewardPubkey, pubkey, 0)
+		b.PrependInt64Slot(rewardLamports, reward.Lamports, 0)
+		b.PrependUint64Slot(rewardPostBalance, reward.PostBalance, 0)
+		b.PrependUOffsetTSlot(rewardType, kind, 0)
+		offsets[i] = b.EndObject()
+	}
+	return tableVector(b, offsets)
+}
+
+// byteVector writes a byte vector, returning 0 for an empty one, which
+// leaves its field out
+func byteVector(b *flatbuffers.Builder, v []byte) flatbuffers.UOffsetT {
+	if len(v) == 0 {
+		return 0
+	}
+	return b.CreateByteVector(v)
+}
+
+func uint64Vector(b *flatbuffers.Builder, v []uint64) flatbuffers.UOffsetT {
+	if len(v) == 0 {
+		return 0
+	}
+	b.StartVector(flatbuffers.SizeUint64, len(v), flatbuffers.SizeUint64)
+	for i := len(v) - 1; i >= 0; i-- {
+		b.PrependUint64(v[i])
+	}
+	return b.EndVector(len(v))
+}
+
+func stringVector(b *flatbuffers.Builder, v []string) flatbuffers.UOffsetT {
+	if len(v) == 0 {
+		return 0
+	}
+	offsets := make([]flatbuffers.UOffsetT, len(v))
+	for i, s := range v {
+		offsets[i] = b.CreateString(s)
+	}
+	return tableVector(b, offsets)
+}
+
+// tableVector writes a vector of the tables or strings at offsets
+func tableVector(b *flatbuffers.Builder, offsets []flatbuffers.UOffsetT) flatbuffers.UOffsetT {
+	if len(offsets) == 0 {
+		return 0
+	}
+	return b.CreateVectorOfTables(offsets)
+}
@@ -0,0 +1,246 @@
+package flatblock
+
+import (
+	"errors"
+	"fmt"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// table reads the fields of one table of a buffer by index
+type table struct {
+	flatbuffers.Table
+}
+
+// offset returns the offset of a field from the table, or 0 when the field
+// is absent
+func (t table) offset(field int) flatbuffers.UOffsetT {
+	return flatbuffers.UOffsetT(t.Offset(flatbuffers.VOffsetT(4 + 2*field)))
+}
+
+func (t table) uint64(field int) uint64 {
+	if o := t.offset(field); o != 0 {
+		return t.GetUint64(t.Pos + o)
+	}
+	return 0
+}
+
+func (t table) int64(field int) int64 {
+	if o := t.offset(field); o != 0 {
+		return t.GetInt64(t.Pos + o)
+	}
+	return 0
+}
+
+func (t table) uint32(field int) uint32 {
+	if o := t.offset(field); o != 0 {
+		return t.GetUint32(t.Pos + o)
+	}
+	return 0
+}
+
+// bytes returns a byte vector, which aliases the buffer
+func (t table) bytes(field int) []byte {
+	if o := t.offset(field); o != 0 {
+		return t.ByteVector(t.Pos + o)
+	}
+	return nil
+}
+
+// string returns a string, which aliases the buffer
+func (t table) string(field int) string {
+	if o := t.offset(field); o != 0 {
+		return t.String(t.Pos + o)
+	}
+	return ""
+}
+
+func (t table) vectorLength(field int) int {
+	if o := t.offset(field); o != 0 {
+		return t.VectorLen(o)
+	}
+	return 0
+}
+
+// element returns the position of element j of a vector of elemSize-byte
+// elements
+func (t table) element(field, j, elemSize int) flatbuffers.UOffsetT {
+	return t.Vector(t.offset(field)) + flatbuffers.UOffsetT(j*elemSize)
+}
+
+func (t table) uint64At(field, j int) uint64 {
+	return t.GetUint64(t.element(field, j, flatbuffers.SizeUint64))
+}
+
+func (t table) stringAt(field, j int) string {
+	return t.String(t.element(field, j, flatbuffers.SizeUOffsetT))
+}
+
+func (t table) tableAt(field, j int) table {
+	pos := t.Indirect(t.element(field, j, flatbuffers.SizeUOffsetT))
+	return table{flatbuffers.Table{Bytes: t.Bytes, Pos: pos}}
+}
+
+// Block is an encoded block
+type Block struct{ t table }
+
+// Read returns the block encoded in data. Fields are read in place when they
+// are accessed, and accessing those of a malformed buffer panics; Walk checks
+// a buffer from an untrusted source first.
+func Read(data []byte) Block {
+	pos := flatbuffers.GetUOffsetT(data)
+	return Block{table{flatbuffers.Table{Bytes: data, Pos: pos}}}
+}
+
+func (b Block) Slot() uint64              { return b.t.uint64(blockSlot) }
+func (b Block) Blockhash() []byte         { return b.t.bytes(blockBlockhash) }
+func (b Block) PreviousBlockhash() []byte { return b.t.bytes(blockPreviousBlockhash) }
+func (b Block) ParentSlot() uint64        { return b.t.uint64(blockParentSlot) }
+func (b Block) BlockTime() int64          { return b.t.int64(blockBlockTime) }
+func (b Block) BlockHeight() uint64       { return b.t.uint64(blockBlockHeight) }
+func (b Block) TransactionsLength() int   { return b.t.vectorLength(blockTransactions) }
+func (b Block) Transaction(j int) Transaction {
+	return Transaction{b.t.tableAt(blockTransactions, j)}
+}
+func (b Block) RewardsLength() int  { return b.t.vectorLength(blockRewards) }
+func (b Block) Reward(j int) Reward { return Reward{b.t.tableAt(blockRewards, j)} }
+
+// Transaction is a transaction in wire format with its metadata
+type Transaction struct{ t table }
+
+func (tx Transaction) Transaction() []byte      { return tx.t.bytes(txTransaction) }
+func (tx Transaction) Err() string              { return tx.t.string(txErr) }
+func (tx Transaction) Fee() uint64              { return tx.t.uint64(txFee) }
+func (tx Transaction) PreBalancesLength() int   { return tx.t.vectorLength(txPreBalances) }
+func (tx Transaction) PreBalance(j int) uint64  { return tx.t.uint64At(txPreBalances, j) }
+func (tx Transaction) PostBalancesLength() int  { return tx.t.vectorLength(txPostBalances) }
+func (tx Transaction) PostBalance(j int) uint64 { return tx.t.uint64At(txPostBalances, j) }
+func (tx Transaction) InnerInstructionsLength() int {
+	return tx.t.vectorLength(txInnerInstructions)
+}
+func (tx Transaction) InnerInstructions(j int) InnerInstructions {
+	return InnerInstructions{tx.t.tableAt(txInnerInstructions, j)}
+}
+func (tx Transaction) PreTokenBalancesLength() int { return tx.t.vectorLength(txPreTokenBalances) }
+func (tx Transaction) PreTokenBalance(j int) TokenBalance {
+	return TokenBalance{tx.t.tableAt(txPreTokenBalances, j)}
+}
+func (tx Transaction) PostTokenBalancesLength() int { return tx.t.vectorLength(txPostTokenBalances) }
+func (tx Transaction) PostTokenBalance(j int) TokenBalance {
+	return TokenBalance{tx.t.tableAt(txPostTokenBalances, j)}
+}
+func (tx Transaction) LogMessagesLength() int  { return tx.t.vectorLength(txLogMessages) }
+func (tx Transaction) LogMessage(j int) string { return tx.t.stringAt(txLogMessages, j) }
+func (tx Transaction) LoadedWritable() []byte  { return tx.t.bytes(txLoadedWritable) }
+func (tx Transaction) LoadedReadonly() []byte  { return tx.t.bytes(txLoadedReadonly) }
+func (tx Transaction) RewardsLength() int      { return tx.t.vectorLength(txRewards) }
+func (tx Transaction) Reward(j int) Reward     { return Reward{tx.t.tableAt(txRewards, j)} }
+
+// InnerInstructions are the inner instructions of one instruction
+type InnerInstructions struct{ t table }
+
+func (in InnerInstructions) Index() uint32           { return in.t.uint32(innerIndex) }
+func (in InnerInstructions) InstructionsLength() int { return in.t.vectorLength(innerInstructions) }
+func (in InnerInstructions) Instruction(j int) Instruction {
+	return Instruction{in.t.tableAt(innerInstructions, j)}
+}
+
+// Instruction is a compiled instruction
+type Instruction struct{ t table }
+
+func (in Instruction) ProgramIDIndex() uint32 { return in.t.uint32(instructionProgramIDIndex) }
+func (in Instruction) Accounts() []byte       { return in.t.bytes(instructionAccounts) }
+func (in Instruction) Data() []byte           { return in.t.bytes(instructionData) }
+
+// TokenBalance is a token balance of a transaction account
+type TokenBalance struct{ t table }
+
+func (tb TokenBalance) AccountIndex() uint32 { return tb.t.uint32(tokenAccountIndex) }
+func (tb TokenBalance) Mint() []byte         { return tb.t.bytes(tokenMint) }
+func (tb TokenBalance) Owner() []byte        { return tb.t.bytes(tokenOwner) }
+func (tb TokenBalance) Amount() string       { return tb.t.string(tokenAmount) }
+func (tb TokenBalance) Decimals() uint32     { return tb.t.uint32(tokenDecimals) }
+
+// Reward is a reward credited in a block or by a transaction
+type Reward struct{ t table }
+
+func (r Reward) Pubkey() []byte      { return r.t.bytes(rewardPubkey) }
+func (r Reward) Lamports() int64     { return r.t.int64(rewardLamports) }
+func (r Reward) PostBalance() uint64 { return r.t.uint64(rewardPostBalance) }
+func (r Reward) RewardType() string  { return r.t.string(rewardType) }
+
+// Walk reads every field of the block encoded in data, the work of a reader
+// consuming the whole block, and returns the number of field bytes it read.
+// It fails instead of panicking when data is not a well-formed encoding.
+func Walk(data []byte) (n int, err error) {
+	if len(data) < flatbuffers.SizeUOffsetT {
+		return 0, errors.New("buffer too short")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			n, err = 0, fmt.Errorf("malformed buffer: %v", r)
+		}
+	}()
+
+	block := Read(data)
+	n += 8*4 + len(block.Blockhash()) + len(block.PreviousBlockhash())
+	block.Slot()
+	block.ParentSlot()
+	block.BlockTime()
+	block.BlockHeight()
+	for i := 0; i < block.TransactionsLength(); i++ {
+		n += walkTransaction(block.Transaction(i))
+	}
+	for i := 0; i < block.RewardsLength(); i++ {
+		n += walkReward(block.Reward(i))
+	}
+	return n, nil
+}
+
+func walkTransaction(tx Transaction) int {
+	n := 8 + len(tx.Transaction()) + len(tx.Err()) + len(tx.LoadedWritable()) + len(tx.LoadedReadonly())
+	tx.Fee()
+	for i := 0; i < tx.PreBalancesLength(); i++ {
+		tx.PreBalance(i)
+		n += 8
+	}
+	for i := 0; i < tx.PostBalancesLength(); i++ {
+		tx.PostBalance(i)
+		n += 8
+	}
+	for i := 0; i < tx.InnerInstructionsLength(); i++ {
+		inner := tx.InnerInstructions(i)
+		inner.Index()
+		n += 4
+		for j := 0; j < inner.InstructionsLength(); j++ {
+			instruction := inner.Instruction(j)
+			instruction.ProgramIDIndex()
+			n += 4 + len(instruction.Accounts()) + len(instruction.Data())
+		}
+	}
+	for i := 0; i < tx.PreTokenBalancesLength(); i++ {
+		n += walkTokenBalance(tx.PreTokenBalance(i))
+	}
+	for i := 0; i < tx.PostTokenBalancesLength(); i++ {
+		n += walkTokenBalance(tx.PostTokenBalance(i))
+	}
+	for i := 0; i < tx.LogMessagesLength(); i++ {
+		n += len(tx.LogMessage(i))
+	}
+	for i := 0; i < tx.RewardsLength(); i++ {
+		n += walkReward(tx.Reward(i))
+	}
+	return n
+}
+
+func walkTokenBalance(tb TokenBalance) int {
+	tb.AccountIndex()
+	tb.Decimals()
+	return 8 + len(tb.Mint()) + len(tb.Owner()) + len(tb.Amount())
+}
+
+func walkReward(r Reward) int {
+	r.Lamports()
+	r.PostBalance()
+	return 16 + len(r.Pubkey()) + len(r.RewardType())
+}
@@ -0,0 +1,291 @@
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// BenchmarkRun is the outcome of a scheduled benchmark run
+type BenchmarkRun struct {
+	// ID numbers the runs from 1 in the order they finished
+	ID       uint64 `json:"id"`
+	Scenario string `json:"scenario"`
+	JobID    string `json:"job_id"`
+	// StartedAt and FinishedAt are Unix times in milliseconds
+	StartedAt  int64 `json:"started_at"`
+	FinishedAt int64 `json:"finished_at"`
+	// Cancelled is set when the run was stopped before it finished, such as
+	// by exceeding its maximum duration
+	Cancelled bool `json:"cancelled"`
+	// Results are the run's BenchmarkResults in their proto JSON form
+	Results json.RawMessage `json:"results"`
+}
+
+// BenchmarkRetention bounds the runs a BenchmarkStore keeps. A zero field
+// leaves that bound off.
+type BenchmarkRetention struct {
+	// MaxRuns is how many of the newest runs of each scenario are kept
+	MaxRuns int
+	// MaxAge drops runs that finished longer ago
+	MaxAge time.Duration
+}
+
+// DefaultBenchmarkRetention applies unless configured otherwise
+var DefaultBenchmarkRetention = BenchmarkRetention{MaxRuns: 1000}
+
+// BenchmarkStore keeps the runs of scheduled benchmarks in the order they
+// finished, within its retention. Runs are appended to a JSON lines log,
+// which is replayed when the store is opened and rewritten without the runs
+// no longer kept once they take up half of it. With a log, only a summary of
+// each run is held in memory and its results are read back from the log.
+type BenchmarkStore struct {
+	mu        sync.RWMutex
+	path      string
+	file      *os.File
+	retention BenchmarkRetention
+	runs      []storedRun
+	lastID    uint64
+	// size is the length of the log, and dropped how much of it holds runs
+	// no longer kept
+	size    int64
+	dropped int64
+}
+
+// storedRun is a kept run and where its line is in the log. Its results are
+// left out when the store has a log.
+type storedRun struct {
+	BenchmarkRun
+	offset, length int64
+}
+
+// OpenBenchmarks opens the log at path, creating it if needed and loading the
+// runs it already holds within retention. An empty path keeps the runs in
+// memory only.
+func OpenBenchmarks(path string, retention BenchmarkRetention) (*BenchmarkStore, error) {
+	store := &BenchmarkStore{path: path, retention: retention}
+	if path == "" {
+		return store, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open benchmark history log: %v", err)
+	}
+
+	reader := bufio.NewReader(file)
+	for line := 1; ; line++ {
+		raw, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			file.Close()
+			return nil, fmt.Errorf("failed to read benchmark history log: %v", err)
+		}
+		if len(raw) == 0 {
+			break
+		}
+		var run BenchmarkRun
+		if err := json.Unmarshal(raw, &run); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to parse benchmark history log %s line %d: %v", path, line, err)
+		}
+		run.Results = nil
+		store.runs = append(store.runs, storedRun{BenchmarkRun: run, offset: store.size, length: int64(len(raw))})
+		store.lastID = run.ID
+		store.size += int64(len(raw))
+	}
+
+	store.file = file
+	store.expire(time.Now())
+	if store.dropped > 0 {
+		if err := store.compact(); err != nil {
+			store.file.Close()
+			return nil, err
+		}
+	}
+	return store, nil
+}
+
+// Append records a finished run, returning it with its ID set
+func (s *BenchmarkStore) Append(run BenchmarkRun) (BenchmarkRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run.ID = s.lastID + 1
+	stored := storedRun{BenchmarkRun: run}
+	if s.file != nil {
+		line, err := json.Marshal(run)
+		if err != nil {
+			return run, err
+		}
+		line = append(line, '\n')
+		if _, err := s.file.Write(line); err != nil {
+			return run, fmt.Errorf("failed to write benchmark history log: %v", err)
+		}
+		stored.Results = nil
+		stored.offset, stored.length = s.size, int64(len(line))
+		s.size += stored.length
+	}
+	s.runs = append(s.runs, stored)
+	s.lastID = run.ID
+
+	s.expire(time.Now())
+	if s.file != nil && s.dropped > s.size/2 {
+		// The run is recorded either way; the log is compacted again after
+		// the next run
+		if err := s.compact(); err != nil {
+			log.Printf("Failed to compact benchmark history log: %v", err)
+		}
+	}
+	return run, nil
+}
+
+// expire drops the runs beyond the retention, counting the log they take up
+func (s *BenchmarkStore) expire(now time.Time) {
+	if s.retention.MaxRuns <= 0 && s.retention.MaxAge <= 0 {
+		return
+	}
+	cutoff := now.Add(-s.retention.MaxAge).UnixMilli()
+
+	keep := make([]bool, len(s.runs))
+	counts := make(map[string]int)
+	kept := 0
+	for i := len(s.runs) - 1; i >= 0; i-- {
+		run := s.runs[i]
+		counts[run.Scenario]++
+		if s.retention.MaxRuns > 0 && counts[run.Scenario] > s.retention.MaxRuns {
+			continue
+		}
+		if s.retention.MaxAge > 0 && run.FinishedAt < cutoff {
+			continue
+		}
+		keep[i] = true
+		kept++
+	}
+	if kept == len(s.runs) {
+		return
+	}
+
+	runs := make([]storedRun, 0, kept)
+	for i, run := range s.runs {
+		if keep[i] {
+			runs = append(runs, run)
+		} else {
+			s.dropped += run.length
+		}
+	}
+	s.runs = runs
+}
+
+// compact rewrites the log with only the kept runs, replacing it once the
+// new log is synced
+func (s *BenchmarkStore) compact() error {
+	tmpPath := s.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create benchmark history log: %v", err)
+	}
+	defer os.Remove(tmpPath)
+
+	runs := make([]storedRun, len(s.runs))
+	writer := bufio.NewWriter(tmp)
+	var size int64
+	for i, run := range s.runs {
+		line := make([]byte, run.length)
+		if _, err := s.file.ReadAt(line, run.offset); err != nil {
+			tmp.Close()
+			return fmt.Errorf("failed to read benchmark history log: %v", err)
+		}
+		if _, err := writer.Write(line); err != nil {
+			tmp.Close()
+			return fmt.Errorf("failed to write benchmark history log: %v", err)
+		}
+		runs[i] = run
+		runs[i].offset = size
+		size += run.length
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write benchmark history log: %v", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync benchmark history log: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write benchmark history log: %v", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("failed to replace benchmark history log: %v", err)
+	}
+
+	file, err := os.OpenFile(s.path, os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open benchmark history log: %v", err)
+	}
+	s.file.Close()
+	s.file, s.runs, s.size, s.dropped = file, runs, size, 0
+	return nil
+}
+
+// List returns up to limit runs newest first, starting with the newest whose
+// ID is below before, and only those of scenario unless it is empty. A before
+// of zero means no upper bound. It also reports whether older runs were left
+// out. The runs' results are read only if withResults is set.
+func (s *BenchmarkStore) List(scenario string, before uint64, limit int, withResults bool) ([]BenchmarkRun, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	end := len(s.runs)
+	if before != 0 {
+		end = sort.Search(len(s.runs), func(i int) bool { return s.runs[i].ID >= before })
+	}
+
+	var runs []BenchmarkRun
+	for i := end - 1; i >= 0; i-- {
+		if scenario != "" && s.runs[i].Scenario != scenario {
+			continue
+		}
+		if len(runs) == limit {
+			return runs, true, nil
+		}
+		run := s.runs[i].BenchmarkRun
+		if withResults && s.file != nil {
+			results, err := s.results(s.runs[i])
+			if err != nil {
+				return nil, false, err
+			}
+			run.Results = results
+		} else if !withResults {
+			run.Results = nil
+		}
+		runs = append(runs, run)
+	}
+	return runs, false, nil
+}
+
+// results reads the results of a run from the log
+func (s *BenchmarkStore) results(stored storedRun) (json.RawMessage, error) {
+	line := make([]byte, stored.length)
+	if _, err := s.file.ReadAt(line, stored.offset); err != nil {
+		return nil, fmt.Errorf("failed to read run %d from benchmark history log: %v", stored.ID, err)
+	}
+	var run BenchmarkRun
+	if err := json.Unmarshal(line, &run); err != nil {
+		return nil, fmt.Errorf("failed to parse run %d in benchmark history log: %v", stored.ID, err)
+	}
+	return run.Results, nil
+}
+
+// Close closes the log
+func (s *BenchmarkStore) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Close()
+}
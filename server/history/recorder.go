@@ -0,0 +1,157 @@
+package history
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+)
+
+// maxMultipleAccounts is the getMultipleAccounts limit per request
+const maxMultipleAccounts = 100
+
+// Recorder writes every change of a set of watched accounts to a store
+type Recorder struct {
+	endpoint   upstream.Endpoint
+	client     *rpc.Client
+	store      *Store
+	accounts   map[solana.PublicKey]bool
+	commitment rpc.CommitmentType
+}
+
+// NewRecorder creates a recorder for the given accounts. Run starts recording.
+func NewRecorder(endpoint upstream.Endpoint, store *Store, accounts []solana.PublicKey, commitment rpc.CommitmentType) *Recorder {
+	watched := make(map[solana.PublicKey]bool, len(accounts))
+	for _, account := range accounts {
+		watched[account] = true
+	}
+	return &Recorder{
+		endpoint:   endpoint,
+		client:     endpoint.NewRPCClient(),
+		store:      store,
+		accounts:   watched,
+		commitment: commitment,
+	}
+}
+
+// Store returns the store the recorder writes to
+func (r *Recorder) Store() *Store {
+	return r.store
+}
+
+// Watches reports whether an account is recorded
+func (r *Recorder) Watches(pubkey solana.PublicKey) bool {
+	return r.accounts[pubkey]
+}
+
+// Run records account changes until ctx is done, reconnecting to the
+// upstream with backoff when the subscriptions fail
+func (r *Recorder) Run(ctx context.Context) {
+	upstream.Reconnect(ctx, &upstream.Backoff{}, r.record, func(err error, delay time.Duration) {
+		log.Printf("Account history subscriptions failed, reconnecting in %v: %v", delay, err)
+	})
+}
+
+// record subscribes to every watched account, stores their current states and
+// then each change until a subscription fails or ctx is done
+func (r *Recorder) record(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wsClient, err := r.endpoint.ConnectWS(ctx)
+	if err != nil {
+		return err
+	}
+	defer wsClient.Close()
+
+	// Subscribe before reading the current states so that no change in
+	// between is missed; older notifications are skipped by the store
+	errs := make(chan error, len(r.accounts))
+	for account := range r.accounts {
+		sub, err := wsClient.AccountSubscribe(account, r.commitment)
+		if err != nil {
+			return err
+		}
+		// Unsubscribing before the client closes unblocks the forwarder
+		defer sub.Unsubscribe()
+		go r.forward(account, sub, errs)
+	}
+
+	if err := r.snapshot(ctx); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-errs:
+		return err
+	}
+}
+
+// snapshot stores the current state of every watched account
+func (r *Recorder) snapshot(ctx context.Context) error {
+	accounts := make([]solana.PublicKey, 0, len(r.accounts))
+	for account := range r.accounts {
+		accounts = append(accounts, account)
+	}
+
+	for start := 0; start < len(accounts); start += maxMultipleAccounts {
+		end := start + maxMultipleAccounts
+		if end > len(accounts) {
+			end = len(accounts)
+		}
+
+		result, err := r.client.GetMultipleAccountsWithOpts(ctx, accounts[start:end], &rpc.GetMultipleAccountsOpts{
+			Commitment: r.commitment,
+		})
+		if err != nil {
+			return err
+		}
+		for i, account := range result.Value {
+			if account != nil {
+				r.append(accounts[start+i], account, result.Context.Slot)
+			}
+		}
+	}
+	return nil
+}
+
+// forward stores each notification of an account subscription
+func (r *Recorder) forward(pubkey solana.PublicKey, sub *ws.AccountSubscription, errs chan<- error) {
+	for {
+		result, err := sub.Recv()
+		if err != nil {
+			errs <- err
+			return
+		}
+		if result == nil {
+			return
+		}
+		r.append(pubkey, &result.Value.Account, result.Context.Slot)
+	}
+}
+
+// append stores an account state, logging rather than failing on write errors
+func (r *Recorder) append(pubkey solana.PublicKey, account *rpc.Account, slot uint64) {
+	record := Record{
+		Pubkey:     pubkey,
+		Slot:       slot,
+		Lamports:   account.Lamports,
+		Owner:      account.Owner,
+		Executable: account.Executable,
+		RentEpoch:  account.RentEpoch,
+		Timestamp:  time.Now().Unix(),
+	}
+	if account.Data != nil {
+		record.Data = account.Data.GetBinary()
+	}
+
+	if _, err := r.store.Append(record); err != nil {
+		log.Printf("Failed to record state of %s at slot %d: %v", pubkey, slot, err)
+	}
+}
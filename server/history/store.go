@@ -0,0 +1,148 @@
+// Package history records the successive states of watched accounts, so the
+// state of an account can be looked up as of any slot since it was watched,
+// and the results of scheduled benchmark runs.
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Record is the state of an account from a slot on
+type Record struct {
+	Pubkey     solana.PublicKey `json:"pubkey"`
+	Slot       uint64           `json:"slot"`
+	Lamports   uint64           `json:"lamports"`
+	Owner      solana.PublicKey `json:"owner"`
+	Data       []byte           `json:"data"`
+	Executable bool             `json:"executable"`
+	RentEpoch  uint64           `json:"rent_epoch"`
+	// Timestamp is the Unix time the state was observed at
+	Timestamp int64 `json:"timestamp"`
+	// Version numbers the recorded states of an account from 1
+	Version uint64 `json:"version"`
+}
+
+// sameState reports whether two records hold the same account state
+func (r Record) sameState(other Record) bool {
+	return r.Lamports == other.Lamports &&
+		r.Owner == other.Owner &&
+		r.Executable == other.Executable &&
+		r.RentEpoch == other.RentEpoch &&
+		bytes.Equal(r.Data, other.Data)
+}
+
+// Store keeps the recorded states of each account in slot order. States are
+// appended to a JSON lines log, which is replayed when the store is opened.
+type Store struct {
+	mu      sync.RWMutex
+	file    *os.File
+	records map[solana.PublicKey][]Record
+}
+
+// Open opens the log at path, creating it if needed and loading the states it
+// already holds. An empty path keeps the history in memory only.
+func Open(path string) (*Store, error) {
+	store := &Store{records: make(map[solana.PublicKey][]Record)}
+	if path == "" {
+		return store, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history log: %v", err)
+	}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to parse history log %s line %d: %v", path, line, err)
+		}
+		store.records[record.Pubkey] = append(store.records[record.Pubkey], record)
+	}
+	if err := scanner.Err(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read history log: %v", err)
+	}
+
+	store.file = file
+	return store, nil
+}
+
+// Append records a new state of an account. It is skipped, returning false,
+// when it is no newer than the latest recorded state or identical to it.
+func (s *Store) Append(record Record) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history := s.records[record.Pubkey]
+	if n := len(history); n > 0 {
+		latest := history[n-1]
+		if record.Slot < latest.Slot || record.sameState(latest) {
+			return false, nil
+		}
+	}
+	record.Version = uint64(len(history)) + 1
+
+	if s.file != nil {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return false, err
+		}
+		if _, err := s.file.Write(append(line, '\n')); err != nil {
+			return false, fmt.Errorf("failed to write history log: %v", err)
+		}
+	}
+
+	s.records[record.Pubkey] = append(history, record)
+	return true, nil
+}
+
+// Range returns up to limit states of an account in slot order, starting
+// with the state in effect at slot from and ending with the last state
+// recorded at or before slot to. A to of zero means no upper bound. It also
+// reports whether states beyond the limit were left out.
+func (s *Store) Range(pubkey solana.PublicKey, from, to uint64, limit int) ([]Record, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	history := s.records[pubkey]
+
+	// The state in effect at from is the last one recorded at or before it
+	start := sort.Search(len(history), func(i int) bool { return history[i].Slot > from })
+	if start > 0 {
+		start--
+	}
+	end := len(history)
+	if to != 0 {
+		end = sort.Search(len(history), func(i int) bool { return history[i].Slot > to })
+	}
+	if start >= end {
+		return nil, false
+	}
+
+	truncated := false
+	if end-start > limit {
+		end = start + limit
+		truncated = true
+	}
+	return append([]Record(nil), history[start:end]...), truncated
+}
+
+// Close closes the log
+func (s *Store) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Close()
+}
@@ -0,0 +1,275 @@
+package hub
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// TopicKind is what a topic carries
+type TopicKind int
+
+const (
+	// TopicAccount carries the updates of one account
+	TopicAccount TopicKind = iota
+	// TopicProgram carries the updates of every account owned by a program
+	TopicProgram
+	// TopicSlot carries the blocks of every slot at a commitment level
+	TopicSlot
+)
+
+func (k TopicKind) String() string {
+	switch k {
+	case TopicAccount:
+		return "account"
+	case TopicProgram:
+		return "program"
+	case TopicSlot:
+		return "slot"
+	}
+	return "unknown"
+}
+
+// Topic identifies a stream of messages. Account and program topics are keyed
+// by pubkey, slot topics by commitment.
+type Topic struct {
+	Kind       TopicKind
+	Pubkey     solana.PublicKey
+	Commitment rpc.CommitmentType
+}
+
+// AccountTopic is the topic of an account's updates
+func AccountTopic(pubkey solana.PublicKey) Topic {
+	return Topic{Kind: TopicAccount, Pubkey: pubkey}
+}
+
+// ProgramTopic is the topic of the updates of a program's accounts
+func ProgramTopic(program solana.PublicKey) Topic {
+	return Topic{Kind: TopicProgram, Pubkey: program}
+}
+
+// SlotTopic is the topic of the blocks at a commitment level
+func SlotTopic(commitment rpc.CommitmentType) Topic {
+	return Topic{Kind: TopicSlot, Commitment: commitment}
+}
+
+func (t Topic) String() string {
+	if t.Kind == TopicSlot {
+		return t.Kind.String() + ":" + string(t.Commitment)
+	}
+	return t.Kind.String() + ":" + t.Pubkey.String()
+}
+
+// Hub fans the messages ingestion goroutines publish on topics out to
+// subscribers. Each subscriber has its own bounded queue drained by its send
+// loop, so a slow client never blocks ingestion or other clients; a
+// subscriber whose queue is full is dropped instead.
+type Hub[T any] struct {
+	buffer   int
+	activate func(Topic) (deactivate func())
+
+	mu     sync.Mutex
+	topics map[Topic]*topic[T]
+}
+
+// topic is the state of a topic with at least one subscriber
+type topic[T any] struct {
+	subscribers map[*Subscription[T]]bool
+	deactivate  func()
+	published   uint64
+	dropped     uint64
+}
+
+// Subscription receives the messages of a set of topics
+type Subscription[T any] struct {
+	hub      *Hub[T]
+	topics   []Topic
+	messages chan T
+	closed   bool
+}
+
+// TopicStats describes a topic's subscribers and their queues
+type TopicStats struct {
+	Topic       Topic
+	Subscribers int
+	// Queued is the number of messages waiting in all subscriber queues, and
+	// MaxQueued the longest subscriber queue
+	Queued    int
+	MaxQueued int
+	// Published counts the messages published on the topic, and Dropped the
+	// subscribers dropped for falling behind, since the topic gained its
+	// first subscriber
+	Published uint64
+	Dropped   uint64
+	// Capacity is the combined size of the subscriber queues
+	Capacity int
+}
+
+// New creates a hub queueing up to buffer messages per subscriber by default. When
+// activate is set, it is called when a topic gains its first subscriber, so
+// ingestion for it can start, and the function it returns when the topic
+// loses its last one. Both are called with the hub locked and must not call
+// back into it.
+func New[T any](buffer int, activate func(Topic) (deactivate func())) *Hub[T] {
+	return &Hub[T]{
+		buffer:   buffer,
+		activate: activate,
+		topics:   make(map[Topic]*topic[T]),
+	}
+}
+
+// Subscribe subscribes to the messages of the given topics with the hub's
+// queue size. A message published on several of them is received once.
+func (h *Hub[T]) Subscribe(topics ...Topic) *Subscription[T] {
+	return h.SubscribeQueue(h.buffer, topics...)
+}
+
+// SubscribeQueue subscribes like Subscribe, queueing up to queue messages
+func (h *Hub[T]) SubscribeQueue(queue int, topics ...Topic) *Subscription[T] {
+	sub := &Subscription[T]{
+		hub:      h,
+		topics:   topics,
+		messages: make(chan T, queue),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, key := range topics {
+		t := h.topics[key]
+		if t == nil {
+			t = &topic[T]{subscribers: make(map[*Subscription[T]]bool)}
+			if h.activate != nil {
+				t.deactivate = h.activate(key)
+			}
+			h.topics[key] = t
+		}
+		t.subscribers[sub] = true
+	}
+	return sub
+}
+
+// Messages returns the subscription's messages. The channel is closed when
+// the subscriber falls too far behind.
+func (s *Subscription[T]) Messages() <-chan T {
+	return s.messages
+}
+
+// Queued returns the number of messages waiting in the subscription's queue
+func (s *Subscription[T]) Queued() int {
+	return len(s.messages)
+}
+
+// Close unsubscribes from every topic
+func (s *Subscription[T]) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
+
+// remove unsubscribes s, deactivating the topics it was the last subscriber
+// of. The hub must be locked.
+func (h *Hub[T]) remove(s *Subscription[T]) {
+	for _, key := range s.topics {
+		t := h.topics[key]
+		if t == nil || !t.subscribers[s] {
+			continue
+		}
+		delete(t.subscribers, s)
+		if len(t.subscribers) == 0 {
+			delete(h.topics, key)
+			if t.deactivate != nil {
+				t.deactivate()
+			}
+		}
+	}
+}
+
+// Publish delivers a message to the subscribers of the given topics,
+// dropping any subscriber whose queue is full. Nothing is delivered once ctx
+// is done, so an ingestion goroutine stopped by a deactivation never publishes
+// alongside the one started by a later activation.
+func (h *Hub[T]) Publish(ctx context.Context, msg T, topics ...Topic) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if ctx.Err() != nil {
+		return
+	}
+
+	var delivered map[*Subscription[T]]bool
+	if len(topics) > 1 {
+		delivered = make(map[*Subscription[T]]bool)
+	}
+	for _, key := range topics {
+		t := h.topics[key]
+		if t == nil {
+			continue
+		}
+		t.published++
+		for sub := range t.subscribers {
+			if delivered != nil {
+				if delivered[sub] {
+					continue
+				}
+				delivered[sub] = true
+			}
+			if sub.closed {
+				continue
+			}
+			select {
+			case sub.messages <- msg:
+			default:
+				t.dropped++
+				sub.closed = true
+				close(sub.messages)
+				h.remove(sub)
+			}
+		}
+	}
+}
+
+// Topics returns the topics with subscribers
+func (h *Hub[T]) Topics() []Topic {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	topics := make([]Topic, 0, len(h.topics))
+	for key := range h.topics {
+		topics = append(topics, key)
+	}
+	return topics
+}
+
+// Stats returns the state of every topic with subscribers, ordered by kind
+// and then name
+func (h *Hub[T]) Stats() []TopicStats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	stats := make([]TopicStats, 0, len(h.topics))
+	for key, t := range h.topics {
+		entry := TopicStats{
+			Topic:       key,
+			Subscribers: len(t.subscribers),
+			Published:   t.published,
+			Dropped:     t.dropped,
+		}
+		for sub := range t.subscribers {
+			entry.Capacity += cap(sub.messages)
+			queued := len(sub.messages)
+			entry.Queued += queued
+			if queued > entry.MaxQueued {
+				entry.MaxQueued = queued
+			}
+		}
+		stats = append(stats, entry)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Topic.Kind != stats[j].Topic.Kind {
+			return stats[i].Topic.Kind < stats[j].Topic.Kind
+		}
+		return stats[i].Topic.String() < stats[j].Topic.String()
+	})
+	return stats
+}
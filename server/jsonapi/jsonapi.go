@@ -0,0 +1,193 @@
+// Package jsonapi serves the unary methods of the benchmark service as JSON
+// over HTTP, so the same handlers and data can be reached without gRPC and
+// the two protocol stacks compared directly.
+package jsonapi
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Path is where Handler is mounted by the server. Each method is served at
+// Path followed by its name, e.g. /v1/GetAccountInfo.
+const Path = "/v1/"
+
+// maxRequestBytes caps the size of a request body
+const maxRequestBytes = 1 << 20
+
+// Handler serves every unary method of srv. A method takes its request as
+// the protojson encoding of its request message in a POST body and returns
+// the protojson encoding of its response. Calls pass through interceptors in
+// order, as they would on the gRPC server; request headers become incoming
+// metadata, and the headers and trailers a call sets are returned as response
+// headers. Errors are returned as {"code", "message"} with the HTTP status
+// closest to their gRPC code.
+func Handler(srv proto.BenchmarkServiceServer, interceptors ...grpc.UnaryServerInterceptor) http.Handler {
+	interceptor := chain(interceptors)
+	mux := http.NewServeMux()
+	for _, method := range proto.BenchmarkService_ServiceDesc.Methods {
+		fullMethod := "/" + proto.BenchmarkService_ServiceDesc.ServiceName + "/" + method.MethodName
+		handler := method.Handler
+		mux.HandleFunc(Path+method.MethodName, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				w.Header().Set("Allow", http.MethodPost)
+				http.Error(w, "methods are called with POST", http.StatusMethodNotAllowed)
+				return
+			}
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+			if err != nil {
+				writeError(w, status.Errorf(codes.InvalidArgument, "failed to read request: %v", err))
+				return
+			}
+
+			stream := &transportStream{method: fullMethod, header: metadata.MD{}, trailer: metadata.MD{}}
+			ctx := grpc.NewContextWithServerTransportStream(callContext(r), stream)
+			decode := func(req interface{}) error {
+				if len(body) == 0 {
+					return nil
+				}
+				if err := protojson.Unmarshal(body, req.(protobuf.Message)); err != nil {
+					return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+				}
+				return nil
+			}
+			resp, err := handler(srv, ctx, decode, interceptor)
+
+			for _, md := range []metadata.MD{stream.header, stream.trailer} {
+				for key, values := range md {
+					for _, value := range values {
+						w.Header().Add(key, value)
+					}
+				}
+			}
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			data, err := protojson.Marshal(resp.(protobuf.Message))
+			if err != nil {
+				writeError(w, status.Errorf(codes.Internal, "failed to encode response: %v", err))
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(data)
+		})
+	}
+	return mux
+}
+
+// callContext carries the caller's address, TLS state and headers the way a
+// gRPC call context carries its peer and metadata
+func callContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if addrPort, err := netip.ParseAddrPort(r.RemoteAddr); err == nil {
+		p := &peer.Peer{Addr: net.TCPAddrFromAddrPort(addrPort)}
+		if r.TLS != nil {
+			p.AuthInfo = credentials.TLSInfo{
+				State:          *r.TLS,
+				CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+			}
+		}
+		ctx = peer.NewContext(ctx, p)
+	}
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		md[strings.ToLower(key)] = values
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// chain combines interceptors into one that runs them in order
+func chain(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	if len(interceptors) == 0 {
+		return nil
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// transportStream collects the headers and trailers a call sets
+type transportStream struct {
+	method  string
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *transportStream) Method() string {
+	return s.method
+}
+
+func (s *transportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *transportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *transportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+// writeError writes a call's error with the HTTP status closest to its code
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	data, _ := protojson.Marshal(st.Proto())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	w.Write(data)
+}
+
+// httpStatus maps a gRPC code to an HTTP status
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
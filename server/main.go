@@ -1,44 +1,538 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/proto/geyser"
+	"github.com/i-tozer/solana-grpc-exploration/server/access"
+	"github.com/i-tozer/solana-grpc-exploration/server/audit"
+	"github.com/i-tozer/solana-grpc-exploration/server/config"
+	"github.com/i-tozer/solana-grpc-exploration/server/cron"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"github.com/i-tozer/solana-grpc-exploration/server/history"
+	"github.com/i-tozer/solana-grpc-exploration/server/jsonapi"
+	"github.com/i-tozer/solana-grpc-exploration/server/metrics"
+	"github.com/i-tozer/solana-grpc-exploration/server/quicconn"
+	"github.com/i-tozer/solana-grpc-exploration/server/schema"
 	"github.com/i-tozer/solana-grpc-exploration/server/services"
+	"github.com/i-tozer/solana-grpc-exploration/server/signing"
+	"github.com/i-tozer/solana-grpc-exploration/server/slo"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"github.com/i-tozer/solana-grpc-exploration/server/yellowstone"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 var (
-	port        = flag.Int("port", 50051, "The server port")
-	rpcEndpoint = flag.String("rpc-endpoint", "https://api.mainnet-beta.solana.com", "Solana RPC endpoint")
+	port                = flag.Int("port", 50051, "The server port")
+	rpcEndpoint         = flag.String("rpc-endpoint", "https://api.mainnet-beta.solana.com", "Solana RPC endpoint")
+	wsEndpoint          = flag.String("ws-endpoint", "", "Solana WebSocket endpoint (derived from --rpc-endpoint if empty, none if the upstream has none)")
+	wsFallbackPoll      = flag.Duration("ws-fallback-poll-interval", services.DefaultWSFallbackPollInterval, "How often streams that subscribe to the WebSocket endpoint poll instead while it is unavailable (0 to fail them)")
+	configPath          = flag.String("config", "", "Path to a JSON config file; endpoint flags override its values")
+	yellowstoneEndpoint = flag.String("yellowstone-endpoint", "", "Serve account streams from this upstream Yellowstone endpoint instead of polling")
+	upstreamTimeout     = flag.Duration("upstream-timeout", upstream.DefaultTimeouts.Default, "Timeout for upstream RPC calls without a method-specific timeout")
+	timeoutMargin       = flag.Duration("upstream-timeout-margin", upstream.DefaultTimeouts.Margin, "Time kept free before a gRPC call's deadline when bounding its upstream calls")
+	maxUpstreamCalls    = flag.Int("upstream-max-calls", upstream.DefaultPoolLimits.MaxConcurrent, "Number of upstream RPC calls allowed in flight at once; further calls queue (0 for unlimited)")
+	maxEndpointCalls    = flag.Int("upstream-max-calls-per-endpoint", upstream.DefaultPoolLimits.MaxPerEndpoint, "Number of upstream RPC calls allowed in flight to one endpoint at once (0 for unlimited)")
+	upstreamQueueWait   = flag.Duration("upstream-queue-timeout", upstream.DefaultPoolLimits.QueueTimeout, "How long an upstream RPC call waits for a free slot before failing (0 to wait for the caller's deadline)")
+	benchmarkCacheTTL   = flag.Duration("benchmark-cache-ttl", time.Minute, "How long completed benchmark results are reused for identical requests (0 to disable)")
+	maxBenchmarks       = flag.Int("max-concurrent-benchmarks", 1, "Number of benchmark runs allowed at once; further runs wait in a queue")
+	region              = flag.String("region", "", "Region the server runs in, recorded with benchmark results")
+	historyAccounts     = flag.String("history-accounts", "", "Comma-separated accounts whose every state is recorded for GetAccountHistory")
+	historyPath         = flag.String("history-path", "", "Append-only file account history is persisted to (in memory only if empty)")
+	wsMonitor           = flag.Bool("ws-monitor", true, "Monitor the upstream WebSocket connection and report it in GetUpstreamHealth")
+	maxStreams          = flag.Int("max-streams", services.DefaultStreamLimits.MaxStreams, "Number of streams allowed open at once (0 for unlimited)")
+	maxStreamsPerPeer   = flag.Int("max-streams-per-peer", services.DefaultStreamLimits.MaxPerPeer, "Number of streams one client address may have open at once (0 for unlimited)")
+	stalledSendTimeout  = flag.Duration("stream-stalled-send-timeout", services.DefaultStreamReaping.StalledSend, "Cancel streams whose client has not read a message for this long (0 to disable)")
+	streamIdleTimeout   = flag.Duration("stream-idle-timeout", services.DefaultStreamReaping.Idle, "Cancel streams that have neither sent nor received a message for this long (0 to disable)")
+	yellowstoneAdapter  = flag.Bool("yellowstone-adapter", false, "Also serve the Yellowstone geyser API for existing Yellowstone clients")
+	allowCIDRs          = flag.String("allow-cidrs", "", "Comma-separated CIDRs or addresses; when set, only these callers are served")
+	denyCIDRs           = flag.String("deny-cidrs", "", "Comma-separated CIDRs or addresses whose calls are rejected")
+	clusterPeers        = flag.String("cluster-peers", "", "Comma-separated gRPC addresses of the other replicas acknowledged stream sessions can be resumed from")
+	signingKey          = flag.String("signing-key", "", "solana-keygen keypair file to sign every response with (unsigned if empty)")
+	auditLog            = flag.String("audit-log", "", "Append a JSON line recording every call to this file (disabled if empty)")
+	metricsAddr         = flag.String("metrics-addr", "", "Address to serve Prometheus latency histograms on over HTTP at "+metrics.Path+" (disabled if empty)")
+	sloInterval         = flag.Duration("slo-eval-interval", 10*time.Second, "How often the latency objectives in the config are evaluated")
+	jsonAddr            = flag.String("json-addr", "", "Address to serve the unary methods as JSON over HTTP on at "+jsonapi.Path+"<Method> (disabled if empty)")
+	schemaAddr          = flag.String("schema-addr", "", "Address to serve the API's FileDescriptorSet on over HTTP at "+schema.Path+" (disabled if empty)")
+	writeDescriptors    = flag.String("write-descriptors", "", "Write the API's FileDescriptorSet to this file and exit")
+	tlsCert             = flag.String("tls-cert", "", "PEM certificate to serve gRPC over TLS with, with --tls-key (plaintext if empty)")
+	tlsKey              = flag.String("tls-key", "", "PEM private key of --tls-cert")
+	tlsCA               = flag.String("tls-ca", "", "PEM CAs client certificates are verified against; cluster peers are verified against them too")
+	mtls                = flag.Bool("mtls", false, "Require clients to present a certificate issued by a CA in --tls-ca")
+	quicAddr            = flag.String("quic-addr", "", "Experimental: also serve gRPC over QUIC on this UDP address, such as :50052; requires --tls-cert (disabled if empty)")
 )
 
 func main() {
 	flag.Parse()
 
+	// Export the API descriptors for schema registries and exit
+	if *writeDescriptors != "" {
+		if err := schema.Write(*writeDescriptors); err != nil {
+			log.Fatalf("failed to write descriptors: %v", err)
+		}
+		log.Printf("Wrote descriptors to %s", *writeDescriptors)
+		return
+	}
+
 	// Create a listener on the specified port
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	// Create a new gRPC server
-	grpcServer := grpc.NewServer()
+	// Load the config file, letting explicitly set flags take precedence
+	cfg := &config.Config{}
+	if *configPath != "" {
+		cfg, err = config.Load(*configPath)
+		if err != nil {
+			log.Fatalf("failed to load config: %v", err)
+		}
+	}
+	if cfg.Upstream.RPCEndpoint == "" || isFlagSet("rpc-endpoint") {
+		cfg.Upstream.RPCEndpoint = *rpcEndpoint
+	}
+	if isFlagSet("ws-endpoint") {
+		cfg.Upstream.WSEndpoint = *wsEndpoint
+	}
+	if cfg.Upstream.WSEndpoint == "" {
+		cfg.Upstream.WSEndpoint = upstream.WSEndpoint(cfg.Upstream.RPCEndpoint)
+	}
+
+	wsURL := cfg.Upstream.WSEndpoint
+	if wsURL == "none" {
+		wsURL = ""
+	}
+	endpoint, err := upstream.NewEndpoint(cfg.Upstream.RPCEndpoint, wsURL, cfg.Upstream.Auth)
+	if err != nil {
+		log.Fatalf("invalid upstream config: %v", err)
+	}
+	timeouts, err := upstreamTimeouts(cfg.Upstream.Timeouts)
+	if err != nil {
+		log.Fatalf("invalid upstream timeouts: %v", err)
+	}
+	endpoint.Timeouts = &timeouts
+	poolLimits, err := upstreamPoolLimits(cfg.Upstream.Concurrency)
+	if err != nil {
+		log.Fatalf("invalid upstream concurrency: %v", err)
+	}
+	endpoint.Pool = upstream.NewPool(poolLimits)
+
+	if isFlagSet("yellowstone-endpoint") {
+		if cfg.Yellowstone == nil {
+			cfg.Yellowstone = &config.Yellowstone{}
+		}
+		cfg.Yellowstone.Endpoint = *yellowstoneEndpoint
+	}
+
+	if cfg.Benchmarks.MaxConcurrent == 0 || isFlagSet("max-concurrent-benchmarks") {
+		cfg.Benchmarks.MaxConcurrent = *maxBenchmarks
+	}
+	if cfg.Benchmarks.MaxConcurrent < 1 {
+		log.Fatalf("invalid max concurrent benchmarks: %d", cfg.Benchmarks.MaxConcurrent)
+	}
+
+	cacheTTL := *benchmarkCacheTTL
+	if cfg.Benchmarks.CacheTTL != "" && !isFlagSet("benchmark-cache-ttl") {
+		cacheTTL, err = time.ParseDuration(cfg.Benchmarks.CacheTTL)
+		if err != nil {
+			log.Fatalf("invalid benchmark cache ttl: %v", err)
+		}
+	}
+
+	if isFlagSet("region") {
+		cfg.Benchmarks.Region = *region
+	}
+
+	fallbackPoll := *wsFallbackPoll
+	if cfg.Upstream.WSFallbackPollInterval != "" && !isFlagSet("ws-fallback-poll-interval") {
+		fallbackPoll, err = time.ParseDuration(cfg.Upstream.WSFallbackPollInterval)
+		if err != nil {
+			log.Fatalf("invalid websocket fallback poll interval: %v", err)
+		}
+	}
+	if fallbackPoll < 0 {
+		log.Fatalf("invalid websocket fallback poll interval: %v", fallbackPoll)
+	}
+
+	limits, err := benchmarkLimits(cfg.Benchmarks.Limits, services.DefaultBenchmarkLimits)
+	if err != nil {
+		log.Fatalf("invalid benchmark limits: %v", err)
+	}
+	keyLimits := make(map[string]services.BenchmarkLimits, len(cfg.Benchmarks.APIKeyLimits))
+	for key, keyCfg := range cfg.Benchmarks.APIKeyLimits {
+		keyLimits[key], err = benchmarkLimits(keyCfg, limits)
+		if err != nil {
+			log.Fatalf("invalid benchmark limits for an API key: %v", err)
+		}
+	}
+
+	if cfg.Streams.MaxStreams == 0 || isFlagSet("max-streams") {
+		cfg.Streams.MaxStreams = *maxStreams
+	}
+	if cfg.Streams.MaxPerPeer == 0 || isFlagSet("max-streams-per-peer") {
+		cfg.Streams.MaxPerPeer = *maxStreamsPerPeer
+	}
+	if cfg.Streams.MaxStreams < 0 || cfg.Streams.MaxPerPeer < 0 {
+		log.Fatalf("invalid stream limits: limits must not be negative")
+	}
+	for _, limit := range cfg.Streams.APIKeyMaxStreams {
+		if limit < 0 {
+			log.Fatalf("invalid stream limits for an API key: limits must not be negative")
+		}
+	}
+
+	reaping := services.StreamReaping{StalledSend: *stalledSendTimeout, Idle: *streamIdleTimeout}
+	if cfg.Streams.StalledSendTimeout != "" && !isFlagSet("stream-stalled-send-timeout") {
+		reaping.StalledSend, err = time.ParseDuration(cfg.Streams.StalledSendTimeout)
+		if err != nil {
+			log.Fatalf("invalid stalled send timeout: %v", err)
+		}
+	}
+	if cfg.Streams.IdleTimeout != "" && !isFlagSet("stream-idle-timeout") {
+		reaping.Idle, err = time.ParseDuration(cfg.Streams.IdleTimeout)
+		if err != nil {
+			log.Fatalf("invalid stream idle timeout: %v", err)
+		}
+	}
+
+	flags, err := features.New(cfg.Features)
+	if err != nil {
+		log.Fatalf("invalid feature flags: %v", err)
+	}
+	if !flags.Enabled(features.BenchmarkCache) {
+		cacheTTL = 0
+	}
+
+	// Create and register the benchmark service. Its stream limits and
+	// reaping apply to every streaming call of the server.
+	benchmarkService := services.NewBenchmarkService(endpoint)
+	benchmarkService.SetFeatures(flags)
+	benchmarkService.SetMaxConcurrentBenchmarks(cfg.Benchmarks.MaxConcurrent)
+	benchmarkService.SetBenchmarkCacheTTL(cacheTTL)
+	benchmarkService.SetBenchmarkLimits(limits, keyLimits)
+	benchmarkService.SetRegion(cfg.Benchmarks.Region)
+	benchmarkService.SetStreamLimits(services.StreamLimits{
+		MaxStreams: cfg.Streams.MaxStreams,
+		MaxPerPeer: cfg.Streams.MaxPerPeer,
+	}, cfg.Streams.APIKeyMaxStreams)
+	benchmarkService.SetStreamReaping(reaping)
+	benchmarkService.SetWSFallbackPollInterval(fallbackPoll)
 
-	// Create and register the benchmark service
-	benchmarkService := services.NewBenchmarkService(*rpcEndpoint)
+	// Audit every call, including the calls the access rules and stream
+	// limits reject
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if isFlagSet("audit-log") {
+		cfg.AuditLog = *auditLog
+	}
+	if cfg.AuditLog != "" {
+		auditor, err := audit.Open(cfg.AuditLog)
+		if err != nil {
+			log.Fatalf("failed to open audit log: %v", err)
+		}
+		defer auditor.Close()
+		unaryInterceptors = append(unaryInterceptors, auditor.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, auditor.StreamInterceptor)
+	}
+
+	// Evaluate the configured latency objectives from the histograms
+	objectives, err := sloObjectives(cfg.SLOs)
+	if err != nil {
+		log.Fatalf("invalid SLOs: %v", err)
+	}
+	if len(objectives) > 0 {
+		if *sloInterval <= 0 {
+			log.Fatalf("invalid SLOs: --slo-eval-interval must be positive")
+		}
+		tracker := slo.New(objectives)
+		go tracker.Run(context.Background(), *sloInterval)
+		benchmarkService.UseSLOTracker(tracker)
+	}
+
+	// Time every unary call for the handler latency histogram, labelling the
+	// calls of each configured API key with a tenant of their own
+	if *metricsAddr != "" || len(objectives) > 0 {
+		unaryInterceptors = append(unaryInterceptors, metrics.UnaryInterceptor)
+	}
+	var tenantKeys []string
+	for key := range cfg.Benchmarks.APIKeyLimits {
+		tenantKeys = append(tenantKeys, key)
+	}
+	for key := range cfg.Streams.APIKeyMaxStreams {
+		if _, ok := cfg.Benchmarks.APIKeyLimits[key]; !ok {
+			tenantKeys = append(tenantKeys, key)
+		}
+	}
+	metrics.SetTenants(tenantKeys)
+
+	// Reject filtered callers before any other work
+	if isFlagSet("allow-cidrs") || isFlagSet("deny-cidrs") {
+		if cfg.Access == nil {
+			cfg.Access = &config.Access{}
+		}
+		if isFlagSet("allow-cidrs") {
+			cfg.Access.Allow = strings.Split(*allowCIDRs, ",")
+		}
+		if isFlagSet("deny-cidrs") {
+			cfg.Access.Deny = strings.Split(*denyCIDRs, ",")
+		}
+	}
+	var filter *access.Filter
+	if cfg.Access != nil {
+		filter, err = access.New(cfg.Access.Allow, cfg.Access.Deny)
+		if err != nil {
+			log.Fatalf("invalid access rules: %v", err)
+		}
+	}
+	if filter != nil && filter.Enabled() {
+		go filter.Report(context.Background(), time.Minute)
+		unaryInterceptors = append(unaryInterceptors, filter.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, filter.StreamInterceptor)
+	}
+
+	// Sign the responses of the calls admitted
+	if isFlagSet("signing-key") {
+		cfg.Signing = &config.Signing{KeyPath: *signingKey}
+	}
+	var signer *signing.Signer
+	if cfg.Signing != nil {
+		key, err := cfg.Signing.Key()
+		if err != nil {
+			log.Fatalf("invalid signing key: %v", err)
+		}
+		if signer, err = signing.New(key); err != nil {
+			log.Fatalf("invalid signing key: %v", err)
+		}
+		unaryInterceptors = append(unaryInterceptors, signer.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, signer.StreamInterceptor)
+	}
+	// Stamped inside the signer, so signatures cover the server slot
+	unaryInterceptors = append(unaryInterceptors, benchmarkService.StampServerSlot)
+	streamInterceptors = append(streamInterceptors, benchmarkService.StampServerSlotStreams)
+	streamInterceptors = append(streamInterceptors, benchmarkService.LimitStreams, benchmarkService.ReapStreams)
+	serverOpts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(unaryInterceptors...), grpc.ChainStreamInterceptor(streamInterceptors...)}
+
+	// Serve over TLS, optionally requiring client certificates
+	if isFlagSet("tls-cert") || isFlagSet("tls-key") || isFlagSet("tls-ca") || isFlagSet("mtls") {
+		if cfg.TLS == nil {
+			cfg.TLS = &config.TLS{}
+		}
+		if isFlagSet("tls-cert") {
+			cfg.TLS.CertFile = *tlsCert
+		}
+		if isFlagSet("tls-key") {
+			cfg.TLS.KeyFile = *tlsKey
+		}
+		if isFlagSet("tls-ca") {
+			cfg.TLS.CAFile = *tlsCA
+		}
+		if isFlagSet("mtls") {
+			cfg.TLS.MTLS = *mtls
+		}
+	}
+	peerCreds := insecure.NewCredentials()
+	var serverTLS *tls.Config
+	if cfg.TLS != nil {
+		serverTLS, err = cfg.TLS.ServerConfig()
+		if err != nil {
+			log.Fatalf("invalid tls config: %v", err)
+		}
+		peerTLS, err := cfg.TLS.PeerConfig()
+		if err != nil {
+			log.Fatalf("invalid tls config: %v", err)
+		}
+		// QUIC connections arrive with their TLS handshake done
+		serverOpts = append(serverOpts, grpc.Creds(quicconn.Credentials(credentials.NewTLS(serverTLS))))
+		peerCreds = credentials.NewTLS(peerTLS)
+	}
+	if *quicAddr != "" && serverTLS == nil {
+		log.Fatalf("--quic-addr requires TLS: set --tls-cert and --tls-key")
+	}
+	grpcServer := grpc.NewServer(serverOpts...)
 	proto.RegisterBenchmarkServiceServer(grpcServer, benchmarkService)
+	proto.RegisterUtilsServiceServer(grpcServer, services.NewUtilsService())
+
+	// Serve account streams from a single upstream Yellowstone subscription
+	if cfg.Yellowstone != nil && cfg.Yellowstone.Endpoint != "" && flags.Enabled(features.YellowstoneGateway) {
+		token, err := cfg.Yellowstone.XToken()
+		if err != nil {
+			log.Fatalf("invalid yellowstone config: %v", err)
+		}
+		commitment := rpc.CommitmentType(cfg.Yellowstone.Commitment)
+		switch commitment {
+		case "":
+			commitment = rpc.CommitmentFinalized
+		case rpc.CommitmentProcessed, rpc.CommitmentConfirmed, rpc.CommitmentFinalized:
+		default:
+			log.Fatalf("invalid yellowstone commitment: %s", commitment)
+		}
+		gateway, err := yellowstone.NewGateway(cfg.Yellowstone.Endpoint, token, commitment)
+		if err != nil {
+			log.Fatalf("failed to create yellowstone gateway: %v", err)
+		}
+		defer gateway.Close()
+		go gateway.Run(context.Background())
+		benchmarkService.UseGateway(gateway)
+	}
+
+	// Share acknowledged stream sessions with the other replicas
+	if isFlagSet("cluster-peers") {
+		cfg.Cluster = &config.Cluster{Peers: strings.Split(*clusterPeers, ",")}
+	}
+	if cfg.Cluster != nil {
+		if err := benchmarkService.SetClusterPeers(cfg.Cluster.Peers, peerCreds); err != nil {
+			log.Fatalf("invalid cluster config: %v", err)
+		}
+	}
+
+	// Record the states of watched accounts for GetAccountHistory
+	if isFlagSet("history-accounts") || isFlagSet("history-path") {
+		if cfg.History == nil {
+			cfg.History = &config.History{}
+		}
+		if isFlagSet("history-accounts") {
+			cfg.History.Accounts = strings.Split(*historyAccounts, ",")
+		}
+		if isFlagSet("history-path") {
+			cfg.History.Path = *historyPath
+		}
+	}
+	if cfg.History != nil && len(cfg.History.Accounts) > 0 && flags.Enabled(features.AccountHistory) {
+		accounts := make([]solana.PublicKey, 0, len(cfg.History.Accounts))
+		for _, account := range cfg.History.Accounts {
+			pubkey, err := solana.PublicKeyFromBase58(strings.TrimSpace(account))
+			if err != nil {
+				log.Fatalf("invalid history account %q: %v", account, err)
+			}
+			accounts = append(accounts, pubkey)
+		}
+		commitment := rpc.CommitmentType(cfg.History.Commitment)
+		switch commitment {
+		case "":
+			commitment = rpc.CommitmentConfirmed
+		case rpc.CommitmentProcessed, rpc.CommitmentConfirmed, rpc.CommitmentFinalized:
+		default:
+			log.Fatalf("invalid history commitment: %s", commitment)
+		}
+		store, err := history.Open(cfg.History.Path)
+		if err != nil {
+			log.Fatalf("failed to open account history: %v", err)
+		}
+		defer store.Close()
+		recorder := history.NewRecorder(endpoint, store, accounts, commitment)
+		go recorder.Run(context.Background())
+		benchmarkService.UseAccountHistory(recorder)
+	}
+
+	for _, flag := range flags.Flags() {
+		if !flags.Enabled(flag) {
+			log.Printf("Feature %s is disabled", flag)
+		}
+	}
+
+	// Run the configured benchmark scenarios on their schedules
+	if cfg.Benchmarks.Scheduled != nil && len(cfg.Benchmarks.Scheduled.Scenarios) > 0 {
+		scenarios, err := benchmarkScenarios(cfg.Benchmarks.Scheduled.Scenarios)
+		if err != nil {
+			log.Fatalf("invalid benchmark scenarios: %v", err)
+		}
+		scheduled := cfg.Benchmarks.Scheduled
+		retention := history.DefaultBenchmarkRetention
+		if scheduled.MaxRuns != 0 {
+			retention.MaxRuns = max(scheduled.MaxRuns, 0)
+		}
+		if scheduled.MaxAge != "" {
+			if retention.MaxAge, err = time.ParseDuration(scheduled.MaxAge); err != nil || retention.MaxAge <= 0 {
+				log.Fatalf("invalid benchmark history max_age: %s", scheduled.MaxAge)
+			}
+		}
+		// Runs are kept with the account history unless given a log of their own
+		historyPath := scheduled.HistoryPath
+		if historyPath == "" && cfg.History != nil && cfg.History.Path != "" {
+			historyPath = cfg.History.Path + ".benchmarks"
+		}
+		store, err := history.OpenBenchmarks(historyPath, retention)
+		if err != nil {
+			log.Fatalf("failed to open benchmark history: %v", err)
+		}
+		defer store.Close()
+		if err := benchmarkService.ScheduleBenchmarks(context.Background(), scenarios, store); err != nil {
+			log.Fatalf("invalid benchmark scenarios: %v", err)
+		}
+	}
+
+	// Track the WebSocket connection the live streams depend on
+	if *wsMonitor && endpoint.WS != "" && flags.Enabled(features.WebSocketStreams) {
+		monitor := upstream.NewWSMonitor(endpoint)
+		go monitor.Run(context.Background())
+		benchmarkService.UseWSMonitor(monitor)
+	}
+
+	// Optionally serve the Yellowstone geyser API alongside it
+	if *yellowstoneAdapter && flags.Enabled(features.YellowstoneAdapter) {
+		geyser.RegisterGeyserServer(grpcServer, yellowstone.NewAdapter(endpoint))
+	}
 
 	// Register reflection service on gRPC server
 	reflection.Register(grpcServer)
 
+	// Serve the compiled descriptors for consumers that don't use reflection,
+	// the latency metrics and the JSON API, sharing a listener if their
+	// addresses match
+	muxes := make(map[string]*http.ServeMux)
+	serveHTTP := func(addr, path string, handler http.Handler) {
+		mux, ok := muxes[addr]
+		if !ok {
+			mux = http.NewServeMux()
+			muxes[addr] = mux
+		}
+		mux.Handle(path, handler)
+	}
+	if *schemaAddr != "" {
+		serveHTTP(*schemaAddr, schema.Path, schema.Handler())
+	}
+	if *metricsAddr != "" {
+		serveHTTP(*metricsAddr, metrics.Path, metrics.Handler())
+	}
+	if *jsonAddr != "" {
+		serveHTTP(*jsonAddr, jsonapi.Path, jsonapi.Handler(benchmarkService, unaryInterceptors...))
+	}
+	for addr, mux := range muxes {
+		go func(addr string, mux *http.ServeMux) {
+			server := &http.Server{Addr: addr, Handler: mux}
+			var err error
+			// The JSON API reaches the same methods as gRPC, so it takes the
+			// same TLS and client certificate requirements
+			if addr == *jsonAddr && serverTLS != nil {
+				server.TLSConfig = serverTLS.Clone()
+				err = server.ListenAndServeTLS("", "")
+			} else {
+				err = server.ListenAndServe()
+			}
+			if err != nil {
+				log.Fatalf("failed to serve HTTP on %s: %v", addr, err)
+			}
+		}(addr, mux)
+	}
+
 	// Handle graceful shutdown
 	go func() {
 		sigCh := make(chan os.Signal, 1)
@@ -50,8 +544,310 @@ func main() {
 
 	// Start the server
 	log.Printf("Starting gRPC server on port %d...", *port)
-	log.Printf("Using Solana RPC endpoint: %s", *rpcEndpoint)
+	log.Printf("Using Solana RPC endpoint: %s", cfg.Upstream.RPCEndpoint)
+	log.Printf("Using Solana WebSocket endpoint: %s", cfg.Upstream.WSEndpoint)
+	if cfg.Upstream.Auth != nil && cfg.Upstream.Auth.Provider != "" {
+		log.Printf("Using %s upstream auth", cfg.Upstream.Auth.Provider)
+	}
+	if *yellowstoneAdapter && flags.Enabled(features.YellowstoneAdapter) {
+		log.Println("Serving Yellowstone geyser API")
+	}
+	if cfg.AuditLog != "" {
+		log.Printf("Recording calls to audit log: %s", cfg.AuditLog)
+	}
+	if cfg.TLS != nil {
+		if cfg.TLS.MTLS {
+			log.Println("Serving gRPC over TLS, requiring client certificates")
+		} else {
+			log.Println("Serving gRPC over TLS")
+		}
+	}
+	if signer != nil {
+		log.Printf("Signing responses as %s", signer.PublicKey())
+	}
+	if cfg.Cluster != nil && len(cfg.Cluster.Peers) > 0 {
+		log.Printf("Resuming sessions from cluster peers: %s", strings.Join(cfg.Cluster.Peers, ", "))
+	}
+	if filter != nil && filter.Enabled() {
+		log.Printf("Filtering callers: %d allow and %d deny rules", len(cfg.Access.Allow), len(cfg.Access.Deny))
+	}
+	if *schemaAddr != "" {
+		log.Printf("Serving descriptors on http://%s%s", *schemaAddr, schema.Path)
+	}
+	if *metricsAddr != "" {
+		log.Printf("Serving metrics on http://%s%s", *metricsAddr, metrics.Path)
+	}
+	if *jsonAddr != "" {
+		scheme := "http"
+		if serverTLS != nil {
+			scheme = "https"
+		}
+		log.Printf("Serving the JSON API on %s://%s%s", scheme, *jsonAddr, jsonapi.Path)
+	}
+	if cfg.Yellowstone != nil && cfg.Yellowstone.Endpoint != "" && flags.Enabled(features.YellowstoneGateway) {
+		log.Printf("Serving account streams from Yellowstone endpoint: %s", cfg.Yellowstone.Endpoint)
+	}
+	if cfg.Benchmarks.Scheduled != nil && len(cfg.Benchmarks.Scheduled.Scenarios) > 0 {
+		log.Printf("Running %d benchmark scenarios on a schedule", len(cfg.Benchmarks.Scheduled.Scenarios))
+	}
+	if len(objectives) > 0 {
+		log.Printf("Evaluating %d latency objectives every %s", len(objectives), *sloInterval)
+	}
+	if cfg.History != nil && len(cfg.History.Accounts) > 0 && flags.Enabled(features.AccountHistory) {
+		log.Printf("Recording history of %d accounts", len(cfg.History.Accounts))
+	}
+
+	// Experimentally serve the same server over QUIC
+	if *quicAddr != "" {
+		quicListener, err := quicconn.Listen(*quicAddr, serverTLS)
+		if err != nil {
+			log.Fatalf("failed to listen for QUIC: %v", err)
+		}
+		log.Printf("Serving gRPC over QUIC on udp %s (experimental)", quicListener.Addr())
+		go func() {
+			if err := grpcServer.Serve(quicListener); err != nil {
+				log.Fatalf("failed to serve QUIC: %v", err)
+			}
+		}()
+	}
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// upstreamTimeouts applies configured upstream timeouts, then explicitly set
+// flags, over the defaults
+func upstreamTimeouts(cfg *config.Timeouts) (upstream.Timeouts, error) {
+	timeouts := upstream.DefaultTimeouts
+	timeouts.Methods = make(map[string]time.Duration, len(upstream.DefaultTimeouts.Methods))
+	for method, timeout := range upstream.DefaultTimeouts.Methods {
+		timeouts.Methods[method] = timeout
+	}
+
+	if cfg != nil {
+		var err error
+		if cfg.Default != "" {
+			if timeouts.Default, err = time.ParseDuration(cfg.Default); err != nil {
+				return timeouts, err
+			}
+		}
+		if cfg.Margin != "" {
+			if timeouts.Margin, err = time.ParseDuration(cfg.Margin); err != nil {
+				return timeouts, err
+			}
+		}
+		for method, raw := range cfg.Methods {
+			timeout, err := time.ParseDuration(raw)
+			if err != nil {
+				return timeouts, fmt.Errorf("%s: %v", method, err)
+			}
+			timeouts.Methods[method] = timeout
+		}
+	}
+
+	if isFlagSet("upstream-timeout") {
+		timeouts.Default = *upstreamTimeout
+	}
+	if isFlagSet("upstream-timeout-margin") {
+		timeouts.Margin = *timeoutMargin
+	}
+	return timeouts, nil
+}
+
+// upstreamPoolLimits applies configured upstream concurrency limits, then
+// explicitly set flags, over the defaults
+func upstreamPoolLimits(cfg *config.Concurrency) (upstream.PoolLimits, error) {
+	limits := upstream.DefaultPoolLimits
+	if cfg != nil {
+		if cfg.MaxCalls != nil {
+			limits.MaxConcurrent = *cfg.MaxCalls
+		}
+		if cfg.MaxCallsPerEndpoint != nil {
+			limits.MaxPerEndpoint = *cfg.MaxCallsPerEndpoint
+		}
+		if cfg.QueueTimeout != "" {
+			var err error
+			if limits.QueueTimeout, err = time.ParseDuration(cfg.QueueTimeout); err != nil {
+				return limits, err
+			}
+		}
+	}
+
+	if isFlagSet("upstream-max-calls") {
+		limits.MaxConcurrent = *maxUpstreamCalls
+	}
+	if isFlagSet("upstream-max-calls-per-endpoint") {
+		limits.MaxPerEndpoint = *maxEndpointCalls
+	}
+	if isFlagSet("upstream-queue-timeout") {
+		limits.QueueTimeout = *upstreamQueueWait
+	}
+	if limits.MaxConcurrent < 0 || limits.MaxPerEndpoint < 0 || limits.QueueTimeout < 0 {
+		return limits, fmt.Errorf("limits must not be negative")
+	}
+	return limits, nil
+}
+
+// benchmarkLimits applies configured benchmark limits over base, which
+// supplies the value of every unset limit
+func benchmarkLimits(cfg config.BenchmarkLimits, base services.BenchmarkLimits) (services.BenchmarkLimits, error) {
+	limits := base
+	if cfg.MaxIterations != 0 {
+		limits.MaxIterations = cfg.MaxIterations
+	}
+	if cfg.MaxTargets != 0 {
+		limits.MaxTargets = cfg.MaxTargets
+	}
+	if cfg.MaxConcurrent != 0 {
+		limits.MaxConcurrent = cfg.MaxConcurrent
+	}
+	if cfg.MaxDuration != "" {
+		d, err := time.ParseDuration(cfg.MaxDuration)
+		if err != nil {
+			return limits, err
+		}
+		limits.MaxDuration = d
+	}
+	if limits.MaxTargets < 0 || limits.MaxConcurrent < 0 || limits.MaxDuration < 0 {
+		return limits, fmt.Errorf("limits must not be negative")
+	}
+	return limits, nil
+}
+
+// benchmarkScenarios parses the schedules and requests of the configured
+// benchmark scenarios
+func benchmarkScenarios(cfgs []config.BenchmarkScenario) ([]services.BenchmarkScenario, error) {
+	scenarios := make([]services.BenchmarkScenario, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		schedule, err := cron.Parse(cfg.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("%s schedule: %v", cfg.Name, err)
+		}
+		req := &proto.BenchmarkRequest{}
+		if err := protojson.Unmarshal(cfg.Request, req); err != nil {
+			return nil, fmt.Errorf("%s request: %v", cfg.Name, err)
+		}
+		scenario := services.BenchmarkScenario{
+			Name:     cfg.Name,
+			Spec:     cfg.Schedule,
+			Schedule: schedule,
+			Request:  req,
+		}
+		if cfg.Alert != nil {
+			if scenario.Alert, err = benchmarkAlert(cfg.Alert); err != nil {
+				return nil, fmt.Errorf("%s alert: %v", cfg.Name, err)
+			}
+		}
+		scenarios = append(scenarios, scenario)
+	}
+	return scenarios, nil
+}
+
+// benchmarkAlert applies a configured regression alert over the defaults
+func benchmarkAlert(cfg *config.BenchmarkAlert) (*services.BenchmarkAlert, error) {
+	alert := &services.BenchmarkAlert{
+		WebhookURL:      cfg.WebhookURL,
+		BaselineRuns:    10,
+		MinBaselineRuns: 3,
+		MaxIncrease:     0.5,
+	}
+	switch cfg.Format {
+	case "", "json":
+	case "slack":
+		alert.Slack = true
+	default:
+		return nil, fmt.Errorf("unknown format %q", cfg.Format)
+	}
+	if cfg.WebhookURL == "" {
+		return nil, fmt.Errorf("webhook_url is required")
+	}
+	if cfg.BaselineRuns > 0 {
+		alert.BaselineRuns = cfg.BaselineRuns
+	}
+	if cfg.MinBaselineRuns > 0 {
+		alert.MinBaselineRuns = cfg.MinBaselineRuns
+	}
+	if alert.MinBaselineRuns > alert.BaselineRuns {
+		return nil, fmt.Errorf("min_baseline_runs must not exceed baseline_runs")
+	}
+	if cfg.MaxIncrease < 0 {
+		return nil, fmt.Errorf("max_increase must not be negative")
+	}
+	if cfg.MaxIncrease > 0 {
+		alert.MaxIncrease = cfg.MaxIncrease
+	}
+	if cfg.MinIncrease != "" {
+		var err error
+		if alert.MinIncrease, err = time.ParseDuration(cfg.MinIncrease); err != nil {
+			return nil, err
+		}
+	}
+	return alert, nil
+}
+
+// sloObjectives parses the configured latency objectives. Handler objectives
+// may name a BenchmarkService method without its service.
+func sloObjectives(cfgs []config.SLO) ([]slo.Objective, error) {
+	objectives := make([]slo.Objective, 0, len(cfgs))
+	unary := unaryMethods(proto.BenchmarkService_ServiceDesc, proto.UtilsService_ServiceDesc)
+	for i, cfg := range cfgs {
+		objective := slo.Objective{Name: cfg.Name, Method: cfg.Method, Percentile: cfg.Percentile}
+		switch cfg.Source {
+		case "", "handler":
+			objective.Source = slo.SourceHandler
+			if cfg.Method != "" && !strings.HasPrefix(cfg.Method, "/") {
+				objective.Method = "/" + proto.BenchmarkService_ServiceDesc.ServiceName + "/" + cfg.Method
+			}
+			// Only unary calls are timed, so any other method is never met
+			// or violated
+			if cfg.Method != "" && !unary[objective.Method] {
+				return nil, fmt.Errorf("SLO %d: %s is not a unary method of the server", i, cfg.Method)
+			}
+		case "upstream":
+			objective.Source = slo.SourceUpstream
+		default:
+			return nil, fmt.Errorf("SLO %d: unknown source %q", i, cfg.Source)
+		}
+
+		var err error
+		if objective.Threshold, err = time.ParseDuration(cfg.Threshold); err != nil {
+			return nil, fmt.Errorf("SLO %d threshold: %v", i, err)
+		}
+		objective.Window = 5 * time.Minute
+		if cfg.Window != "" {
+			if objective.Window, err = time.ParseDuration(cfg.Window); err != nil {
+				return nil, fmt.Errorf("SLO %d window: %v", i, err)
+			}
+		}
+		if objective.Name == "" {
+			objective.Name = fmt.Sprintf("p%g %s < %s over %s", cfg.Percentile, cfg.Method, objective.Threshold, objective.Window)
+		}
+		if err := objective.Validate(); err != nil {
+			return nil, err
+		}
+		objectives = append(objectives, objective)
+	}
+	return objectives, nil
+}
+
+// unaryMethods returns the full names of the unary methods of services
+func unaryMethods(services ...grpc.ServiceDesc) map[string]bool {
+	methods := make(map[string]bool)
+	for _, service := range services {
+		for _, method := range service.Methods {
+			methods["/"+service.ServiceName+"/"+method.MethodName] = true
+		}
+	}
+	return methods
+}
+
+// isFlagSet reports whether a flag was passed on the command line
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
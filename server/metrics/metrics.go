@@ -0,0 +1,298 @@
+// Package metrics collects latency histograms of the calls the server serves
+// and makes upstream, along with counters of rejected calls, and serves them
+// in the Prometheus text exposition format.
+package metrics
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Path is the HTTP path metrics are served at
+const Path = "/metrics"
+
+// maxRecentSamples caps the latencies kept per method for Recent
+const maxRecentSamples = 100000
+
+// maxTenants caps the API keys given a tenant label value of their own, so
+// that the number of series stays bounded however many keys are configured
+const maxTenants = 50
+
+// apiKeyHeader is the metadata key callers identify themselves with
+const apiKeyHeader = "x-api-key"
+
+const (
+	// TenantNone labels calls without an x-api-key
+	TenantNone = "none"
+	// TenantOther labels calls with an x-api-key that is not configured, or
+	// past the first maxTenants keys
+	TenantOther = "other"
+)
+
+// buckets are the upper bounds of the latency histograms, in seconds, from
+// cached reads to slow getProgramAccounts and getBlock calls
+var buckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+var (
+	// UpstreamLatency is the latency of each JSON-RPC call to the Solana
+	// upstream, by JSON-RPC method and tenant
+	UpstreamLatency = newHistogramVec("solana_upstream_rpc_duration_seconds",
+		"Latency of JSON-RPC calls to the Solana upstream, by JSON-RPC method and tenant.")
+	// HandlerLatency is the total latency of each unary gRPC call served,
+	// upstream calls included, by gRPC method and tenant
+	HandlerLatency = newHistogramVec("grpc_server_handler_duration_seconds",
+		"Total latency of unary gRPC calls served, including their upstream calls, by gRPC method and tenant.")
+	// AccessRejected counts the calls the access filter rejected, by the
+	// deny rule that matched or "not allowed", and by tenant
+	AccessRejected = newCounterVec("grpc_access_rejected_total",
+		"Calls rejected by the access filter, by the deny rule that matched or not allowed, and by tenant.",
+		"reason", "tenant")
+)
+
+// tenants maps the configured API keys to their tenant label values
+var tenants struct {
+	mu   sync.RWMutex
+	keys map[string]string
+}
+
+// SetTenants sets the API keys whose calls are labelled with a tenant of their
+// own: a fingerprint of the key, never the key itself. Past the first
+// maxTenants keys in sorted order, and for any other key, calls are labelled
+// "other".
+func SetTenants(keys []string) {
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+	known := make(map[string]string, min(len(sorted), maxTenants))
+	for _, key := range sorted {
+		if len(known) == maxTenants {
+			break
+		}
+		known[key] = fingerprint(key)
+	}
+
+	tenants.mu.Lock()
+	defer tenants.mu.Unlock()
+	tenants.keys = known
+}
+
+// Tenant returns the tenant label value of the call whose incoming metadata
+// ctx carries
+func Tenant(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(apiKeyHeader)
+	if len(keys) == 0 {
+		return TenantNone
+	}
+	tenants.mu.RLock()
+	defer tenants.mu.RUnlock()
+	if tenant, ok := tenants.keys[keys[0]]; ok {
+		return tenant
+	}
+	return TenantOther
+}
+
+func fingerprint(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return "sha256:" + hex.EncodeToString(sum[:8])
+}
+
+// labelSeparator joins label values into series keys; it cannot appear in
+// a label value read from UTF-8 text
+const labelSeparator = "\xff"
+
+// counterVec is a counter per combination of label values
+type counterVec struct {
+	name, help string
+	labels     []string
+
+	mu     sync.Mutex
+	series map[string]uint64
+}
+
+func newCounterVec(name, help string, labels ...string) *counterVec {
+	return &counterVec{name: name, help: help, labels: labels, series: make(map[string]uint64)}
+}
+
+// Inc adds one to the counter of values, one per label in order
+func (c *counterVec) Inc(values ...string) {
+	key := strings.Join(values, labelSeparator)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.series[key]++
+}
+
+// write writes the counters in the text exposition format, values sorted
+func (c *counterVec) write(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", c.name, c.help, c.name)
+	keys := make([]string, 0, len(c.series))
+	for key := range c.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		values := strings.Split(key, labelSeparator)
+		pairs := make([]string, len(c.labels))
+		for i, label := range c.labels {
+			pairs[i] = label + "=" + strconv.Quote(values[i])
+		}
+		fmt.Fprintf(w, "%s{%s} %d\n", c.name, strings.Join(pairs, ","), c.series[key])
+	}
+}
+
+// histogramVec is a latency histogram per method and tenant
+type histogramVec struct {
+	name, help string
+
+	mu     sync.Mutex
+	series map[seriesKey]*histogram
+	// recent are the latencies observed within the retention per method,
+	// across tenants, oldest first
+	recent map[string][]sample
+	// retain is how long latencies are kept for Recent; 0 keeps none
+	retain time.Duration
+}
+
+type seriesKey struct {
+	method, tenant string
+}
+
+type histogram struct {
+	// counts are per bucket, not cumulative
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// sample is a latency and when it was observed
+type sample struct {
+	at      time.Time
+	latency time.Duration
+}
+
+func newHistogramVec(name, help string) *histogramVec {
+	return &histogramVec{name: name, help: help, series: make(map[seriesKey]*histogram), recent: make(map[string][]sample)}
+}
+
+// Observe records a latency of method called by tenant
+func (h *histogramVec) Observe(method, tenant string, latency time.Duration) {
+	seconds := latency.Seconds()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	key := seriesKey{method: method, tenant: tenant}
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogram{counts: make([]uint64, len(buckets))}
+		h.series[key] = s
+	}
+	if i := sort.SearchFloat64s(buckets, seconds); i < len(buckets) {
+		s.counts[i]++
+	}
+	s.sum += seconds
+	s.count++
+
+	if h.retain > 0 {
+		now := time.Now()
+		recent := append(h.recent[method], sample{at: now, latency: latency})
+		expired := sort.Search(len(recent), func(i int) bool { return now.Sub(recent[i].at) <= h.retain })
+		expired = max(expired, len(recent)-maxRecentSamples)
+		h.recent[method] = recent[expired:]
+	}
+}
+
+// Retain keeps the latencies observed for at least d, so that Recent can
+// return them. The longest retention asked for applies.
+func (h *histogramVec) Retain(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.retain = max(h.retain, d)
+}
+
+// Recent returns the latencies of method observed since t for every tenant,
+// oldest first, as far back as they are retained and up to the latest 100000
+func (h *histogramVec) Recent(method string, t time.Time) []time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	recent, ok := h.recent[method]
+	if !ok {
+		return nil
+	}
+	first := sort.Search(len(recent), func(i int) bool { return !recent[i].at.Before(t) })
+	latencies := make([]time.Duration, 0, len(recent)-first)
+	for _, sample := range recent[first:] {
+		latencies = append(latencies, sample.latency)
+	}
+	return latencies
+}
+
+// write writes the histograms in the text exposition format, sorted by method
+// then tenant
+func (h *histogramVec) write(w io.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", h.name, h.help, h.name)
+	keys := make([]seriesKey, 0, len(h.series))
+	for key := range h.series {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].tenant < keys[j].tenant
+	})
+	for _, key := range keys {
+		s := h.series[key]
+		labels := "method=" + strconv.Quote(key.method) + ",tenant=" + strconv.Quote(key.tenant)
+		var cumulative uint64
+		for i, bound := range buckets {
+			cumulative += s.counts[i]
+			fmt.Fprintf(w, "%s_bucket{%s,le=\"%s\"} %d\n", h.name, labels, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(w, "%s_bucket{%s,le=\"+Inf\"} %d\n", h.name, labels, s.count)
+		fmt.Fprintf(w, "%s_sum{%s} %s\n", h.name, labels, strconv.FormatFloat(s.sum, 'g', -1, 64))
+		fmt.Fprintf(w, "%s_count{%s} %d\n", h.name, labels, s.count)
+	}
+}
+
+// Handler serves every metric in the Prometheus text exposition format
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		var b strings.Builder
+		UpstreamLatency.write(&b)
+		HandlerLatency.write(&b)
+		AccessRejected.write(&b)
+		io.WriteString(w, b.String())
+	})
+}
+
+// UnaryInterceptor records the latency of every unary call by its caller's
+// tenant. Streams are left out, as their duration is how long the client kept
+// them open.
+func UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	HandlerLatency.Observe(info.FullMethod, Tenant(ctx), time.Since(start))
+	return resp, err
+}
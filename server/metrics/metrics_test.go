@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func withAPIKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyHeader, key))
+}
+
+// TestTenantCapped expects the first maxTenants configured keys in sorted
+// order to get a tenant of their own, and every other key to fold into
+// "other"
+func TestTenantCapped(t *testing.T) {
+	keys := make([]string, maxTenants+10)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key-%03d", i)
+	}
+	SetTenants(keys)
+	defer SetTenants(nil)
+
+	labels := make(map[string]bool)
+	for _, key := range keys {
+		labels[Tenant(withAPIKey(key))] = true
+	}
+	if len(labels) != maxTenants+1 || !labels[TenantOther] {
+		t.Errorf("got %d tenants, want %d plus %q", len(labels), maxTenants, TenantOther)
+	}
+	if tenant := Tenant(withAPIKey(keys[0])); tenant != fingerprint(keys[0]) {
+		t.Errorf("tenant of the first key is %q, want its fingerprint", tenant)
+	}
+	if tenant := Tenant(withAPIKey(keys[maxTenants])); tenant != TenantOther {
+		t.Errorf("tenant of a key past the cap is %q, want %q", tenant, TenantOther)
+	}
+	if tenant := Tenant(withAPIKey("unknown")); tenant != TenantOther {
+		t.Errorf("tenant of an unknown key is %q, want %q", tenant, TenantOther)
+	}
+	if tenant := Tenant(context.Background()); tenant != TenantNone {
+		t.Errorf("tenant without a key is %q, want %q", tenant, TenantNone)
+	}
+}
+
+// TestHistogramByTenant expects a series per method and tenant, with Recent
+// still returning the latencies of every tenant of a method
+func TestHistogramByTenant(t *testing.T) {
+	h := newHistogramVec("test_duration_seconds", "Test.")
+	h.Retain(time.Minute)
+	start := time.Now()
+	h.Observe("getSlot", "sha256:0011223344556677", 10*time.Millisecond)
+	h.Observe("getSlot", TenantOther, 20*time.Millisecond)
+	h.Observe("getSlot", TenantOther, 30*time.Millisecond)
+
+	var b strings.Builder
+	h.write(&b)
+	for _, line := range []string{
+		`test_duration_seconds_count{method="getSlot",tenant="other"} 2`,
+		`test_duration_seconds_count{method="getSlot",tenant="sha256:0011223344556677"} 1`,
+		`test_duration_seconds_bucket{method="getSlot",tenant="other",le="+Inf"} 2`,
+	} {
+		if !strings.Contains(b.String(), line+"\n") {
+			t.Errorf("output lacks %s:\n%s", line, b.String())
+		}
+	}
+
+	if recent := h.Recent("getSlot", start); len(recent) != 3 {
+		t.Errorf("Recent returned %d latencies, want 3", len(recent))
+	}
+}
+
+// TestCounterLabels expects a counter line per combination of label values
+func TestCounterLabels(t *testing.T) {
+	c := newCounterVec("test_total", "Test.", "reason", "tenant")
+	c.Inc("not allowed", TenantNone)
+	c.Inc("not allowed", TenantNone)
+	c.Inc("not allowed", TenantOther)
+
+	var b strings.Builder
+	c.write(&b)
+	want := "# HELP test_total Test.\n# TYPE test_total counter\n" +
+		`test_total{reason="not allowed",tenant="none"} 2` + "\n" +
+		`test_total{reason="not allowed",tenant="other"} 1` + "\n"
+	if b.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", b.String(), want)
+	}
+}
@@ -0,0 +1,401 @@
+package mock
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// Account is the state of an account held by the backend
+type Account struct {
+	Lamports   uint64
+	Owner      solana.PublicKey
+	Data       []byte
+	Executable bool
+	RentEpoch  uint64
+}
+
+// Transaction is a confirmed transaction held by the backend
+type Transaction struct {
+	Slot        uint64
+	BlockTime   *int64
+	Transaction *solana.Transaction
+	Fee         uint64
+	// Err is the transaction error as returned by the RPC; nil means success
+	Err interface{}
+}
+
+// Block is a produced block held by the backend
+type Block struct {
+	Slot              uint64
+	ParentSlot        uint64
+	Blockhash         solana.Hash
+	PreviousBlockhash solana.Hash
+	BlockTime         *int64
+	BlockHeight       *uint64
+	Transactions      []*Transaction
+}
+
+// Backend is an in-memory Solana JSON-RPC backend. It implements
+// rpc.JSONRPCClient so that an rpc.Client can be pointed at it, and answers the
+// RPC methods the server uses from the accounts, transactions and blocks it
+// holds. State is the same at every commitment level.
+type Backend struct {
+	mu           sync.RWMutex
+	slot         uint64
+	accounts     map[solana.PublicKey]*Account
+	transactions map[solana.Signature]*Transaction
+	blocks       map[uint64]*Block
+}
+
+// NewBackend creates an empty backend
+func NewBackend() *Backend {
+	return &Backend{
+		accounts:     make(map[solana.PublicKey]*Account),
+		transactions: make(map[solana.Signature]*Transaction),
+		blocks:       make(map[uint64]*Block),
+	}
+}
+
+// SetSlot sets the current slot
+func (b *Backend) SetSlot(slot uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.slot = slot
+}
+
+// Slot returns the current slot
+func (b *Backend) Slot() uint64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.slot
+}
+
+// SetAccount stores the state of an account
+func (b *Backend) SetAccount(pubkey solana.PublicKey, account *Account) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.accounts[pubkey] = account
+}
+
+// DeleteAccount removes an account
+func (b *Backend) DeleteAccount(pubkey solana.PublicKey) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.accounts, pubkey)
+}
+
+// AddTransaction stores a transaction under its first signature
+func (b *Backend) AddTransaction(tx *Transaction) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.addTransaction(tx)
+}
+
+// AddBlock stores a block and its transactions, advancing the current slot to
+// the block's slot if it is newer
+func (b *Backend) AddBlock(block *Block) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.blocks[block.Slot] = block
+	for _, tx := range block.Transactions {
+		b.addTransaction(tx)
+	}
+	if block.Slot > b.slot {
+		b.slot = block.Slot
+	}
+}
+
+func (b *Backend) addTransaction(tx *Transaction) {
+	if tx.Transaction != nil && len(tx.Transaction.Signatures) > 0 {
+		b.transactions[tx.Transaction.Signatures[0]] = tx
+	}
+}
+
+// CallForInto answers a JSON-RPC call by encoding the result as the RPC would
+// and decoding it into out
+func (b *Backend) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	// Round-trip the params through JSON so they can be decoded uniformly
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	var args []json.RawMessage
+	if err := json.Unmarshal(encoded, &args); err != nil {
+		return err
+	}
+
+	b.mu.RLock()
+	result, err := b.call(method, args)
+	b.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// CallWithCallback is not supported by the backend
+func (b *Backend) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	return fmt.Errorf("mock backend does not support raw calls to %s", method)
+}
+
+// CallBatch is not supported by the backend
+func (b *Backend) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	return nil, fmt.Errorf("mock backend does not support batch calls")
+}
+
+// callOpts holds the config object options the backend understands
+type callOpts struct {
+	DataSlice          *rpc.DataSlice  `json:"dataSlice"`
+	Filters            []rpc.RPCFilter `json:"filters"`
+	TransactionDetails string          `json:"transactionDetails"`
+}
+
+func (b *Backend) call(method string, args []json.RawMessage) (interface{}, error) {
+	switch method {
+	case "getSlot", "getBlockHeight":
+		return b.slot, nil
+	case "getVersion":
+		return map[string]interface{}{"solana-core": "mock", "feature-set": 0}, nil
+	case "getAccountInfo":
+		var pubkey solana.PublicKey
+		var opts callOpts
+		if err := decodeArgs(args, &pubkey, &opts); err != nil {
+			return nil, err
+		}
+		return b.withContext(b.accountResult(pubkey, opts.DataSlice)), nil
+	case "getMultipleAccounts":
+		var pubkeys []solana.PublicKey
+		var opts callOpts
+		if err := decodeArgs(args, &pubkeys, &opts); err != nil {
+			return nil, err
+		}
+		accounts := make([]interface{}, 0, len(pubkeys))
+		for _, pubkey := range pubkeys {
+			accounts = append(accounts, b.accountResult(pubkey, opts.DataSlice))
+		}
+		return b.withContext(accounts), nil
+	case "getProgramAccounts":
+		var program solana.PublicKey
+		var opts callOpts
+		if err := decodeArgs(args, &program, &opts); err != nil {
+			return nil, err
+		}
+		return b.programAccounts(program, opts), nil
+	case "getTransaction":
+		var signature solana.Signature
+		if err := decodeArgs(args, &signature); err != nil {
+			return nil, err
+		}
+		tx, ok := b.transactions[signature]
+		if !ok {
+			return nil, nil
+		}
+		return transactionResult(tx, true)
+	case "getBlock":
+		var slot uint64
+		var opts callOpts
+		if err := decodeArgs(args, &slot, &opts); err != nil {
+			return nil, err
+		}
+		block, ok := b.blocks[slot]
+		if !ok {
+			return nil, &jsonrpc.RPCError{Code: -32007, Message: fmt.Sprintf("Slot %d was skipped, or missing due to ledger jump to recent snapshot", slot)}
+		}
+		return blockResult(block, opts.TransactionDetails)
+	case "getBlocks":
+		return b.blocksInRange(args)
+	default:
+		return nil, &jsonrpc.RPCError{Code: -32601, Message: fmt.Sprintf("Method not found: %s is not supported by the mock backend", method)}
+	}
+}
+
+// withContext wraps a value in the RPC response context
+func (b *Backend) withContext(value interface{}) interface{} {
+	return map[string]interface{}{
+		"context": map[string]interface{}{"slot": b.slot},
+		"value":   value,
+	}
+}
+
+// accountResult encodes an account, or nil if it does not exist
+func (b *Backend) accountResult(pubkey solana.PublicKey, slice *rpc.DataSlice) interface{} {
+	account, ok := b.accounts[pubkey]
+	if !ok {
+		return nil
+	}
+	return encodeAccount(account, slice)
+}
+
+func (b *Backend) programAccounts(program solana.PublicKey, opts callOpts) []interface{} {
+	// Sort for a stable result order
+	pubkeys := make([]solana.PublicKey, 0, len(b.accounts))
+	for pubkey, account := range b.accounts {
+		if account.Owner.Equals(program) && matchesFilters(account.Data, opts.Filters) {
+			pubkeys = append(pubkeys, pubkey)
+		}
+	}
+	sort.Slice(pubkeys, func(i, j int) bool {
+		return bytes.Compare(pubkeys[i][:], pubkeys[j][:]) < 0
+	})
+
+	result := make([]interface{}, 0, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		result = append(result, map[string]interface{}{
+			"pubkey":  pubkey,
+			"account": encodeAccount(b.accounts[pubkey], opts.DataSlice),
+		})
+	}
+	return result
+}
+
+// blocksInRange answers getBlocks, whose end slot is optional
+func (b *Backend) blocksInRange(args []json.RawMessage) (interface{}, error) {
+	var start uint64
+	if err := decodeArgs(args, &start); err != nil {
+		return nil, err
+	}
+	end := b.slot
+	if len(args) > 1 {
+		// The second param is either the end slot or the config object
+		var explicit uint64
+		if err := json.Unmarshal(args[1], &explicit); err == nil {
+			end = explicit
+		}
+	}
+
+	slots := []uint64{}
+	for slot := range b.blocks {
+		if slot >= start && slot <= end {
+			slots = append(slots, slot)
+		}
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+	return slots, nil
+}
+
+// decodeArgs decodes positional params, leaving missing trailing params zero
+func decodeArgs(args []json.RawMessage, targets ...interface{}) error {
+	for i, target := range targets {
+		if i >= len(args) {
+			break
+		}
+		if err := json.Unmarshal(args[i], target); err != nil {
+			return &jsonrpc.RPCError{Code: -32602, Message: fmt.Sprintf("Invalid params: %v", err)}
+		}
+	}
+	return nil
+}
+
+func encodeAccount(account *Account, slice *rpc.DataSlice) map[string]interface{} {
+	data := account.Data
+	if slice != nil && slice.Offset != nil && slice.Length != nil {
+		start := *slice.Offset
+		if start > uint64(len(data)) {
+			start = uint64(len(data))
+		}
+		end := start + *slice.Length
+		if end > uint64(len(data)) {
+			end = uint64(len(data))
+		}
+		data = data[start:end]
+	}
+
+	return map[string]interface{}{
+		"lamports":   account.Lamports,
+		"owner":      account.Owner,
+		"data":       []string{base64.StdEncoding.EncodeToString(data), "base64"},
+		"executable": account.Executable,
+		"rentEpoch":  account.RentEpoch,
+	}
+}
+
+func matchesFilters(data []byte, filters []rpc.RPCFilter) bool {
+	for _, filter := range filters {
+		if filter.DataSize != 0 && uint64(len(data)) != filter.DataSize {
+			return false
+		}
+		if m := filter.Memcmp; m != nil {
+			end := m.Offset + uint64(len(m.Bytes))
+			if end > uint64(len(data)) || !bytes.Equal(data[m.Offset:end], m.Bytes) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// transactionResult encodes a transaction as getTransaction does. Block
+// transactions omit the slot and block time, which the block carries.
+func transactionResult(tx *Transaction, standalone bool) (map[string]interface{}, error) {
+	raw, err := tx.Transaction.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction: %v", err)
+	}
+
+	result := map[string]interface{}{
+		"transaction": []string{base64.StdEncoding.EncodeToString(raw), "base64"},
+		"meta": map[string]interface{}{
+			"err":          tx.Err,
+			"fee":          tx.Fee,
+			"preBalances":  []uint64{},
+			"postBalances": []uint64{},
+			"logMessages":  []string{},
+		},
+		"version": "legacy",
+	}
+	if standalone {
+		result["slot"] = tx.Slot
+		result["blockTime"] = tx.BlockTime
+	}
+	return result, nil
+}
+
+// blockResult encodes a block with the requested level of transaction detail
+func blockResult(block *Block, details string) (map[string]interface{}, error) {
+	result := map[string]interface{}{
+		"blockhash":         block.Blockhash,
+		"previousBlockhash": block.PreviousBlockhash,
+		"parentSlot":        block.ParentSlot,
+		"blockTime":         block.BlockTime,
+		"blockHeight":       block.BlockHeight,
+	}
+
+	switch rpc.TransactionDetailsType(details) {
+	case rpc.TransactionDetailsNone:
+	case rpc.TransactionDetailsSignatures:
+		signatures := make([]solana.Signature, 0, len(block.Transactions))
+		for _, tx := range block.Transactions {
+			if tx.Transaction != nil && len(tx.Transaction.Signatures) > 0 {
+				signatures = append(signatures, tx.Transaction.Signatures[0])
+			}
+		}
+		result["signatures"] = signatures
+	default:
+		transactions := make([]interface{}, 0, len(block.Transactions))
+		for _, tx := range block.Transactions {
+			encoded, err := transactionResult(tx, false)
+			if err != nil {
+				return nil, err
+			}
+			transactions = append(transactions, encoded)
+		}
+		result["transactions"] = transactions
+	}
+	return result, nil
+}
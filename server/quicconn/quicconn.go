@@ -0,0 +1,181 @@
+// Package quicconn carries gRPC over QUIC, experimentally. Each QUIC
+// connection carries one bidirectional stream, which stands in for the TCP
+// connection beneath gRPC's HTTP/2 transport. Connections get QUIC's
+// handshake, which sets up TLS 1.3 with the transport in one round trip, and
+// its loss recovery and congestion control, while calls are still multiplexed
+// by HTTP/2 over the one stream, so loss on it delays every call in flight.
+package quicconn
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"google.golang.org/grpc/credentials"
+)
+
+// ALPN is the application protocol QUIC connections negotiate
+const ALPN = "grpc-exp"
+
+// streamTimeout bounds how long an accepted connection may take to open its
+// stream
+const streamTimeout = 10 * time.Second
+
+// quicConfig is the transport configuration of both ends. Keep-alives hold
+// idle gRPC channels open, as TCP connections would stay open.
+var quicConfig = &quic.Config{
+	MaxIdleTimeout:  time.Minute,
+	KeepAlivePeriod: 20 * time.Second,
+}
+
+// Listener accepts QUIC connections, returning the stream of each as a
+// net.Conn for a gRPC server to serve
+type Listener struct {
+	listener *quic.Listener
+	conns    chan net.Conn
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+// Listen listens for QUIC connections on a UDP address. tlsConfig is the
+// server's; ALPN is added to a copy of it.
+func Listen(addr string, tlsConfig *tls.Config) (*Listener, error) {
+	tlsConfig = tlsConfig.Clone()
+	tlsConfig.NextProtos = []string{ALPN}
+	listener, err := quic.ListenAddr(addr, tlsConfig, quicConfig)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &Listener{listener: listener, conns: make(chan net.Conn), ctx: ctx, cancel: cancel}
+	go l.run()
+	return l, nil
+}
+
+// run accepts connections, waiting for the stream of each apart, so a client
+// that never opens one holds up no other
+func (l *Listener) run() {
+	for {
+		conn, err := l.listener.Accept(l.ctx)
+		if err != nil {
+			return
+		}
+		go func() {
+			ctx, cancel := context.WithTimeout(l.ctx, streamTimeout)
+			defer cancel()
+			stream, err := conn.AcceptStream(ctx)
+			if err != nil {
+				conn.CloseWithError(0, "no stream opened")
+				return
+			}
+			select {
+			case l.conns <- &Conn{Stream: stream, conn: conn}:
+			case <-l.ctx.Done():
+				conn.CloseWithError(0, "server closed")
+			}
+		}()
+	}
+}
+
+func (l *Listener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.ctx.Done():
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *Listener) Close() error {
+	l.cancel()
+	return l.listener.Close()
+}
+
+func (l *Listener) Addr() net.Addr {
+	return l.listener.Addr()
+}
+
+// Dial connects to a QUIC listener and opens the stream gRPC runs over.
+// tlsConfig is the client's; ALPN is added to a copy of it.
+func Dial(ctx context.Context, addr string, tlsConfig *tls.Config) (*Conn, error) {
+	tlsConfig = tlsConfig.Clone()
+	tlsConfig.NextProtos = []string{ALPN}
+	conn, err := quic.DialAddr(ctx, addr, tlsConfig, quicConfig)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		conn.CloseWithError(0, "")
+		return nil, err
+	}
+	return &Conn{Stream: stream, conn: conn}, nil
+}
+
+// Conn is the stream of a QUIC connection as a net.Conn. Closing it closes
+// the connection.
+type Conn struct {
+	quic.Stream
+	conn quic.Connection
+}
+
+func (c *Conn) Close() error {
+	c.Stream.CancelRead(0)
+	err := c.Stream.Close()
+	return errors.Join(err, c.conn.CloseWithError(0, ""))
+}
+
+func (c *Conn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// ConnectionState returns the TLS state of the connection's handshake
+func (c *Conn) ConnectionState() tls.ConnectionState {
+	return c.conn.ConnectionState().TLS
+}
+
+// authInfo reports the connection's TLS state as gRPC's TLS credentials
+// would
+func (c *Conn) authInfo() credentials.AuthInfo {
+	return credentials.TLSInfo{
+		State:          c.ConnectionState(),
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+	}
+}
+
+// Credentials wraps the transport credentials of a gRPC server or client so
+// it can also serve or dial QUIC connections. Those are passed through,
+// since QUIC has already done their TLS handshake; TCP connections go
+// through creds.
+func Credentials(creds credentials.TransportCredentials) credentials.TransportCredentials {
+	return &quicCredentials{TransportCredentials: creds}
+}
+
+type quicCredentials struct {
+	credentials.TransportCredentials
+}
+
+func (q *quicCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if c, ok := conn.(*Conn); ok {
+		return c, c.authInfo(), nil
+	}
+	return q.TransportCredentials.ClientHandshake(ctx, authority, conn)
+}
+
+func (q *quicCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if c, ok := conn.(*Conn); ok {
+		return c, c.authInfo(), nil
+	}
+	return q.TransportCredentials.ServerHandshake(conn)
+}
+
+func (q *quicCredentials) Clone() credentials.TransportCredentials {
+	return &quicCredentials{TransportCredentials: q.TransportCredentials.Clone()}
+}
@@ -0,0 +1,93 @@
+// Package schema exports the compiled descriptors of the served protobuf
+// APIs, so consumers outside Go and schema registries can use the exact
+// message schemas without going through gRPC reflection.
+package schema
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/proto/geyser"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Path is where Handler is mounted by the server
+const Path = "/descriptors"
+
+// Descriptors returns the descriptors of the served API files and everything
+// they import, each file after its dependencies as protoc's
+// --include_imports output orders them
+func Descriptors() *descriptorpb.FileDescriptorSet {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := make(map[string]bool)
+	var add func(file protoreflect.FileDescriptor)
+	add = func(file protoreflect.FileDescriptor) {
+		if seen[file.Path()] {
+			return
+		}
+		seen[file.Path()] = true
+		imports := file.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(file))
+	}
+	add(proto.File_proto_solana_benchmark_proto)
+	add(geyser.File_proto_geyser_geyser_proto)
+	return set
+}
+
+// Write stores the descriptor set in binary form at path, readable by buf,
+// protoc's --descriptor_set_in and schema registries
+func Write(path string) error {
+	data, err := protobuf.Marshal(Descriptors())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Handler serves the descriptor set, in binary form unless the request asks
+// for ?format=json
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var (
+			data        []byte
+			err         error
+			contentType string
+		)
+		switch r.URL.Query().Get("format") {
+		case "", "binary":
+			data, err = protobuf.Marshal(Descriptors())
+			contentType = "application/x-protobuf"
+		case "json":
+			data, err = protojson.MarshalOptions{Multiline: true}.Marshal(Descriptors())
+			contentType = "application/json"
+		default:
+			http.Error(w, "format must be binary or json", http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	})
+}
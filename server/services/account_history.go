@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/history"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxHistoryStates caps the number of states returned by one history request
+const maxHistoryStates = 1000
+
+// UseAccountHistory serves GetAccountHistory from the states a recorder has
+// stored. Without it, account history is unavailable.
+func (s *BenchmarkService) UseAccountHistory(recorder *history.Recorder) {
+	s.history = recorder
+}
+
+// GetAccountHistory returns the recorded states of a watched account over a slot range
+func (s *BenchmarkService) GetAccountHistory(ctx context.Context, req *proto.AccountHistoryRequest) (*proto.AccountHistoryResponse, error) {
+	if s.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "account history is not enabled on this server")
+	}
+
+	pubkey, err := solana.PublicKeyFromBase58(req.Pubkey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pubkey: %v", err)
+	}
+	if req.EndSlot != 0 && req.EndSlot < req.StartSlot {
+		return nil, status.Error(codes.InvalidArgument, "end slot must not be before start slot")
+	}
+	if !s.history.Watches(pubkey) {
+		return nil, status.Errorf(codes.NotFound, "account %s is not recorded", req.Pubkey)
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 || limit > maxHistoryStates {
+		limit = maxHistoryStates
+	}
+
+	records, truncated := s.history.Store().Range(pubkey, req.StartSlot, req.EndSlot, limit)
+	states := make([]*proto.AccountUpdate, 0, len(records))
+	for _, record := range records {
+		states = append(states, &proto.AccountUpdate{
+			Pubkey:       record.Pubkey.String(),
+			Data:         record.Data,
+			Owner:        record.Owner.String(),
+			Lamports:     record.Lamports,
+			Slot:         record.Slot,
+			Timestamp:    uint64(record.Timestamp),
+			WriteVersion: record.Version,
+		})
+	}
+
+	return &proto.AccountHistoryResponse{States: states, Truncated: truncated}, nil
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxTrackedAccounts caps the number of accounts a single stream may watch
+	maxTrackedAccounts = 10000
+	// ownerRefreshInterval is how often owner programs are re-resolved so that
+	// newly created accounts join the stream
+	ownerRefreshInterval = 30 * time.Second
+	// maxMultipleAccounts is the getMultipleAccounts per-call limit
+	maxMultipleAccounts = 100
+)
+
+// accountSet is the set of accounts watched by an account stream: explicit
+// pubkeys plus every account owned by the requested owner programs
+type accountSet struct {
+	pubkeys    []solana.PublicKey
+	owners     []solana.PublicKey
+	resolved   []solana.PublicKey
+	resolvedAt time.Time
+}
+
+// newAccountSet validates the account selection of a stream request
+func newAccountSet(req *proto.AccountStreamRequest) (*accountSet, error) {
+	pubkeys, err := parsePubkeys(req.Pubkeys)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make([]solana.PublicKey, 0, len(req.Owners))
+	for _, ownerStr := range req.Owners {
+		owner, err := solana.PublicKeyFromBase58(ownerStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid owner: %v", err)
+		}
+		owners = append(owners, owner)
+	}
+
+	if len(pubkeys) == 0 && len(owners) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one pubkey or owner is required")
+	}
+	if len(pubkeys) > maxTrackedAccounts {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d pubkeys may be streamed", maxTrackedAccounts)
+	}
+
+	return &accountSet{pubkeys: pubkeys, owners: owners}, nil
+}
+
+// resolveAccounts returns the current account set, re-resolving owner programs
+// when the previous resolution is older than ownerRefreshInterval
+func (s *BenchmarkService) resolveAccounts(ctx context.Context, set *accountSet, commitment rpc.CommitmentType) ([]solana.PublicKey, error) {
+	if len(set.owners) == 0 {
+		return set.pubkeys, nil
+	}
+	if set.resolved != nil && time.Since(set.resolvedAt) < ownerRefreshInterval {
+		return set.resolved, nil
+	}
+
+	seen := make(map[solana.PublicKey]bool, len(set.pubkeys))
+	resolved := make([]solana.PublicKey, 0, len(set.pubkeys))
+	for _, pubkey := range set.pubkeys {
+		if !seen[pubkey] {
+			seen[pubkey] = true
+			resolved = append(resolved, pubkey)
+		}
+	}
+
+	// Only the account addresses are needed, so skip the account data
+	zero := uint64(0)
+	for _, owner := range set.owners {
+		owned, err := s.solanaClient.GetProgramAccountsWithOpts(ctx, owner, &rpc.GetProgramAccountsOpts{
+			Commitment: commitment,
+			DataSlice:  &rpc.DataSlice{Offset: &zero, Length: &zero},
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to resolve accounts owned by %s: %v", owner, err)
+		}
+		for _, account := range owned {
+			if !seen[account.Pubkey] {
+				seen[account.Pubkey] = true
+				resolved = append(resolved, account.Pubkey)
+			}
+		}
+	}
+
+	if len(resolved) > maxTrackedAccounts {
+		return nil, status.Errorf(codes.ResourceExhausted, "owner filter matches %d accounts, more than the limit of %d", len(resolved), maxTrackedAccounts)
+	}
+
+	set.resolved = resolved
+	set.resolvedAt = time.Now()
+	return resolved, nil
+}
+
+// readAccounts reads the current state of the given accounts in batches of
+// getMultipleAccounts calls and stamps each update with its write version in
+// the stream's scope.
+// Accounts that do not exist or whose state is older than one already
+// delivered are omitted.
+func (s *BenchmarkService) readAccounts(ctx context.Context, versions *writeVersionScope, pubkeys []solana.PublicKey, commitment rpc.CommitmentType, snapshot bool) ([]*proto.AccountUpdate, error) {
+	ctx, timing := startTiming(ctx)
+	updates := make([]*proto.AccountUpdate, 0, len(pubkeys))
+
+	for start := 0; start < len(pubkeys); start += maxMultipleAccounts {
+		end := start + maxMultipleAccounts
+		if end > len(pubkeys) {
+			end = len(pubkeys)
+		}
+
+		result, err := s.solanaClient.GetMultipleAccountsWithOpts(ctx, pubkeys[start:end], &rpc.GetMultipleAccountsOpts{
+			Commitment: commitment,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get accounts: %v", err)
+		}
+
+		for i, account := range result.Value {
+			if account == nil {
+				continue
+			}
+
+			pubkey := pubkeys[start+i]
+			writeVersion, ok := versions.observe(pubkey, result.Context.Slot)
+			if !ok {
+				continue
+			}
+
+			built := time.Now()
+			update := &proto.AccountUpdate{
+				Pubkey:       pubkey.String(),
+				Data:         account.Data.GetBinary(),
+				Owner:        account.Owner.String(),
+				Lamports:     account.Lamports,
+				Slot:         result.Context.Slot,
+				Timestamp:    uint64(time.Now().Unix()),
+				WriteVersion: writeVersion,
+				Snapshot:     snapshot,
+			}
+			update.Timing = timing.breakdown(built, update)
+			updates = append(updates, update)
+		}
+	}
+
+	return updates, nil
+}
@@ -0,0 +1,266 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc/status"
+)
+
+// maxAccountSubscriptions caps the upstream subscriptions an account stream
+// opens, one per pubkey and owner program. Larger sets are polled instead.
+const maxAccountSubscriptions = 100
+
+// accountChange is an account state pushed by a subscription
+type accountChange struct {
+	pubkey  solana.PublicKey
+	account *rpc.Account
+	slot    uint64
+}
+
+// accountFeed fans the notifications of an account stream's subscriptions,
+// all on one WebSocket connection, into a single channel
+type accountFeed struct {
+	wsClient      *ws.Client
+	unsubscribers []func()
+	changes       chan accountChange
+	// errs receives the failure of any subscription; one is enough to
+	// resubscribe them all
+	errs chan error
+	done chan struct{}
+}
+
+// close unsubscribes before closing the connection, which unblocks the
+// forwarders
+func (f *accountFeed) close() {
+	close(f.done)
+	for _, unsubscribe := range f.unsubscribers {
+		unsubscribe()
+	}
+	f.wsClient.Close()
+}
+
+// forward delivers the notifications received by recv until it fails or the
+// feed is closed
+func (f *accountFeed) forward(recv func() (accountChange, error)) {
+	for {
+		change, err := recv()
+		if err != nil {
+			select {
+			case f.errs <- err:
+			default:
+			}
+			return
+		}
+		select {
+		case f.changes <- change:
+		case <-f.done:
+			return
+		}
+	}
+}
+
+// subscribeAccounts connects to the WebSocket upstream and subscribes to each
+// pubkey of an account set with accountSubscribe and to each owner program
+// with programSubscribe
+func (s *BenchmarkService) subscribeAccounts(ctx context.Context, set *accountSet, commitment rpc.CommitmentType) (*accountFeed, error) {
+	wsClient, err := s.endpoint.ConnectWS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	feed := &accountFeed{
+		wsClient: wsClient,
+		changes:  make(chan accountChange),
+		errs:     make(chan error, 1),
+		done:     make(chan struct{}),
+	}
+
+	var recvs []func() (accountChange, error)
+	for _, pubkey := range set.pubkeys {
+		sub, err := wsClient.AccountSubscribe(pubkey, commitment)
+		if err != nil {
+			feed.close()
+			return nil, err
+		}
+		feed.unsubscribers = append(feed.unsubscribers, sub.Unsubscribe)
+		recvs = append(recvs, func() (accountChange, error) {
+			result, err := sub.Recv()
+			if err == nil && result == nil {
+				err = errors.New("subscription closed")
+			}
+			if err != nil {
+				return accountChange{}, err
+			}
+			return accountChange{pubkey: pubkey, account: &result.Value.Account, slot: result.Context.Slot}, nil
+		})
+	}
+	for _, owner := range set.owners {
+		sub, err := wsClient.ProgramSubscribe(owner, commitment)
+		if err != nil {
+			feed.close()
+			return nil, err
+		}
+		feed.unsubscribers = append(feed.unsubscribers, sub.Unsubscribe)
+		recvs = append(recvs, func() (accountChange, error) {
+			result, err := sub.Recv()
+			if err == nil && result == nil {
+				err = errors.New("subscription closed")
+			}
+			if err != nil {
+				return accountChange{}, err
+			}
+			return accountChange{pubkey: result.Value.Pubkey, account: result.Value.Account, slot: result.Context.Slot}, nil
+		})
+	}
+
+	for _, recv := range recvs {
+		go feed.forward(recv)
+	}
+	return feed, nil
+}
+
+// accountLiveStream is the state of the live phase of an account stream
+type accountLiveStream struct {
+	method     string
+	set        *accountSet
+	commitment rpc.CommitmentType
+	versions   *writeVersionScope
+	// feed is nil when the stream polls every pollInterval instead
+	feed         *accountFeed
+	pollInterval time.Duration
+}
+
+// close closes the stream's subscriptions, if any
+func (l *accountLiveStream) close() {
+	if l.feed != nil {
+		l.feed.close()
+	}
+}
+
+// startAccountStream subscribes to the accounts of a stream, falling back to
+// polling them when there is no WebSocket upstream or subscribing fails. Sets
+// needing more than maxAccountSubscriptions subscriptions, and every set while
+// WebSocket streams are disabled, are always polled, even with the fallback
+// disabled. It is called before any snapshot is read, so no change in between
+// is missed.
+func (s *BenchmarkService) startAccountStream(ctx context.Context, method string, set *accountSet, commitment rpc.CommitmentType, versions *writeVersionScope) (*accountLiveStream, error) {
+	live := &accountLiveStream{method: method, set: set, commitment: commitment, versions: versions}
+	if !s.features.Enabled(features.WebSocketStreams) || len(set.pubkeys)+len(set.owners) > maxAccountSubscriptions {
+		live.pollInterval = s.pollInterval()
+		return live, nil
+	}
+
+	var cause error
+	if s.wsAvailable() {
+		feed, err := s.subscribeAccounts(ctx, set, commitment)
+		if err == nil {
+			live.feed = feed
+			return live, nil
+		}
+		cause = err
+	}
+
+	interval, err := s.wsFallback(method, 0, cause)
+	if err != nil {
+		return nil, err
+	}
+	live.pollInterval = interval
+	return live, nil
+}
+
+// followAccounts sends the changes to a stream's accounts until the client
+// disconnects. When its subscriptions fail, it resubscribes with backoff and
+// rereads the accounts, so changes made while disconnected are not lost.
+func (s *BenchmarkService) followAccounts(ctx context.Context, live *accountLiveStream, send func(*proto.AccountUpdate) error) error {
+	if live.feed == nil {
+		return s.pollAccountStream(ctx, live, send)
+	}
+
+	backoff := upstream.Backoff{Max: maxResubscribeDelay}
+	subscribed := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case change := <-live.feed.changes:
+			writeVersion, ok := live.versions.observe(change.pubkey, change.slot)
+			if !ok {
+				continue
+			}
+			update := &proto.AccountUpdate{
+				Pubkey:       change.pubkey.String(),
+				Data:         change.account.Data.GetBinary(),
+				Owner:        change.account.Owner.String(),
+				Lamports:     change.account.Lamports,
+				Slot:         change.slot,
+				Timestamp:    uint64(time.Now().Unix()),
+				WriteVersion: writeVersion,
+			}
+			if err := send(update); err != nil {
+				return err
+			}
+		case err := <-live.feed.errs:
+			live.feed.close()
+			live.feed = nil
+
+			// Subscriptions that stayed up for a while reset the backoff
+			if time.Since(subscribed) > maxResubscribeDelay {
+				backoff.Reset()
+			}
+			for live.feed == nil {
+				log.Printf("%s account subscriptions failed, resubscribing in %v: %v", live.method, backoff.Delay(), err)
+				if err := backoff.Wait(ctx); err != nil {
+					return status.FromContextError(err).Err()
+				}
+				if live.feed, err = s.subscribeAccounts(ctx, live.set, live.commitment); err != nil {
+					live.feed = nil
+				}
+			}
+			subscribed = time.Now()
+
+			for _, update := range s.pollAccounts(ctx, live.versions, live.set, live.commitment) {
+				if err := send(update); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// pollAccountStream rereads a stream's accounts every poll interval until the
+// client disconnects, sending those whose state changed since the previous
+// read
+func (s *BenchmarkService) pollAccountStream(ctx context.Context, live *accountLiveStream, send func(*proto.AccountUpdate) error) error {
+	ticker := time.NewTicker(live.pollInterval)
+	defer ticker.Stop()
+
+	known := make(map[string]polledAccount)
+	for {
+		for _, update := range s.pollAccounts(ctx, live.versions, live.set, live.commitment) {
+			state := polledAccount{lamports: update.Lamports, data: sha256.Sum256(update.Data)}
+			state.owner, _ = solana.PublicKeyFromBase58(update.Owner)
+			if previous, ok := known[update.Pubkey]; ok && previous == state {
+				continue
+			}
+			known[update.Pubkey] = state
+			if err := send(update); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
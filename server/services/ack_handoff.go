@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// handoffTimeout bounds asking one peer for a session
+	handoffTimeout = 5 * time.Second
+	// maxHandoffSize caps a handed-off session, whose buffered updates may
+	// carry account data up to the session's max_unacked
+	maxHandoffSize = 256 << 20
+)
+
+// clusterPeers are the other replicas of a cluster that acknowledged stream
+// sessions are handed off between
+type clusterPeers struct {
+	addrs   []string
+	clients []proto.BenchmarkServiceClient
+}
+
+// SetClusterPeers makes the server a replica of a cluster with the servers
+// at the given gRPC addresses. A client may resume an acknowledged stream
+// session on any replica: one that does not hold the session takes it over
+// from the peer that does. The list may include the server itself. Peers
+// are dialed with creds.
+func (s *BenchmarkService) SetClusterPeers(addrs []string, creds credentials.TransportCredentials) error {
+	peers := &clusterPeers{}
+	for _, addr := range addrs {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+		conn, err := grpc.Dial(addr,
+			grpc.WithTransportCredentials(creds),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxHandoffSize)),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to connect to cluster peer %s: %v", addr, err)
+		}
+		peers.addrs = append(peers.addrs, addr)
+		peers.clients = append(peers.clients, proto.NewBenchmarkServiceClient(conn))
+	}
+	if len(peers.addrs) > 0 {
+		s.ackSessions.peers = peers
+	}
+	return nil
+}
+
+// handoff takes a session over from the peer holding it. Peers that do not
+// hold it, or cannot be reached, are skipped.
+func (p *clusterPeers) handoff(ctx context.Context, id string) (*proto.AckSessionHandoff, error) {
+	for i, client := range p.clients {
+		peerCtx, cancel := context.WithTimeout(ctx, handoffTimeout)
+		handoff, err := client.HandoffAckSession(peerCtx, &proto.AckSessionHandoffRequest{SessionId: id})
+		cancel()
+		switch status.Code(err) {
+		case codes.OK:
+			log.Printf("Took over session %s from cluster peer %s", id, p.addrs[i])
+			return handoff, nil
+		case codes.NotFound:
+			continue
+		case codes.FailedPrecondition:
+			// The peer has not yet noticed the client leave; it may retry
+			return nil, status.Errorf(codes.FailedPrecondition, "session %s is still attached to another replica", id)
+		}
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		log.Printf("Failed to ask cluster peer %s for session %s: %v", p.addrs[i], id, err)
+	}
+	return nil, status.Errorf(codes.NotFound, "session %s not found or expired on any replica", id)
+}
+
+// HandoffAckSession moves a detached acknowledged stream session to the
+// replica asking for it
+func (s *BenchmarkService) HandoffAckSession(ctx context.Context, req *proto.AckSessionHandoffRequest) (*proto.AckSessionHandoff, error) {
+	if s.ackSessions.peers == nil {
+		return nil, status.Error(codes.Unimplemented, "this server is not part of a cluster")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	return s.ackSessions.release(req.SessionId)
+}
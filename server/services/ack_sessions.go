@@ -0,0 +1,267 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultMaxUnacked is the number of unacknowledged updates buffered per
+	// session when the client does not request a limit
+	defaultMaxUnacked = 1000
+	// maxUnackedLimit caps the per-session buffer a client may request
+	maxUnackedLimit = 10000
+	// ackSessionRetention is how long a detached session is kept for resumption
+	ackSessionRetention = 30 * time.Second
+)
+
+// ackSession holds the delivery state of an acknowledged account stream.
+// Updates stay buffered until the client acknowledges their cursor, so they
+// can be redelivered when the client reconnects to the same session.
+type ackSession struct {
+	id         string
+	accounts   *accountSet
+	commitment rpc.CommitmentType
+	class      streamClass
+	limit      int
+	// subscription is the request that started the session, kept to hand
+	// the session off to another replica
+	subscription *proto.AccountStreamRequest
+
+	mu         sync.Mutex
+	pending    []*proto.AckedAccountUpdate
+	nextCursor uint64
+	attached   bool
+	detachedAt time.Time
+	wake       chan struct{}
+}
+
+// push assigns the next cursor to an update and buffers it until acknowledged.
+// It blocks while the buffer is full, applying backpressure to the producer.
+func (s *ackSession) push(ctx context.Context, update *proto.AccountUpdate) (*proto.AckedAccountUpdate, error) {
+	for {
+		s.mu.Lock()
+		if len(s.pending) < s.limit {
+			s.nextCursor++
+			acked := &proto.AckedAccountUpdate{
+				SessionId: s.id,
+				Cursor:    s.nextCursor,
+				Update:    update,
+			}
+			s.pending = append(s.pending, acked)
+			s.mu.Unlock()
+			return acked, nil
+		}
+		wake := s.wake
+		s.mu.Unlock()
+
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// ack releases every buffered update up to and including cursor
+func (s *ackSession) ack(cursor uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := 0
+	for i < len(s.pending) && s.pending[i].Cursor <= cursor {
+		i++
+	}
+	if i == 0 {
+		return
+	}
+	s.pending = s.pending[i:]
+	close(s.wake)
+	s.wake = make(chan struct{})
+}
+
+// unacked returns a copy of the updates awaiting acknowledgement
+func (s *ackSession) unacked() []*proto.AckedAccountUpdate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*proto.AckedAccountUpdate(nil), s.pending...)
+}
+
+// ackSessionStore tracks acknowledged stream sessions across client connections
+type ackSessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]*ackSession
+	// peers are the other replicas of the cluster, asked for sessions this
+	// replica does not hold; nil outside a cluster
+	peers *clusterPeers
+}
+
+func newAckSessionStore() *ackSessionStore {
+	return &ackSessionStore{
+		sessions: make(map[string]*ackSession),
+	}
+}
+
+// attach starts a new session or resumes an existing one from the first
+// message of an acknowledged stream
+func (st *ackSessionStore) attach(ctx context.Context, req *proto.AccountAckStreamRequest) (*ackSession, error) {
+	if req.SessionId != "" {
+		return st.resume(ctx, req.SessionId)
+	}
+
+	if req.Subscription == nil {
+		return nil, status.Error(codes.InvalidArgument, "first message must contain a subscription or session_id")
+	}
+	id, err := newID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create session: %v", err)
+	}
+	session, err := newAckSession(id, req.Subscription, req.MaxUnacked)
+	if err != nil {
+		return nil, err
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.expire(time.Now())
+	st.sessions[id] = session
+	return session, nil
+}
+
+// newAckSession creates an attached session for a subscription
+func newAckSession(id string, subscription *proto.AccountStreamRequest, maxUnacked uint32) (*ackSession, error) {
+	accounts, err := newAccountSet(subscription)
+	if err != nil {
+		return nil, err
+	}
+	commitment, err := parseCommitment(subscription.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	class, err := parseStreamQoS(subscription.Qos)
+	if err != nil {
+		return nil, err
+	}
+	if err := rejectBatching(subscription); err != nil {
+		return nil, err
+	}
+
+	limit := defaultMaxUnacked
+	if maxUnacked > 0 {
+		limit = int(maxUnacked)
+	}
+	if limit > maxUnackedLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "max_unacked must not exceed %d", maxUnackedLimit)
+	}
+
+	return &ackSession{
+		id:           id,
+		subscription: subscription,
+		accounts:     accounts,
+		commitment:   commitment,
+		class:        class,
+		limit:        limit,
+		attached:     true,
+		wake:         make(chan struct{}),
+	}, nil
+}
+
+// resume reattaches a detached session. A session this replica does not hold
+// is taken over from the cluster peer holding it, if any.
+func (st *ackSessionStore) resume(ctx context.Context, id string) (*ackSession, error) {
+	st.mu.Lock()
+	st.expire(time.Now())
+	session, ok := st.sessions[id]
+	if ok {
+		defer st.mu.Unlock()
+		session.mu.Lock()
+		defer session.mu.Unlock()
+		if session.attached {
+			return nil, status.Errorf(codes.FailedPrecondition, "session %s is already attached", id)
+		}
+		session.attached = true
+		return session, nil
+	}
+	st.mu.Unlock()
+
+	if st.peers == nil {
+		return nil, status.Errorf(codes.NotFound, "session %s not found or expired", id)
+	}
+	handoff, err := st.peers.handoff(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	session, err = newAckSession(id, handoff.Subscription, handoff.MaxUnacked)
+	if err != nil {
+		return nil, err
+	}
+	session.nextCursor = handoff.LastCursor
+	session.pending = handoff.Pending
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.sessions[id] = session
+	return session, nil
+}
+
+// release removes a detached session to hand it off to another replica
+func (st *ackSessionStore) release(id string) (*proto.AckSessionHandoff, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.expire(time.Now())
+	session, ok := st.sessions[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "session %s not found or expired", id)
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.attached {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %s is still attached", id)
+	}
+	delete(st.sessions, id)
+	return &proto.AckSessionHandoff{
+		SessionId:    id,
+		Subscription: session.subscription,
+		MaxUnacked:   uint32(session.limit),
+		LastCursor:   session.nextCursor,
+		Pending:      session.pending,
+	}, nil
+}
+
+// detach marks a session as disconnected, keeping it for resumption
+func (st *ackSessionStore) detach(session *ackSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.attached = false
+	session.detachedAt = time.Now()
+}
+
+// expire drops sessions that have been detached longer than the retention period
+func (st *ackSessionStore) expire(now time.Time) {
+	for id, session := range st.sessions {
+		session.mu.Lock()
+		expired := !session.attached && now.Sub(session.detachedAt) > ackSessionRetention
+		session.mu.Unlock()
+		if expired {
+			delete(st.sessions, id)
+		}
+	}
+}
+
+// newID returns a random identifier for sessions and jobs
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
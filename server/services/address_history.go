@@ -0,0 +1,242 @@
+package services
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultAddressHistory and maxAddressHistory bound the transactions of
+	// an address history stream
+	defaultAddressHistory = 1000
+	maxAddressHistory     = 10000
+	// signaturesPageSize is the most signatures getSignaturesForAddress returns
+	signaturesPageSize = 1000
+	// addressPollInterval is how often a live address history stream looks
+	// for new transactions
+	addressPollInterval = 2 * time.Second
+	// maxRateLimitRetries and maxRateLimitDelay bound the waiting for a rate
+	// limiting upstream
+	maxRateLimitRetries = 6
+	maxRateLimitDelay   = 10 * time.Second
+)
+
+// StreamAddressHistory walks the signatures of an address back from the newest,
+// or from the before cursor, to the until cursor or the limit, then streams
+// the full transactions oldest first. With continue_live it then polls for
+// new signatures and streams their transactions as they land.
+func (s *BenchmarkService) StreamAddressHistory(req *proto.AddressHistoryRequest, stream proto.BenchmarkService_StreamAddressHistoryServer) error {
+	address, err := solana.PublicKeyFromBase58(req.Address)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	until, err := parseCursor(req.Until)
+	if err != nil {
+		return err
+	}
+	before, err := parseCursor(req.Before)
+	if err != nil {
+		return err
+	}
+	if req.ContinueLive && !before.IsZero() {
+		return status.Error(codes.InvalidArgument, "live updates cannot follow a history ending before a cursor")
+	}
+
+	limit := defaultAddressHistory
+	if req.Limit > 0 {
+		limit = int(req.Limit)
+	}
+	if limit > maxAddressHistory {
+		return status.Errorf(codes.InvalidArgument, "at most %d transactions of history may be requested", maxAddressHistory)
+	}
+
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return err
+	}
+	// Transaction history is not served at processed commitment
+	if commitment == rpc.CommitmentProcessed {
+		return status.Error(codes.InvalidArgument, "address history supports confirmed or finalized commitment")
+	}
+	class, err := parseStreamQoS(req.Qos)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+
+	signatures, truncated, err := s.addressSignatures(ctx, address, before, until, limit, commitment, class)
+	if err != nil {
+		return err
+	}
+	cursor, err := s.sendAddressTransactions(ctx, stream, req, signatures, until, commitment, class, false)
+	if err != nil {
+		return err
+	}
+	complete := &proto.AddressHistoryUpdate{HistoryComplete: true, Truncated: truncated}
+	if !cursor.IsZero() {
+		complete.Cursor = cursor.String()
+	}
+	if err := stream.Send(complete); err != nil {
+		return status.Errorf(codes.Internal, "failed to send history marker: %v", err)
+	}
+	if !req.ContinueLive {
+		return nil
+	}
+
+	ticker := time.NewTicker(addressPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+
+		// Page all the way back to the cursor, however many signatures landed
+		// since, so none are skipped
+		signatures, _, err := s.addressSignatures(ctx, address, solana.Signature{}, cursor, math.MaxInt, commitment, class)
+		if err != nil {
+			log.Printf("Error polling signatures of %s: %v", address, err)
+			continue
+		}
+		cursor, err = s.sendAddressTransactions(ctx, stream, req, signatures, cursor, commitment, class, true)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// parseCursor parses an optional signature cursor
+func parseCursor(cursor string) (solana.Signature, error) {
+	if cursor == "" {
+		return solana.Signature{}, nil
+	}
+	signature, err := solana.SignatureFromBase58(cursor)
+	if err != nil {
+		return solana.Signature{}, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+	}
+	return signature, nil
+}
+
+// addressSignatures pages back through the signatures of an address, newest
+// first, from before (or the newest) to until (or the limit). truncated is
+// set when the limit stopped the walk.
+func (s *BenchmarkService) addressSignatures(ctx context.Context, address solana.PublicKey, before, until solana.Signature, limit int, commitment rpc.CommitmentType, class streamClass) (signatures []*rpc.TransactionSignature, truncated bool, err error) {
+	for len(signatures) < limit {
+		pageSize := signaturesPageSize
+		if remaining := limit - len(signatures); remaining < pageSize {
+			pageSize = remaining
+		}
+
+		var page []*rpc.TransactionSignature
+		err := s.callUpstream(ctx, class, func() error {
+			var err error
+			page, err = s.solanaClient.GetSignaturesForAddressWithOpts(ctx, address, &rpc.GetSignaturesForAddressOpts{
+				Limit:      &pageSize,
+				Before:     before,
+				Until:      until,
+				Commitment: commitment,
+			})
+			return err
+		})
+		if err != nil {
+			return nil, false, status.Errorf(codes.Internal, "failed to get signatures: %v", err)
+		}
+
+		signatures = append(signatures, page...)
+		if len(page) < pageSize {
+			return signatures, false, nil
+		}
+		before = page[len(page)-1].Signature
+	}
+	return signatures, true, nil
+}
+
+// sendAddressTransactions streams the transactions of newest-first signatures
+// oldest first, and returns the cursor after the newest, which is cursor if
+// there were none
+func (s *BenchmarkService) sendAddressTransactions(ctx context.Context, stream proto.BenchmarkService_StreamAddressHistoryServer, req *proto.AddressHistoryRequest, signatures []*rpc.TransactionSignature, cursor solana.Signature, commitment rpc.CommitmentType, class streamClass, live bool) (solana.Signature, error) {
+	for i := len(signatures) - 1; i >= 0; i-- {
+		entry := signatures[i]
+		if entry.Err != nil && !req.IncludeFailed {
+			cursor = entry.Signature
+			continue
+		}
+
+		update, err := s.addressTransaction(ctx, entry.Signature, commitment, class)
+		if err != nil {
+			return cursor, err
+		}
+		cursor = entry.Signature
+		err = stream.Send(&proto.AddressHistoryUpdate{
+			Transaction: update,
+			Live:        live,
+			Cursor:      cursor.String(),
+		})
+		if err != nil {
+			return cursor, status.Errorf(codes.Internal, "failed to send transaction: %v", err)
+		}
+	}
+	return cursor, nil
+}
+
+// addressTransaction reads a transaction in full
+func (s *BenchmarkService) addressTransaction(ctx context.Context, signature solana.Signature, commitment rpc.CommitmentType, class streamClass) (*proto.TransactionUpdate, error) {
+	maxVersion := uint64(0)
+	var result *rpc.GetTransactionResult
+	err := s.callUpstream(ctx, class, func() error {
+		var err error
+		result, err = s.solanaClient.GetTransaction(ctx, signature, &rpc.GetTransactionOpts{
+			Encoding:                       solana.EncodingBase64,
+			Commitment:                     commitment,
+			MaxSupportedTransactionVersion: &maxVersion,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get transaction %s: %v", signature, err)
+	}
+	if result.Meta == nil {
+		return nil, status.Errorf(codes.Internal, "transaction %s has no metadata", signature)
+	}
+	tx, err := result.Transaction.GetTransaction()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode transaction %s: %v", signature, err)
+	}
+	return transactionUpdate(result.Slot, result.Transaction.GetBinary(), tx, result.Meta), nil
+}
+
+// callUpstream makes an upstream call under the scheduler for the stream's
+// class, waiting with backoff and retrying while the upstream rate limits
+func (s *BenchmarkService) callUpstream(ctx context.Context, class streamClass, call func() error) error {
+	backoff := upstream.Backoff{Initial: 500 * time.Millisecond, Max: maxRateLimitDelay}
+	for attempt := 0; ; attempt++ {
+		release, err := s.scheduler.acquire(ctx, class)
+		if err != nil {
+			return err
+		}
+		err = call()
+		release()
+		if err == nil || !upstream.IsRateLimited(err) || attempt == maxRateLimitRetries {
+			return err
+		}
+
+		log.Printf("Upstream is rate limiting, retrying in %v", backoff.Delay())
+		if err := backoff.Wait(ctx); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAlertConditions caps the number of conditions a single alert stream may register
+const maxAlertConditions = 100
+
+// alertCondition is a validated alert condition
+type alertCondition struct {
+	name        string
+	kind        proto.AlertConditionType
+	minLamports uint64
+	account     *solana.PublicKey
+	threshold   uint64
+	program     solana.PublicKey
+}
+
+// parseAlertConditions validates the conditions of an alert stream request
+func parseAlertConditions(conditions []*proto.AlertCondition) ([]alertCondition, error) {
+	if len(conditions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one condition is required")
+	}
+	if len(conditions) > maxAlertConditions {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d conditions may be registered", maxAlertConditions)
+	}
+
+	parsed := make([]alertCondition, 0, len(conditions))
+	for i, c := range conditions {
+		cond := alertCondition{name: c.Name, kind: c.Type, minLamports: c.MinLamports, threshold: c.ThresholdLamports}
+		if cond.name == "" {
+			cond.name = fmt.Sprintf("condition %d", i+1)
+		}
+		if c.Account != "" {
+			account, err := solana.PublicKeyFromBase58(c.Account)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "%s: invalid account: %v", cond.name, err)
+			}
+			cond.account = &account
+		}
+
+		switch c.Type {
+		case proto.AlertConditionType_ALERT_CONDITION_LAMPORT_TRANSFER:
+			if c.MinLamports == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "%s: lamport transfer conditions require min_lamports", cond.name)
+			}
+		case proto.AlertConditionType_ALERT_CONDITION_BALANCE_CROSSES:
+			if cond.account == nil {
+				return nil, status.Errorf(codes.InvalidArgument, "%s: balance conditions require an account", cond.name)
+			}
+		case proto.AlertConditionType_ALERT_CONDITION_PROGRAM_INVOKED:
+			program, err := solana.PublicKeyFromBase58(c.ProgramId)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "%s: invalid program ID: %v", cond.name, err)
+			}
+			cond.program = program
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "%s: unknown condition type %d", cond.name, c.Type)
+		}
+		parsed = append(parsed, cond)
+	}
+	return parsed, nil
+}
+
+// StreamAlerts streams an alert for every transaction that matches one of the
+// registered conditions. Transactions are read from the blocks at the
+// requested commitment; balances are taken from their pre and post balances.
+func (s *BenchmarkService) StreamAlerts(req *proto.AlertStreamRequest, stream proto.BenchmarkService_StreamAlertsServer) error {
+	if err := s.requireFeature(features.BlockStreams); err != nil {
+		return err
+	}
+	conditions, err := parseAlertConditions(req.Conditions)
+	if err != nil {
+		return err
+	}
+
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return err
+	}
+	if commitment == rpc.CommitmentProcessed {
+		return status.Error(codes.InvalidArgument, "alert streams support confirmed or finalized commitment")
+	}
+	class, err := parseStreamQoS(req.Qos)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+
+	return s.followBlocks(ctx, commitment, class, func(slot uint64, block *rpc.GetBlockResult, _ *stageTiming) error {
+		for _, txWithMeta := range block.Transactions {
+			meta := txWithMeta.Meta
+			if meta == nil || (meta.Err != nil && !req.IncludeFailed) {
+				continue
+			}
+			tx, err := txWithMeta.GetTransaction()
+			if err != nil {
+				log.Printf("Error decoding transaction: %v", err)
+				continue
+			}
+
+			for _, cond := range conditions {
+				alert := cond.match(tx, meta)
+				if alert == nil {
+					continue
+				}
+				alert.Slot = slot
+				if len(tx.Signatures) > 0 {
+					alert.Signature = tx.Signatures[0].String()
+				}
+				alert.Timestamp = uint64(time.Now().Unix())
+				alert.Success = meta.Err == nil
+				if err := stream.Send(alert); err != nil {
+					return status.Errorf(codes.Internal, "failed to send alert: %v", err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// match returns the alert a transaction raises for the condition, or nil
+func (c alertCondition) match(tx *solana.Transaction, meta *rpc.TransactionMeta) *proto.Alert {
+	keys := transactionKeys(tx, meta)
+
+	switch c.kind {
+	case proto.AlertConditionType_ALERT_CONDITION_LAMPORT_TRANSFER:
+		// Report the largest movement among the considered accounts
+		best, bestDelta := -1, int64(0)
+		for i, key := range keys {
+			if c.account != nil && key != *c.account {
+				continue
+			}
+			delta, ok := lamportsDelta(meta, i)
+			if ok && absDelta(delta) > c.minLamports && (best < 0 || absDelta(delta) > absDelta(bestDelta)) {
+				best, bestDelta = i, delta
+			}
+		}
+		if best < 0 {
+			return nil
+		}
+		return c.balanceAlert(keys[best], meta, best, bestDelta)
+
+	case proto.AlertConditionType_ALERT_CONDITION_BALANCE_CROSSES:
+		for i, key := range keys {
+			if key != *c.account || i >= len(meta.PreBalances) || i >= len(meta.PostBalances) {
+				continue
+			}
+			pre, post := meta.PreBalances[i], meta.PostBalances[i]
+			if (pre < c.threshold && post >= c.threshold) || (pre >= c.threshold && post < c.threshold) {
+				return c.balanceAlert(key, meta, i, int64(post-pre))
+			}
+			return nil
+		}
+		return nil
+
+	case proto.AlertConditionType_ALERT_CONDITION_PROGRAM_INVOKED:
+		invoked := func(instruction solana.CompiledInstruction) bool {
+			index := int(instruction.ProgramIDIndex)
+			return index < len(keys) && keys[index] == c.program
+		}
+		instructions := append([]solana.CompiledInstruction{}, tx.Message.Instructions...)
+		for _, inner := range meta.InnerInstructions {
+			instructions = append(instructions, inner.Instructions...)
+		}
+		for _, instruction := range instructions {
+			if invoked(instruction) {
+				return &proto.Alert{Condition: c.name, Type: c.kind, ProgramId: c.program.String()}
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// balanceAlert builds the alert for a lamport condition on the account at index
+func (c alertCondition) balanceAlert(account solana.PublicKey, meta *rpc.TransactionMeta, index int, delta int64) *proto.Alert {
+	return &proto.Alert{
+		Condition:     c.name,
+		Type:          c.kind,
+		Account:       account.String(),
+		LamportsDelta: delta,
+		PreBalance:    meta.PreBalances[index],
+		PostBalance:   meta.PostBalances[index],
+	}
+}
+
+// lamportsDelta returns the change of the lamport balance at index, with the
+// fee added back for the fee payer so that fees do not count as transfers
+func lamportsDelta(meta *rpc.TransactionMeta, index int) (int64, bool) {
+	if index >= len(meta.PreBalances) || index >= len(meta.PostBalances) {
+		return 0, false
+	}
+	delta := int64(meta.PostBalances[index] - meta.PreBalances[index])
+	if index == 0 {
+		delta += int64(meta.Fee)
+	}
+	return delta, true
+}
+
+// absDelta returns the magnitude of a balance change
+func absDelta(delta int64) uint64 {
+	if delta < 0 {
+		return uint64(-delta)
+	}
+	return uint64(delta)
+}
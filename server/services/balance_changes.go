@@ -0,0 +1,197 @@
+package services
+
+import (
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// balanceKey identifies a balance: an account's lamports, or a token account's
+// balance of a mint
+type balanceKey struct {
+	account solana.PublicKey
+	mint    solana.PublicKey
+}
+
+// StreamBalanceChanges streams the net lamport and token balance changes of a
+// set of accounts per block, computed from the pre and post balances of the
+// block's transactions. Only blocks that change a watched balance are sent.
+func (s *BenchmarkService) StreamBalanceChanges(req *proto.BalanceChangeStreamRequest, stream proto.BenchmarkService_StreamBalanceChangesServer) error {
+	if err := s.requireFeature(features.BlockStreams); err != nil {
+		return err
+	}
+	accounts, err := parsePubkeys(req.Accounts)
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one account is required")
+	}
+	if len(accounts) > maxTrackedAccounts {
+		return status.Errorf(codes.InvalidArgument, "at most %d accounts may be streamed", maxTrackedAccounts)
+	}
+	watched := make(map[solana.PublicKey]bool, len(accounts))
+	for _, account := range accounts {
+		watched[account] = true
+	}
+
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return err
+	}
+	if commitment == rpc.CommitmentProcessed {
+		return status.Error(codes.InvalidArgument, "balance change streams support confirmed or finalized commitment")
+	}
+	class, err := parseStreamQoS(req.Qos)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+
+	return s.followBlocks(ctx, commitment, class, func(slot uint64, block *rpc.GetBlockResult, _ *stageTiming) error {
+		changes := blockBalanceChanges(block, watched)
+		if len(changes) == 0 {
+			return nil
+		}
+		err := stream.Send(&proto.BlockBalanceChanges{
+			Slot:      slot,
+			Blockhash: block.Blockhash.String(),
+			Timestamp: uint64(time.Now().Unix()),
+			Status:    slotStatus(commitment),
+			Changes:   changes,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to send balance changes: %v", err)
+		}
+		return nil
+	})
+}
+
+// blockBalanceChanges aggregates the balance changes of watched accounts over
+// the transactions of a block. Lamports are matched by account; token balances
+// by token account or owner.
+func blockBalanceChanges(block *rpc.GetBlockResult, watched map[solana.PublicKey]bool) []*proto.BalanceChange {
+	changes := make(map[balanceKey]*proto.BalanceChange)
+	var order []balanceKey
+
+	// record folds one transaction's change of a balance into the block's,
+	// keeping the first pre balance and the last post balance
+	record := func(key balanceKey, owner *solana.PublicKey, pre, post uint64, decimals uint8) {
+		if pre == post {
+			return
+		}
+		change, ok := changes[key]
+		if !ok {
+			change = &proto.BalanceChange{
+				Account:    key.account.String(),
+				PreBalance: pre,
+				Decimals:   uint32(decimals),
+			}
+			if !key.mint.IsZero() {
+				change.Mint = key.mint.String()
+			}
+			if owner != nil {
+				change.Owner = owner.String()
+			}
+			changes[key] = change
+			order = append(order, key)
+		}
+		change.PostBalance = post
+		change.Delta = int64(change.PostBalance - change.PreBalance)
+		change.Transactions++
+	}
+
+	for _, txWithMeta := range block.Transactions {
+		meta := txWithMeta.Meta
+		if meta == nil {
+			continue
+		}
+		tx, err := txWithMeta.GetTransaction()
+		if err != nil {
+			log.Printf("Error decoding transaction: %v", err)
+			continue
+		}
+
+		keys := transactionKeys(tx, meta)
+
+		for i, key := range keys {
+			if !watched[key] || i >= len(meta.PreBalances) || i >= len(meta.PostBalances) {
+				continue
+			}
+			record(balanceKey{account: key}, nil, meta.PreBalances[i], meta.PostBalances[i], 0)
+		}
+
+		// Token accounts missing before the transaction were created by it and
+		// those missing after it were closed, so their balance counts as zero
+		type tokenBalance struct {
+			owner    *solana.PublicKey
+			pre      uint64
+			post     uint64
+			decimals uint8
+		}
+		tokens := make(map[balanceKey]*tokenBalance)
+		var tokenOrder []balanceKey
+		collect := func(balances []rpc.TokenBalance, post bool) {
+			for _, balance := range balances {
+				if int(balance.AccountIndex) >= len(keys) || balance.UiTokenAmount == nil {
+					continue
+				}
+				account := keys[balance.AccountIndex]
+				if !watched[account] && (balance.Owner == nil || !watched[*balance.Owner]) {
+					continue
+				}
+				amount, err := strconv.ParseUint(balance.UiTokenAmount.Amount, 10, 64)
+				if err != nil {
+					continue
+				}
+
+				key := balanceKey{account: account, mint: balance.Mint}
+				token, ok := tokens[key]
+				if !ok {
+					token = &tokenBalance{}
+					tokens[key] = token
+					tokenOrder = append(tokenOrder, key)
+				}
+				token.owner = balance.Owner
+				token.decimals = balance.UiTokenAmount.Decimals
+				if post {
+					token.post = amount
+				} else {
+					token.pre = amount
+				}
+			}
+		}
+		collect(meta.PreTokenBalances, false)
+		collect(meta.PostTokenBalances, true)
+
+		for _, key := range tokenOrder {
+			token := tokens[key]
+			record(key, token.owner, token.pre, token.post, token.decimals)
+		}
+	}
+
+	// Lamport changes first, then token changes, each in account order
+	sort.SliceStable(order, func(i, j int) bool {
+		if order[i].mint.IsZero() != order[j].mint.IsZero() {
+			return order[i].mint.IsZero()
+		}
+		return order[i].account.String() < order[j].account.String()
+	})
+	result := make([]*proto.BalanceChange, 0, len(order))
+	for _, key := range order {
+		result = append(result, changes[key])
+	}
+	return result
+}
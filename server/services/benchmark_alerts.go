@@ -0,0 +1,198 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/history"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// webhookTimeout bounds the delivery of a regression alert
+const webhookTimeout = 10 * time.Second
+
+// BenchmarkAlert notifies a webhook when the average latency of a scheduled
+// run regresses from the scenario's trailing baseline
+type BenchmarkAlert struct {
+	WebhookURL string
+	// Slack posts a Slack incoming webhook message instead of the comparison
+	// as JSON
+	Slack bool
+	// BaselineRuns is how many of the previous runs the baseline averages,
+	// and MinBaselineRuns how many it needs before runs are compared
+	BaselineRuns    int
+	MinBaselineRuns int
+	// MaxIncrease is the fraction a latency may exceed its baseline by, such
+	// as 0.5 for 50% slower
+	MaxIncrease float64
+	// MinIncrease ignores smaller regressions, which are mostly noise for
+	// latencies of a few milliseconds
+	MinIncrease time.Duration
+}
+
+// benchmarkComparison compares an average latency of a run with its baseline
+type benchmarkComparison struct {
+	// Metric is the benchmark and transport, such as account_grpc
+	Metric     string  `json:"metric"`
+	LatencyMs  float64 `json:"latency_ms"`
+	BaselineMs float64 `json:"baseline_ms"`
+	// Increase is the fraction the latency exceeds the baseline by, negative
+	// when it is faster
+	Increase  float64 `json:"increase"`
+	Regressed bool    `json:"regressed"`
+}
+
+// benchmarkRegressionAlert is the notification of a regressed run
+type benchmarkRegressionAlert struct {
+	// Text summarizes the regressions for chat tools
+	Text         string                `json:"text"`
+	Scenario     string                `json:"scenario"`
+	RunID        uint64                `json:"run_id"`
+	JobID        string                `json:"job_id"`
+	BaselineRuns int                   `json:"baseline_runs"`
+	Comparisons  []benchmarkComparison `json:"comparisons"`
+}
+
+// benchmarkLatency is the average latency of a benchmark and transport of a
+// run, in milliseconds
+type benchmarkLatency struct {
+	metric    string
+	latencyMs float64
+}
+
+// benchmarkLatencies returns the average latency of each benchmark and
+// transport of a run that had successful requests
+func benchmarkLatencies(results *proto.BenchmarkResults) []benchmarkLatency {
+	var latencies []benchmarkLatency
+	for _, metric := range []struct {
+		name       string
+		avg        uint64
+		successful uint32
+	}{
+		{"account_grpc", results.GetAccountGrpc().GetAvgResponseTimeMs(), results.GetAccountGrpc().GetSuccessfulRequests()},
+		{"account_jsonrpc", results.GetAccountJsonrpc().GetAvgResponseTimeMs(), results.GetAccountJsonrpc().GetSuccessfulRequests()},
+		{"transaction_grpc", results.GetTransactionGrpc().GetAvgResponseTimeMs(), results.GetTransactionGrpc().GetSuccessfulRequests()},
+		{"transaction_jsonrpc", results.GetTransactionJsonrpc().GetAvgResponseTimeMs(), results.GetTransactionJsonrpc().GetSuccessfulRequests()},
+		{"block_grpc", results.GetBlockGrpc().GetAvgResponseTimeMs(), results.GetBlockGrpc().GetSuccessfulRequests()},
+		{"block_jsonrpc", results.GetBlockJsonrpc().GetAvgResponseTimeMs(), results.GetBlockJsonrpc().GetSuccessfulRequests()},
+		{"cluster_nodes_grpc", results.GetClusterNodesGrpc().GetAvgResponseTimeMs(), results.GetClusterNodesGrpc().GetSuccessfulRequests()},
+		{"cluster_nodes_jsonrpc", results.GetClusterNodesJsonrpc().GetAvgResponseTimeMs(), results.GetClusterNodesJsonrpc().GetSuccessfulRequests()},
+	} {
+		if metric.successful > 0 {
+			latencies = append(latencies, benchmarkLatency{metric.name, float64(metric.avg)})
+		}
+	}
+	return latencies
+}
+
+// compareToBaseline compares the latencies of a run with their averages over
+// the scenario's completed runs before it. It returns no comparisons until
+// the baseline has enough runs.
+func compareToBaseline(store *history.BenchmarkStore, run history.BenchmarkRun, results *proto.BenchmarkResults, alert *BenchmarkAlert) ([]benchmarkComparison, int, error) {
+	previous, _, err := store.List(run.Scenario, run.ID, alert.BaselineRuns, true)
+	if err != nil {
+		return nil, 0, err
+	}
+	sums := make(map[string]float64)
+	counts := make(map[string]int)
+	baselineRuns := 0
+	for _, prior := range previous {
+		if prior.Cancelled {
+			continue
+		}
+		priorResults := &proto.BenchmarkResults{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(prior.Results, priorResults); err != nil {
+			continue
+		}
+		baselineRuns++
+		for _, latency := range benchmarkLatencies(priorResults) {
+			sums[latency.metric] += latency.latencyMs
+			counts[latency.metric]++
+		}
+	}
+	if baselineRuns < alert.MinBaselineRuns {
+		return nil, baselineRuns, nil
+	}
+
+	var comparisons []benchmarkComparison
+	for _, current := range benchmarkLatencies(results) {
+		metric, latency := current.metric, current.latencyMs
+		if counts[metric] < alert.MinBaselineRuns {
+			continue
+		}
+		baseline := sums[metric] / float64(counts[metric])
+		comparison := benchmarkComparison{Metric: metric, LatencyMs: latency, BaselineMs: baseline}
+		if baseline > 0 {
+			comparison.Increase = (latency - baseline) / baseline
+		}
+		increase := time.Duration((latency - baseline) * float64(time.Millisecond))
+		comparison.Regressed = latency > baseline*(1+alert.MaxIncrease) && increase >= alert.MinIncrease
+		comparisons = append(comparisons, comparison)
+	}
+	return comparisons, baselineRuns, nil
+}
+
+// alertOnRegression notifies the scenario's webhook if a run regressed from
+// its baseline
+func (s *BenchmarkService) alertOnRegression(ctx context.Context, scenario BenchmarkScenario, run history.BenchmarkRun, results *proto.BenchmarkResults) error {
+	comparisons, baselineRuns, err := compareToBaseline(s.scheduled.store, run, results, scenario.Alert)
+	if err != nil {
+		return err
+	}
+	var regressions []string
+	for _, comparison := range comparisons {
+		if comparison.Regressed {
+			regressions = append(regressions, fmt.Sprintf("%s %.0fms vs %.0fms baseline (%+.0f%%)",
+				comparison.Metric, comparison.LatencyMs, comparison.BaselineMs, comparison.Increase*100))
+		}
+	}
+	if len(regressions) == 0 {
+		return nil
+	}
+
+	alert := benchmarkRegressionAlert{
+		Text: fmt.Sprintf("Benchmark scenario %s regressed in run %d against the average of its previous %d runs: %s",
+			scenario.Name, run.ID, baselineRuns, strings.Join(regressions, ", ")),
+		Scenario:     scenario.Name,
+		RunID:        run.ID,
+		JobID:        run.JobID,
+		BaselineRuns: baselineRuns,
+		Comparisons:  comparisons,
+	}
+	var payload interface{} = alert
+	if scenario.Alert.Slack {
+		payload = map[string]string{"text": alert.Text}
+	}
+	return postWebhook(ctx, scenario.Alert.WebhookURL, payload)
+}
+
+// postWebhook posts payload as JSON to url
+func postWebhook(ctx context.Context, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// defaultBenchmarkCacheTTL is how long completed benchmark results are served
+// to identical requests unless configured otherwise
+const defaultBenchmarkCacheTTL = time.Minute
+
+// benchmarkCache holds completed benchmark results by request, so repeating an
+// identical request within the TTL does not hit the upstream again
+type benchmarkCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]benchmarkCacheEntry
+}
+
+type benchmarkCacheEntry struct {
+	results  *proto.BenchmarkResults
+	storedAt time.Time
+}
+
+func newBenchmarkCache(ttl time.Duration) *benchmarkCache {
+	return &benchmarkCache{
+		ttl:     ttl,
+		entries: make(map[string]benchmarkCacheEntry),
+	}
+}
+
+// get returns a copy of the cached results for a key, flagged as cached, or
+// nil if there are none within the TTL
+func (c *benchmarkCache) get(key string) *proto.BenchmarkResults {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Since(entry.storedAt) > c.ttl {
+		return nil
+	}
+
+	results := protobuf.Clone(entry.results).(*proto.BenchmarkResults)
+	results.Cached = true
+	results.CachedAt = uint64(entry.storedAt.Unix())
+	return results
+}
+
+// put stores completed results for a key, dropping expired entries
+func (c *benchmarkCache) put(key string, results *proto.BenchmarkResults) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.Sub(entry.storedAt) > c.ttl {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = benchmarkCacheEntry{
+		results:  protobuf.Clone(results).(*proto.BenchmarkResults),
+		storedAt: now,
+	}
+}
+
+// benchmarkCacheKey hashes a normalized benchmark request, so requests that
+// differ only in the order of their targets, in options that do not affect
+// the run or in the client environment share an entry
+func benchmarkCacheKey(req *proto.BenchmarkRequest) string {
+	normalized := protobuf.Clone(req).(*proto.BenchmarkRequest)
+	normalized.BypassCache = false
+	normalized.ClientOs = ""
+	normalized.ClientRegion = ""
+
+	sort.Strings(normalized.TestAccounts)
+	sort.Strings(normalized.TestSignatures)
+	sort.Slice(normalized.TestSlots, func(i, j int) bool { return normalized.TestSlots[i] < normalized.TestSlots[j] })
+
+	fraction, _ := outlierFraction(req)
+	normalized.OutlierFraction = fraction
+
+	data, _ := protobuf.MarshalOptions{Deterministic: true}.Marshal(normalized)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// SetBenchmarkCacheTTL sets how long completed benchmark results are reused
+// for identical requests; zero disables the cache. It must be called before
+// the service is serving requests.
+func (s *BenchmarkService) SetBenchmarkCacheTTL(ttl time.Duration) {
+	s.benchmarkCache = newBenchmarkCache(ttl)
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+)
+
+// upstreamRTTProbes is the number of round trips timed before a benchmark
+const upstreamRTTProbes = 3
+
+// SetRegion sets the region the server runs in, recorded with benchmark
+// results. It must be called before the service is serving requests.
+func (s *BenchmarkService) SetRegion(region string) {
+	s.region = region
+}
+
+// benchmarkEnvironment records what a benchmark runs on, probing the round
+// trip time to the upstream
+func (s *BenchmarkService) benchmarkEnvironment(ctx context.Context, req *proto.BenchmarkRequest) *proto.BenchmarkEnvironment {
+	env := &proto.BenchmarkEnvironment{
+		GoVersion:        runtime.Version(),
+		ServerOs:         runtime.GOOS + "/" + runtime.GOARCH,
+		ServerRegion:     s.region,
+		UpstreamEndpoint: redactEndpoint(s.endpoint.RPC),
+		ClientOs:         req.ClientOs,
+		ClientRegion:     req.ClientRegion,
+	}
+	if s.endpoint.Transport != nil {
+		env.UpstreamEndpoint = "in-memory"
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		env.ServerVersion = info.Main.Version
+		var modified bool
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				env.ServerCommit = setting.Value
+			case "vcs.modified":
+				modified = setting.Value == "true"
+			}
+		}
+		if modified && env.ServerCommit != "" {
+			env.ServerCommit += "-dirty"
+		}
+	}
+
+	// getVersion does no work on the node, so its fastest round trip is
+	// close to the network's
+	for i := 0; i < upstreamRTTProbes; i++ {
+		start := time.Now()
+		if _, err := s.solanaClient.GetVersion(ctx); err != nil {
+			continue
+		}
+		rtt := float64(time.Since(start).Microseconds()) / 1000
+		if env.UpstreamRttProbes == 0 || rtt < env.UpstreamRttMs {
+			env.UpstreamRttMs = rtt
+		}
+		env.UpstreamRttProbes++
+	}
+	return env
+}
+
+// redactEndpoint reduces an endpoint URL to its scheme and host, since
+// providers put API keys in the user info, the query or the path
+func redactEndpoint(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+}
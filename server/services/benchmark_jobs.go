@@ -0,0 +1,349 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// benchmarkJobRetention is how long a finished job's results are kept
+	benchmarkJobRetention = time.Hour
+	// progressBuffer is the number of progress updates buffered per watcher
+	// before further updates are dropped for it
+	progressBuffer = 256
+)
+
+// observeFunc is called as each request of a benchmark completes
+type observeFunc func(iteration int, ok bool, responseTimeMs uint64)
+
+// benchmarkObserver is called as each request of a benchmark suite completes,
+// with the name of the benchmark it belongs to
+type benchmarkObserver func(benchmark string, iteration int, ok bool, responseTimeMs uint64)
+
+// benchmark returns the observer for the requests of a single benchmark
+func (o benchmarkObserver) benchmark(name string) observeFunc {
+	return func(iteration int, ok bool, responseTimeMs uint64) {
+		o(name, iteration, ok, responseTimeMs)
+	}
+}
+
+// benchmarkJob is a benchmark suite running in the background
+type benchmarkJob struct {
+	id      string
+	request *proto.BenchmarkRequest
+	total   uint32
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	mu         sync.Mutex
+	state      proto.BenchmarkJobState
+	completed  uint32
+	results    *proto.BenchmarkResults
+	finishedAt time.Time
+	watchers   map[chan *proto.BenchmarkProgress]bool
+}
+
+// info returns the job's ID and state
+func (j *benchmarkJob) info() *proto.BenchmarkJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return &proto.BenchmarkJob{JobId: j.id, State: j.state}
+}
+
+// result returns the job's state and request and, once finished, its results
+func (j *benchmarkJob) result() *proto.BenchmarkJobResult {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return &proto.BenchmarkJobResult{JobId: j.id, State: j.state, Results: j.results, Request: j.request}
+}
+
+// progress returns the job's overall progress
+func (j *benchmarkJob) progress() *proto.BenchmarkProgress {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return &proto.BenchmarkProgress{
+		JobId:             j.id,
+		State:             j.state,
+		CompletedRequests: j.completed,
+		TotalRequests:     j.total,
+	}
+}
+
+// observe records a completed request and notifies the watchers, dropping the
+// update for any watcher that is not keeping up
+func (j *benchmarkJob) observe(benchmark string, iteration int, ok bool, responseTimeMs uint64) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.completed++
+	update := &proto.BenchmarkProgress{
+		JobId:             j.id,
+		State:             j.state,
+		Benchmark:         benchmark,
+		Iteration:         uint32(iteration),
+		Success:           ok,
+		ResponseTimeMs:    responseTimeMs,
+		CompletedRequests: j.completed,
+		TotalRequests:     j.total,
+	}
+	for watcher := range j.watchers {
+		select {
+		case watcher <- update:
+		default:
+		}
+	}
+}
+
+// watch registers for progress updates until the returned function is called
+func (j *benchmarkJob) watch() (<-chan *proto.BenchmarkProgress, func()) {
+	updates := make(chan *proto.BenchmarkProgress, progressBuffer)
+
+	j.mu.Lock()
+	j.watchers[updates] = true
+	j.mu.Unlock()
+
+	return updates, func() {
+		j.mu.Lock()
+		delete(j.watchers, updates)
+		j.mu.Unlock()
+	}
+}
+
+// setState moves the job to a new state
+func (j *benchmarkJob) setState(state proto.BenchmarkJobState) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.state = state
+}
+
+// finish records the job's results and final state
+func (j *benchmarkJob) finish(state proto.BenchmarkJobState, results *proto.BenchmarkResults) {
+	j.mu.Lock()
+	j.state = state
+	j.results = results
+	j.finishedAt = time.Now()
+	j.mu.Unlock()
+
+	close(j.done)
+}
+
+// benchmarkJobStore tracks benchmark jobs by ID
+type benchmarkJobStore struct {
+	mu   sync.Mutex
+	jobs map[string]*benchmarkJob
+}
+
+func newBenchmarkJobStore() *benchmarkJobStore {
+	return &benchmarkJobStore{
+		jobs: make(map[string]*benchmarkJob),
+	}
+}
+
+// create registers a new queued job for a benchmark request that will make
+// total requests. The returned context is cancelled when the job is.
+func (st *benchmarkJobStore) create(req *proto.BenchmarkRequest, total uint32) (*benchmarkJob, context.Context, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to create job: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &benchmarkJob{
+		id:       id,
+		request:  req,
+		total:    total,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+		state:    proto.BenchmarkJobState_BENCHMARK_JOB_STATE_QUEUED,
+		watchers: make(map[chan *proto.BenchmarkProgress]bool),
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.expire(time.Now())
+	st.jobs[id] = job
+	return job, ctx, nil
+}
+
+// get returns a job by ID
+func (st *benchmarkJobStore) get(id string) (*benchmarkJob, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	job, ok := st.jobs[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "benchmark job %s not found or expired", id)
+	}
+	return job, nil
+}
+
+// expire drops jobs that finished longer ago than the retention period
+func (st *benchmarkJobStore) expire(now time.Time) {
+	for id, job := range st.jobs {
+		job.mu.Lock()
+		expired := !job.finishedAt.IsZero() && now.Sub(job.finishedAt) > benchmarkJobRetention
+		job.mu.Unlock()
+		if expired {
+			delete(st.jobs, id)
+		}
+	}
+}
+
+// benchmarkRequests returns the number of requests a benchmark suite will
+// make
+func benchmarkRequests(req *proto.BenchmarkRequest) uint32 {
+	perClient := benchmarkTargets(req)
+	targets := 0
+	if req.RunGrpcTests {
+		targets += perClient
+	}
+	if req.RunJsonrpcTests {
+		targets += perClient
+	}
+	return uint32(targets) * req.Iterations
+}
+
+// benchmarkTargets returns the requests each iteration of a benchmark suite
+// makes on each transport, fetching its slots once per block detail tier and
+// the cluster nodes once
+func benchmarkTargets(req *proto.BenchmarkRequest) int {
+	targets := len(req.TestAccounts) + len(req.TestSignatures) + len(req.TestSlots)*max(1, len(req.BlockDetails))
+	if req.RunClusterNodes {
+		targets++
+	}
+	return targets
+}
+
+// StartBenchmark starts a benchmark suite in the background and returns its job
+func (s *BenchmarkService) StartBenchmark(ctx context.Context, req *proto.BenchmarkRequest) (*proto.BenchmarkJob, error) {
+	if err := validateBenchmark(req); err != nil {
+		return nil, err
+	}
+	if err := s.benchmarkLimits.check(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// Identical requests within the cache TTL finish immediately without
+	// making any requests
+	key := benchmarkCacheKey(req)
+	if !req.BypassCache {
+		if cached := s.benchmarkCache.get(key); cached != nil {
+			job, _, err := s.benchmarkJobs.create(req, 0)
+			if err != nil {
+				return nil, err
+			}
+			job.finish(proto.BenchmarkJobState_BENCHMARK_JOB_STATE_SUCCEEDED, cached)
+			job.cancel()
+			return job.info(), nil
+		}
+	}
+
+	maxDuration, release, err := s.benchmarkLimits.admit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, jobCtx, err := s.benchmarkJobs.create(req, benchmarkRequests(req))
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	go func() {
+		defer job.cancel()
+		defer release()
+
+		// Wait for the benchmarks ahead of this one
+		if err := s.benchmarkQueue.acquire(jobCtx); err != nil {
+			job.finish(proto.BenchmarkJobState_BENCHMARK_JOB_STATE_CANCELLED, nil)
+			return
+		}
+		defer s.benchmarkQueue.release()
+
+		// The maximum duration counts from the start of the run, not the queue
+		runCtx, cancel := withMaxDuration(jobCtx, maxDuration)
+		defer cancel()
+
+		job.setState(proto.BenchmarkJobState_BENCHMARK_JOB_STATE_RUNNING)
+		results := s.runBenchmark(runCtx, req, job.observe)
+
+		state := proto.BenchmarkJobState_BENCHMARK_JOB_STATE_SUCCEEDED
+		if runCtx.Err() != nil {
+			state = proto.BenchmarkJobState_BENCHMARK_JOB_STATE_CANCELLED
+		} else {
+			s.benchmarkCache.put(key, results)
+		}
+		job.finish(state, results)
+	}()
+
+	return job.info(), nil
+}
+
+// StreamBenchmarkProgress streams the progress of a benchmark job until it
+// finishes, ending with its final state
+func (s *BenchmarkService) StreamBenchmarkProgress(req *proto.BenchmarkJobRequest, stream proto.BenchmarkService_StreamBenchmarkProgressServer) error {
+	job, err := s.benchmarkJobs.get(req.JobId)
+	if err != nil {
+		return err
+	}
+
+	updates, unwatch := job.watch()
+	defer unwatch()
+
+	send := func(progress *proto.BenchmarkProgress) error {
+		if err := stream.Send(progress); err != nil {
+			return status.Errorf(codes.Internal, "failed to send benchmark progress: %v", err)
+		}
+		return nil
+	}
+
+	// A job that has already finished only has its final progress to report
+	select {
+	case <-job.done:
+		return send(job.progress())
+	default:
+	}
+
+	if err := send(job.progress()); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case update := <-updates:
+			if err := send(update); err != nil {
+				return err
+			}
+		case <-job.done:
+			return send(job.progress())
+		}
+	}
+}
+
+// GetBenchmarkResult returns the state of a benchmark job and its results once finished
+func (s *BenchmarkService) GetBenchmarkResult(ctx context.Context, req *proto.BenchmarkJobRequest) (*proto.BenchmarkJobResult, error) {
+	job, err := s.benchmarkJobs.get(req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return job.result(), nil
+}
+
+// CancelBenchmark stops a benchmark job. Results gathered before cancellation
+// remain available; cancelling a finished job has no effect.
+func (s *BenchmarkService) CancelBenchmark(ctx context.Context, req *proto.BenchmarkJobRequest) (*proto.BenchmarkJob, error) {
+	job, err := s.benchmarkJobs.get(req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	job.cancel()
+	<-job.done
+	return job.info(), nil
+}
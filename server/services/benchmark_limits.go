@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyHeader is the metadata key whose value selects per-key benchmark limits
+const apiKeyHeader = "x-api-key"
+
+// BenchmarkLimits caps what benchmark requests may ask of the upstream
+type BenchmarkLimits struct {
+	// MaxIterations caps the iterations per target
+	MaxIterations uint32
+	// MaxTargets caps the accounts, signatures and slots per request
+	MaxTargets int
+	// MaxConcurrent caps the benchmark runs, queued or running, at once
+	MaxConcurrent int
+	// MaxDuration stops runs that take longer, keeping their partial results
+	MaxDuration time.Duration
+}
+
+// DefaultBenchmarkLimits apply to requests without a configured API key
+var DefaultBenchmarkLimits = BenchmarkLimits{
+	MaxIterations: 1000,
+	MaxTargets:    100,
+	MaxConcurrent: 4,
+	MaxDuration:   10 * time.Minute,
+}
+
+// benchmarkLimiter enforces benchmark limits per API key. Requests without a
+// configured key share the default limits and one concurrency budget.
+type benchmarkLimiter struct {
+	defaults BenchmarkLimits
+	keys     map[string]BenchmarkLimits
+
+	mu     sync.Mutex
+	active map[string]int
+}
+
+func newBenchmarkLimiter(defaults BenchmarkLimits, keys map[string]BenchmarkLimits) *benchmarkLimiter {
+	return &benchmarkLimiter{
+		defaults: defaults,
+		keys:     keys,
+		active:   make(map[string]int),
+	}
+}
+
+// limits returns the API key of a request, empty when it has no configured
+// key, and the limits that apply to it
+func (l *benchmarkLimiter) limits(ctx context.Context) (string, BenchmarkLimits) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range md.Get(apiKeyHeader) {
+		if limits, ok := l.keys[key]; ok {
+			return key, limits
+		}
+	}
+	return "", l.defaults
+}
+
+// check rejects a benchmark request that exceeds the size limits of its key
+func (l *benchmarkLimiter) check(ctx context.Context, req *proto.BenchmarkRequest) error {
+	_, limits := l.limits(ctx)
+	if req.Iterations > limits.MaxIterations {
+		return status.Errorf(codes.InvalidArgument, "%d iterations exceed the limit of %d", req.Iterations, limits.MaxIterations)
+	}
+	targets := benchmarkTargets(req)
+	if targets > limits.MaxTargets {
+		return status.Errorf(codes.InvalidArgument, "%d targets exceed the limit of %d", targets, limits.MaxTargets)
+	}
+	return nil
+}
+
+// admit counts a benchmark run against the concurrency limit of its key. It
+// returns the run's maximum duration and a function to call once the run ends.
+func (l *benchmarkLimiter) admit(ctx context.Context) (time.Duration, func(), error) {
+	key, limits := l.limits(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.active[key] >= limits.MaxConcurrent {
+		return 0, nil, status.Errorf(codes.ResourceExhausted, "%d benchmark runs already in progress, the limit is %d", l.active[key], limits.MaxConcurrent)
+	}
+	l.active[key]++
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			l.active[key]--
+		})
+	}
+	return limits.MaxDuration, release, nil
+}
+
+// withMaxDuration bounds a run's context by its maximum duration, if any
+func withMaxDuration(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	if d <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, d)
+}
+
+// SetBenchmarkLimits sets the limits applied to benchmark requests, with
+// overrides for requests carrying one of the given API keys in the x-api-key
+// metadata. It must be called before the service is serving requests.
+func (s *BenchmarkService) SetBenchmarkLimits(defaults BenchmarkLimits, keys map[string]BenchmarkLimits) {
+	s.benchmarkLimits = newBenchmarkLimiter(defaults, keys)
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultMaxConcurrentBenchmarks serializes benchmark runs unless configured
+// otherwise. Concurrent runs share the upstream client and connection, so
+// their requests queue behind each other and skew every run's latencies.
+const defaultMaxConcurrentBenchmarks = 1
+
+// benchmarkQueue admits benchmark runs in arrival order, at most limit at a time
+type benchmarkQueue struct {
+	mu      sync.Mutex
+	limit   int
+	running int
+	waiting []chan struct{}
+}
+
+func newBenchmarkQueue(limit int) *benchmarkQueue {
+	return &benchmarkQueue{limit: limit}
+}
+
+// acquire blocks until the run may start or ctx is done. A run that acquired
+// its turn must call release when it finishes.
+func (q *benchmarkQueue) acquire(ctx context.Context) error {
+	q.mu.Lock()
+	if q.running < q.limit && len(q.waiting) == 0 {
+		q.running++
+		q.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	q.waiting = append(q.waiting, ready)
+	q.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, w := range q.waiting {
+		if w == ready {
+			q.waiting = append(q.waiting[:i], q.waiting[i+1:]...)
+			return ctx.Err()
+		}
+	}
+	// The turn was handed over just as ctx was done, so pass it on
+	q.releaseLocked()
+	return ctx.Err()
+}
+
+// release ends a run, handing its turn to the longest waiting run
+func (q *benchmarkQueue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.releaseLocked()
+}
+
+func (q *benchmarkQueue) releaseLocked() {
+	if len(q.waiting) > 0 {
+		close(q.waiting[0])
+		q.waiting = q.waiting[1:]
+		return
+	}
+	q.running--
+}
+
+// SetMaxConcurrentBenchmarks sets how many benchmark runs may execute at once;
+// further runs wait in a queue. It must be called before the service is
+// serving requests.
+func (s *BenchmarkService) SetMaxConcurrentBenchmarks(n int) {
+	s.benchmarkQueue = newBenchmarkQueue(n)
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/cron"
+	"github.com/i-tozer/solana-grpc-exploration/server/history"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// defaultBenchmarkPageSize and maxBenchmarkPageSize bound the runs
+	// ListBenchmarks returns per page
+	defaultBenchmarkPageSize = 20
+	maxBenchmarkPageSize     = 100
+)
+
+// BenchmarkScenario is a benchmark request run on a schedule
+type BenchmarkScenario struct {
+	Name string
+	// Spec is the cron expression Schedule was parsed from
+	Spec     string
+	Schedule *cron.Schedule
+	Request  *proto.BenchmarkRequest
+	// Alert, when set, notifies a webhook of runs that regress
+	Alert *BenchmarkAlert
+}
+
+// scheduledBenchmarks tracks the scenarios run on a schedule
+type scheduledBenchmarks struct {
+	scenarios []BenchmarkScenario
+	store     *history.BenchmarkStore
+
+	mu sync.Mutex
+	// next is when each scenario runs next, and running the job of each
+	// scenario with a run in progress
+	next    map[string]time.Time
+	running map[string]string
+}
+
+// ScheduleBenchmarks runs each scenario whenever its schedule fires until ctx
+// is done, recording the runs in store for ListBenchmarks. A scenario still
+// running when its schedule fires skips that time. Scheduled runs wait in the
+// benchmark queue like any other run, and are bound by the default maximum
+// duration but not the other benchmark limits.
+func (s *BenchmarkService) ScheduleBenchmarks(ctx context.Context, scenarios []BenchmarkScenario, store *history.BenchmarkStore) error {
+	names := make(map[string]bool, len(scenarios))
+	for _, scenario := range scenarios {
+		switch {
+		case scenario.Name == "":
+			return fmt.Errorf("benchmark scenario has no name")
+		case names[scenario.Name]:
+			return fmt.Errorf("benchmark scenario %s is defined twice", scenario.Name)
+		case benchmarkRequests(scenario.Request) == 0:
+			return fmt.Errorf("benchmark scenario %s makes no requests", scenario.Name)
+		}
+		if err := validateBenchmark(scenario.Request); err != nil {
+			return fmt.Errorf("benchmark scenario %s: %s", scenario.Name, status.Convert(err).Message())
+		}
+		names[scenario.Name] = true
+	}
+
+	s.scheduled = &scheduledBenchmarks{
+		scenarios: scenarios,
+		store:     store,
+		next:      make(map[string]time.Time, len(scenarios)),
+		running:   make(map[string]string),
+	}
+	for _, scenario := range scenarios {
+		go s.runScenario(ctx, scenario)
+	}
+	return nil
+}
+
+// runScenario runs a scenario whenever its schedule fires until ctx is done
+func (s *BenchmarkService) runScenario(ctx context.Context, scenario BenchmarkScenario) {
+	for {
+		next := scenario.Schedule.Next(time.Now())
+		if next.IsZero() {
+			log.Printf("Benchmark scenario %s is never scheduled", scenario.Name)
+			return
+		}
+		s.scheduled.mu.Lock()
+		s.scheduled.next[scenario.Name] = next
+		s.scheduled.mu.Unlock()
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err := s.runScheduledBenchmark(ctx, scenario); err != nil {
+			log.Printf("Failed to run benchmark scenario %s: %v", scenario.Name, err)
+		}
+	}
+}
+
+// runScheduledBenchmark runs a scenario once as a benchmark job and records
+// its results
+func (s *BenchmarkService) runScheduledBenchmark(ctx context.Context, scenario BenchmarkScenario) error {
+	req := scenario.Request
+	job, jobCtx, err := s.benchmarkJobs.create(req, benchmarkRequests(req))
+	if err != nil {
+		return err
+	}
+	defer job.cancel()
+	stop := context.AfterFunc(ctx, job.cancel)
+	defer stop()
+
+	s.scheduled.mu.Lock()
+	delete(s.scheduled.next, scenario.Name)
+	s.scheduled.running[scenario.Name] = job.id
+	s.scheduled.mu.Unlock()
+	defer func() {
+		s.scheduled.mu.Lock()
+		delete(s.scheduled.running, scenario.Name)
+		s.scheduled.mu.Unlock()
+	}()
+
+	if err := s.benchmarkQueue.acquire(jobCtx); err != nil {
+		job.finish(proto.BenchmarkJobState_BENCHMARK_JOB_STATE_CANCELLED, nil)
+		return err
+	}
+	defer s.benchmarkQueue.release()
+
+	runCtx, cancel := withMaxDuration(jobCtx, s.benchmarkLimits.defaults.MaxDuration)
+	defer cancel()
+
+	job.setState(proto.BenchmarkJobState_BENCHMARK_JOB_STATE_RUNNING)
+	startedAt := time.Now()
+	results := s.runBenchmark(runCtx, req, job.observe)
+	state := proto.BenchmarkJobState_BENCHMARK_JOB_STATE_SUCCEEDED
+	if runCtx.Err() != nil {
+		state = proto.BenchmarkJobState_BENCHMARK_JOB_STATE_CANCELLED
+	}
+	job.finish(state, results)
+
+	// Runs cut short by shutdown are not representative
+	if ctx.Err() != nil {
+		return nil
+	}
+	encoded, err := protojson.Marshal(results)
+	if err != nil {
+		return err
+	}
+	run, err := s.scheduled.store.Append(history.BenchmarkRun{
+		Scenario:   scenario.Name,
+		JobID:      job.id,
+		StartedAt:  startedAt.UnixMilli(),
+		FinishedAt: time.Now().UnixMilli(),
+		Cancelled:  state == proto.BenchmarkJobState_BENCHMARK_JOB_STATE_CANCELLED,
+		Results:    encoded,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Cancelled runs cover only part of the workload, so are not compared
+	if scenario.Alert != nil && !run.Cancelled {
+		if err := s.alertOnRegression(ctx, scenario, run, results); err != nil {
+			return fmt.Errorf("failed to send regression alert: %v", err)
+		}
+	}
+	return nil
+}
+
+// ListBenchmarks returns a page of the runs of the scheduled benchmark
+// scenarios, newest first, along with the scenarios
+func (s *BenchmarkService) ListBenchmarks(ctx context.Context, req *proto.ListBenchmarksRequest) (*proto.ListBenchmarksResponse, error) {
+	if s.scheduled == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no benchmarks are scheduled on this server")
+	}
+	pageSize := defaultBenchmarkPageSize
+	if req.PageSize > 0 {
+		if req.PageSize > maxBenchmarkPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "page_size must be at most %d", maxBenchmarkPageSize)
+		}
+		pageSize = int(req.PageSize)
+	}
+	var before uint64
+	if req.PageToken != "" {
+		var err error
+		if before, err = strconv.ParseUint(req.PageToken, 10, 64); err != nil || before == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q", req.PageToken)
+		}
+	}
+
+	runs, more, err := s.scheduled.store.List(req.Scenario, before, pageSize, !req.ExcludeResults)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list benchmark runs: %v", err)
+	}
+	resp := &proto.ListBenchmarksResponse{Runs: make([]*proto.ScheduledBenchmarkRun, 0, len(runs))}
+	for _, run := range runs {
+		scheduled := &proto.ScheduledBenchmarkRun{
+			RunId:            run.ID,
+			Scenario:         run.Scenario,
+			JobId:            run.JobID,
+			State:            proto.BenchmarkJobState_BENCHMARK_JOB_STATE_SUCCEEDED,
+			StartedAtUnixMs:  run.StartedAt,
+			FinishedAtUnixMs: run.FinishedAt,
+		}
+		if run.Cancelled {
+			scheduled.State = proto.BenchmarkJobState_BENCHMARK_JOB_STATE_CANCELLED
+		}
+		if !req.ExcludeResults {
+			scheduled.Results = &proto.BenchmarkResults{}
+			if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(run.Results, scheduled.Results); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to decode results of run %d: %v", run.ID, err)
+			}
+		}
+		resp.Runs = append(resp.Runs, scheduled)
+	}
+	if more {
+		resp.NextPageToken = strconv.FormatUint(runs[len(runs)-1].ID, 10)
+	}
+
+	s.scheduled.mu.Lock()
+	defer s.scheduled.mu.Unlock()
+	for _, scenario := range s.scheduled.scenarios {
+		info := &proto.BenchmarkScenario{
+			Name:         scenario.Name,
+			Schedule:     scenario.Spec,
+			Request:      scenario.Request,
+			RunningJobId: s.scheduled.running[scenario.Name],
+		}
+		if next, ok := s.scheduled.next[scenario.Name]; ok {
+			info.NextRunUnixMs = next.UnixMilli()
+		}
+		resp.Scenarios = append(resp.Scenarios, info)
+	}
+	return resp, nil
+}
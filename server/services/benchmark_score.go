@@ -0,0 +1,45 @@
+package services
+
+import (
+	"encoding/json"
+	"math"
+)
+
+// geometricMean returns the geometric mean of the positive values, ignoring
+// categories that were not measured, or zero if there are none. Unlike the
+// arithmetic mean it treats a 2x speedup and a 2x slowdown symmetrically.
+func geometricMean(values []float64) float64 {
+	var logSum float64
+	var n int
+	for _, v := range values {
+		if v <= 0 {
+			continue
+		}
+		logSum += math.Log(v)
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+	return math.Exp(logSum / float64(n))
+}
+
+// normalizedSpeedup compares the transports' response times per payload byte,
+// so that a transport is not credited for being fast only because it returns
+// less data. It returns zero unless both transports measured times and sizes.
+func normalizedSpeedup(grpcMs, grpcBytes, jsonrpcMs, jsonrpcBytes uint64) float64 {
+	if grpcMs == 0 || grpcBytes == 0 || jsonrpcMs == 0 || jsonrpcBytes == 0 {
+		return 0
+	}
+	return (float64(jsonrpcMs) / float64(jsonrpcBytes)) / (float64(grpcMs) / float64(grpcBytes))
+}
+
+// jsonSize returns the size of a JSON-RPC result encoded as JSON, an estimate
+// of the payload the upstream sent for it
+func jsonSize(result interface{}) uint64 {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return 0
+	}
+	return uint64(len(data))
+}
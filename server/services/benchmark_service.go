@@ -4,38 +4,92 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"github.com/i-tozer/solana-grpc-exploration/server/history"
+	"github.com/i-tozer/solana-grpc-exploration/server/hub"
+	"github.com/i-tozer/solana-grpc-exploration/server/slo"
+	"github.com/i-tozer/solana-grpc-exploration/server/stats"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"github.com/i-tozer/solana-grpc-exploration/server/yellowstone"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
+// defaultOutlierFraction is the fraction of samples adjusted in each tail when
+// outlier handling is requested without a fraction
+const defaultOutlierFraction = 0.01
+
 // BenchmarkService implements the gRPC benchmark service
 type BenchmarkService struct {
 	proto.UnimplementedBenchmarkServiceServer
-	solanaClient *rpc.Client
-	rpcEndpoint  string
+	solanaClient    *rpc.Client
+	endpoint        upstream.Endpoint
+	writeVersions   *writeVersionTracker
+	ackSessions     *ackSessionStore
+	benchmarkJobs   *benchmarkJobStore
+	benchmarkQueue  *benchmarkQueue
+	benchmarkCache  *benchmarkCache
+	benchmarkLimits *benchmarkLimiter
+	gateway         *yellowstone.Gateway
+	history         *history.Recorder
+	wsMonitor       *upstream.WSMonitor
+	slos            *slo.Tracker
+	scheduled       *scheduledBenchmarks
+	wsFallbackPoll  time.Duration
+	blocks          *hub.Hub[blockEvent]
+	ownedAccounts   *ownedSets
+	scheduler       *upstreamScheduler
+	streamLimits    *streamLimiter
+	streamReaper    *streamReaper
+	region          string
+	features        *features.Set
+	// blocksPushed is set while block subscriptions deliver notifications
+	blocksPushed atomic.Bool
 }
 
 // NewBenchmarkService creates a new benchmark service
-func NewBenchmarkService(rpcEndpoint string) *BenchmarkService {
-	client := rpc.New(rpcEndpoint)
-	return &BenchmarkService{
-		solanaClient: client,
-		rpcEndpoint:  rpcEndpoint,
+func NewBenchmarkService(endpoint upstream.Endpoint) *BenchmarkService {
+	client := endpoint.NewRPCClient()
+	s := &BenchmarkService{
+		solanaClient:    client,
+		endpoint:        endpoint,
+		writeVersions:   newWriteVersionTracker(),
+		ackSessions:     newAckSessionStore(),
+		benchmarkJobs:   newBenchmarkJobStore(),
+		benchmarkQueue:  newBenchmarkQueue(defaultMaxConcurrentBenchmarks),
+		benchmarkCache:  newBenchmarkCache(defaultBenchmarkCacheTTL),
+		benchmarkLimits: newBenchmarkLimiter(DefaultBenchmarkLimits, nil),
+		scheduler:       newUpstreamScheduler(),
+		streamLimits:    newStreamLimiter(DefaultStreamLimits, nil),
+		streamReaper:    &streamReaper{StreamReaping: DefaultStreamReaping},
+		features:        features.Defaults(),
+		wsFallbackPoll:  DefaultWSFallbackPollInterval,
+		ownedAccounts:   newOwnedSets(),
 	}
+	s.blocks = hub.New[blockEvent](blockFeedBuffer, s.startBlockFeed)
+	return s
 }
 
 // GetAccountInfo retrieves account information and measures performance
 func (s *BenchmarkService) GetAccountInfo(ctx context.Context, req *proto.AccountInfoRequest) (*proto.AccountInfoResponse, error) {
+	ctx, timing := startTiming(ctx)
 	pubkey, err := solana.PublicKeyFromBase58(req.Pubkey)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid pubkey: %v", err)
 	}
+	mask, err := parseFieldMask(req.FieldMask, &proto.AccountInfoResponse{})
+	if err != nil {
+		return nil, err
+	}
 
 	startTime := time.Now()
 
@@ -46,6 +100,7 @@ func (s *BenchmarkService) GetAccountInfo(ctx context.Context, req *proto.Accoun
 	}
 
 	responseTime := time.Since(startTime).Milliseconds()
+	built := time.Now()
 
 	// Convert account info to response
 	response := &proto.AccountInfoResponse{
@@ -57,16 +112,23 @@ func (s *BenchmarkService) GetAccountInfo(ctx context.Context, req *proto.Accoun
 		RentEpoch:      accountInfo.Value.RentEpoch,
 		ResponseTimeMs: uint64(responseTime),
 	}
+	response.Timing = timing.breakdown(built, response)
+	mask.apply(response)
 
 	return response, nil
 }
 
 // GetTransaction retrieves transaction information and measures performance
 func (s *BenchmarkService) GetTransaction(ctx context.Context, req *proto.TransactionRequest) (*proto.TransactionResponse, error) {
+	ctx, timing := startTiming(ctx)
 	signature, err := solana.SignatureFromBase58(req.Signature)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid signature: %v", err)
 	}
+	mask, err := parseFieldMask(req.FieldMask, &proto.TransactionResponse{})
+	if err != nil {
+		return nil, err
+	}
 
 	startTime := time.Now()
 
@@ -77,6 +139,7 @@ func (s *BenchmarkService) GetTransaction(ctx context.Context, req *proto.Transa
 	}
 
 	responseTime := time.Since(startTime).Milliseconds()
+	built := time.Now()
 
 	// Convert transaction to response
 	response := &proto.TransactionResponse{
@@ -86,33 +149,34 @@ func (s *BenchmarkService) GetTransaction(ctx context.Context, req *proto.Transa
 		Success:        tx.Meta.Err == nil,
 		ResponseTimeMs: uint64(responseTime),
 	}
+	response.Timing = timing.breakdown(built, response)
+	mask.apply(response)
+	mask.hash(response)
 
 	return response, nil
 }
 
 // GetBlock retrieves block information and measures performance
 func (s *BenchmarkService) GetBlock(ctx context.Context, req *proto.BlockRequest) (*proto.BlockResponse, error) {
+	ctx, timing := startTiming(ctx)
+	mask, err := parseFieldMask(req.FieldMask, &proto.BlockResponse{})
+	if err != nil {
+		return nil, err
+	}
+	opts, err := blockOpts(req.Detail)
+	if err != nil {
+		return nil, err
+	}
 	startTime := time.Now()
 
-	// Get block
-	block, err := s.solanaClient.GetBlock(ctx, uint64(req.Slot))
+	// Get block at the requested detail tier
+	block, err := s.solanaClient.GetBlockWithOpts(ctx, uint64(req.Slot), opts)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get block: %v", err)
 	}
 
 	responseTime := time.Since(startTime).Milliseconds()
-
-	// Extract transaction signatures
-	txSigs := make([]string, 0, len(block.Transactions))
-	for _, tx := range block.Transactions {
-		txWithMeta, err := tx.GetTransaction()
-		if err != nil {
-			continue
-		}
-		if len(txWithMeta.Signatures) > 0 {
-			txSigs = append(txSigs, txWithMeta.Signatures[0].String())
-		}
-	}
+	built := time.Now()
 
 	// Convert block to response
 	response := &proto.BlockResponse{
@@ -120,249 +184,782 @@ func (s *BenchmarkService) GetBlock(ctx context.Context, req *proto.BlockRequest
 		Blockhash:         block.Blockhash.String(),
 		PreviousBlockhash: block.PreviousBlockhash.String(),
 		ParentSlot:        block.ParentSlot,
-		Transactions:      txSigs,
+		Transactions:      blockSignatures(block, req.Detail),
 		ResponseTimeMs:    uint64(responseTime),
+		Rewards:           uint32(len(block.Rewards)),
 	}
+	response.Timing = timing.breakdown(built, response)
+	mask.apply(response)
+	mask.hash(response)
 
 	return response, nil
 }
 
-// StreamAccountUpdates streams account updates in real-time
+// StreamAccountUpdates streams account updates in real-time, pushed by
+// upstream WebSocket subscriptions or a Yellowstone gateway, or polled when
+// neither is available, until the client disconnects
 func (s *BenchmarkService) StreamAccountUpdates(req *proto.AccountStreamRequest, stream proto.BenchmarkService_StreamAccountUpdatesServer) error {
-	// Convert pubkeys to solana.PublicKey
-	pubkeys := make([]solana.PublicKey, 0, len(req.Pubkeys))
-	for _, pubkeyStr := range req.Pubkeys {
-		pubkey, err := solana.PublicKeyFromBase58(pubkeyStr)
+	if err := rejectBatching(req); err != nil {
+		return err
+	}
+	return s.streamAccounts(stream.Context(), "StreamAccountUpdates", req, func(update *proto.AccountUpdate) error {
+		err := stream.Send(update)
+		releaseAccountUpdate(update)
 		if err != nil {
-			return status.Errorf(codes.InvalidArgument, "invalid pubkey: %v", err)
+			return status.Errorf(codes.Internal, "failed to send account update: %v", err)
 		}
-		pubkeys = append(pubkeys, pubkey)
+		return nil
+	})
+}
+
+// StreamAccountUpdateBatches streams the updates of StreamAccountUpdates,
+// coalesced into batches
+func (s *BenchmarkService) StreamAccountUpdateBatches(req *proto.AccountStreamRequest, stream proto.BenchmarkService_StreamAccountUpdateBatchesServer) error {
+	class, err := parseStreamQoS(req.Qos)
+	if err != nil {
+		return err
+	}
+	opts, err := parseBatchOptions(req.BatchMaxMessages, req.BatchMaxWaitMs)
+	if err != nil {
+		return err
+	}
+	if req.BatchMaxMessages == 0 {
+		opts = class.batch
 	}
 
-	// For demo purposes, we'll simulate account updates
-	// In a real implementation, you would use WebSocket subscriptions
-	for i := 0; i < 10; i++ {
-		for _, pubkey := range pubkeys {
-			// Get account info
-			accountInfo, err := s.solanaClient.GetAccountInfo(context.Background(), pubkey)
-			if err != nil {
-				log.Printf("Error getting account info: %v", err)
-				continue
+	batch := newBatcher(opts, func(updates []*proto.AccountUpdate) error {
+		err := stream.Send(&proto.AccountUpdateBatch{Updates: updates})
+		for _, update := range updates {
+			releaseAccountUpdate(update)
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to send account updates: %v", err)
+		}
+		return nil
+	})
+	defer batch.stop()
+	return s.streamAccounts(stream.Context(), "StreamAccountUpdateBatches", req, batch.add)
+}
+
+// streamAccounts streams the updates of an account stream request to send,
+// which owns each update it is given
+func (s *BenchmarkService) streamAccounts(ctx context.Context, method string, req *proto.AccountStreamRequest, send func(*proto.AccountUpdate) error) error {
+	set, err := newAccountSet(req)
+	if err != nil {
+		return err
+	}
+
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return err
+	}
+	if err := s.checkGatewayCommitment(commitment); err != nil {
+		return err
+	}
+	class, err := parseStreamQoS(req.Qos)
+	if err != nil {
+		return err
+	}
+	mask, err := parseFieldMask(req.FieldMask, &proto.AccountUpdate{})
+	if err != nil {
+		return err
+	}
+	if mask != nil {
+		unmasked := send
+		send = func(update *proto.AccountUpdate) error {
+			mask.apply(update)
+			return unmasked(update)
+		}
+	}
+
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+
+	versions := s.writeVersions.scope()
+	defer versions.release()
+
+	// Subscribe before reading the snapshot, so no change in between is missed
+	var live *accountLiveStream
+	if s.gateway == nil {
+		live, err = s.startAccountStream(ctx, method, set, commitment, versions)
+		if err != nil {
+			return err
+		}
+		defer live.close()
+	}
+
+	// Send the current state of every account before live updates begin
+	if req.IncludeSnapshot {
+		pubkeys, err := s.resolveAccounts(ctx, set, commitment)
+		if err != nil {
+			return err
+		}
+		snapshot, err := s.readAccounts(ctx, versions, pubkeys, commitment, true)
+		if err != nil {
+			return err
+		}
+		for _, update := range snapshot {
+			if err := send(update); err != nil {
+				return err
 			}
+		}
+	}
 
-			// Send account update
-			err = stream.Send(&proto.AccountUpdate{
-				Pubkey:    pubkey.String(),
-				Data:      accountInfo.Value.Data.GetBinary(),
-				Owner:     accountInfo.Value.Owner.String(),
-				Lamports:  accountInfo.Value.Lamports,
-				Slot:      accountInfo.Context.Slot,
-				Timestamp: uint64(time.Now().Unix()),
-			})
+	// A Yellowstone gateway pushes updates, so there is nothing to poll
+	if s.gateway != nil {
+		return s.forwardGateway(ctx, versions, set, class, send)
+	}
+	return s.followAccounts(ctx, live, send)
+}
+
+// StreamAccountUpdatesWithAck streams account updates that the client must acknowledge.
+// Unacknowledged updates are buffered per session up to a limit and redelivered
+// when the client resumes the session, giving at-least-once delivery.
+func (s *BenchmarkService) StreamAccountUpdatesWithAck(stream proto.BenchmarkService_StreamAccountUpdatesWithAckServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	session, err := s.ackSessions.attach(stream.Context(), first)
+	if err != nil {
+		return err
+	}
+	defer s.ackSessions.detach(session)
+	if err := s.checkGatewayCommitment(session.commitment); err != nil {
+		return err
+	}
+	session.ack(first.AckCursor)
+
+	compression := first.Compression
+	if compression == "" {
+		compression = first.Subscription.GetCompression()
+	}
+	if err := setStreamCompression(stream.Context(), compression); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// Receive acknowledgements until the client goes away
+	go func() {
+		defer cancel()
+		for {
+			msg, err := stream.Recv()
 			if err != nil {
-				return status.Errorf(codes.Internal, "failed to send account update: %v", err)
+				return
 			}
+			session.ack(msg.AckCursor)
+		}
+	}()
+
+	// Redeliver anything the client has not acknowledged yet
+	for _, update := range session.unacked() {
+		if err := stream.Send(update); err != nil {
+			return status.Errorf(codes.Internal, "failed to send account update: %v", err)
 		}
-		time.Sleep(1 * time.Second)
 	}
 
-	return nil
-}
+	versions := s.writeVersions.scope()
+	defer versions.release()
 
-// StreamTransactions streams transactions in real-time
-func (s *BenchmarkService) StreamTransactions(req *proto.TransactionStreamRequest, stream proto.BenchmarkService_StreamTransactionsServer) error {
-	// For demo purposes, we'll simulate transaction updates
-	// In a real implementation, you would use WebSocket subscriptions
-	for i := 0; i < 10; i++ {
-		// Send transaction update
-		err := stream.Send(&proto.TransactionUpdate{
-			Signature:   "simulated_signature_" + fmt.Sprint(i),
-			Slot:        uint64(100000 + i),
-			Transaction: []byte("simulated_transaction_data"),
-			Success:     true,
-			Timestamp:   uint64(time.Now().Unix()),
-		})
+	var live *accountLiveStream
+	if s.gateway == nil {
+		live, err = s.startAccountStream(ctx, "StreamAccountUpdatesWithAck", session.accounts, session.commitment, versions)
 		if err != nil {
-			return status.Errorf(codes.Internal, "failed to send transaction update: %v", err)
+			return err
 		}
-		time.Sleep(1 * time.Second)
+		defer live.close()
 	}
 
-	return nil
-}
+	// A new session starts with the current state of every account if asked
+	// to, and a resumed one always catches up with it, on this replica or
+	// after a handoff, as nothing was streamed while the session was detached
+	if first.SessionId != "" || first.Subscription.GetIncludeSnapshot() {
+		pubkeys, err := s.resolveAccounts(ctx, session.accounts, session.commitment)
+		if err != nil {
+			return err
+		}
+		snapshot, err := s.readAccounts(ctx, versions, pubkeys, session.commitment, true)
+		if err != nil {
+			return err
+		}
+		for _, update := range snapshot {
+			acked, err := session.push(ctx, update)
+			if err != nil {
+				return status.FromContextError(err).Err()
+			}
+			if err := stream.Send(acked); err != nil {
+				return status.Errorf(codes.Internal, "failed to send account snapshot: %v", err)
+			}
+		}
+	}
 
-// StreamBlocks streams blocks in real-time
-func (s *BenchmarkService) StreamBlocks(req *proto.BlockStreamRequest, stream proto.BenchmarkService_StreamBlocksServer) error {
-	// For demo purposes, we'll simulate block updates
-	// In a real implementation, you would use WebSocket subscriptions
-	for i := 0; i < 10; i++ {
-		// Send block update
-		err := stream.Send(&proto.BlockUpdate{
-			Slot:              uint64(100000 + i),
-			Blockhash:         fmt.Sprintf("simulated_blockhash_%d", i),
-			PreviousBlockhash: fmt.Sprintf("simulated_previous_blockhash_%d", i-1),
-			ParentSlot:        uint64(100000 + i - 1),
-			Timestamp:         uint64(time.Now().Unix()),
+	if s.gateway != nil {
+		// The session keeps updates for redelivery, so they are not released
+		return s.forwardGateway(ctx, versions, session.accounts, session.class, func(update *proto.AccountUpdate) error {
+			acked, err := session.push(ctx, update)
+			if err != nil {
+				return status.FromContextError(err).Err()
+			}
+			if err := stream.Send(acked); err != nil {
+				return status.Errorf(codes.Internal, "failed to send account update: %v", err)
+			}
+			return nil
 		})
+	}
+
+	return s.followAccounts(ctx, live, func(update *proto.AccountUpdate) error {
+		acked, err := session.push(ctx, update)
 		if err != nil {
-			return status.Errorf(codes.Internal, "failed to send block update: %v", err)
+			return status.FromContextError(err).Err()
 		}
-		time.Sleep(1 * time.Second)
+		if err := stream.Send(acked); err != nil {
+			return status.Errorf(codes.Internal, "failed to send account update: %v", err)
+		}
+		return nil
+	})
+}
+
+// pollAccounts reads one round of updates for an account set. Errors are logged
+// and the round is skipped so a transient RPC failure does not end the stream.
+func (s *BenchmarkService) pollAccounts(ctx context.Context, versions *writeVersionScope, set *accountSet, commitment rpc.CommitmentType) []*proto.AccountUpdate {
+	pubkeys, err := s.resolveAccounts(ctx, set, commitment)
+	if err != nil {
+		log.Printf("Error resolving account set: %v", err)
+		return nil
 	}
 
-	return nil
+	updates, err := s.readAccounts(ctx, versions, pubkeys, commitment, false)
+	if err != nil {
+		log.Printf("Error getting account info: %v", err)
+		return nil
+	}
+	return updates
+}
+
+// parseCommitment converts a commitment name to an RPC commitment level,
+// defaulting to finalized
+func parseCommitment(commitment string) (rpc.CommitmentType, error) {
+	switch rpc.CommitmentType(commitment) {
+	case "":
+		return rpc.CommitmentFinalized, nil
+	case rpc.CommitmentProcessed, rpc.CommitmentConfirmed, rpc.CommitmentFinalized:
+		return rpc.CommitmentType(commitment), nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid commitment: %s", commitment)
+	}
+}
+
+// parsePubkeys converts base58 strings to public keys
+func parsePubkeys(pubkeyStrs []string) ([]solana.PublicKey, error) {
+	pubkeys := make([]solana.PublicKey, 0, len(pubkeyStrs))
+	for _, pubkeyStr := range pubkeyStrs {
+		pubkey, err := solana.PublicKeyFromBase58(pubkeyStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pubkey: %v", err)
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	return pubkeys, nil
 }
 
 // RunBenchmark runs a comprehensive benchmark suite and returns results
 func (s *BenchmarkService) RunBenchmark(ctx context.Context, req *proto.BenchmarkRequest) (*proto.BenchmarkResults, error) {
+	if err := validateBenchmark(req); err != nil {
+		return nil, err
+	}
+	if err := s.benchmarkLimits.check(ctx, req); err != nil {
+		return nil, err
+	}
+
+	key := benchmarkCacheKey(req)
+	if !req.BypassCache {
+		if cached := s.benchmarkCache.get(key); cached != nil {
+			return cached, nil
+		}
+	}
+
+	maxDuration, release, err := s.benchmarkLimits.admit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Wait for the benchmarks ahead of this one
+	if err := s.benchmarkQueue.acquire(ctx); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	defer s.benchmarkQueue.release()
+
+	runCtx, cancel := withMaxDuration(ctx, maxDuration)
+	defer cancel()
+	results := s.runBenchmark(runCtx, req, func(string, int, bool, uint64) {})
+	if !results.Truncated {
+		s.benchmarkCache.put(key, results)
+	}
+	return results, nil
+}
+
+// validateBenchmark checks the options of a benchmark request
+func validateBenchmark(req *proto.BenchmarkRequest) error {
+	if _, err := outlierFraction(req); err != nil {
+		return err
+	}
+	if req.TargetRate < 0 {
+		return status.Errorf(codes.InvalidArgument, "target rate must not be negative, got %v", req.TargetRate)
+	}
+	if _, err := blockTiers(req); err != nil {
+		return err
+	}
+	return nil
+}
+
+// runBenchmark runs a validated benchmark request, notifying observe as each
+// benchmark request completes
+func (s *BenchmarkService) runBenchmark(ctx context.Context, req *proto.BenchmarkRequest, observe benchmarkObserver) *proto.BenchmarkResults {
+	// Interactive calls are served ahead of the run's upstream calls
+	ctx = upstream.WithPriority(ctx, upstream.PriorityBenchmark)
+	fraction, _ := outlierFraction(req)
+	tiers, _ := blockTiers(req)
+	// Probed before the run, so the probes do not count toward it
+	environment := s.benchmarkEnvironment(ctx, req)
 	startTime := time.Now()
 
 	results := &proto.BenchmarkResults{
-		AccountGrpc:        &proto.AccountBenchmark{},
-		AccountJsonrpc:     &proto.AccountBenchmark{},
-		TransactionGrpc:    &proto.TransactionBenchmark{},
-		TransactionJsonrpc: &proto.TransactionBenchmark{},
-		BlockGrpc:          &proto.BlockBenchmark{},
-		BlockJsonrpc:       &proto.BlockBenchmark{},
-		Summary:            &proto.BenchmarkSummary{},
+		AccountGrpc:         &proto.AccountBenchmark{},
+		AccountJsonrpc:      &proto.AccountBenchmark{},
+		TransactionGrpc:     &proto.TransactionBenchmark{},
+		TransactionJsonrpc:  &proto.TransactionBenchmark{},
+		BlockGrpc:           &proto.BlockBenchmark{},
+		BlockJsonrpc:        &proto.BlockBenchmark{},
+		ClusterNodesGrpc:    &proto.ClusterNodesBenchmark{},
+		ClusterNodesJsonrpc: &proto.ClusterNodesBenchmark{},
+		Summary:             &proto.BenchmarkSummary{},
+		Seed:                req.Seed,
+		Environment:         environment,
 	}
 
+	// Raw response time samples, used to test the significance of differences
+	var accountGrpcSamples, accountJsonrpcSamples []float64
+	var transactionGrpcSamples, transactionJsonrpcSamples []float64
+	var blockGrpcSamples, blockJsonrpcSamples []float64
+	var clusterNodesGrpcSamples, clusterNodesJsonrpcSamples []float64
+
+	// Benchmarks run concurrently unless runtime stats are collected. Those
+	// are process-wide, so each benchmark then runs alone to be attributed.
 	var wg sync.WaitGroup
+	run := func(runtimeStats **proto.RuntimeStats, benchmark func()) {
+		if req.CollectRuntimeStats {
+			// Start from a clean heap so garbage left by the previous
+			// benchmark is not collected on this one's account
+			runtime.GC()
+			start := takeRuntimeSnapshot()
+			benchmark()
+			*runtimeStats = start.since()
+			return
+		}
 
-	// Run account benchmarks
-	if len(req.TestAccounts) > 0 && req.RunGrpcTests {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			s.runAccountGrpcBenchmark(ctx, req, results.AccountGrpc)
+			benchmark()
 		}()
 	}
 
+	// Run account benchmarks
+	if len(req.TestAccounts) > 0 && req.RunGrpcTests {
+		run(&results.AccountGrpc.Runtime, func() {
+			accountGrpcSamples = s.runAccountGrpcBenchmark(ctx, req, results.AccountGrpc, observe.benchmark("account gRPC"))
+		})
+	}
+
 	if len(req.TestAccounts) > 0 && req.RunJsonrpcTests {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			s.runAccountJsonRpcBenchmark(ctx, req, results.AccountJsonrpc)
-		}()
+		run(&results.AccountJsonrpc.Runtime, func() {
+			accountJsonrpcSamples = s.runAccountJsonRpcBenchmark(ctx, req, results.AccountJsonrpc, observe.benchmark("account JSON-RPC"))
+		})
 	}
 
 	// Run transaction benchmarks
 	if len(req.TestSignatures) > 0 && req.RunGrpcTests {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			s.runTransactionGrpcBenchmark(ctx, req, results.TransactionGrpc)
-		}()
+		run(&results.TransactionGrpc.Runtime, func() {
+			transactionGrpcSamples = s.runTransactionGrpcBenchmark(ctx, req, results.TransactionGrpc, observe.benchmark("transaction gRPC"))
+		})
 	}
 
 	if len(req.TestSignatures) > 0 && req.RunJsonrpcTests {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			s.runTransactionJsonRpcBenchmark(ctx, req, results.TransactionJsonrpc)
-		}()
+		run(&results.TransactionJsonrpc.Runtime, func() {
+			transactionJsonrpcSamples = s.runTransactionJsonRpcBenchmark(ctx, req, results.TransactionJsonrpc, observe.benchmark("transaction JSON-RPC"))
+		})
 	}
 
-	// Run block benchmarks
-	if len(req.TestSlots) > 0 && req.RunGrpcTests {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			s.runBlockGrpcBenchmark(ctx, req, results.BlockGrpc)
-		}()
+	// Run block benchmarks at each detail tier. The first tier's results
+	// are the block results the summary is computed from.
+	for t, detail := range tiers {
+		tier := &proto.BlockTierBenchmark{Detail: detail, Grpc: results.BlockGrpc, Jsonrpc: results.BlockJsonrpc}
+		name := "block"
+		if len(req.BlockDetails) > 0 {
+			results.BlockTiers = append(results.BlockTiers, tier)
+			name = "block " + blockTierName(detail)
+		}
+		if t > 0 {
+			tier.Grpc, tier.Jsonrpc = &proto.BlockBenchmark{}, &proto.BlockBenchmark{}
+		}
+
+		if len(req.TestSlots) > 0 && req.RunGrpcTests {
+			run(&tier.Grpc.Runtime, func() {
+				samples := s.runBlockGrpcBenchmark(ctx, req, detail, tier.Grpc, observe.benchmark(name+" gRPC"))
+				if t == 0 {
+					blockGrpcSamples = samples
+				}
+			})
+		}
+
+		if len(req.TestSlots) > 0 && req.RunJsonrpcTests {
+			run(&tier.Jsonrpc.Runtime, func() {
+				samples := s.runBlockJsonRpcBenchmark(ctx, req, detail, tier.Jsonrpc, observe.benchmark(name+" JSON-RPC"))
+				if t == 0 {
+					blockJsonrpcSamples = samples
+				}
+			})
+		}
 	}
 
-	if len(req.TestSlots) > 0 && req.RunJsonrpcTests {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			s.runBlockJsonRpcBenchmark(ctx, req, results.BlockJsonrpc)
-		}()
+	// Run cluster nodes benchmarks
+	if req.RunClusterNodes && req.RunGrpcTests {
+		run(&results.ClusterNodesGrpc.Runtime, func() {
+			clusterNodesGrpcSamples = s.runClusterNodesGrpcBenchmark(ctx, req, results.ClusterNodesGrpc, observe.benchmark("cluster nodes gRPC"))
+		})
+	}
+
+	if req.RunClusterNodes && req.RunJsonrpcTests {
+		run(&results.ClusterNodesJsonrpc.Runtime, func() {
+			clusterNodesJsonrpcSamples = s.runClusterNodesJsonRpcBenchmark(ctx, req, results.ClusterNodesJsonrpc, observe.benchmark("cluster nodes JSON-RPC"))
+		})
 	}
 
 	wg.Wait()
 
+	results.Truncated = results.AccountGrpc.Truncated || results.AccountJsonrpc.Truncated ||
+		results.TransactionGrpc.Truncated || results.TransactionJsonrpc.Truncated ||
+		results.BlockGrpc.Truncated || results.BlockJsonrpc.Truncated ||
+		results.ClusterNodesGrpc.Truncated || results.ClusterNodesJsonrpc.Truncated
+	for _, tier := range results.BlockTiers {
+		tier.Speedup = speedup(tier.Grpc.AvgResponseTimeMs, tier.Jsonrpc.AvgResponseTimeMs)
+		results.Truncated = results.Truncated || tier.Grpc.Truncated || tier.Jsonrpc.Truncated
+	}
+
 	// Calculate summary
 	totalDuration := time.Since(startTime).Milliseconds()
 	results.Summary.TotalDurationMs = uint64(totalDuration)
 
-	// Calculate speedup
-	if results.AccountJsonrpc.AvgResponseTimeMs > 0 && results.AccountGrpc.AvgResponseTimeMs > 0 {
-		speedup := float64(results.AccountJsonrpc.AvgResponseTimeMs) / float64(results.AccountGrpc.AvgResponseTimeMs)
-		results.Summary.GrpcVsJsonrpcSpeedup = speedup
+	// Report statistics after outlier handling alongside the raw ones. The
+	// significance tests then use the adjusted samples too.
+	if req.OutlierHandling != proto.OutlierHandling_OUTLIER_HANDLING_NONE {
+		for _, category := range []struct {
+			samples  *[]float64
+			adjusted **proto.LatencyStats
+		}{
+			{&accountGrpcSamples, &results.AccountGrpc.Adjusted},
+			{&accountJsonrpcSamples, &results.AccountJsonrpc.Adjusted},
+			{&transactionGrpcSamples, &results.TransactionGrpc.Adjusted},
+			{&transactionJsonrpcSamples, &results.TransactionJsonrpc.Adjusted},
+			{&blockGrpcSamples, &results.BlockGrpc.Adjusted},
+			{&blockJsonrpcSamples, &results.BlockJsonrpc.Adjusted},
+			{&clusterNodesGrpcSamples, &results.ClusterNodesGrpc.Adjusted},
+			{&clusterNodesJsonrpcSamples, &results.ClusterNodesJsonrpc.Adjusted},
+		} {
+			if len(*category.samples) == 0 {
+				continue
+			}
+			*category.samples = adjustOutliers(req.OutlierHandling, fraction, *category.samples)
+			*category.adjusted = latencyStats(*category.samples)
+		}
+	}
 
-		if speedup > 1.0 {
-			results.Summary.Conclusion = fmt.Sprintf("gRPC is %.2fx faster than JSON-RPC for Solana operations", speedup)
-		} else {
-			results.Summary.Conclusion = fmt.Sprintf("JSON-RPC is %.2fx faster than gRPC for Solana operations", 1/speedup)
+	// Calculate speedup per category
+	results.Summary.AccountSpeedup = speedup(results.AccountGrpc.AvgResponseTimeMs, results.AccountJsonrpc.AvgResponseTimeMs)
+	results.Summary.TransactionSpeedup = speedup(results.TransactionGrpc.AvgResponseTimeMs, results.TransactionJsonrpc.AvgResponseTimeMs)
+	results.Summary.BlockSpeedup = speedup(results.BlockGrpc.AvgResponseTimeMs, results.BlockJsonrpc.AvgResponseTimeMs)
+	results.Summary.ClusterNodesSpeedup = speedup(results.ClusterNodesGrpc.AvgResponseTimeMs, results.ClusterNodesJsonrpc.AvgResponseTimeMs)
+	results.Summary.GrpcVsJsonrpcSpeedup = results.Summary.AccountSpeedup
+
+	// Test whether each category's difference is more than noise
+	categories := []struct {
+		test    *proto.SignificanceTest
+		speedup float64
+		weight  uint32
+	}{
+		{
+			significanceTest("accounts", accountGrpcSamples, accountJsonrpcSamples),
+			results.Summary.AccountSpeedup,
+			pairs(results.AccountGrpc.SuccessfulRequests, results.AccountJsonrpc.SuccessfulRequests),
+		},
+		{
+			significanceTest("transactions", transactionGrpcSamples, transactionJsonrpcSamples),
+			results.Summary.TransactionSpeedup,
+			pairs(results.TransactionGrpc.SuccessfulRequests, results.TransactionJsonrpc.SuccessfulRequests),
+		},
+		{
+			significanceTest("blocks", blockGrpcSamples, blockJsonrpcSamples),
+			results.Summary.BlockSpeedup,
+			pairs(results.BlockGrpc.SuccessfulRequests, results.BlockJsonrpc.SuccessfulRequests),
+		},
+		{
+			significanceTest("cluster nodes", clusterNodesGrpcSamples, clusterNodesJsonrpcSamples),
+			results.Summary.ClusterNodesSpeedup,
+			pairs(results.ClusterNodesGrpc.SuccessfulRequests, results.ClusterNodesJsonrpc.SuccessfulRequests),
+		},
+	}
+	// Score the run as a whole, per category and per payload byte
+	results.Summary.GeometricMeanSpeedup = geometricMean([]float64{
+		results.Summary.AccountSpeedup,
+		results.Summary.TransactionSpeedup,
+		results.Summary.BlockSpeedup,
+		results.Summary.ClusterNodesSpeedup,
+	})
+	results.Summary.NormalizedScore = geometricMean([]float64{
+		normalizedSpeedup(results.AccountGrpc.AvgResponseTimeMs, results.AccountGrpc.AvgPayloadBytes, results.AccountJsonrpc.AvgResponseTimeMs, results.AccountJsonrpc.AvgPayloadBytes),
+		normalizedSpeedup(results.TransactionGrpc.AvgResponseTimeMs, results.TransactionGrpc.AvgPayloadBytes, results.TransactionJsonrpc.AvgResponseTimeMs, results.TransactionJsonrpc.AvgPayloadBytes),
+		normalizedSpeedup(results.BlockGrpc.AvgResponseTimeMs, results.BlockGrpc.AvgPayloadBytes, results.BlockJsonrpc.AvgResponseTimeMs, results.BlockJsonrpc.AvgPayloadBytes),
+		normalizedSpeedup(results.ClusterNodesGrpc.AvgResponseTimeMs, results.ClusterNodesGrpc.AvgPayloadBytes, results.ClusterNodesJsonrpc.AvgResponseTimeMs, results.ClusterNodesJsonrpc.AvgPayloadBytes),
+	})
+
+	// Weight each category's speedup by its successful request pairs. The
+	// difference only counts as noise when no measured category is significant.
+	var weighted, totalWeight float64
+	tested, significant := false, false
+	var pValue float64
+	for _, category := range categories {
+		if category.test != nil {
+			results.Summary.Significance = append(results.Summary.Significance, category.test)
+		}
+		if category.speedup == 0 {
+			continue
+		}
+		weighted += category.speedup * float64(category.weight)
+		totalWeight += float64(category.weight)
+		if category.test != nil {
+			if !tested || category.test.PValue < pValue {
+				pValue = category.test.PValue
+			}
+			tested = true
+			significant = significant || category.test.Significant
 		}
 	}
+	if totalWeight == 0 {
+		return results
+	}
+	overall := weighted / totalWeight
+	results.Summary.OverallSpeedup = overall
+
+	switch {
+	case tested && !significant:
+		results.Summary.Conclusion = fmt.Sprintf("No statistically significant difference between gRPC and JSON-RPC (observed %.2fx, p=%.3f)", overall, pValue)
+	case overall > 1.0:
+		results.Summary.Conclusion = fmt.Sprintf("gRPC is %.2fx faster than JSON-RPC for Solana operations", overall)
+	default:
+		results.Summary.Conclusion = fmt.Sprintf("JSON-RPC is %.2fx faster than gRPC for Solana operations", 1/overall)
+	}
+	if significant {
+		results.Summary.Conclusion += fmt.Sprintf(" (p=%.3f)", pValue)
+	}
 
-	return results, nil
+	return results
+}
+
+// speedup returns the JSON-RPC average response time over the gRPC one, or
+// zero unless both are set
+func speedup(grpcMs, jsonrpcMs uint64) float64 {
+	if grpcMs == 0 || jsonrpcMs == 0 {
+		return 0
+	}
+	return float64(jsonrpcMs) / float64(grpcMs)
+}
+
+// pairs returns the number of successful requests a category has on both
+// transports, its weight in the overall speedup
+func pairs(grpcRequests, jsonrpcRequests uint32) uint32 {
+	if grpcRequests < jsonrpcRequests {
+		return grpcRequests
+	}
+	return jsonrpcRequests
+}
+
+// outlierFraction validates the outlier handling options of a benchmark request
+// and returns the fraction to adjust in each tail
+func outlierFraction(req *proto.BenchmarkRequest) (float64, error) {
+	switch req.OutlierHandling {
+	case proto.OutlierHandling_OUTLIER_HANDLING_NONE:
+		return 0, nil
+	case proto.OutlierHandling_OUTLIER_HANDLING_TRIM, proto.OutlierHandling_OUTLIER_HANDLING_WINSORIZE:
+	default:
+		return 0, status.Errorf(codes.InvalidArgument, "invalid outlier handling: %v", req.OutlierHandling)
+	}
+
+	if req.OutlierFraction == 0 {
+		return defaultOutlierFraction, nil
+	}
+	if req.OutlierFraction < 0 || req.OutlierFraction >= 0.5 {
+		return 0, status.Errorf(codes.InvalidArgument, "outlier fraction must be in [0, 0.5), got %v", req.OutlierFraction)
+	}
+	return req.OutlierFraction, nil
+}
+
+// adjustOutliers trims or winsorizes response time samples
+func adjustOutliers(handling proto.OutlierHandling, fraction float64, samples []float64) []float64 {
+	if handling == proto.OutlierHandling_OUTLIER_HANDLING_WINSORIZE {
+		return stats.Winsorize(samples, fraction)
+	}
+	return stats.Trim(samples, fraction)
+}
+
+// latencyStats summarizes response time samples
+func latencyStats(samples []float64) *proto.LatencyStats {
+	summary := stats.Summarize(samples)
+	return &proto.LatencyStats{
+		AvgResponseTimeMs:    summary.Mean,
+		MinResponseTimeMs:    summary.Min,
+		MaxResponseTimeMs:    summary.Max,
+		Samples:              uint32(len(samples)),
+		P50ResponseTimeMs:    summary.P50,
+		P90ResponseTimeMs:    summary.P90,
+		P95ResponseTimeMs:    summary.P95,
+		P99ResponseTimeMs:    summary.P99,
+		StddevResponseTimeMs: summary.StdDev,
+	}
+}
+
+// significanceTest compares the gRPC and JSON-RPC samples of a category with
+// Welch's t-test, or returns nil if either set is too small to test
+func significanceTest(category string, grpcSamples, jsonrpcSamples []float64) *proto.SignificanceTest {
+	test, err := stats.WelchTTest(grpcSamples, jsonrpcSamples)
+	if err != nil {
+		return nil
+	}
+
+	return &proto.SignificanceTest{
+		Category:         category,
+		MeanDifferenceMs: test.MeanDifference,
+		CiLowMs:          test.CILow,
+		CiHighMs:         test.CIHigh,
+		ConfidenceLevel:  1 - stats.Alpha,
+		TStatistic:       test.T,
+		DegreesOfFreedom: test.DF,
+		PValue:           test.PValue,
+		Significant:      test.Significant(),
+	}
 }
 
 // Helper methods for benchmarking
 
-func (s *BenchmarkService) runAccountGrpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.AccountBenchmark) {
+func (s *BenchmarkService) runAccountGrpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.AccountBenchmark, observe observeFunc) []float64 {
 	var totalTime uint64
 	var minTime uint64 = ^uint64(0) // Max uint64 value
 	var maxTime uint64
 	var successCount uint32
+	var requests uint32
+	var payloadBytes uint64
+	var samples []float64
+	sched := newSchedule(req.TargetRate)
 
+iterations:
 	for i := 0; i < int(req.Iterations); i++ {
-		for _, account := range req.TestAccounts {
+		for _, account := range workloadOrder(req.TestAccounts, req.Seed, i) {
+			// Stop as soon as the run is cancelled or times out
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
+			intended := sched.wait(ctx)
 			resp, err := s.GetAccountInfo(ctx, &proto.AccountInfoRequest{
 				Pubkey:         account,
 				Commitment:     "finalized",
 				EncodingBinary: true,
 			})
+			// A request cut short by the run ending is not a failure
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
 
+			var responseTime uint64
 			if err == nil {
-				totalTime += resp.ResponseTimeMs
+				responseTime = sched.latencyMs(intended, resp.ResponseTimeMs)
+				payloadBytes += uint64(protobuf.Size(resp))
+				totalTime += responseTime
 				successCount++
+				samples = append(samples, float64(responseTime))
 
-				if resp.ResponseTimeMs < minTime {
-					minTime = resp.ResponseTimeMs
+				if responseTime < minTime {
+					minTime = responseTime
 				}
 
-				if resp.ResponseTimeMs > maxTime {
-					maxTime = resp.ResponseTimeMs
+				if responseTime > maxTime {
+					maxTime = responseTime
 				}
 			}
+			requests++
+			observe(i+1, err == nil, responseTime)
 		}
 	}
 
 	if successCount > 0 {
 		result.AvgResponseTimeMs = totalTime / uint64(successCount)
+		result.AvgPayloadBytes = payloadBytes / uint64(successCount)
 		result.MinResponseTimeMs = minTime
 		result.MaxResponseTimeMs = maxTime
 		result.SuccessfulRequests = successCount
-		result.FailedRequests = uint32(len(req.TestAccounts)*int(req.Iterations)) - successCount
+		result.FailedRequests = requests - successCount
+		result.Distribution = latencyStats(samples)
 	}
+
+	return samples
 }
 
-func (s *BenchmarkService) runAccountJsonRpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.AccountBenchmark) {
+func (s *BenchmarkService) runAccountJsonRpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.AccountBenchmark, observe observeFunc) []float64 {
 	var totalTime uint64
 	var minTime uint64 = ^uint64(0) // Max uint64 value
 	var maxTime uint64
 	var successCount uint32
+	var requests uint32
+	var payloadBytes uint64
+	var samples []float64
+	sched := newSchedule(req.TargetRate)
 
+iterations:
 	for i := 0; i < int(req.Iterations); i++ {
-		for _, accountStr := range req.TestAccounts {
+		for _, accountStr := range workloadOrder(req.TestAccounts, req.Seed, i) {
+			// Stop as soon as the run is cancelled or times out
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
 			account, err := solana.PublicKeyFromBase58(accountStr)
 			if err != nil {
+				requests++
+				observe(i+1, false, 0)
 				continue
 			}
 
-			startTime := time.Now()
+			startTime := sched.wait(ctx)
 
-			_, err = s.solanaClient.GetAccountInfo(ctx, account)
+			out, err := s.solanaClient.GetAccountInfo(ctx, account)
+			// A request cut short by the run ending is not a failure
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
 
 			responseTime := time.Since(startTime).Milliseconds()
 
 			if err == nil {
 				totalTime += uint64(responseTime)
+				payloadBytes += jsonSize(out)
 				successCount++
+				samples = append(samples, float64(responseTime))
 
 				if uint64(responseTime) < minTime {
 					minTime = uint64(responseTime)
@@ -372,77 +969,128 @@ func (s *BenchmarkService) runAccountJsonRpcBenchmark(ctx context.Context, req *
 					maxTime = uint64(responseTime)
 				}
 			}
+			requests++
+			observe(i+1, err == nil, uint64(responseTime))
 		}
 	}
 
 	if successCount > 0 {
 		result.AvgResponseTimeMs = totalTime / uint64(successCount)
+		result.AvgPayloadBytes = payloadBytes / uint64(successCount)
 		result.MinResponseTimeMs = minTime
 		result.MaxResponseTimeMs = maxTime
 		result.SuccessfulRequests = successCount
-		result.FailedRequests = uint32(len(req.TestAccounts)*int(req.Iterations)) - successCount
+		result.FailedRequests = requests - successCount
+		result.Distribution = latencyStats(samples)
 	}
+
+	return samples
 }
 
-func (s *BenchmarkService) runTransactionGrpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.TransactionBenchmark) {
+func (s *BenchmarkService) runTransactionGrpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.TransactionBenchmark, observe observeFunc) []float64 {
 	var totalTime uint64
 	var minTime uint64 = ^uint64(0) // Max uint64 value
 	var maxTime uint64
 	var successCount uint32
+	var requests uint32
+	var payloadBytes uint64
+	var samples []float64
+	sched := newSchedule(req.TargetRate)
 
+iterations:
 	for i := 0; i < int(req.Iterations); i++ {
-		for _, signature := range req.TestSignatures {
+		for _, signature := range workloadOrder(req.TestSignatures, req.Seed, i) {
+			// Stop as soon as the run is cancelled or times out
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
+			intended := sched.wait(ctx)
 			resp, err := s.GetTransaction(ctx, &proto.TransactionRequest{
 				Signature:  signature,
 				Commitment: "finalized",
 			})
+			// A request cut short by the run ending is not a failure
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
 
+			var responseTime uint64
 			if err == nil {
-				totalTime += resp.ResponseTimeMs
+				responseTime = sched.latencyMs(intended, resp.ResponseTimeMs)
+				payloadBytes += uint64(protobuf.Size(resp))
+				totalTime += responseTime
 				successCount++
+				samples = append(samples, float64(responseTime))
 
-				if resp.ResponseTimeMs < minTime {
-					minTime = resp.ResponseTimeMs
+				if responseTime < minTime {
+					minTime = responseTime
 				}
 
-				if resp.ResponseTimeMs > maxTime {
-					maxTime = resp.ResponseTimeMs
+				if responseTime > maxTime {
+					maxTime = responseTime
 				}
 			}
+			requests++
+			observe(i+1, err == nil, responseTime)
 		}
 	}
 
 	if successCount > 0 {
 		result.AvgResponseTimeMs = totalTime / uint64(successCount)
+		result.AvgPayloadBytes = payloadBytes / uint64(successCount)
 		result.MinResponseTimeMs = minTime
 		result.MaxResponseTimeMs = maxTime
 		result.SuccessfulRequests = successCount
-		result.FailedRequests = uint32(len(req.TestSignatures)*int(req.Iterations)) - successCount
+		result.FailedRequests = requests - successCount
+		result.Distribution = latencyStats(samples)
 	}
+
+	return samples
 }
 
-func (s *BenchmarkService) runTransactionJsonRpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.TransactionBenchmark) {
+func (s *BenchmarkService) runTransactionJsonRpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.TransactionBenchmark, observe observeFunc) []float64 {
 	var totalTime uint64
 	var minTime uint64 = ^uint64(0) // Max uint64 value
 	var maxTime uint64
 	var successCount uint32
+	var requests uint32
+	var payloadBytes uint64
+	var samples []float64
+	sched := newSchedule(req.TargetRate)
 
+iterations:
 	for i := 0; i < int(req.Iterations); i++ {
-		for _, signatureStr := range req.TestSignatures {
+		for _, signatureStr := range workloadOrder(req.TestSignatures, req.Seed, i) {
+			// Stop as soon as the run is cancelled or times out
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
 			signature, err := solana.SignatureFromBase58(signatureStr)
 			if err != nil {
+				requests++
+				observe(i+1, false, 0)
 				continue
 			}
 
-			startTime := time.Now()
+			startTime := sched.wait(ctx)
 
-			_, err = s.solanaClient.GetTransaction(ctx, signature, &rpc.GetTransactionOpts{})
+			out, err := s.solanaClient.GetTransaction(ctx, signature, &rpc.GetTransactionOpts{})
+			// A request cut short by the run ending is not a failure
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
 
 			responseTime := time.Since(startTime).Milliseconds()
 
 			if err == nil {
 				totalTime += uint64(responseTime)
+				payloadBytes += jsonSize(out)
 				successCount++
+				samples = append(samples, float64(responseTime))
 
 				if uint64(responseTime) < minTime {
 					minTime = uint64(responseTime)
@@ -452,72 +1100,123 @@ func (s *BenchmarkService) runTransactionJsonRpcBenchmark(ctx context.Context, r
 					maxTime = uint64(responseTime)
 				}
 			}
+			requests++
+			observe(i+1, err == nil, uint64(responseTime))
 		}
 	}
 
 	if successCount > 0 {
 		result.AvgResponseTimeMs = totalTime / uint64(successCount)
+		result.AvgPayloadBytes = payloadBytes / uint64(successCount)
 		result.MinResponseTimeMs = minTime
 		result.MaxResponseTimeMs = maxTime
 		result.SuccessfulRequests = successCount
-		result.FailedRequests = uint32(len(req.TestSignatures)*int(req.Iterations)) - successCount
+		result.FailedRequests = requests - successCount
+		result.Distribution = latencyStats(samples)
 	}
+
+	return samples
 }
 
-func (s *BenchmarkService) runBlockGrpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.BlockBenchmark) {
+func (s *BenchmarkService) runBlockGrpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, detail proto.BlockDetail, result *proto.BlockBenchmark, observe observeFunc) []float64 {
 	var totalTime uint64
 	var minTime uint64 = ^uint64(0) // Max uint64 value
 	var maxTime uint64
 	var successCount uint32
+	var requests uint32
+	var payloadBytes uint64
+	var samples []float64
+	sched := newSchedule(req.TargetRate)
 
+iterations:
 	for i := 0; i < int(req.Iterations); i++ {
-		for _, slot := range req.TestSlots {
+		for _, slot := range workloadOrder(req.TestSlots, req.Seed, i) {
+			// Stop as soon as the run is cancelled or times out
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
+			intended := sched.wait(ctx)
 			resp, err := s.GetBlock(ctx, &proto.BlockRequest{
 				Slot:       slot,
 				Commitment: "finalized",
+				Detail:     detail,
 			})
+			// A request cut short by the run ending is not a failure
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
 
+			var responseTime uint64
 			if err == nil {
-				totalTime += resp.ResponseTimeMs
+				responseTime = sched.latencyMs(intended, resp.ResponseTimeMs)
+				payloadBytes += uint64(protobuf.Size(resp))
+				totalTime += responseTime
 				successCount++
+				samples = append(samples, float64(responseTime))
 
-				if resp.ResponseTimeMs < minTime {
-					minTime = resp.ResponseTimeMs
+				if responseTime < minTime {
+					minTime = responseTime
 				}
 
-				if resp.ResponseTimeMs > maxTime {
-					maxTime = resp.ResponseTimeMs
+				if responseTime > maxTime {
+					maxTime = responseTime
 				}
 			}
+			requests++
+			observe(i+1, err == nil, responseTime)
 		}
 	}
 
 	if successCount > 0 {
 		result.AvgResponseTimeMs = totalTime / uint64(successCount)
+		result.AvgPayloadBytes = payloadBytes / uint64(successCount)
 		result.MinResponseTimeMs = minTime
 		result.MaxResponseTimeMs = maxTime
 		result.SuccessfulRequests = successCount
-		result.FailedRequests = uint32(len(req.TestSlots)*int(req.Iterations)) - successCount
+		result.FailedRequests = requests - successCount
+		result.Distribution = latencyStats(samples)
 	}
+
+	return samples
 }
 
-func (s *BenchmarkService) runBlockJsonRpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.BlockBenchmark) {
+func (s *BenchmarkService) runBlockJsonRpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, detail proto.BlockDetail, result *proto.BlockBenchmark, observe observeFunc) []float64 {
+	opts, _ := blockOpts(detail)
 	var totalTime uint64
 	var minTime uint64 = ^uint64(0) // Max uint64 value
 	var maxTime uint64
 	var successCount uint32
+	var requests uint32
+	var payloadBytes uint64
+	var samples []float64
+	sched := newSchedule(req.TargetRate)
 
+iterations:
 	for i := 0; i < int(req.Iterations); i++ {
-		for _, slot := range req.TestSlots {
-			startTime := time.Now()
+		for _, slot := range workloadOrder(req.TestSlots, req.Seed, i) {
+			// Stop as soon as the run is cancelled or times out
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
+			startTime := sched.wait(ctx)
 
-			_, err := s.solanaClient.GetBlock(ctx, slot)
+			out, err := s.solanaClient.GetBlockWithOpts(ctx, slot, opts)
+			// A request cut short by the run ending is not a failure
+			if ctx.Err() != nil {
+				result.Truncated = true
+				break iterations
+			}
 
 			responseTime := time.Since(startTime).Milliseconds()
 
 			if err == nil {
 				totalTime += uint64(responseTime)
+				payloadBytes += jsonSize(out)
 				successCount++
+				samples = append(samples, float64(responseTime))
 
 				if uint64(responseTime) < minTime {
 					minTime = uint64(responseTime)
@@ -527,14 +1226,135 @@ func (s *BenchmarkService) runBlockJsonRpcBenchmark(ctx context.Context, req *pr
 					maxTime = uint64(responseTime)
 				}
 			}
+			requests++
+			observe(i+1, err == nil, uint64(responseTime))
+		}
+	}
+
+	if successCount > 0 {
+		result.AvgResponseTimeMs = totalTime / uint64(successCount)
+		result.AvgPayloadBytes = payloadBytes / uint64(successCount)
+		result.MinResponseTimeMs = minTime
+		result.MaxResponseTimeMs = maxTime
+		result.SuccessfulRequests = successCount
+		result.FailedRequests = requests - successCount
+		result.Distribution = latencyStats(samples)
+	}
+
+	return samples
+}
+
+func (s *BenchmarkService) runClusterNodesGrpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.ClusterNodesBenchmark, observe observeFunc) []float64 {
+	var totalTime uint64
+	var minTime uint64 = ^uint64(0) // Max uint64 value
+	var maxTime uint64
+	var successCount uint32
+	var requests uint32
+	var payloadBytes uint64
+	var samples []float64
+	sched := newSchedule(req.TargetRate)
+
+	for i := 0; i < int(req.Iterations); i++ {
+		// Stop as soon as the run is cancelled or times out
+		if ctx.Err() != nil {
+			result.Truncated = true
+			break
+		}
+		intended := sched.wait(ctx)
+		resp, err := s.GetClusterNodes(ctx, &proto.ClusterNodesRequest{})
+		// A request cut short by the run ending is not a failure
+		if ctx.Err() != nil {
+			result.Truncated = true
+			break
+		}
+
+		var responseTime uint64
+		if err == nil {
+			responseTime = sched.latencyMs(intended, resp.ResponseTimeMs)
+			payloadBytes += uint64(protobuf.Size(resp))
+			totalTime += responseTime
+			successCount++
+			samples = append(samples, float64(responseTime))
+
+			if responseTime < minTime {
+				minTime = responseTime
+			}
+
+			if responseTime > maxTime {
+				maxTime = responseTime
+			}
+		}
+		requests++
+		observe(i+1, err == nil, responseTime)
+	}
+
+	if successCount > 0 {
+		result.AvgResponseTimeMs = totalTime / uint64(successCount)
+		result.AvgPayloadBytes = payloadBytes / uint64(successCount)
+		result.MinResponseTimeMs = minTime
+		result.MaxResponseTimeMs = maxTime
+		result.SuccessfulRequests = successCount
+		result.FailedRequests = requests - successCount
+		result.Distribution = latencyStats(samples)
+	}
+
+	return samples
+}
+
+func (s *BenchmarkService) runClusterNodesJsonRpcBenchmark(ctx context.Context, req *proto.BenchmarkRequest, result *proto.ClusterNodesBenchmark, observe observeFunc) []float64 {
+	var totalTime uint64
+	var minTime uint64 = ^uint64(0) // Max uint64 value
+	var maxTime uint64
+	var successCount uint32
+	var requests uint32
+	var payloadBytes uint64
+	var samples []float64
+	sched := newSchedule(req.TargetRate)
+
+	for i := 0; i < int(req.Iterations); i++ {
+		// Stop as soon as the run is cancelled or times out
+		if ctx.Err() != nil {
+			result.Truncated = true
+			break
+		}
+		startTime := sched.wait(ctx)
+
+		out, err := s.solanaClient.GetClusterNodes(ctx)
+		// A request cut short by the run ending is not a failure
+		if ctx.Err() != nil {
+			result.Truncated = true
+			break
+		}
+
+		responseTime := time.Since(startTime).Milliseconds()
+
+		if err == nil {
+			totalTime += uint64(responseTime)
+			payloadBytes += jsonSize(out)
+			successCount++
+			samples = append(samples, float64(responseTime))
+
+			if uint64(responseTime) < minTime {
+				minTime = uint64(responseTime)
+			}
+
+			if uint64(responseTime) > maxTime {
+				maxTime = uint64(responseTime)
+			}
 		}
+		requests++
+		observe(i+1, err == nil, uint64(responseTime))
 	}
 
 	if successCount > 0 {
 		result.AvgResponseTimeMs = totalTime / uint64(successCount)
+		result.AvgPayloadBytes = payloadBytes / uint64(successCount)
 		result.MinResponseTimeMs = minTime
 		result.MaxResponseTimeMs = maxTime
 		result.SuccessfulRequests = successCount
-		result.FailedRequests = uint32(len(req.TestSlots)*int(req.Iterations)) - successCount
+		result.FailedRequests = requests - successCount
+		result.Distribution = latencyStats(samples)
 	}
+
+	return samples
 }
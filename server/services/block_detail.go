@@ -0,0 +1,98 @@
+package services
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blockDetails maps detail tiers to getBlock transactionDetails values.
+// solana-go has no constant for the accounts tier.
+var blockDetails = map[proto.BlockDetail]rpc.TransactionDetailsType{
+	proto.BlockDetail_BLOCK_DETAIL_FULL:       rpc.TransactionDetailsFull,
+	proto.BlockDetail_BLOCK_DETAIL_ACCOUNTS:   "accounts",
+	proto.BlockDetail_BLOCK_DETAIL_SIGNATURES: rpc.TransactionDetailsSignatures,
+	proto.BlockDetail_BLOCK_DETAIL_NONE:       rpc.TransactionDetailsNone,
+}
+
+// blockOpts returns the getBlock options of a detail tier, the same for
+// both transports so benchmarks compare like with like
+func blockOpts(detail proto.BlockDetail) (*rpc.GetBlockOpts, error) {
+	details, ok := blockDetails[detail]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block detail: %v", detail)
+	}
+	maxVersion := uint64(0)
+	return &rpc.GetBlockOpts{
+		Encoding:                       solana.EncodingBase64,
+		TransactionDetails:             details,
+		MaxSupportedTransactionVersion: &maxVersion,
+	}, nil
+}
+
+// blockSignatures returns the first signature of each transaction of a block
+// fetched at a detail tier, none for BLOCK_DETAIL_NONE
+func blockSignatures(block *rpc.GetBlockResult, detail proto.BlockDetail) []string {
+	var signatures []string
+	switch detail {
+	case proto.BlockDetail_BLOCK_DETAIL_SIGNATURES:
+		signatures = make([]string, 0, len(block.Signatures))
+		for _, signature := range block.Signatures {
+			signatures = append(signatures, signature.String())
+		}
+	case proto.BlockDetail_BLOCK_DETAIL_ACCOUNTS:
+		// Transactions come as JSON with their signatures and account keys
+		signatures = make([]string, 0, len(block.Transactions))
+		for _, tx := range block.Transactions {
+			var parsed struct {
+				Signatures []string `json:"signatures"`
+			}
+			if tx.Transaction == nil || json.Unmarshal(tx.Transaction.GetRawJSON(), &parsed) != nil {
+				continue
+			}
+			if len(parsed.Signatures) > 0 {
+				signatures = append(signatures, parsed.Signatures[0])
+			}
+		}
+	case proto.BlockDetail_BLOCK_DETAIL_FULL:
+		signatures = make([]string, 0, len(block.Transactions))
+		for _, tx := range block.Transactions {
+			txWithMeta, err := tx.GetTransaction()
+			if err != nil {
+				continue
+			}
+			if len(txWithMeta.Signatures) > 0 {
+				signatures = append(signatures, txWithMeta.Signatures[0].String())
+			}
+		}
+	}
+	return signatures
+}
+
+// blockTierName is the lowercase name of a detail tier, as in getBlock
+func blockTierName(detail proto.BlockDetail) string {
+	return string(blockDetails[detail])
+}
+
+// blockTiers returns the detail tiers a benchmark request runs its block
+// benchmarks at, in request order without repeats
+func blockTiers(req *proto.BenchmarkRequest) ([]proto.BlockDetail, error) {
+	if len(req.BlockDetails) == 0 {
+		return []proto.BlockDetail{proto.BlockDetail_BLOCK_DETAIL_FULL}, nil
+	}
+	tiers := make([]proto.BlockDetail, 0, len(req.BlockDetails))
+	for _, detail := range req.BlockDetails {
+		if _, ok := blockDetails[detail]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid block detail: %v", detail)
+		}
+		if !slices.Contains(tiers, detail) {
+			tiers = append(tiers, detail)
+		}
+	}
+	return tiers, nil
+}
@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/hub"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blockFeedBuffer is the number of blocks queued per block feed subscriber.
+// Subscribers that fall further behind are dropped.
+const blockFeedBuffer = 64
+
+// blockHandler is called with each block read by followBlocks, with the timing
+// of its fetch for the updates built from it. An error ends the stream.
+type blockHandler func(slot uint64, block *rpc.GetBlockResult, timing *stageTiming) error
+
+// blockEvent is a block published on the slot topic of its commitment. The
+// block and timing are shared by every subscriber and must not be modified.
+type blockEvent struct {
+	slot   uint64
+	block  *rpc.GetBlockResult
+	timing *stageTiming
+}
+
+// followBlocks hands every block, with its transactions, from the current tip
+// on at the given commitment to handle until ctx is done or handle fails.
+// Streams that derive their updates from transactions build on it. The blocks
+// come from a feed shared by every stream at the commitment, so each block is
+// fetched once however many streams follow it. The stream's class sizes its
+// queue of blocks.
+func (s *BenchmarkService) followBlocks(ctx context.Context, commitment rpc.CommitmentType, class streamClass, handle blockHandler) error {
+	sub := s.blocks.SubscribeQueue(class.queueScale*blockFeedBuffer, hub.SlotTopic(commitment))
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case event, ok := <-sub.Messages():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "stream fell too far behind the block feed")
+			}
+			if err := handle(event.slot, event.block, event.timing); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// startBlockFeed starts following the blocks of a slot topic's commitment when
+// the topic gains its first subscriber, and returns the function stopping it
+func (s *BenchmarkService) startBlockFeed(topic hub.Topic) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	go s.runBlockFeed(ctx, topic)
+	return cancel
+}
+
+// runBlockFeed publishes every block from the current tip on until ctx is
+// done. Blocks are pushed by blockSubscribe while the WebSocket upstream
+// serves it, and polled with getBlock otherwise; see blockTrigger.
+func (s *BenchmarkService) runBlockFeed(ctx context.Context, topic hub.Topic) {
+	publish := func(slot uint64, block *rpc.GetBlockResult, timing *stageTiming) error {
+		s.blocks.Publish(ctx, blockEvent{slot: slot, block: block, timing: timing}, topic)
+		return nil
+	}
+	trigger := s.newBlockTrigger(topic.Commitment, rpc.TransactionDetailsFull)
+	defer trigger.close()
+
+	var next uint64
+	var notified *blockNotification
+	for {
+		var err error
+		if next == 0 {
+			// Start from the current tip
+			if next, err = s.solanaClient.GetSlot(ctx, topic.Commitment); err != nil && ctx.Err() == nil {
+				log.Printf("Error getting slot: %v", err)
+			}
+		} else if release, err := s.scheduler.acquire(ctx, streamClasses[proto.StreamQoS_STREAM_QOS_REALTIME]); err == nil {
+			// The feed serves streams of every class, so it reads as realtime
+			if notified != nil {
+				next, _ = s.readNotifiedBlocks(ctx, next, notified, topic.Commitment, publish)
+			} else {
+				next, _ = s.pollFullBlocks(ctx, next, topic.Commitment, publish)
+			}
+			release()
+		}
+
+		if notified, err = trigger.wait(ctx); err != nil {
+			return
+		}
+	}
+}
+
+// readNotifiedBlocks handles the blocks from slot next up to a notified one
+// and returns the slot to continue from. Slots the subscription skipped past,
+// and a block notified without its content, are read with getBlock. RPC
+// errors are logged and retried later; only handler errors end the stream.
+func (s *BenchmarkService) readNotifiedBlocks(ctx context.Context, next uint64, notified *blockNotification, commitment rpc.CommitmentType, handle blockHandler) (uint64, error) {
+	if notified.slot < next {
+		return next, nil
+	}
+	end := notified.slot
+	if notified.block != nil {
+		end--
+	}
+	if end >= next {
+		missed := end
+		if missed-next >= maxBlocksPerPoll {
+			missed = next + maxBlocksPerPoll - 1
+		}
+		var err error
+		if next, err = s.fetchFullBlocks(ctx, next, missed, commitment, handle); err != nil || next <= end {
+			return next, err
+		}
+	}
+	if notified.block == nil {
+		return next, nil
+	}
+
+	if err := handle(notified.slot, notified.block, notified.timing); err != nil {
+		return notified.slot, err
+	}
+	return notified.slot + 1, nil
+}
+
+// pollFullBlocks handles the blocks produced from slot next on and returns the
+// slot to continue from. RPC errors are logged and retried on the next poll;
+// only handler errors end the stream.
+func (s *BenchmarkService) pollFullBlocks(ctx context.Context, next uint64, commitment rpc.CommitmentType, handle blockHandler) (uint64, error) {
+	tip, err := s.solanaClient.GetSlot(ctx, commitment)
+	if err != nil {
+		log.Printf("Error getting slot: %v", err)
+		return next, nil
+	}
+	if tip < next {
+		return next, nil
+	}
+
+	end := tip
+	if end-next >= maxBlocksPerPoll {
+		end = next + maxBlocksPerPoll - 1
+	}
+	return s.fetchFullBlocks(ctx, next, end, commitment, handle)
+}
+
+// fetchFullBlocks handles the blocks produced from slot next to end with
+// getBlock and returns the slot to continue from, past end unless an RPC
+// call failed
+func (s *BenchmarkService) fetchFullBlocks(ctx context.Context, next, end uint64, commitment rpc.CommitmentType, handle blockHandler) (uint64, error) {
+	slots, err := s.solanaClient.GetBlocks(ctx, next, &end, commitment)
+	if err != nil {
+		log.Printf("Error getting blocks: %v", err)
+		return next, nil
+	}
+
+	maxVersion := uint64(0)
+	rewards := false
+	for _, slot := range slots {
+		blockCtx, timing := startTiming(ctx)
+		block, err := s.solanaClient.GetBlockWithOpts(blockCtx, slot, &rpc.GetBlockOpts{
+			Encoding:                       solana.EncodingBase64,
+			TransactionDetails:             rpc.TransactionDetailsFull,
+			Rewards:                        &rewards,
+			Commitment:                     commitment,
+			MaxSupportedTransactionVersion: &maxVersion,
+		})
+		if err != nil {
+			log.Printf("Error getting block %d: %v", slot, err)
+			return slot, nil
+		}
+
+		if err := handle(slot, block, timing); err != nil {
+			return slot, err
+		}
+	}
+
+	return end + 1, nil
+}
+
+// transactionKeys returns the account keys a transaction's balances and
+// instructions are indexed by: the static keys followed by the keys loaded
+// from address lookup tables
+func transactionKeys(tx *solana.Transaction, meta *rpc.TransactionMeta) solana.PublicKeySlice {
+	keys := append(solana.PublicKeySlice{}, tx.Message.AccountKeys...)
+	keys = append(keys, meta.LoadedAddresses.Writable...)
+	return append(keys, meta.LoadedAddresses.ReadOnly...)
+}
+
+// writableKeys returns the accounts a transaction may write: the writable
+// static keys followed by the writable keys loaded from address lookup tables
+func writableKeys(tx *solana.Transaction, meta *rpc.TransactionMeta) solana.PublicKeySlice {
+	var keys solana.PublicKeySlice
+	for i, key := range tx.Message.AccountKeys {
+		if staticKeyWritable(tx.Message.Header, len(tx.Message.AccountKeys), i) {
+			keys = append(keys, key)
+		}
+	}
+	if meta != nil {
+		keys = append(keys, meta.LoadedAddresses.Writable...)
+	}
+	return keys
+}
+
+// staticKeyWritable reports whether the i-th of n static account keys is
+// writable. Keys are ordered writable signers, read-only signers, writable
+// non-signers, then read-only non-signers.
+func staticKeyWritable(header solana.MessageHeader, n, i int) bool {
+	signers := int(header.NumRequiredSignatures)
+	if i < signers {
+		return i < signers-int(header.NumReadonlySignedAccounts)
+	}
+	return i < n-int(header.NumReadonlyUnsignedAccounts)
+}
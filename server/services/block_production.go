@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultBlockProductionInterval is how often block production streams update
+// the current epoch when the request does not set an interval
+const defaultBlockProductionInterval = time.Minute
+
+// GetBlockProduction passes getBlockProduction through, decoding the leader
+// slots and produced blocks of each validator
+func (s *BenchmarkService) GetBlockProduction(ctx context.Context, req *proto.BlockProductionRequest) (*proto.BlockProduction, error) {
+	startTime := time.Now()
+
+	opts, err := blockProductionOpts(req.Identity, req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case req.FirstSlot != 0:
+		if req.LastSlot != 0 && req.LastSlot < req.FirstSlot {
+			return nil, status.Errorf(codes.InvalidArgument, "last_slot %d is before first_slot %d", req.LastSlot, req.FirstSlot)
+		}
+		opts.Range = &rpc.SlotRangeRequest{FirstSlot: req.FirstSlot}
+		if req.LastSlot != 0 {
+			lastSlot := req.LastSlot
+			opts.Range.LastSlot = &lastSlot
+		}
+	case req.LastSlot != 0:
+		return nil, status.Error(codes.InvalidArgument, "last_slot requires first_slot")
+	}
+
+	production, err := s.blockProduction(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := s.solanaClient.GetEpochSchedule(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get epoch schedule: %v", err)
+	}
+	production.Epoch, _, _ = epochOf(schedule, production.FirstSlot)
+	production.ResponseTimeMs = uint64(time.Since(startTime).Milliseconds())
+	return production, nil
+}
+
+// StreamBlockProduction sends the block production of the current epoch so
+// far at once and then every interval. When the epoch changes, the final
+// summary of the one that ended is sent before the new one's first update.
+// Updates that fail upstream are skipped until the next interval.
+func (s *BenchmarkService) StreamBlockProduction(req *proto.BlockProductionStreamRequest, stream proto.BenchmarkService_StreamBlockProductionServer) error {
+	opts, err := blockProductionOpts(req.Identity, req.Commitment)
+	if err != nil {
+		return err
+	}
+	interval := defaultBlockProductionInterval
+	if req.IntervalSeconds > 0 {
+		interval = time.Duration(req.IntervalSeconds) * time.Second
+	}
+
+	ctx := stream.Context()
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+
+	send := func(epoch, firstSlot, lastSlot uint64, complete bool) error {
+		rangeOpts := *opts
+		rangeOpts.Range = &rpc.SlotRangeRequest{FirstSlot: firstSlot, LastSlot: &lastSlot}
+		production, err := s.blockProduction(ctx, &rangeOpts)
+		if err != nil {
+			log.Printf("Failed to get block production of slots %d-%d: %v", firstSlot, lastSlot, err)
+			return nil
+		}
+		production.Epoch = epoch
+		production.EpochComplete = complete
+		if err := stream.Send(production); err != nil {
+			return status.Errorf(codes.Internal, "failed to send block production: %v", err)
+		}
+		return nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var epoch, epochStart uint64
+	started := false
+	for {
+		info, err := s.solanaClient.GetEpochInfo(ctx, opts.Commitment)
+		switch {
+		case ctx.Err() != nil:
+			return status.FromContextError(ctx.Err()).Err()
+		case err != nil:
+			log.Printf("Failed to get epoch info for block production: %v", err)
+		default:
+			firstSlot := info.AbsoluteSlot - info.SlotIndex
+			if started && info.Epoch != epoch {
+				// Summarize the epoch that ended
+				if err := send(epoch, epochStart, firstSlot-1, true); err != nil {
+					return err
+				}
+			}
+			epoch, epochStart, started = info.Epoch, firstSlot, true
+			if err := send(epoch, firstSlot, info.AbsoluteSlot, false); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// blockProductionOpts parses the validator identity and commitment of a block
+// production request
+func blockProductionOpts(identity, commitment string) (*rpc.GetBlockProductionOpts, error) {
+	parsed, err := parseCommitment(commitment)
+	if err != nil {
+		return nil, err
+	}
+	opts := &rpc.GetBlockProductionOpts{Commitment: parsed}
+	if identity != "" {
+		key, err := solana.PublicKeyFromBase58(identity)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid identity: %v", err)
+		}
+		opts.Identity = &key
+	}
+	return opts, nil
+}
+
+// blockProduction fetches block production and decodes it per validator,
+// leaving the epoch to the caller
+func (s *BenchmarkService) blockProduction(ctx context.Context, opts *rpc.GetBlockProductionOpts) (*proto.BlockProduction, error) {
+	result, err := s.solanaClient.GetBlockProductionWithOpts(ctx, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get block production: %v", err)
+	}
+
+	production := &proto.BlockProduction{
+		FirstSlot:  result.Value.Range.FirstSlot,
+		LastSlot:   result.Value.Range.LastSlot,
+		Validators: make([]*proto.ValidatorProduction, 0, len(result.Value.ByIdentity)),
+	}
+	for identity, counts := range result.Value.ByIdentity {
+		// Each entry is the validator's leader slots and blocks produced
+		leaderSlots, blocks := uint64(counts[0]), uint64(counts[1])
+		production.Validators = append(production.Validators, &proto.ValidatorProduction{
+			Identity:       identity.String(),
+			LeaderSlots:    leaderSlots,
+			BlocksProduced: blocks,
+			SkippedSlots:   leaderSlots - blocks,
+			SkipRate:       skipRate(leaderSlots, blocks),
+		})
+		production.LeaderSlots += leaderSlots
+		production.BlocksProduced += blocks
+	}
+	production.SkipRate = skipRate(production.LeaderSlots, production.BlocksProduced)
+
+	sort.Slice(production.Validators, func(i, j int) bool {
+		a, b := production.Validators[i], production.Validators[j]
+		if a.LeaderSlots != b.LeaderSlots {
+			return a.LeaderSlots > b.LeaderSlots
+		}
+		return a.Identity < b.Identity
+	})
+	return production, nil
+}
+
+// skipRate is the fraction of leader slots without a block
+func skipRate(leaderSlots, blocks uint64) float64 {
+	if leaderSlots == 0 {
+		return 0
+	}
+	return float64(leaderSlots-blocks) / float64(leaderSlots)
+}
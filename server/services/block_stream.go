@@ -0,0 +1,445 @@
+package services
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// blockPollInterval is how often block streams poll for new slots without
+	// a block subscription
+	blockPollInterval = 1 * time.Second
+	// maxBlocksPerPoll bounds the slot range fetched in one poll when the
+	// stream falls behind the chain tip
+	maxBlocksPerPoll = 100
+	// maxReorgDepth bounds how far back a fork is walked to find the common ancestor
+	maxReorgDepth = 64
+)
+
+// blockStream holds the state of a single block stream
+type blockStream struct {
+	commitment rpc.CommitmentType
+	next       uint64
+	// last is the most recently streamed block on the canonical chain
+	last *proto.BlockUpdate
+	// pending holds streamed slots that have not been finalized yet
+	pending map[uint64]*proto.BlockUpdate
+	// checked is when pending slots were last checked for finalization
+	checked time.Time
+}
+
+// StreamBlocks streams blocks in real-time. Blocks are streamed at the
+// requested commitment with an explicit marker for every skipped slot, and
+// when that is below finalized every streamed slot is followed by a status
+// change once it is finalized, so consumers know when its data is safe.
+// Parent lineage is tracked so that a fork switch is reported as a reorg
+// before the blocks of the new canonical chain are streamed. Blocks are pushed
+// by blockSubscribe while the WebSocket upstream serves it, and polled
+// otherwise; either way skipped slots and finalization are read over RPC.
+func (s *BenchmarkService) StreamBlocks(req *proto.BlockStreamRequest, stream proto.BenchmarkService_StreamBlocksServer) error {
+	if err := s.requireFeature(features.BlockStreams); err != nil {
+		return err
+	}
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return err
+	}
+	if commitment == rpc.CommitmentProcessed {
+		return status.Error(codes.InvalidArgument, "block streams support confirmed or finalized commitment")
+	}
+	class, err := parseStreamQoS(req.Qos)
+	if err != nil {
+		return err
+	}
+	mask, err := parseFieldMask(req.FieldMask, &proto.BlockUpdate{})
+	if err != nil {
+		return err
+	}
+	stream = &maskedBlockStream{stream, mask}
+
+	ctx := stream.Context()
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+
+	// Start from the current tip
+	tip, err := s.solanaClient.GetSlot(ctx, commitment)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get slot: %v", err)
+	}
+
+	bs := &blockStream{
+		commitment: commitment,
+		next:       tip,
+		pending:    make(map[uint64]*proto.BlockUpdate),
+	}
+
+	trigger := s.newBlockTrigger(commitment, rpc.TransactionDetailsNone)
+	defer trigger.close()
+
+	var notified *blockNotification
+	for {
+		release, err := s.scheduler.acquire(ctx, class)
+		if err != nil {
+			return err
+		}
+		if notified != nil {
+			err = s.sendNotifiedBlocks(ctx, bs, notified, stream)
+		} else {
+			err = s.pollBlocks(ctx, bs, stream)
+		}
+		release()
+		if err != nil {
+			return err
+		}
+
+		if notified, err = trigger.wait(ctx); err != nil {
+			return status.FromContextError(err).Err()
+		}
+	}
+}
+
+// pollBlocks sends the blocks produced since the previous poll followed by
+// status changes for pending slots. RPC errors are logged and retried on the
+// next poll; only send failures end the stream.
+func (s *BenchmarkService) pollBlocks(ctx context.Context, bs *blockStream, stream proto.BenchmarkService_StreamBlocksServer) error {
+	tip, err := s.solanaClient.GetSlot(ctx, bs.commitment)
+	if err != nil {
+		log.Printf("Error getting slot: %v", err)
+		return nil
+	}
+
+	if tip >= bs.next {
+		end := tip
+		if end-bs.next >= maxBlocksPerPoll {
+			end = bs.next + maxBlocksPerPoll - 1
+		}
+		if err := s.sendSlots(ctx, bs, end, stream); err != nil {
+			return err
+		}
+	}
+
+	bs.checked = time.Now()
+	return s.sendFinalized(ctx, bs, stream)
+}
+
+// sendNotifiedBlocks sends the slots from the next one up to a notified block,
+// then status changes for pending slots at most every blockPollInterval.
+// Slots the subscription skipped past, and a block notified without its
+// header, are read over RPC. RPC errors are logged and retried later; only
+// send failures end the stream.
+func (s *BenchmarkService) sendNotifiedBlocks(ctx context.Context, bs *blockStream, notified *blockNotification, stream proto.BenchmarkService_StreamBlocksServer) error {
+	if notified.slot >= bs.next {
+		end := notified.slot
+		if notified.block != nil {
+			end--
+		}
+		if end >= bs.next {
+			missed := end
+			if missed-bs.next >= maxBlocksPerPoll {
+				missed = bs.next + maxBlocksPerPoll - 1
+			}
+			if err := s.sendSlots(ctx, bs, missed, stream); err != nil {
+				return err
+			}
+		}
+
+		if notified.block != nil && bs.next == notified.slot {
+			update := newBlockUpdate(notified.slot, notified.block, bs.commitment, notified.timing)
+			chain, reorg, err := s.linkBlock(ctx, bs, update)
+			if err != nil {
+				log.Printf("Error resolving fork at block %d: %v", notified.slot, err)
+				return nil
+			}
+			if err := s.sendChain(bs, chain, reorg, stream); err != nil {
+				return err
+			}
+			bs.next = notified.slot + 1
+		}
+	}
+
+	if time.Since(bs.checked) < blockPollInterval {
+		return nil
+	}
+	bs.checked = time.Now()
+	return s.sendFinalized(ctx, bs, stream)
+}
+
+// sendSlots sends every slot from the next one to end: a block for each slot
+// that produced one and a skipped marker for the others. It stops at the
+// first RPC error, which is logged; only send failures are returned.
+func (s *BenchmarkService) sendSlots(ctx context.Context, bs *blockStream, end uint64, stream proto.BenchmarkService_StreamBlocksServer) error {
+	slots, err := s.solanaClient.GetBlocks(ctx, bs.next, &end, bs.commitment)
+	if err != nil {
+		log.Printf("Error getting blocks: %v", err)
+		return nil
+	}
+
+	produced := make(map[uint64]bool, len(slots))
+	for _, slot := range slots {
+		produced[slot] = true
+	}
+
+	for slot := bs.next; slot <= end; slot++ {
+		// Mark slots without a block so consumers can account for every slot
+		if !produced[slot] {
+			err := stream.Send(&proto.BlockUpdate{
+				Slot:      slot,
+				Timestamp: uint64(time.Now().Unix()),
+				Status:    slotStatus(bs.commitment),
+				Skipped:   true,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to send skipped slot: %v", err)
+			}
+			bs.next = slot + 1
+			continue
+		}
+
+		update, err := s.fetchBlockUpdate(ctx, slot, bs.commitment)
+		if err != nil {
+			log.Printf("Error getting block %d: %v", slot, err)
+			return nil
+		}
+
+		chain, reorg, err := s.linkBlock(ctx, bs, update)
+		if err != nil {
+			log.Printf("Error resolving fork at block %d: %v", slot, err)
+			return nil
+		}
+		if err := s.sendChain(bs, chain, reorg, stream); err != nil {
+			return err
+		}
+		bs.next = slot + 1
+	}
+	return nil
+}
+
+// linkBlock connects a new block to the streamed chain. If its parent is not
+// the last streamed block, the new fork is walked back to the common ancestor
+// and every pending slot past the ancestor is reported as abandoned. It returns
+// the blocks to stream, oldest first, and the reorg to report, if any.
+func (s *BenchmarkService) linkBlock(ctx context.Context, bs *blockStream, update *proto.BlockUpdate) ([]*proto.BlockUpdate, *proto.ReorgEvent, error) {
+	chain := []*proto.BlockUpdate{update}
+	if bs.last == nil || (update.ParentSlot == bs.last.Slot && update.PreviousBlockhash == bs.last.Blockhash) {
+		return chain, nil, nil
+	}
+
+	// Walk back while the parent is a streamed block that is no longer canonical
+	cur := update
+	for depth := 0; depth < maxReorgDepth; depth++ {
+		streamed, ok := bs.pending[cur.ParentSlot]
+		if !ok || streamed.Blockhash == cur.PreviousBlockhash {
+			break
+		}
+
+		parent, err := s.fetchBlockUpdate(ctx, cur.ParentSlot, bs.commitment)
+		if err != nil {
+			return nil, nil, err
+		}
+		chain = append([]*proto.BlockUpdate{parent}, chain...)
+		cur = parent
+	}
+
+	ancestor := cur.ParentSlot
+	var abandoned []uint64
+	for slot := range bs.pending {
+		if slot > ancestor {
+			abandoned = append(abandoned, slot)
+		}
+	}
+	if len(abandoned) == 0 {
+		return chain, nil, nil
+	}
+	sort.Slice(abandoned, func(i, j int) bool { return abandoned[i] < abandoned[j] })
+
+	reorg := &proto.ReorgEvent{
+		AbandonedSlots:     abandoned,
+		CommonAncestorSlot: ancestor,
+	}
+	for _, block := range chain {
+		reorg.NewChainSlots = append(reorg.NewChainSlots, block.Slot)
+	}
+	return chain, reorg, nil
+}
+
+// sendChain reports a reorg, if any, and then streams the blocks of the chain
+func (s *BenchmarkService) sendChain(bs *blockStream, chain []*proto.BlockUpdate, reorg *proto.ReorgEvent, stream proto.BenchmarkService_StreamBlocksServer) error {
+	if reorg != nil {
+		err := stream.Send(&proto.BlockUpdate{
+			Timestamp: uint64(time.Now().Unix()),
+			Reorg:     reorg,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to send reorg event: %v", err)
+		}
+		for _, slot := range reorg.AbandonedSlots {
+			delete(bs.pending, slot)
+		}
+	}
+
+	for _, block := range chain {
+		// Send block update
+		if err := stream.Send(block); err != nil {
+			return status.Errorf(codes.Internal, "failed to send block update: %v", err)
+		}
+		if block.Status != proto.SlotStatus_SLOT_STATUS_FINALIZED {
+			bs.pending[block.Slot] = block
+		}
+		// Replacements for finalized forks can be older than the streamed tip
+		if bs.last == nil || block.Slot >= bs.last.Slot {
+			bs.last = block
+		}
+	}
+
+	return nil
+}
+
+// sendFinalized emits a status change for every pending slot at or below the
+// finalized slot. Pending slots missing from the finalized chain are reported
+// as abandoned, followed by the finalized blocks that replaced them.
+func (s *BenchmarkService) sendFinalized(ctx context.Context, bs *blockStream, stream proto.BenchmarkService_StreamBlocksServer) error {
+	if len(bs.pending) == 0 {
+		return nil
+	}
+
+	finalized, err := s.solanaClient.GetSlot(ctx, rpc.CommitmentFinalized)
+	if err != nil {
+		log.Printf("Error getting finalized slot: %v", err)
+		return nil
+	}
+
+	var slots []uint64
+	for slot := range bs.pending {
+		if slot <= finalized {
+			slots = append(slots, slot)
+		}
+	}
+	if len(slots) == 0 {
+		return nil
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+
+	first, last := slots[0], slots[len(slots)-1]
+	canonical, err := s.solanaClient.GetBlocks(ctx, first, &last, rpc.CommitmentFinalized)
+	if err != nil {
+		log.Printf("Error getting finalized blocks: %v", err)
+		return nil
+	}
+	isCanonical := make(map[uint64]bool, len(canonical))
+	for _, slot := range canonical {
+		isCanonical[slot] = true
+	}
+
+	var abandoned []uint64
+	for _, slot := range slots {
+		if !isCanonical[slot] {
+			abandoned = append(abandoned, slot)
+		}
+	}
+
+	if len(abandoned) > 0 {
+		// The fork point is the newest finalized slot before the first abandoned
+		// one, or that block's parent if the range holds none
+		ancestor := bs.pending[abandoned[0]].ParentSlot
+		var replacements []*proto.BlockUpdate
+		for _, slot := range canonical {
+			if slot < abandoned[0] {
+				ancestor = slot
+				continue
+			}
+			if _, streamed := bs.pending[slot]; streamed {
+				continue
+			}
+			block, err := s.fetchBlockUpdate(ctx, slot, rpc.CommitmentFinalized)
+			if err != nil {
+				log.Printf("Error getting block %d: %v", slot, err)
+				return nil
+			}
+			replacements = append(replacements, block)
+		}
+
+		reorg := &proto.ReorgEvent{
+			AbandonedSlots:     abandoned,
+			CommonAncestorSlot: ancestor,
+		}
+		for _, block := range replacements {
+			reorg.NewChainSlots = append(reorg.NewChainSlots, block.Slot)
+		}
+		if err := s.sendChain(bs, replacements, reorg, stream); err != nil {
+			return err
+		}
+	}
+
+	for _, slot := range slots {
+		block, ok := bs.pending[slot]
+		if !ok {
+			continue
+		}
+		err := stream.Send(&proto.BlockUpdate{
+			Slot:              slot,
+			Blockhash:         block.Blockhash,
+			PreviousBlockhash: block.PreviousBlockhash,
+			ParentSlot:        block.ParentSlot,
+			Timestamp:         uint64(time.Now().Unix()),
+			Status:            proto.SlotStatus_SLOT_STATUS_FINALIZED,
+			StatusChanged:     true,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to send block status update: %v", err)
+		}
+		delete(bs.pending, slot)
+	}
+
+	return nil
+}
+
+// fetchBlockUpdate reads the header of a block without its transactions
+func (s *BenchmarkService) fetchBlockUpdate(ctx context.Context, slot uint64, commitment rpc.CommitmentType) (*proto.BlockUpdate, error) {
+	ctx, timing := startTiming(ctx)
+	rewards := false
+	block, err := s.solanaClient.GetBlockWithOpts(ctx, slot, &rpc.GetBlockOpts{
+		TransactionDetails: rpc.TransactionDetailsNone,
+		Rewards:            &rewards,
+		Commitment:         commitment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBlockUpdate(slot, block, commitment, timing), nil
+}
+
+// newBlockUpdate builds the update of a block's header, read at commitment
+func newBlockUpdate(slot uint64, block *rpc.GetBlockResult, commitment rpc.CommitmentType, timing *stageTiming) *proto.BlockUpdate {
+	built := time.Now()
+	update := &proto.BlockUpdate{
+		Slot:              slot,
+		Blockhash:         block.Blockhash.String(),
+		PreviousBlockhash: block.PreviousBlockhash.String(),
+		ParentSlot:        block.ParentSlot,
+		Timestamp:         uint64(time.Now().Unix()),
+		Status:            slotStatus(commitment),
+	}
+	update.Timing = timing.breakdown(built, update)
+	return update
+}
+
+// slotStatus maps a commitment level to the status of slots streamed at it
+func slotStatus(commitment rpc.CommitmentType) proto.SlotStatus {
+	switch commitment {
+	case rpc.CommitmentFinalized:
+		return proto.SlotStatus_SLOT_STATUS_FINALIZED
+	case rpc.CommitmentConfirmed:
+		return proto.SlotStatus_SLOT_STATUS_CONFIRMED
+	default:
+		return proto.SlotStatus_SLOT_STATUS_PROCESSED
+	}
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+)
+
+// blockStallTimeout is how long a block subscription may go without a
+// notification before it is taken to have failed. Upstreams started without
+// block subscriptions accept blockSubscribe but never notify.
+const blockStallTimeout = 10 * time.Second
+
+// blockNotification is a block pushed by blockSubscribe. The block is nil when
+// the upstream could not encode it: solana-go cannot pass blockSubscribe
+// maxSupportedTransactionVersion, so blocks holding versioned transactions
+// arrive as errors and are read with getBlock instead.
+type blockNotification struct {
+	slot   uint64
+	block  *rpc.GetBlockResult
+	timing *stageTiming
+}
+
+// blockSubscription forwards the notifications of a blockSubscribe
+// subscription on a WebSocket connection of its own
+type blockSubscription struct {
+	wsClient      *ws.Client
+	sub           *ws.BlockSubscription
+	notifications chan blockNotification
+	errs          chan error
+	done          chan struct{}
+}
+
+// subscribeBlocks connects to the WebSocket upstream and subscribes to every
+// block at a commitment, with the given transaction details
+func (s *BenchmarkService) subscribeBlocks(ctx context.Context, commitment rpc.CommitmentType, details rpc.TransactionDetailsType) (*blockSubscription, error) {
+	wsClient, err := s.endpoint.ConnectWS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rewards := false
+	sub, err := wsClient.BlockSubscribe(ws.NewBlockSubscribeFilterAll(), &ws.BlockSubscribeOpts{
+		Commitment:         commitment,
+		Encoding:           solana.EncodingBase64,
+		TransactionDetails: details,
+		Rewards:            &rewards,
+	})
+	if err != nil {
+		wsClient.Close()
+		return nil, err
+	}
+
+	b := &blockSubscription{
+		wsClient:      wsClient,
+		sub:           sub,
+		notifications: make(chan blockNotification),
+		errs:          make(chan error, 1),
+		done:          make(chan struct{}),
+	}
+	go b.forward()
+	return b, nil
+}
+
+// close unsubscribes before closing the connection, which unblocks the
+// forwarder
+func (b *blockSubscription) close() {
+	close(b.done)
+	b.sub.Unsubscribe()
+	b.wsClient.Close()
+}
+
+// forward delivers the notifications until the subscription fails or is closed
+func (b *blockSubscription) forward() {
+	for {
+		result, err := b.sub.Recv()
+		if err == nil && result == nil {
+			err = errors.New("subscription closed")
+		}
+		if err != nil {
+			select {
+			case b.errs <- err:
+			default:
+			}
+			return
+		}
+
+		_, timing := startTiming(context.Background())
+		select {
+		case b.notifications <- blockNotification{slot: result.Value.Slot, block: result.Value.Block, timing: timing}:
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// blockTrigger paces a stream reading new blocks: it waits for the next
+// blockSubscribe notification while the WebSocket upstream serves one, and
+// blockPollInterval between polls otherwise. The stream also polls when
+// notifications pause for that long, so an upstream that accepts the
+// subscription without notifying delays no block. A failed or stalled
+// subscription is reopened with backoff.
+type blockTrigger struct {
+	s          *BenchmarkService
+	commitment rpc.CommitmentType
+	details    rpc.TransactionDetailsType
+	sub        *blockSubscription
+	// notified is when the subscription last notified, or was opened
+	notified time.Time
+	// subscribed is when the subscription first notified
+	subscribed time.Time
+	backoff    upstream.Backoff
+	// retry is when to try subscribing again
+	retry time.Time
+}
+
+// newBlockTrigger paces a stream following blocks at a commitment, whose
+// notifications carry the given transaction details
+func (s *BenchmarkService) newBlockTrigger(commitment rpc.CommitmentType, details rpc.TransactionDetailsType) *blockTrigger {
+	return &blockTrigger{
+		s:          s,
+		commitment: commitment,
+		details:    details,
+		backoff:    upstream.Backoff{Max: maxResubscribeDelay},
+	}
+}
+
+// wait returns once there may be new blocks to read: with the notified block
+// when one is pushed, and with nil when the stream should poll. It only fails
+// when ctx is done.
+func (t *blockTrigger) wait(ctx context.Context) (*blockNotification, error) {
+	if t.sub == nil && t.s.features.Enabled(features.WebSocketStreams) && t.s.wsAvailable() && !time.Now().Before(t.retry) {
+		sub, err := t.s.subscribeBlocks(ctx, t.commitment, t.details)
+		if err != nil {
+			t.failed(err)
+		} else {
+			t.sub, t.notified = sub, time.Now()
+		}
+	}
+
+	var notifications <-chan blockNotification
+	var errs <-chan error
+	if t.sub != nil {
+		notifications, errs = t.sub.notifications, t.sub.errs
+	}
+	poll := time.NewTimer(blockPollInterval)
+	defer poll.Stop()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case notification := <-notifications:
+		if t.subscribed.IsZero() {
+			t.subscribed = time.Now()
+		}
+		t.notified = time.Now()
+		t.s.blocksPushed.Store(true)
+		return &notification, nil
+	case err := <-errs:
+		t.close()
+		t.failed(err)
+	case <-poll.C:
+		if t.sub != nil && time.Since(t.notified) > blockStallTimeout {
+			t.close()
+			t.failed(fmt.Errorf("no block notification for %v", blockStallTimeout))
+		}
+	}
+	return nil, nil
+}
+
+// failed schedules the next subscription attempt after a failed one
+func (t *blockTrigger) failed(err error) {
+	t.s.blocksPushed.Store(false)
+	// Subscriptions that stayed up for a while reset the backoff
+	if !t.subscribed.IsZero() && time.Since(t.subscribed) > maxResubscribeDelay {
+		t.backoff.Reset()
+	}
+	t.subscribed = time.Time{}
+	delay := t.backoff.Next()
+	t.retry = time.Now().Add(delay)
+	log.Printf("Block subscription at %s commitment failed, polling until resubscribing in %v: %v", t.commitment, delay, err)
+}
+
+// close closes the subscription, if any
+func (t *blockTrigger) close() {
+	if t.sub != nil {
+		t.sub.close()
+		t.sub = nil
+	}
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc"
+)
+
+// blockUpstream is a fake upstream whose chain stays at slot 100 over RPC, so
+// only its blockSubscribe notifications advance a block feed
+type blockUpstream struct {
+	// blocks are the slots getBlocks reports as produced
+	blocks        []uint64
+	notifications []string
+
+	mu        sync.Mutex
+	getBlocks []uint64
+}
+
+// produced reports whether the fake chain produced a block in slot
+func (u *blockUpstream) produced(slot uint64) bool {
+	for _, p := range u.blocks {
+		if p == slot {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *blockUpstream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		u.serveWS(w, r)
+		return
+	}
+
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	json.NewDecoder(r.Body).Decode(&req)
+	var result interface{}
+	switch req.Method {
+	case "getSlot":
+		result = 100
+	case "getBlocks":
+		var start, end uint64
+		json.Unmarshal(req.Params[0], &start)
+		json.Unmarshal(req.Params[1], &end)
+		slots := []uint64{}
+		for slot := start; slot <= end; slot++ {
+			if u.produced(slot) {
+				slots = append(slots, slot)
+			}
+		}
+		result = slots
+	case "getBlock":
+		var slot uint64
+		json.Unmarshal(req.Params[0], &slot)
+		u.mu.Lock()
+		u.getBlocks = append(u.getBlocks, slot)
+		u.mu.Unlock()
+		result = json.RawMessage(testBlockJSON(slot))
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+func (u *blockUpstream) serveWS(w http.ResponseWriter, r *http.Request) {
+	conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	var req struct {
+		ID     uint64 `json:"id"`
+		Method string `json:"method"`
+	}
+	if err := conn.ReadJSON(&req); err != nil || req.Method != "blockSubscribe" {
+		return
+	}
+	conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": 1})
+	for _, notification := range u.notifications {
+		conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","method":"blockNotification","params":{"subscription":1,"result":`+notification+`}}`))
+	}
+	// Keep the subscription open until the client leaves
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// newBlockUpstreamService creates a service on a fake upstream serving RPC
+// and WebSocket on the same address
+func newBlockUpstreamService(t *testing.T, fake *blockUpstream) *BenchmarkService {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	endpoint, err := upstream.NewEndpoint(server.URL, "ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("NewEndpoint: %v", err)
+	}
+	return NewBenchmarkService(endpoint)
+}
+
+func testBlockJSON(slot uint64) string {
+	b, _ := json.Marshal(map[string]interface{}{
+		"blockhash":         "11111111111111111111111111111111",
+		"previousBlockhash": "11111111111111111111111111111111",
+		"parentSlot":        slot - 1,
+		"transactions":      []interface{}{},
+	})
+	return string(b)
+}
+
+func testNotificationJSON(slot uint64, block bool) string {
+	value := `{"slot":` + jsonNumber(slot) + `,"err":"UnsupportedTransactionVersion(0)","block":null}`
+	if block {
+		value = `{"slot":` + jsonNumber(slot) + `,"err":null,"block":` + testBlockJSON(slot) + `}`
+	}
+	return `{"context":{"slot":` + jsonNumber(slot) + `},"value":` + value + `}`
+}
+
+func jsonNumber(n uint64) string {
+	b, _ := json.Marshal(n)
+	return string(b)
+}
+
+// TestBlockFeedSubscribed expects the block feed to publish the blocks pushed
+// by blockSubscribe, reading with getBlock only the one notified without its
+// content
+func TestBlockFeedSubscribed(t *testing.T) {
+	fake := &blockUpstream{
+		blocks: []uint64{101, 102, 103},
+		notifications: []string{
+			testNotificationJSON(101, true),
+			testNotificationJSON(102, false),
+			testNotificationJSON(103, true),
+		},
+	}
+	s := newBlockUpstreamService(t, fake)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	done := errors.New("done")
+	var slots []uint64
+	err := s.followBlocks(ctx, rpc.CommitmentConfirmed, streamClasses[proto.StreamQoS_STREAM_QOS_REALTIME], func(slot uint64, block *rpc.GetBlockResult, _ *stageTiming) error {
+		slots = append(slots, slot)
+		if block.ParentSlot != slot-1 {
+			t.Errorf("block %d has parent %d", slot, block.ParentSlot)
+		}
+		if slot == 103 {
+			return done
+		}
+		return nil
+	})
+	if err != done {
+		t.Fatalf("followBlocks: %v", err)
+	}
+
+	if len(slots) != 3 || slots[0] != 101 || slots[1] != 102 || slots[2] != 103 {
+		t.Errorf("got slots %v, want [101 102 103]", slots)
+	}
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if len(fake.getBlocks) != 1 || fake.getBlocks[0] != 102 {
+		t.Errorf("read blocks %v with getBlock, want only 102", fake.getBlocks)
+	}
+	if !s.blocksPushed.Load() {
+		t.Error("blocks not reported as pushed")
+	}
+}
+
+// blockUpdateStream collects the updates sent on a block stream
+type blockUpdateStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates []*proto.BlockUpdate
+	// last ends the stream once sent
+	last uint64
+}
+
+func (s *blockUpdateStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *blockUpdateStream) Send(update *proto.BlockUpdate) error {
+	s.updates = append(s.updates, update)
+	if update.Slot == s.last {
+		return errors.New("done")
+	}
+	return nil
+}
+
+// TestStreamBlocksSubscribed expects block headers pushed by blockSubscribe
+// to be streamed as they are, with the slots skipped past before and between
+// notifications read over RPC and marked skipped unless they produced a block
+func TestStreamBlocksSubscribed(t *testing.T) {
+	fake := &blockUpstream{
+		blocks: []uint64{101, 102, 104},
+		notifications: []string{
+			testNotificationJSON(101, true),
+			testNotificationJSON(104, true),
+		},
+	}
+	s := newBlockUpstreamService(t, fake)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream := &blockUpdateStream{ctx: ctx, last: 104}
+	err := s.StreamBlocks(&proto.BlockStreamRequest{Commitment: "confirmed"}, stream)
+	if err == nil || ctx.Err() != nil {
+		t.Fatalf("StreamBlocks: %v", err)
+	}
+
+	want := []struct {
+		slot    uint64
+		skipped bool
+	}{{100, true}, {101, false}, {102, false}, {103, true}, {104, false}}
+	if len(stream.updates) != len(want) {
+		t.Fatalf("got %d updates, want %d", len(stream.updates), len(want))
+	}
+	for i, update := range stream.updates {
+		if update.Slot != want[i].slot || update.Skipped != want[i].skipped {
+			t.Errorf("update %d is slot %d, skipped %t; want slot %d, skipped %t", i, update.Slot, update.Skipped, want[i].slot, want[i].skipped)
+		}
+	}
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if len(fake.getBlocks) != 1 || fake.getBlocks[0] != 102 {
+		t.Errorf("read blocks %v with getBlock, want only 102", fake.getBlocks)
+	}
+}
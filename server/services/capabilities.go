@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+)
+
+// GetCapabilities lists the features this deployment supports
+func (s *BenchmarkService) GetCapabilities(ctx context.Context, req *proto.CapabilitiesRequest) (*proto.Capabilities, error) {
+	caps := &proto.Capabilities{
+		UpstreamType:        "rpc",
+		Yellowstone:         s.gateway != nil,
+		Websocket:           s.wsAvailable(),
+		BenchmarkCache:      s.benchmarkCache.ttl > 0,
+		BenchmarkCacheTtlMs: uint64(s.benchmarkCache.ttl.Milliseconds()),
+		AccountHistory:      s.history != nil,
+	}
+	for _, flag := range s.features.Flags() {
+		caps.Features = append(caps.Features, &proto.FeatureFlag{Name: string(flag), Enabled: s.features.Enabled(flag)})
+	}
+	if s.endpoint.Transport != nil {
+		caps.UpstreamType = "in-memory"
+	}
+
+	// Program subscriptions and commitment latency need a WebSocket upstream,
+	// or poll in degraded mode without one
+	subscriptions := proto.StreamSource_STREAM_SOURCE_UNAVAILABLE
+	if s.features.Enabled(features.WebSocketStreams) {
+		switch {
+		case caps.Websocket:
+			subscriptions = proto.StreamSource_STREAM_SOURCE_PUSHED
+		case s.wsFallbackPoll > 0:
+			subscriptions = proto.StreamSource_STREAM_SOURCE_POLLED
+			caps.Degraded = true
+			caps.FallbackPollIntervalMs = uint32(s.wsFallbackPoll.Milliseconds())
+		}
+	}
+	// Account streams are pushed by a gateway or the WebSocket upstream, and
+	// otherwise poll; large account sets poll even with a WebSocket upstream
+	accounts := subscriptions
+	switch {
+	case caps.Yellowstone:
+		accounts = proto.StreamSource_STREAM_SOURCE_PUSHED
+	case accounts == proto.StreamSource_STREAM_SOURCE_UNAVAILABLE && s.wsFallbackPoll > 0:
+		accounts = proto.StreamSource_STREAM_SOURCE_POLLED
+	}
+	// Block-derived streams follow the shared block feeds, pushed while the
+	// WebSocket upstream serves block subscriptions
+	blocks := proto.StreamSource_STREAM_SOURCE_POLLED
+	switch {
+	case !s.features.Enabled(features.BlockStreams):
+		blocks = proto.StreamSource_STREAM_SOURCE_UNAVAILABLE
+	case s.blocksPushed.Load():
+		blocks = proto.StreamSource_STREAM_SOURCE_PUSHED
+	}
+
+	for _, stream := range []struct {
+		method string
+		source proto.StreamSource
+	}{
+		{proto.BenchmarkService_StreamAccountUpdates_FullMethodName, accounts},
+		{proto.BenchmarkService_StreamAccountUpdatesWithAck_FullMethodName, accounts},
+		{proto.BenchmarkService_StreamProgramAccountsSnapshot_FullMethodName, subscriptions},
+		{proto.BenchmarkService_MeasureCommitmentLatency_FullMethodName, subscriptions},
+		{proto.BenchmarkService_StreamTransactions_FullMethodName, blocks},
+		{proto.BenchmarkService_StreamBlocks_FullMethodName, blocks},
+		{proto.BenchmarkService_StreamBalanceChanges_FullMethodName, blocks},
+		{proto.BenchmarkService_StreamAlerts_FullMethodName, blocks},
+		{proto.BenchmarkService_StreamFeeStats_FullMethodName, blocks},
+		{proto.BenchmarkService_StreamAddressHistory_FullMethodName, proto.StreamSource_STREAM_SOURCE_POLLED},
+		{proto.BenchmarkService_StreamBlockProduction_FullMethodName, proto.StreamSource_STREAM_SOURCE_POLLED},
+		{proto.BenchmarkService_StreamEpochEvents_FullMethodName, proto.StreamSource_STREAM_SOURCE_POLLED},
+		{proto.BenchmarkService_StreamOwnedAccountChanges_FullMethodName, subscriptions},
+	} {
+		caps.Streams = append(caps.Streams, &proto.StreamCapability{Method: stream.method, Source: stream.source})
+	}
+	return caps, nil
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetClusterNodes passes getClusterNodes through, keeping the nodes that
+// match the request's version, feature set and RPC filters
+func (s *BenchmarkService) GetClusterNodes(ctx context.Context, req *proto.ClusterNodesRequest) (*proto.ClusterNodesResponse, error) {
+	startTime := time.Now()
+
+	nodes, err := s.solanaClient.GetClusterNodes(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get cluster nodes: %v", err)
+	}
+
+	resp := &proto.ClusterNodesResponse{
+		TotalNodes: uint32(len(nodes)),
+		Versions:   make(map[string]uint32),
+	}
+	for _, node := range nodes {
+		converted := clusterNode(node)
+		if !matchesVersion(converted.Version, req.Version) ||
+			(req.FeatureSet != 0 && converted.FeatureSet != req.FeatureSet) ||
+			(req.RpcOnly && converted.Rpc == "") {
+			continue
+		}
+		resp.Nodes = append(resp.Nodes, converted)
+
+		version := converted.Version
+		if version == "" {
+			version = "unknown"
+		}
+		resp.Versions[version]++
+	}
+	sort.Slice(resp.Nodes, func(i, j int) bool { return resp.Nodes[i].Pubkey < resp.Nodes[j].Pubkey })
+
+	resp.ResponseTimeMs = uint64(time.Since(startTime).Milliseconds())
+	return resp, nil
+}
+
+// matchesVersion reports whether a node version is the requested version or
+// a release of it, so that 1.18 matches 1.18.22 but not 1.180.0
+func matchesVersion(version, requested string) bool {
+	return requested == "" || version == requested || strings.HasPrefix(version, requested+".")
+}
+
+func clusterNode(node *rpc.GetClusterNodesResult) *proto.ClusterNode {
+	converted := &proto.ClusterNode{
+		Pubkey:       node.Pubkey.String(),
+		FeatureSet:   node.FeatureSet,
+		ShredVersion: uint32(node.ShredVersion),
+	}
+	for _, field := range []struct {
+		dst *string
+		src *string
+	}{
+		{&converted.Gossip, node.Gossip},
+		{&converted.Tpu, node.TPU},
+		{&converted.Rpc, node.RPC},
+		{&converted.Version, node.Version},
+	} {
+		if field.src != nil {
+			*field.dst = *field.src
+		}
+	}
+	return converted
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultLatencyDuration is how long a commitment latency measurement runs
+	// when the client does not specify a duration
+	defaultLatencyDuration = 30 * time.Second
+	// maxLatencyDuration caps the duration a client may request
+	maxLatencyDuration = 5 * time.Minute
+)
+
+// latencyCommitments are the commitment levels measured, in transition order
+var latencyCommitments = []rpc.CommitmentType{
+	rpc.CommitmentProcessed,
+	rpc.CommitmentConfirmed,
+	rpc.CommitmentFinalized,
+}
+
+// commitmentObservation records when a slot was seen at a commitment level
+type commitmentObservation struct {
+	level int
+	slot  uint64
+	at    time.Time
+}
+
+// MeasureCommitmentLatency subscribes to the same account at every commitment
+// level and, once a slot is seen at finalized commitment, reports how long each
+// transition took relative to when the slot was first seen at processed. When
+// no WebSocket upstream is available, it polls the slot at each level instead.
+func (s *BenchmarkService) MeasureCommitmentLatency(req *proto.CommitmentLatencyRequest, stream proto.BenchmarkService_MeasureCommitmentLatencyServer) error {
+	if err := s.requireFeature(features.WebSocketStreams); err != nil {
+		return err
+	}
+	pubkey := solana.SysVarClockPubkey
+	if req.Pubkey != "" {
+		var err error
+		pubkey, err = solana.PublicKeyFromBase58(req.Pubkey)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid pubkey: %v", err)
+		}
+	}
+
+	duration := defaultLatencyDuration
+	if req.DurationSeconds > 0 {
+		duration = time.Duration(req.DurationSeconds) * time.Second
+	}
+	if duration > maxLatencyDuration {
+		return status.Errorf(codes.InvalidArgument, "duration must not exceed %v", maxLatencyDuration)
+	}
+
+	if err := setStreamCompression(stream.Context(), req.Compression); err != nil {
+		return err
+	}
+	requestedPoll, err := parsePollInterval(req.PollIntervalMs)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(stream.Context(), duration)
+	defer cancel()
+
+	if !s.wsAvailable() {
+		interval, err := s.wsFallback("Commitment latency measurement", requestedPoll, nil)
+		if err != nil {
+			return err
+		}
+		return s.pollCommitmentLatency(ctx, stream, interval)
+	}
+	wsClient, err := s.endpoint.ConnectWS(ctx)
+	if err != nil {
+		interval, err := s.wsFallback("Commitment latency measurement", requestedPoll, err)
+		if err != nil {
+			return err
+		}
+		return s.pollCommitmentLatency(ctx, stream, interval)
+	}
+	defer wsClient.Close()
+
+	observations := make(chan commitmentObservation)
+	errs := make(chan error, len(latencyCommitments))
+	for level, commitment := range latencyCommitments {
+		sub, err := wsClient.AccountSubscribe(pubkey, commitment)
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "failed to subscribe at %s commitment: %v", commitment, err)
+		}
+		defer sub.Unsubscribe()
+		go observeCommitment(ctx, sub, level, observations, errs)
+	}
+
+	// First arrival time of each pending slot per commitment level
+	pending := make(map[uint64][]time.Time)
+	for {
+		var obs commitmentObservation
+		select {
+		case <-ctx.Done():
+			// Reaching the requested duration ends the measurement normally
+			if stream.Context().Err() != nil {
+				return status.FromContextError(stream.Context().Err()).Err()
+			}
+			return nil
+		case err := <-errs:
+			return status.Errorf(codes.Unavailable, "account subscription failed: %v", err)
+		case obs = <-observations:
+		}
+
+		seen, ok := pending[obs.slot]
+		if !ok {
+			seen = make([]time.Time, len(latencyCommitments))
+			pending[obs.slot] = seen
+		}
+		if seen[obs.level].IsZero() {
+			seen[obs.level] = obs.at
+		}
+
+		if obs.level != len(latencyCommitments)-1 {
+			continue
+		}
+
+		// The slot is finalized, so nothing older can still transition
+		for slot := range pending {
+			if slot < obs.slot {
+				delete(pending, slot)
+			}
+		}
+		delete(pending, obs.slot)
+
+		// Slots that were not seen at every level cannot be measured
+		processed, confirmed, finalized := seen[0], seen[1], seen[2]
+		if processed.IsZero() || confirmed.IsZero() {
+			continue
+		}
+
+		err := stream.Send(&proto.CommitmentLatencySample{
+			Slot:                   obs.slot,
+			ProcessedToConfirmedMs: uint64(confirmed.Sub(processed).Milliseconds()),
+			ConfirmedToFinalizedMs: uint64(finalized.Sub(confirmed).Milliseconds()),
+			ProcessedToFinalizedMs: uint64(finalized.Sub(processed).Milliseconds()),
+			Timestamp:              uint64(time.Now().Unix()),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to send commitment latency sample: %v", err)
+		}
+	}
+}
+
+// observeCommitment forwards the slot of every notification of an account
+// subscription until the context is done
+func observeCommitment(ctx context.Context, sub *ws.AccountSubscription, level int, observations chan<- commitmentObservation, errs chan<- error) {
+	// Unsubscribing unblocks Recv once the measurement ends
+	go func() {
+		<-ctx.Done()
+		sub.Unsubscribe()
+	}()
+
+	for {
+		result, err := sub.Recv()
+		if err != nil {
+			errs <- err
+			return
+		}
+		if result == nil {
+			return
+		}
+
+		select {
+		case observations <- commitmentObservation{level: level, slot: result.Context.Slot, at: time.Now()}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
+)
+
+// setStreamCompression applies the compression a streaming request asked for
+// to that stream's responses, independently of the compression the client
+// chose for the channel. An empty name keeps the channel default, which is to
+// answer with the compressor the client used for its request. It must be
+// called before the first response is sent.
+func setStreamCompression(ctx context.Context, name string) error {
+	switch name {
+	case "":
+		return nil
+	case "none":
+		name = encoding.Identity
+	case gzip.Name:
+	default:
+		return status.Errorf(codes.InvalidArgument, "unsupported compression: %s", name)
+	}
+
+	if err := grpc.SetSendCompressor(ctx, name); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to set compression: %v", err)
+	}
+	return nil
+}
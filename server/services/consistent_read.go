@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxConsistentAccounts caps the accounts of a consistent read
+	maxConsistentAccounts = 1000
+	// maxConsistentReadAttempts bounds the reads made to find a common slot
+	maxConsistentReadAttempts = 5
+	// jsonrpcMinContextSlotNotReached is the JSON-RPC error code of a call
+	// made to a node behind its minContextSlot
+	jsonrpcMinContextSlotNotReached = -32016
+)
+
+// consistentChunk is one getMultipleAccounts call of a consistent read
+type consistentChunk struct {
+	pubkeys  []solana.PublicKey
+	slot     uint64
+	accounts []*rpc.Account
+}
+
+// ReadConsistent reads several accounts as of one context slot. A single
+// getMultipleAccounts call is consistent by itself, but it is limited to 100
+// accounts, and separate calls may be answered at different slots or by
+// different nodes. Larger reads are split into calls made concurrently, and
+// those answered behind the newest slot are repeated until all agree.
+func (s *BenchmarkService) ReadConsistent(ctx context.Context, req *proto.ConsistentReadRequest) (*proto.ConsistentReadResponse, error) {
+	pubkeys, err := parsePubkeys(req.Pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(pubkeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one pubkey is required")
+	}
+	if len(pubkeys) > maxConsistentAccounts {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d accounts may be read at once", maxConsistentAccounts)
+	}
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+
+	var chunks []*consistentChunk
+	for start := 0; start < len(pubkeys); start += maxMultipleAccounts {
+		end := start + maxMultipleAccounts
+		if end > len(pubkeys) {
+			end = len(pubkeys)
+		}
+		chunks = append(chunks, &consistentChunk{pubkeys: pubkeys[start:end]})
+	}
+
+	stale := chunks
+	attempts := 0
+	var slot uint64
+	for len(stale) > 0 {
+		if attempts == maxConsistentReadAttempts {
+			return nil, status.Errorf(codes.Aborted, "no consistent snapshot after %d attempts", attempts)
+		}
+		attempts++
+		if err := s.readChunks(ctx, stale, commitment, slot); err != nil {
+			return nil, err
+		}
+
+		// Chunks behind the newest slot are read again
+		for _, chunk := range chunks {
+			if chunk.slot > slot {
+				slot = chunk.slot
+			}
+		}
+		stale = nil
+		for _, chunk := range chunks {
+			if chunk.slot != slot {
+				stale = append(stale, chunk)
+			}
+		}
+	}
+
+	responseTime := time.Since(startTime).Milliseconds()
+
+	response := &proto.ConsistentReadResponse{
+		Slot:           slot,
+		Accounts:       make([]*proto.ConsistentAccount, 0, len(pubkeys)),
+		Attempts:       uint32(attempts),
+		ResponseTimeMs: uint64(responseTime),
+	}
+	for _, chunk := range chunks {
+		for i, pubkey := range chunk.pubkeys {
+			state := &proto.ConsistentAccount{Pubkey: pubkey.String()}
+			if i < len(chunk.accounts) && chunk.accounts[i] != nil {
+				account := chunk.accounts[i]
+				state.Exists = true
+				state.Data = account.Data.GetBinary()
+				state.Owner = account.Owner.String()
+				state.Lamports = account.Lamports
+				state.Executable = account.Executable
+				state.RentEpoch = account.RentEpoch
+			}
+			response.Accounts = append(response.Accounts, state)
+		}
+	}
+	return response, nil
+}
+
+// readChunks reads the chunks concurrently, so that their calls are answered
+// as close to the same slot as possible. Re-reads pass the newest slot seen
+// so far as minSlot, so a node behind it refuses the call rather than
+// answering from an older slot; such a chunk is left stale.
+func (s *BenchmarkService) readChunks(ctx context.Context, chunks []*consistentChunk, commitment rpc.CommitmentType, minSlot uint64) error {
+	opts := &rpc.GetMultipleAccountsOpts{
+		Encoding:   solana.EncodingBase64,
+		Commitment: commitment,
+	}
+	if minSlot > 0 {
+		opts.MinContextSlot = &minSlot
+	}
+
+	errs := make([]error, len(chunks))
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk *consistentChunk) {
+			defer wg.Done()
+			result, err := s.solanaClient.GetMultipleAccountsWithOpts(ctx, chunk.pubkeys, opts)
+			var rpcErr *jsonrpc.RPCError
+			if errors.As(err, &rpcErr) && rpcErr.Code == jsonrpcMinContextSlotNotReached {
+				return
+			}
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			chunk.slot = result.Context.Slot
+			chunk.accounts = result.Value
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get accounts: %v", err)
+		}
+	}
+	return nil
+}
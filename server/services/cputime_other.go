@@ -0,0 +1,10 @@
+//go:build !unix
+
+package services
+
+import "time"
+
+// processCPUTime is not available on this platform and always returns zero
+func processCPUTime() time.Duration {
+	return 0
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// jsonrpcMethodNotFound is the JSON-RPC error code of an unknown method
+	jsonrpcMethodNotFound = -32601
+	// maxAssetsPerPage is the page size limit of the DAS API
+	maxAssetsPerPage = 1000
+	// maxTreeDepth is the deepest concurrent Merkle tree of account compression
+	maxTreeDepth = 30
+)
+
+// dasAsset is the part of a DAS asset mapped to proto fields
+type dasAsset struct {
+	Interface string `json:"interface"`
+	ID        string `json:"id"`
+	Content   struct {
+		JSONURI  string `json:"json_uri"`
+		Metadata struct {
+			Name   string `json:"name"`
+			Symbol string `json:"symbol"`
+		} `json:"metadata"`
+	} `json:"content"`
+	Compression struct {
+		Compressed bool   `json:"compressed"`
+		Tree       string `json:"tree"`
+		LeafID     uint64 `json:"leaf_id"`
+	} `json:"compression"`
+	Grouping []struct {
+		GroupKey   string `json:"group_key"`
+		GroupValue string `json:"group_value"`
+	} `json:"grouping"`
+	Ownership struct {
+		Owner string `json:"owner"`
+	} `json:"ownership"`
+	Mutable bool `json:"mutable"`
+	Burnt   bool `json:"burnt"`
+}
+
+// GetAsset proxies the DAS getAsset method
+func (s *BenchmarkService) GetAsset(ctx context.Context, req *proto.AssetRequest) (*proto.AssetResponse, error) {
+	if _, err := solana.PublicKeyFromBase58(req.Id); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset ID: %v", err)
+	}
+
+	startTime := time.Now()
+
+	var raw json.RawMessage
+	if err := s.dasCall(ctx, "getAsset", map[string]interface{}{"id": req.Id}, &raw); err != nil {
+		return nil, err
+	}
+	asset, err := decodeAsset(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AssetResponse{
+		Asset:          asset,
+		ResponseTimeMs: uint64(time.Since(startTime).Milliseconds()),
+	}, nil
+}
+
+// GetAssetsByOwner proxies the DAS getAssetsByOwner method
+func (s *BenchmarkService) GetAssetsByOwner(ctx context.Context, req *proto.AssetsByOwnerRequest) (*proto.AssetsByOwnerResponse, error) {
+	if _, err := solana.PublicKeyFromBase58(req.Owner); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner: %v", err)
+	}
+	if req.Limit > maxAssetsPerPage {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d assets may be requested per page", maxAssetsPerPage)
+	}
+	params := map[string]interface{}{"ownerAddress": req.Owner, "page": req.Page}
+	if req.Page == 0 {
+		params["page"] = 1
+	}
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+	}
+
+	startTime := time.Now()
+
+	var result struct {
+		Total uint32            `json:"total"`
+		Limit uint32            `json:"limit"`
+		Page  uint32            `json:"page"`
+		Items []json.RawMessage `json:"items"`
+	}
+	if err := s.dasCall(ctx, "getAssetsByOwner", params, &result); err != nil {
+		return nil, err
+	}
+
+	response := &proto.AssetsByOwnerResponse{
+		Assets: make([]*proto.Asset, 0, len(result.Items)),
+		Total:  result.Total,
+		Page:   result.Page,
+		Limit:  result.Limit,
+	}
+	for _, item := range result.Items {
+		asset, err := decodeAsset(item)
+		if err != nil {
+			return nil, err
+		}
+		response.Assets = append(response.Assets, asset)
+	}
+	response.ResponseTimeMs = uint64(time.Since(startTime).Milliseconds())
+	return response, nil
+}
+
+// GetAssetProof proxies the DAS getAssetProof method, decoding the hashes of
+// the proof and deriving the leaf index from the node index
+func (s *BenchmarkService) GetAssetProof(ctx context.Context, req *proto.AssetProofRequest) (*proto.AssetProofResponse, error) {
+	if _, err := solana.PublicKeyFromBase58(req.Id); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset ID: %v", err)
+	}
+
+	startTime := time.Now()
+
+	var result struct {
+		Root      string   `json:"root"`
+		Proof     []string `json:"proof"`
+		NodeIndex uint64   `json:"node_index"`
+		Leaf      string   `json:"leaf"`
+		TreeID    string   `json:"tree_id"`
+	}
+	if err := s.dasCall(ctx, "getAssetProof", map[string]interface{}{"id": req.Id}, &result); err != nil {
+		return nil, err
+	}
+
+	// The node index counts from the root at 1, so leaves start at 2^depth
+	depth := len(result.Proof)
+	if depth > maxTreeDepth || result.NodeIndex < 1<<depth || result.NodeIndex >= 2<<depth {
+		return nil, status.Errorf(codes.Internal, "node index %d does not match a proof of %d hashes", result.NodeIndex, depth)
+	}
+
+	response := &proto.AssetProofResponse{
+		Id:        req.Id,
+		Tree:      result.TreeID,
+		Proof:     make([][]byte, depth),
+		NodeIndex: result.NodeIndex,
+		LeafIndex: result.NodeIndex - 1<<depth,
+		Depth:     uint32(depth),
+	}
+	var err error
+	if response.Root, err = decodeHash(result.Root); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode root: %v", err)
+	}
+	if response.Leaf, err = decodeHash(result.Leaf); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode leaf: %v", err)
+	}
+	for i, node := range result.Proof {
+		if response.Proof[i], err = decodeHash(node); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decode proof node %d: %v", i, err)
+		}
+	}
+	response.ResponseTimeMs = uint64(time.Since(startTime).Milliseconds())
+	return response, nil
+}
+
+// decodeHash decodes a base58-encoded 32-byte hash
+func decodeHash(encoded string) ([]byte, error) {
+	hash, err := solana.HashFromBase58(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return hash[:], nil
+}
+
+// dasCall calls a DAS method and decodes its result into out. DAS methods
+// take their params by name, which the positional calls of the RPC client
+// cannot express, so the call is made as a single-request batch.
+func (s *BenchmarkService) dasCall(ctx context.Context, method string, params map[string]interface{}, out interface{}) error {
+	responses, err := s.solanaClient.RPCCallBatch(ctx, jsonrpc.RPCRequests{{Method: method, Params: params}})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to call %s: %v", method, err)
+	}
+	if len(responses) != 1 {
+		return status.Errorf(codes.Internal, "failed to call %s: %d responses to a single request", method, len(responses))
+	}
+
+	if rpcErr := responses[0].Error; rpcErr != nil {
+		switch {
+		case rpcErr.Code == jsonrpcMethodNotFound:
+			return status.Errorf(codes.Unimplemented, "the upstream does not support the DAS method %s", method)
+		case strings.Contains(strings.ToLower(rpcErr.Message), "not found"):
+			return status.Errorf(codes.NotFound, "%s: %s", method, rpcErr.Message)
+		default:
+			return status.Errorf(codes.Internal, "failed to call %s: %v", method, rpcErr)
+		}
+	}
+	if err := json.Unmarshal(responses[0].Result, out); err != nil {
+		return status.Errorf(codes.Internal, "failed to decode %s result: %v", method, err)
+	}
+	return nil
+}
+
+// decodeAsset maps a DAS asset to its proto form, keeping the raw JSON
+func decodeAsset(raw json.RawMessage) (*proto.Asset, error) {
+	var asset dasAsset
+	if err := json.Unmarshal(raw, &asset); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode asset: %v", err)
+	}
+
+	result := &proto.Asset{
+		Id:         asset.ID,
+		Interface:  asset.Interface,
+		Name:       asset.Content.Metadata.Name,
+		Symbol:     asset.Content.Metadata.Symbol,
+		JsonUri:    asset.Content.JSONURI,
+		Owner:      asset.Ownership.Owner,
+		Mutable:    asset.Mutable,
+		Burnt:      asset.Burnt,
+		Compressed: asset.Compression.Compressed,
+		RawJson:    raw,
+	}
+	if asset.Compression.Compressed {
+		result.Tree = asset.Compression.Tree
+		result.LeafId = asset.Compression.LeafID
+	}
+	for _, group := range asset.Grouping {
+		if group.GroupKey == "collection" {
+			result.Collection = group.GroupValue
+		}
+	}
+	return result, nil
+}
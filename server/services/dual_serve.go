@@ -0,0 +1,305 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/jsonapi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultDualServeIterations is the number of calls per target and
+	// protocol when the request does not set one
+	defaultDualServeIterations = 100
+	// maxDualServeIterations caps the iterations a request may ask for
+	maxDualServeIterations = 10000
+	// maxDualServeTargets caps the targets of one dual-serve benchmark
+	maxDualServeTargets = 100
+)
+
+// frozenService answers GetAccountInfo, GetTransaction and GetBlock with
+// responses fetched before a dual-serve benchmark, so both protocols serve
+// the same data without calling the upstream
+type frozenService struct {
+	proto.UnimplementedBenchmarkServiceServer
+	accounts     map[string]*proto.AccountInfoResponse
+	transactions map[string]*proto.TransactionResponse
+	blocks       map[uint64]*proto.BlockResponse
+}
+
+func (f *frozenService) GetAccountInfo(ctx context.Context, req *proto.AccountInfoRequest) (*proto.AccountInfoResponse, error) {
+	if resp, ok := f.accounts[req.Pubkey]; ok {
+		return resp, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "account %s was not fetched", req.Pubkey)
+}
+
+func (f *frozenService) GetTransaction(ctx context.Context, req *proto.TransactionRequest) (*proto.TransactionResponse, error) {
+	if resp, ok := f.transactions[req.Signature]; ok {
+		return resp, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "transaction %s was not fetched", req.Signature)
+}
+
+func (f *frozenService) GetBlock(ctx context.Context, req *proto.BlockRequest) (*proto.BlockResponse, error) {
+	if resp, ok := f.blocks[req.Slot]; ok {
+		return resp, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "block %d was not fetched", req.Slot)
+}
+
+// dualServeCall is one target of a dual-serve benchmark, called over each
+// protocol
+type dualServeCall struct {
+	// fullMethod is the gRPC method, and its name the JSON endpoint
+	fullMethod string
+	name       string
+	req        protobuf.Message
+	// newResp returns an empty response to decode into
+	newResp func() protobuf.Message
+}
+
+// BenchmarkDualServe fetches the requested targets once, then serves them
+// from loopback gRPC and JSON over HTTP servers in this process and calls
+// each target over both, alternating between the protocols so drift in the
+// host affects them equally. It waits its turn in the benchmark queue, so
+// benchmark runs do not skew it.
+func (s *BenchmarkService) BenchmarkDualServe(ctx context.Context, req *proto.DualServeBenchmarkRequest) (*proto.DualServeBenchmarkResults, error) {
+	count := len(req.TestAccounts) + len(req.TestSignatures) + len(req.TestSlots)
+	if count == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one account, signature or slot is required")
+	}
+	if count > maxDualServeTargets {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d targets may be benchmarked", maxDualServeTargets)
+	}
+	iterations := defaultDualServeIterations
+	if req.Iterations > 0 {
+		iterations = int(req.Iterations)
+	}
+	if iterations > maxDualServeIterations {
+		return nil, status.Errorf(codes.InvalidArgument, "iterations must not exceed %d", maxDualServeIterations)
+	}
+
+	if err := s.benchmarkQueue.acquire(ctx); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	defer s.benchmarkQueue.release()
+
+	frozen, targets, err := s.freezeTargets(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Serve the frozen responses over both protocols on loopback
+	grpcListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to listen: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	proto.RegisterBenchmarkServiceServer(grpcServer, frozen)
+	go grpcServer.Serve(grpcListener)
+	defer grpcServer.Stop()
+
+	httpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to listen: %v", err)
+	}
+	httpServer := &http.Server{Handler: jsonapi.Handler(frozen)}
+	go httpServer.Serve(httpListener)
+	defer httpServer.Close()
+
+	conn, err := grpc.Dial(grpcListener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to connect: %v", err)
+	}
+	defer conn.Close()
+	httpClient := &http.Client{Transport: &http.Transport{}}
+	defer httpClient.CloseIdleConnections()
+	jsonURL := "http://" + httpListener.Addr().String() + jsonapi.Path
+
+	results := &proto.DualServeBenchmarkResults{Iterations: uint32(iterations)}
+	for _, category := range []struct {
+		calls  []dualServeCall
+		result **proto.DualServeCategory
+	}{
+		{targets.accounts, &results.Account},
+		{targets.transactions, &results.Transaction},
+		{targets.blocks, &results.Block},
+	} {
+		if len(category.calls) == 0 {
+			continue
+		}
+		var grpcSamples, jsonSamples dualServeSamples
+		for i := 0; i < iterations; i++ {
+			for _, call := range category.calls {
+				if ctx.Err() != nil {
+					return nil, status.FromContextError(ctx.Err()).Err()
+				}
+				if err := grpcSamples.time(func() (int, error) {
+					resp := call.newResp()
+					if err := conn.Invoke(ctx, call.fullMethod, call.req, resp); err != nil {
+						return 0, err
+					}
+					return protobuf.Size(resp), nil
+				}); err != nil {
+					return nil, status.Errorf(codes.Internal, "gRPC call to %s failed: %v", call.name, err)
+				}
+				if err := jsonSamples.time(func() (int, error) {
+					return callJSON(ctx, httpClient, jsonURL+call.name, call.req, call.newResp())
+				}); err != nil {
+					return nil, status.Errorf(codes.Internal, "JSON call to %s failed: %v", call.name, err)
+				}
+			}
+		}
+
+		result := &proto.DualServeCategory{Grpc: grpcSamples.protocol(), Json: jsonSamples.protocol()}
+		if result.Grpc.AvgUs > 0 {
+			result.Speedup = result.Json.AvgUs / result.Grpc.AvgUs
+		}
+		*category.result = result
+	}
+	return results, nil
+}
+
+// dualServeTargets are the calls of a dual-serve benchmark by category
+type dualServeTargets struct {
+	accounts     []dualServeCall
+	transactions []dualServeCall
+	blocks       []dualServeCall
+}
+
+// freezeTargets fetches each target through the service's own handlers, so
+// both protocols serve the responses a client would receive
+func (s *BenchmarkService) freezeTargets(ctx context.Context, req *proto.DualServeBenchmarkRequest) (*frozenService, *dualServeTargets, error) {
+	frozen := &frozenService{
+		accounts:     make(map[string]*proto.AccountInfoResponse),
+		transactions: make(map[string]*proto.TransactionResponse),
+		blocks:       make(map[uint64]*proto.BlockResponse),
+	}
+	targets := &dualServeTargets{}
+
+	for _, pubkey := range req.TestAccounts {
+		target := &proto.AccountInfoRequest{Pubkey: pubkey}
+		resp, err := s.GetAccountInfo(ctx, target)
+		if err != nil {
+			return nil, nil, err
+		}
+		frozen.accounts[pubkey] = resp
+		targets.accounts = append(targets.accounts, dualServeCall{
+			fullMethod: proto.BenchmarkService_GetAccountInfo_FullMethodName,
+			name:       "GetAccountInfo",
+			req:        target,
+			newResp:    func() protobuf.Message { return &proto.AccountInfoResponse{} },
+		})
+	}
+	for _, signature := range req.TestSignatures {
+		target := &proto.TransactionRequest{Signature: signature}
+		resp, err := s.GetTransaction(ctx, target)
+		if err != nil {
+			return nil, nil, err
+		}
+		frozen.transactions[signature] = resp
+		targets.transactions = append(targets.transactions, dualServeCall{
+			fullMethod: proto.BenchmarkService_GetTransaction_FullMethodName,
+			name:       "GetTransaction",
+			req:        target,
+			newResp:    func() protobuf.Message { return &proto.TransactionResponse{} },
+		})
+	}
+	for _, slot := range req.TestSlots {
+		target := &proto.BlockRequest{Slot: slot}
+		resp, err := s.GetBlock(ctx, target)
+		if err != nil {
+			return nil, nil, err
+		}
+		frozen.blocks[slot] = resp
+		targets.blocks = append(targets.blocks, dualServeCall{
+			fullMethod: proto.BenchmarkService_GetBlock_FullMethodName,
+			name:       "GetBlock",
+			req:        target,
+			newResp:    func() protobuf.Message { return &proto.BlockResponse{} },
+		})
+	}
+	return frozen, targets, nil
+}
+
+// callJSON posts a request to a JSON endpoint and decodes the response into
+// resp, returning the size of the response body
+func callJSON(ctx context.Context, client *http.Client, url string, req, resp protobuf.Message) (int, error) {
+	body, err := protojson.Marshal(req)
+	if err != nil {
+		return 0, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer httpResp.Body.Close()
+	data, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%s: %s", httpResp.Status, data)
+	}
+	if err := protojson.Unmarshal(data, resp); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// dualServeSamples accumulates the calls of one protocol
+type dualServeSamples struct {
+	calls        int
+	total        time.Duration
+	min, max     time.Duration
+	payloadBytes int
+}
+
+// time times one call, which returns the size of its response
+func (d *dualServeSamples) time(call func() (int, error)) error {
+	start := time.Now()
+	size, err := call()
+	elapsed := time.Since(start)
+	if err != nil {
+		return err
+	}
+	if d.calls == 0 || elapsed < d.min {
+		d.min = elapsed
+	}
+	d.max = max(d.max, elapsed)
+	d.calls++
+	d.total += elapsed
+	d.payloadBytes += size
+	return nil
+}
+
+func (d *dualServeSamples) protocol() *proto.DualServeProtocol {
+	if d.calls == 0 {
+		return &proto.DualServeProtocol{}
+	}
+	return &proto.DualServeProtocol{
+		Calls:           uint32(d.calls),
+		AvgUs:           averageUs(d.total, d.calls),
+		MinUs:           float64(d.min) / float64(time.Microsecond),
+		MaxUs:           float64(d.max) / float64(time.Microsecond),
+		AvgPayloadBytes: uint64(d.payloadBytes / d.calls),
+	}
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/flatblock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// GetEncodedBlock fetches a block with full transactions and returns it
+// encoded as a SerializedBlock or as a FlatBuffers block, so clients can
+// compare what each costs them to receive and read
+func (s *BenchmarkService) GetEncodedBlock(ctx context.Context, req *proto.EncodedBlockRequest) (*proto.EncodedBlock, error) {
+	if req.Slot == 0 {
+		return nil, status.Error(codes.InvalidArgument, "slot is required")
+	}
+	var encode func(*proto.SerializedBlock) ([]byte, error)
+	switch req.Encoding {
+	case proto.BlockEncoding_BLOCK_ENCODING_PROTOBUF:
+		encode = func(message *proto.SerializedBlock) ([]byte, error) { return protobuf.Marshal(message) }
+	case proto.BlockEncoding_BLOCK_ENCODING_FLATBUFFERS:
+		encode = func(message *proto.SerializedBlock) ([]byte, error) { return flatblock.Encode(message), nil }
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown encoding %v", req.Encoding)
+	}
+
+	opts, err := blockOpts(proto.BlockDetail_BLOCK_DETAIL_FULL)
+	if err != nil {
+		return nil, err
+	}
+	startTime := time.Now()
+	block, err := s.solanaClient.GetBlockWithOpts(ctx, req.Slot, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get block: %v", err)
+	}
+	responseTime := time.Since(startTime).Milliseconds()
+
+	message, err := serializedBlock(req.Slot, block)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert block: %v", err)
+	}
+	encodeStart := time.Now()
+	payload, err := encode(message)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode block: %v", err)
+	}
+
+	return &proto.EncodedBlock{
+		Slot:           req.Slot,
+		Encoding:       req.Encoding,
+		Payload:        payload,
+		ResponseTimeMs: uint64(responseTime),
+		EncodeUs:       averageUs(time.Since(encodeStart), 1),
+	}, nil
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// epochPollInterval is how often epoch event streams check the epoch;
+	// the check is local while the slot clock is live
+	epochPollInterval = 5 * time.Second
+	// maxRewardAddresses caps the addresses an epoch event stream watches
+	maxRewardAddresses = 100
+	// maxRewardPolls bounds the checks for the rewards of an ended epoch
+	// before they are sent with the addresses not yet credited, as rewards
+	// are paid out over the first blocks of the next epoch
+	maxRewardPolls = 60
+)
+
+// epochEvents tracks the information an epoch event stream still waits for
+type epochEvents struct {
+	schedule *rpc.GetEpochScheduleResult
+	epoch    uint64
+	// inflationPending is set while the epoch's inflation is not reported
+	inflationPending bool
+	// rewardsPending lists the ended epochs whose rewards are not sent yet,
+	// with the polls made for each
+	rewardsPending map[uint64]int
+}
+
+// StreamEpochEvents sends an event when an epoch starts, then the inflation
+// of the new epoch and the rewards of the watched addresses for the ended one
+// as they become available. Epoch boundaries are read from the slot clock
+// while it is live, and from the upstream otherwise.
+func (s *BenchmarkService) StreamEpochEvents(req *proto.EpochEventsRequest, stream proto.BenchmarkService_StreamEpochEventsServer) error {
+	if len(req.RewardAddresses) > maxRewardAddresses {
+		return status.Errorf(codes.InvalidArgument, "at most %d reward addresses may be watched", maxRewardAddresses)
+	}
+	addresses, err := parsePubkeys(req.RewardAddresses)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+	schedule, err := s.solanaClient.GetEpochSchedule(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get epoch schedule: %v", err)
+	}
+
+	events := &epochEvents{schedule: schedule, rewardsPending: make(map[uint64]int)}
+	send := func(event *proto.EpochEvent) error {
+		event.Timestamp = uint64(time.Now().Unix())
+		if err := stream.Send(event); err != nil {
+			return status.Errorf(codes.Internal, "failed to send epoch event: %v", err)
+		}
+		return nil
+	}
+
+	ticker := time.NewTicker(epochPollInterval)
+	defer ticker.Stop()
+	started := false
+	for {
+		epoch, slot, err := s.currentEpoch(ctx, schedule)
+		switch {
+		case ctx.Err() != nil:
+			return status.FromContextError(ctx.Err()).Err()
+		case err != nil:
+			log.Printf("Failed to get epoch for epoch events: %v", err)
+		case !started:
+			started = true
+			events.epoch = epoch
+			if req.IncludeCurrent {
+				event := events.boundary(proto.EpochEventType_EPOCH_EVENT_TYPE_CURRENT, slot)
+				event.Inflation = s.epochInflation(ctx, epoch)
+				events.inflationPending = event.Inflation == nil
+				if err := send(event); err != nil {
+					return err
+				}
+			}
+		case epoch > events.epoch:
+			if len(addresses) > 0 {
+				events.rewardsPending[epoch-1] = 0
+			}
+			events.epoch = epoch
+			event := events.boundary(proto.EpochEventType_EPOCH_EVENT_TYPE_STARTED, slot)
+			event.Inflation = s.epochInflation(ctx, epoch)
+			events.inflationPending = event.Inflation == nil
+			if err := send(event); err != nil {
+				return err
+			}
+		default:
+			if events.inflationPending {
+				if inflation := s.epochInflation(ctx, epoch); inflation != nil {
+					events.inflationPending = false
+					event := events.boundary(proto.EpochEventType_EPOCH_EVENT_TYPE_INFLATION, slot)
+					event.Inflation = inflation
+					if err := send(event); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		for ended, polls := range events.rewardsPending {
+			rewards, complete := s.epochRewards(ctx, addresses, ended)
+			if !complete && polls+1 < maxRewardPolls {
+				events.rewardsPending[ended] = polls + 1
+				continue
+			}
+			delete(events.rewardsPending, ended)
+			event := events.bounds(proto.EpochEventType_EPOCH_EVENT_TYPE_REWARDS, ended, slot)
+			event.Rewards = rewards
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// currentEpoch returns the epoch of the slot clock's slot, or asks the
+// upstream while the clock is not live
+func (s *BenchmarkService) currentEpoch(ctx context.Context, schedule *rpc.GetEpochScheduleResult) (epoch, slot uint64, err error) {
+	if slot, _, ok := s.slotClock(); ok {
+		epoch, _, _ := epochOf(schedule, slot)
+		return epoch, slot, nil
+	}
+	info, err := s.solanaClient.GetEpochInfo(ctx, rpc.CommitmentProcessed)
+	if err != nil {
+		return 0, 0, err
+	}
+	return info.Epoch, info.AbsoluteSlot, nil
+}
+
+// boundary returns an event of the given type for the current epoch
+func (e *epochEvents) boundary(eventType proto.EpochEventType, slot uint64) *proto.EpochEvent {
+	return e.bounds(eventType, e.epoch, slot)
+}
+
+// bounds returns an event of the given type for an epoch, with its slots
+func (e *epochEvents) bounds(eventType proto.EpochEventType, epoch, slot uint64) *proto.EpochEvent {
+	first := epochFirstSlot(e.schedule, epoch)
+	_, _, length := epochOf(e.schedule, first)
+	return &proto.EpochEvent{
+		Type:         eventType,
+		Epoch:        epoch,
+		FirstSlot:    first,
+		LastSlot:     first + length - 1,
+		SlotsInEpoch: length,
+		ObservedSlot: slot,
+	}
+}
+
+// epochInflation returns the inflation of an epoch, or nil while the upstream
+// still reports that of an earlier epoch
+func (s *BenchmarkService) epochInflation(ctx context.Context, epoch uint64) *proto.EpochInflation {
+	rate, err := s.solanaClient.GetInflationRate(ctx)
+	if err != nil {
+		log.Printf("Failed to get inflation rate of epoch %d: %v", epoch, err)
+		return nil
+	}
+	if uint64(rate.Epoch) != epoch {
+		return nil
+	}
+	return &proto.EpochInflation{Total: rate.Total, Validator: rate.Validator, Foundation: rate.Foundation}
+}
+
+// epochRewards returns the inflation rewards of addresses for an epoch, and
+// whether every address has been credited
+func (s *BenchmarkService) epochRewards(ctx context.Context, addresses []solana.PublicKey, epoch uint64) ([]*proto.InflationReward, bool) {
+	results, err := s.solanaClient.GetInflationReward(ctx, addresses, &rpc.GetInflationRewardOpts{Epoch: &epoch})
+	if err != nil {
+		log.Printf("Failed to get inflation rewards of epoch %d: %v", epoch, err)
+		results = nil
+	}
+	rewards := inflationRewards(addresses, epoch, results)
+	complete := err == nil
+	for _, reward := range rewards {
+		complete = complete && reward.Credited
+	}
+	return rewards, complete
+}
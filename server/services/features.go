@@ -0,0 +1,21 @@
+package services
+
+import (
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetFeatures sets the feature flags gating the service's subsystems. It must
+// be called before the service is serving requests.
+func (s *BenchmarkService) SetFeatures(flags *features.Set) {
+	s.features = flags
+}
+
+// requireFeature rejects a call with UNIMPLEMENTED if its feature is disabled
+func (s *BenchmarkService) requireFeature(flag features.Flag) error {
+	if !s.features.Enabled(flag) {
+		return status.Errorf(codes.Unimplemented, "the %s feature is disabled on this server", flag)
+	}
+	return nil
+}
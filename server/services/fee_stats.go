@@ -0,0 +1,187 @@
+package services
+
+import (
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// lamportsPerSignature is the base fee charged per transaction signature;
+	// any fee beyond it is a priority fee
+	lamportsPerSignature = 5000
+	// maxFeeWindowSlots caps the number of slots aggregated per fee update
+	maxFeeWindowSlots = 1000
+	// setComputeUnitPrice is the compute budget instruction setting the
+	// compute unit price in micro-lamports
+	setComputeUnitPrice = 3
+)
+
+// feeWindow accumulates the fees of the transactions in a window of slots
+type feeWindow struct {
+	start, end    uint64
+	blocks        uint32
+	priorityFees  []uint64
+	computePrices []uint64
+}
+
+// StreamFeeStats streams, per window of slots, percentiles of the priority fees
+// and compute unit prices of the non-vote transactions included in them. A
+// window is sent once its last slot is read, or the first block after it if
+// that slot was skipped.
+func (s *BenchmarkService) StreamFeeStats(req *proto.FeeStatsRequest, stream proto.BenchmarkService_StreamFeeStatsServer) error {
+	if err := s.requireFeature(features.BlockStreams); err != nil {
+		return err
+	}
+	size := uint64(req.WindowSlots)
+	if size == 0 {
+		size = 1
+	}
+	if size > maxFeeWindowSlots {
+		return status.Errorf(codes.InvalidArgument, "at most %d slots may be aggregated per update", maxFeeWindowSlots)
+	}
+
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return err
+	}
+	if commitment == rpc.CommitmentProcessed {
+		return status.Error(codes.InvalidArgument, "fee stats streams support confirmed or finalized commitment")
+	}
+	class, err := parseStreamQoS(req.Qos)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+
+	send := func(window *feeWindow) error {
+		if err := stream.Send(window.stats()); err != nil {
+			return status.Errorf(codes.Internal, "failed to send fee stats: %v", err)
+		}
+		return nil
+	}
+
+	var window *feeWindow
+	return s.followBlocks(ctx, commitment, class, func(slot uint64, block *rpc.GetBlockResult, _ *stageTiming) error {
+		// Windows are aligned to multiples of their size
+		if window != nil && slot > window.end {
+			if err := send(window); err != nil {
+				return err
+			}
+			window = nil
+		}
+		if window == nil {
+			start := slot - slot%size
+			window = &feeWindow{start: start, end: start + size - 1}
+		}
+
+		window.add(block)
+		if slot == window.end {
+			err := send(window)
+			window = nil
+			return err
+		}
+		return nil
+	})
+}
+
+// add records the fees of a block's non-vote transactions
+func (w *feeWindow) add(block *rpc.GetBlockResult) {
+	w.blocks++
+	for _, txWithMeta := range block.Transactions {
+		meta := txWithMeta.Meta
+		if meta == nil {
+			continue
+		}
+		tx, err := txWithMeta.GetTransaction()
+		if err != nil {
+			continue
+		}
+
+		keys := transactionKeys(tx, meta)
+		vote := false
+		price := uint64(0)
+		for _, instruction := range tx.Message.Instructions {
+			if int(instruction.ProgramIDIndex) >= len(keys) {
+				continue
+			}
+			switch program := keys[instruction.ProgramIDIndex]; {
+			case program.Equals(solana.VoteProgramID):
+				vote = true
+			case program.Equals(solana.ComputeBudget):
+				data := instruction.Data
+				if len(data) == 9 && data[0] == setComputeUnitPrice {
+					price = binary.LittleEndian.Uint64(data[1:9])
+				}
+			}
+		}
+		if vote {
+			continue
+		}
+
+		priorityFee := uint64(0)
+		baseFee := uint64(lamportsPerSignature * len(tx.Signatures))
+		if meta.Fee > baseFee {
+			priorityFee = meta.Fee - baseFee
+		}
+		w.priorityFees = append(w.priorityFees, priorityFee)
+		w.computePrices = append(w.computePrices, price)
+	}
+}
+
+// stats summarizes the window
+func (w *feeWindow) stats() *proto.FeeStats {
+	prioritized := 0
+	for _, fee := range w.priorityFees {
+		if fee > 0 {
+			prioritized++
+		}
+	}
+	return &proto.FeeStats{
+		StartSlot:               w.start,
+		EndSlot:                 w.end,
+		Blocks:                  w.blocks,
+		Transactions:            uint32(len(w.priorityFees)),
+		PrioritizedTransactions: uint32(prioritized),
+		PriorityFeeLamports:     feePercentiles(w.priorityFees),
+		ComputeUnitPrice:        feePercentiles(w.computePrices),
+		Timestamp:               uint64(time.Now().Unix()),
+	}
+}
+
+// feePercentiles summarizes fee samples using nearest-rank percentiles
+func feePercentiles(samples []uint64) *proto.FeePercentiles {
+	if len(samples) == 0 {
+		return &proto.FeePercentiles{}
+	}
+	sorted := append([]uint64(nil), samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := func(p int) uint64 {
+		index := (p*len(sorted)+99)/100 - 1
+		if index < 0 {
+			index = 0
+		}
+		return sorted[index]
+	}
+	return &proto.FeePercentiles{
+		Min: sorted[0],
+		P25: rank(25),
+		P50: rank(50),
+		P75: rank(75),
+		P90: rank(90),
+		P99: rank(99),
+		Max: sorted[len(sorted)-1],
+	}
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"log"
+	"strings"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/canonical"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// fieldMask is a parsed google.protobuf.FieldMask as a tree of field names.
+// A field mapped to nil is kept whole; one mapped to a subtree keeps only the
+// subtree's fields of the nested message. A nil mask keeps everything.
+type fieldMask map[string]fieldMask
+
+// parseFieldMask validates a request's field mask against the message type it
+// selects fields of. An empty mask selects every field.
+func parseFieldMask(mask *fieldmaskpb.FieldMask, response protobuf.Message) (fieldMask, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	if !mask.IsValid(response) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid field mask for %s: %v", response.ProtoReflect().Descriptor().Name(), mask.GetPaths())
+	}
+
+	tree := fieldMask{}
+	for _, path := range mask.GetPaths() {
+		node := tree
+		parts := strings.Split(path, ".")
+		for i, part := range parts {
+			child, seen := node[part]
+			if seen && child == nil {
+				// A parent path already keeps the whole field
+				break
+			}
+			if i == len(parts)-1 {
+				node[part] = nil
+				break
+			}
+			if !seen {
+				child = fieldMask{}
+				node[part] = child
+			}
+			node = child
+		}
+	}
+	return tree, nil
+}
+
+// apply clears the fields of msg the mask does not select
+func (m fieldMask) apply(msg protobuf.Message) {
+	if m != nil {
+		m.applyReflect(msg.ProtoReflect())
+	}
+}
+
+// hash sets the content hash of msg over the fields the mask kept, unless the
+// mask dropped the hash itself
+func (m fieldMask) hash(msg protobuf.Message) {
+	if m != nil {
+		if _, ok := m[canonical.ContentHashField]; !ok {
+			return
+		}
+	}
+	if err := canonical.SetContentHash(msg); err != nil {
+		log.Printf("Failed to hash %s: %v", msg.ProtoReflect().Descriptor().Name(), err)
+	}
+}
+
+func (m fieldMask) applyReflect(msg protoreflect.Message) {
+	var cleared []protoreflect.FieldDescriptor
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sub, ok := m[string(fd.Name())]
+		switch {
+		case !ok:
+			cleared = append(cleared, fd)
+		case sub != nil:
+			// Masks only descend into singular message fields
+			sub.applyReflect(v.Message())
+		}
+		return true
+	})
+	for _, fd := range cleared {
+		msg.Clear(fd)
+	}
+}
+
+// maskedTransactionStream applies a field mask to every transaction update it
+// sends, then sets its content hash
+type maskedTransactionStream struct {
+	proto.BenchmarkService_StreamTransactionsServer
+	mask fieldMask
+}
+
+func (m *maskedTransactionStream) Send(update *proto.TransactionUpdate) error {
+	m.mask.apply(update)
+	m.mask.hash(update)
+	return m.BenchmarkService_StreamTransactionsServer.Send(update)
+}
+
+// maskedBlockStream applies a field mask to every block update it sends, then
+// sets its content hash. The block stream keeps sent updates to track
+// lineage, so copies are masked.
+type maskedBlockStream struct {
+	proto.BenchmarkService_StreamBlocksServer
+	mask fieldMask
+}
+
+func (m *maskedBlockStream) Send(update *proto.BlockUpdate) error {
+	masked := update
+	if m.mask != nil {
+		masked = protobuf.Clone(update).(*proto.BlockUpdate)
+		m.mask.apply(masked)
+	}
+	m.mask.hash(masked)
+	return m.BenchmarkService_StreamBlocksServer.Send(masked)
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/yellowstone"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UseGateway serves account streams from a Yellowstone gateway instead of
+// polling the RPC upstream
+func (s *BenchmarkService) UseGateway(gateway *yellowstone.Gateway) {
+	s.gateway = gateway
+}
+
+// checkGatewayCommitment rejects account streams at a commitment the gateway
+// does not serve. Without a gateway every commitment is accepted.
+func (s *BenchmarkService) checkGatewayCommitment(commitment rpc.CommitmentType) error {
+	if s.gateway != nil && commitment != s.gateway.Commitment() {
+		return status.Errorf(codes.InvalidArgument, "account streams are served at %s commitment by the yellowstone gateway", s.gateway.Commitment())
+	}
+	return nil
+}
+
+// forwardGateway relays gateway events for an account set until the stream
+// ends. Events are versioned by the shared write version tracker, so gateway
+// streams and snapshots agree on write versions, and recorded in the stream's
+// scope. Updates come from
+// accountUpdatePool; send owns each update and may release it once sent.
+func (s *BenchmarkService) forwardGateway(ctx context.Context, versions *writeVersionScope, set *accountSet, class streamClass, send func(*proto.AccountUpdate) error) error {
+	sub := s.gateway.Subscribe(set.pubkeys, set.owners, class.queueScale*yellowstone.DefaultSubscriberQueue)
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case event, ok := <-sub.Messages():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "account stream fell too far behind the yellowstone gateway")
+			}
+
+			version, ok := versions.observe(event.Pubkey, event.Slot)
+			if !ok {
+				continue
+			}
+			if err := send(gatewayUpdate(newAccountUpdate(), event, version)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// gatewayUpdate fills an empty account update with a gateway event
+func gatewayUpdate(update *proto.AccountUpdate, event yellowstone.AccountEvent, version uint64) *proto.AccountUpdate {
+	update.Pubkey = event.Pubkey.String()
+	update.Data = event.Data
+	update.Owner = event.Owner.String()
+	update.Lamports = event.Lamports
+	update.Slot = event.Slot
+	update.Timestamp = uint64(time.Now().Unix())
+	update.WriteVersion = version
+	return update
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+// schedule paces benchmark requests on a fixed cadence. Each request has an
+// intended start time independent of how long earlier requests took, so when
+// the upstream falls behind, the time a request spends waiting for its turn
+// counts towards its latency instead of being silently omitted (the
+// coordinated omission problem described by wrk2 and HdrHistogram).
+type schedule struct {
+	start    time.Time
+	interval time.Duration
+	next     int64
+}
+
+// newSchedule creates a schedule issuing rate requests per second, or one
+// that issues requests back to back if rate is zero
+func newSchedule(rate float64) *schedule {
+	s := &schedule{start: time.Now()}
+	if rate > 0 {
+		s.interval = time.Duration(float64(time.Second) / rate)
+	}
+	return s
+}
+
+// paced reports whether requests follow a fixed cadence
+func (s *schedule) paced() bool {
+	return s.interval > 0
+}
+
+// wait blocks until the next request is due and returns the time latency
+// should be measured from: the intended start when paced, and now otherwise.
+// A request that is already overdue starts immediately.
+func (s *schedule) wait(ctx context.Context) time.Time {
+	if !s.paced() {
+		return time.Now()
+	}
+
+	intended := s.start.Add(time.Duration(s.next) * s.interval)
+	s.next++
+
+	if delay := time.Until(intended); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+	}
+	return intended
+}
+
+// latencyMs returns the latency of a request in milliseconds. Requests that
+// measure their own response time report it as measured; paced requests are
+// measured from their intended start instead, so queueing delay is included.
+func (s *schedule) latencyMs(intended time.Time, measured uint64) uint64 {
+	if !s.paced() {
+		return measured
+	}
+	return uint64(time.Since(intended).Milliseconds())
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"encoding/binary"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxNFTsPerOwner caps the candidate NFTs resolved per request
+	maxNFTsPerOwner = 1000
+	// metadataKeyV1 marks a Metaplex metadata account
+	metadataKeyV1 = 4
+)
+
+// nftCandidate is a token account holding a single token of a mint
+type nftCandidate struct {
+	account solana.PublicKey
+	mint    solana.PublicKey
+	program solana.PublicKey
+}
+
+// GetNFTsByOwner lists the NFTs held by an owner. Token accounts of both token
+// programs holding exactly one token are kept if their mint has no decimals,
+// and each NFT is resolved to its Metaplex metadata.
+func (s *BenchmarkService) GetNFTsByOwner(ctx context.Context, req *proto.NFTsByOwnerRequest) (*proto.NFTsByOwnerResponse, error) {
+	owner, err := solana.PublicKeyFromBase58(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner: %v", err)
+	}
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+
+	var candidates []nftCandidate
+	for _, program := range []solana.PublicKey{solana.TokenProgramID, token2022ProgramID} {
+		program := program
+		result, err := s.solanaClient.GetTokenAccountsByOwner(ctx, owner, &rpc.GetTokenAccountsConfig{ProgramId: &program}, &rpc.GetTokenAccountsOpts{
+			Commitment: commitment,
+			Encoding:   solana.EncodingBase64,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get token accounts: %v", err)
+		}
+		for _, account := range result.Value {
+			data := account.Account.Data.GetBinary()
+			if len(data) < tokenAccountSize {
+				continue
+			}
+			// Layout: mint, owner, amount u64, ...
+			if binary.LittleEndian.Uint64(data[64:72]) != 1 {
+				continue
+			}
+			candidates = append(candidates, nftCandidate{
+				account: account.Pubkey,
+				mint:    solana.PublicKeyFromBytes(data[0:32]),
+				program: program,
+			})
+		}
+	}
+
+	response := &proto.NFTsByOwnerResponse{Owner: req.Owner}
+	if len(candidates) > maxNFTsPerOwner {
+		candidates = candidates[:maxNFTsPerOwner]
+		response.Truncated = true
+	}
+
+	// Keep the candidates whose mint has no decimals
+	mints := make([]solana.PublicKey, len(candidates))
+	for i, candidate := range candidates {
+		mints[i] = candidate.mint
+	}
+	mintAccounts, err := s.getAccounts(ctx, mints, commitment)
+	if err != nil {
+		return nil, err
+	}
+	var nfts []nftCandidate
+	for i, account := range mintAccounts {
+		if account == nil {
+			continue
+		}
+		data := account.Data.GetBinary()
+		if len(data) < tokenMintSize || decodeTokenMint(data[:tokenMintSize]).Decimals != 0 {
+			continue
+		}
+		nfts = append(nfts, candidates[i])
+	}
+
+	// Resolve each NFT's metadata account
+	addresses := make([]solana.PublicKey, len(nfts))
+	for i, nft := range nfts {
+		addresses[i], _, err = solana.FindTokenMetadataAddress(nft.mint)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to derive metadata address: %v", err)
+		}
+	}
+	metadataAccounts, err := s.getAccounts(ctx, addresses, commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, nft := range nfts {
+		entry := &proto.NFT{
+			Mint:         nft.mint.String(),
+			TokenAccount: nft.account.String(),
+			ProgramId:    nft.program.String(),
+		}
+		if account := metadataAccounts[i]; account != nil && account.Owner.Equals(solana.TokenMetadataProgramID) {
+			metadata, ok := decodeMetadata(account.Data.GetBinary())
+			if ok {
+				metadata.Address = addresses[i].String()
+				entry.Metadata = metadata
+			} else {
+				log.Printf("Error decoding metadata of mint %s", nft.mint)
+			}
+		}
+		response.Nfts = append(response.Nfts, entry)
+	}
+
+	response.ResponseTimeMs = uint64(time.Since(startTime).Milliseconds())
+	return response, nil
+}
+
+// getAccounts reads accounts in getMultipleAccounts chunks, returning them in
+// the order of pubkeys with nil for missing accounts
+func (s *BenchmarkService) getAccounts(ctx context.Context, pubkeys []solana.PublicKey, commitment rpc.CommitmentType) ([]*rpc.Account, error) {
+	accounts := make([]*rpc.Account, 0, len(pubkeys))
+	for start := 0; start < len(pubkeys); start += maxMultipleAccounts {
+		end := start + maxMultipleAccounts
+		if end > len(pubkeys) {
+			end = len(pubkeys)
+		}
+
+		result, err := s.solanaClient.GetMultipleAccountsWithOpts(ctx, pubkeys[start:end], &rpc.GetMultipleAccountsOpts{
+			Encoding:   solana.EncodingBase64,
+			Commitment: commitment,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get accounts: %v", err)
+		}
+		for i := range pubkeys[start:end] {
+			var account *rpc.Account
+			if i < len(result.Value) {
+				account = result.Value[i]
+			}
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts, nil
+}
+
+// decodeMetadata decodes a Metaplex metadata account up to its collection.
+// Fields after the collection are not needed and older accounts may lack them.
+func decodeMetadata(data []byte) (*proto.NFTMetadata, bool) {
+	r := &borshReader{data: data}
+	if r.u8() != metadataKeyV1 {
+		return nil, false
+	}
+
+	metadata := &proto.NFTMetadata{UpdateAuthority: r.pubkey().String()}
+	r.pubkey() // mint
+	metadata.Name = r.paddedString()
+	metadata.Symbol = r.paddedString()
+	metadata.Uri = r.paddedString()
+	metadata.SellerFeeBasisPoints = uint32(r.u16())
+	if r.u8() == 1 {
+		for n := r.u32(); n > 0 && !r.failed; n-- {
+			metadata.Creators = append(metadata.Creators, &proto.NFTCreator{
+				Address:  r.pubkey().String(),
+				Verified: r.u8() != 0,
+				Share:    uint32(r.u8()),
+			})
+		}
+	}
+	metadata.PrimarySaleHappened = r.u8() != 0
+	metadata.IsMutable = r.u8() != 0
+	if r.failed {
+		return nil, false
+	}
+
+	// Edition nonce and token standard precede the collection
+	for i := 0; i < 2; i++ {
+		if r.u8() == 1 {
+			r.u8()
+		}
+	}
+	if r.u8() == 1 {
+		metadata.CollectionVerified = r.u8() != 0
+		metadata.Collection = r.pubkey().String()
+	}
+	if r.failed {
+		// Older accounts end before the collection
+		metadata.Collection, metadata.CollectionVerified = "", false
+	}
+	return metadata, true
+}
+
+// borshReader reads Borsh-encoded values, recording rather than returning
+// running past the end of the data
+type borshReader struct {
+	data   []byte
+	failed bool
+}
+
+func (r *borshReader) next(n int) []byte {
+	if r.failed || len(r.data) < n {
+		r.failed = true
+		return make([]byte, n)
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *borshReader) u8() uint8 {
+	return r.next(1)[0]
+}
+
+func (r *borshReader) u16() uint16 {
+	return binary.LittleEndian.Uint16(r.next(2))
+}
+
+func (r *borshReader) u32() uint32 {
+	return binary.LittleEndian.Uint32(r.next(4))
+}
+
+func (r *borshReader) pubkey() solana.PublicKey {
+	return solana.PublicKeyFromBytes(r.next(32))
+}
+
+// paddedString reads a string stored with trailing NUL padding
+func (r *borshReader) paddedString() string {
+	n := r.u32()
+	if r.failed || int(n) > len(r.data) {
+		r.failed = true
+		return ""
+	}
+	return strings.TrimRight(string(r.next(int(n))), "\x00")
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// nonceAccountSize is the size of a system program nonce account: version,
+	// state, authority, nonce and fee calculator
+	nonceAccountSize = 80
+	// nonceStateInitialized marks a nonce account holding a nonce
+	nonceStateInitialized = 1
+)
+
+// GetNonceAccount reads and decodes a durable nonce account
+func (s *BenchmarkService) GetNonceAccount(ctx context.Context, req *proto.NonceAccountRequest) (*proto.NonceAccountResponse, error) {
+	pubkey, err := solana.PublicKeyFromBase58(req.Pubkey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pubkey: %v", err)
+	}
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+
+	account, err := s.solanaClient.GetAccountInfoWithOpts(ctx, pubkey, &rpc.GetAccountInfoOpts{
+		Encoding:   solana.EncodingBase64,
+		Commitment: commitment,
+	})
+	if errors.Is(err, rpc.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Pubkey)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get account info: %v", err)
+	}
+
+	responseTime := time.Since(startTime).Milliseconds()
+
+	data := account.Value.Data.GetBinary()
+	if !account.Value.Owner.Equals(solana.SystemProgramID) || len(data) != nonceAccountSize {
+		return nil, status.Errorf(codes.FailedPrecondition, "account %s is not a nonce account", req.Pubkey)
+	}
+	// Layout: version u32, state u32, authority, nonce, lamports per signature u64
+	if binary.LittleEndian.Uint32(data[4:8]) != nonceStateInitialized {
+		return nil, status.Errorf(codes.FailedPrecondition, "nonce account %s is not initialized", req.Pubkey)
+	}
+
+	return &proto.NonceAccountResponse{
+		Pubkey:               req.Pubkey,
+		Authority:            solana.PublicKeyFromBytes(data[8:40]).String(),
+		Nonce:                solana.HashFromBytes(data[40:72]).String(),
+		LamportsPerSignature: binary.LittleEndian.Uint64(data[72:80]),
+		Lamports:             account.Value.Lamports,
+		Slot:                 account.Context.Slot,
+		ResponseTimeMs:       uint64(responseTime),
+	}, nil
+}
@@ -0,0 +1,758 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"github.com/i-tozer/solana-grpc-exploration/server/hub"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxOwnedAccounts caps the accounts an owner's set holds in memory;
+	// owners with more are refused
+	maxOwnedAccounts = 50000
+	// ownedSetLinger is how long an owner's set is kept current after its
+	// last call ends, so paging through it or reopening a feed does not
+	// reread it
+	ownedSetLinger = 5 * time.Minute
+	// ownedSweepInterval is how often a subscribed set looks for the accounts
+	// that left it, which programSubscribe does not notify of
+	ownedSweepInterval = 30 * time.Second
+	// ownedChangeBuffer is the number of changes queued per change feed.
+	// Feeds that fall further behind are dropped.
+	ownedChangeBuffer = 1024
+	// defaultOwnedPageSize and maxOwnedPageSize bound ListOwnedAccounts pages
+	defaultOwnedPageSize = 100
+	maxOwnedPageSize     = 1000
+)
+
+// ownedAccount is the state of an account in an owner's set
+type ownedAccount struct {
+	lamports   uint64
+	data       []byte
+	executable bool
+	rentEpoch  uint64
+	slot       uint64
+}
+
+func newOwnedAccount(account *rpc.Account, slot uint64) ownedAccount {
+	return ownedAccount{
+		lamports:   account.Lamports,
+		data:       account.Data.GetBinary(),
+		executable: account.Executable,
+		rentEpoch:  account.RentEpoch,
+		slot:       slot,
+	}
+}
+
+// ownedChange is a change to an owner's set, published on its topic. It is
+// shared by every feed and must not be modified.
+type ownedChange struct {
+	seq       uint64
+	eventType proto.OwnedAccountEventType
+	pubkey    solana.PublicKey
+	account   ownedAccount
+	total     int
+	resync    bool
+	// closure is set on closed changes
+	closure *ownedClosure
+}
+
+// ownedClosure describes how an account left its owner's set
+type ownedClosure struct {
+	reason           proto.AccountClosureReason
+	reclaimed        uint64
+	previousLamports uint64
+	previousDataSize int
+	// newOwner is nil if the account was deleted
+	newOwner *solana.PublicKey
+}
+
+// newOwnedClosure classifies the closure of an account from its last state in
+// the set and the state it closed in, nil if it was deleted
+func newOwnedClosure(previous ownedAccount, account *rpc.Account) *ownedClosure {
+	closure := &ownedClosure{
+		reason:           proto.AccountClosureReason_ACCOUNT_CLOSURE_REASON_DRAINED,
+		reclaimed:        previous.lamports,
+		previousLamports: previous.lamports,
+		previousDataSize: len(previous.data),
+	}
+	if account == nil || account.Lamports == 0 {
+		return closure
+	}
+	// Reassigned accounts keep the lamports not withdrawn in the same
+	// transaction
+	owner := account.Owner
+	closure.newOwner = &owner
+	closure.reclaimed = previous.lamports - min(previous.lamports, account.Lamports)
+	closure.reason = proto.AccountClosureReason_ACCOUNT_CLOSURE_REASON_ASSIGNED
+	if owner.Equals(solana.SystemProgramID) {
+		closure.reason = proto.AccountClosureReason_ACCOUNT_CLOSURE_REASON_ASSIGNED_TO_SYSTEM
+	}
+	return closure
+}
+
+// ownedSet is the materialized set of the accounts of an owner at a
+// commitment. It is loaded with getProgramAccounts and kept current with
+// programSubscribe, or by rereading it when no WebSocket upstream is
+// available.
+type ownedSet struct {
+	topic hub.Topic
+	// ready is closed once the set is loaded, or loading failed with err
+	ready chan struct{}
+	err   error
+
+	mu       sync.Mutex
+	accounts map[solana.PublicKey]ownedAccount
+	// seq numbers the changes applied, so a feed can tell the changes its
+	// snapshot already includes
+	seq  uint64
+	slot uint64
+	// pollInterval is set when the set is reread instead of subscribed to
+	pollInterval time.Duration
+	// publishMu is held while changes are made and published, so feeds
+	// receive them in sequence order
+	publishMu sync.Mutex
+
+	// users counts the calls using the set and idleSince is when the last
+	// one ended. Both are guarded by the registry's lock.
+	users     int
+	idleSince time.Time
+}
+
+// ownedSets holds the owner sets in use or lingering after their last use,
+// and the hub their changes are published on
+type ownedSets struct {
+	mu      sync.Mutex
+	sets    map[hub.Topic]*ownedSet
+	changes *hub.Hub[ownedChange]
+}
+
+func newOwnedSets() *ownedSets {
+	return &ownedSets{
+		sets:    make(map[hub.Topic]*ownedSet),
+		changes: hub.New[ownedChange](ownedChangeBuffer, nil),
+	}
+}
+
+// ownedTopic is the topic of the changes to an owner's set at a commitment
+func ownedTopic(owner solana.PublicKey, commitment rpc.CommitmentType) hub.Topic {
+	topic := hub.ProgramTopic(owner)
+	topic.Commitment = commitment
+	return topic
+}
+
+// ListOwnedAccounts returns a page of the accounts of an owner. The first
+// call for an owner loads its set, which later pages and calls read until it
+// has gone unused for a while.
+func (s *BenchmarkService) ListOwnedAccounts(ctx context.Context, req *proto.ListOwnedAccountsRequest) (*proto.ListOwnedAccountsResponse, error) {
+	startTime := time.Now()
+	if err := s.requireFeature(features.WebSocketStreams); err != nil {
+		return nil, err
+	}
+	owner, commitment, err := parseOwner(req.Owner, req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := defaultOwnedPageSize
+	if req.PageSize > 0 {
+		if req.PageSize > maxOwnedPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "page_size must be at most %d", maxOwnedPageSize)
+		}
+		pageSize = int(req.PageSize)
+	}
+	var after *solana.PublicKey
+	if req.PageToken != "" {
+		token, err := solana.PublicKeyFromBase58(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		after = &token
+	}
+
+	set, release, err := s.useOwnedSet(ctx, owner, commitment)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	set.mu.Lock()
+	keys := set.keys(after)
+	more := len(keys) > pageSize
+	if more {
+		keys = keys[:pageSize]
+	}
+	resp := &proto.ListOwnedAccountsResponse{
+		Accounts:       make([]*proto.OwnedAccount, 0, len(keys)),
+		TotalAccounts:  uint32(len(set.accounts)),
+		IndexSlot:      set.slot,
+		PollIntervalMs: uint32(set.pollInterval.Milliseconds()),
+	}
+	for _, key := range keys {
+		resp.Accounts = append(resp.Accounts, ownedAccountProto(key, set.accounts[key], !req.ExcludeData))
+	}
+	set.mu.Unlock()
+
+	if more {
+		resp.NextPageToken = keys[len(keys)-1].String()
+	}
+	resp.ResponseTimeMs = uint64(time.Since(startTime).Milliseconds())
+	return resp, nil
+}
+
+// StreamOwnedAccountChanges streams the changes to the accounts of an owner,
+// after the accounts its set has when the stream opens if include_snapshot is
+// set. Changes to the set while it is in use are never missed: it is
+// subscribed to before it is loaded, and reread after the subscription drops.
+func (s *BenchmarkService) StreamOwnedAccountChanges(req *proto.OwnedAccountChangesRequest, stream proto.BenchmarkService_StreamOwnedAccountChangesServer) error {
+	if err := s.requireFeature(features.WebSocketStreams); err != nil {
+		return err
+	}
+	owner, commitment, err := parseOwner(req.Owner, req.Commitment)
+	if err != nil {
+		return err
+	}
+	if req.ClosedOnly && req.IncludeSnapshot {
+		return status.Error(codes.InvalidArgument, "closed_only streams carry no snapshot")
+	}
+
+	ctx := stream.Context()
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+
+	set, release, err := s.useOwnedSet(ctx, owner, commitment)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	send := func(event *proto.OwnedAccountEvent) error {
+		event.Timestamp = uint64(time.Now().Unix())
+		if err := stream.Send(event); err != nil {
+			return status.Errorf(codes.Internal, "failed to send owned account event: %v", err)
+		}
+		return nil
+	}
+
+	// Subscribe before reading the set, so the changes after the snapshot
+	// are queued and those before it can be told apart by their sequence
+	sub := s.ownedAccounts.changes.Subscribe(set.topic)
+	defer sub.Close()
+
+	set.mu.Lock()
+	seq := set.seq
+	var snapshot []*proto.OwnedAccount
+	if req.IncludeSnapshot {
+		keys := set.keys(nil)
+		snapshot = make([]*proto.OwnedAccount, 0, len(keys))
+		for _, key := range keys {
+			snapshot = append(snapshot, ownedAccountProto(key, set.accounts[key], !req.ExcludeData))
+		}
+	}
+	set.mu.Unlock()
+
+	if req.IncludeSnapshot {
+		total := uint32(len(snapshot))
+		for _, account := range snapshot {
+			event := &proto.OwnedAccountEvent{
+				Type:          proto.OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_SNAPSHOT,
+				Account:       account,
+				TotalAccounts: total,
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+		event := &proto.OwnedAccountEvent{
+			Type:          proto.OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_SNAPSHOT_COMPLETE,
+			TotalAccounts: total,
+		}
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case change, ok := <-sub.Messages():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "stream fell too far behind the owner's change feed")
+			}
+			if change.seq <= seq || (req.ClosedOnly && change.closure == nil) {
+				continue
+			}
+			event := &proto.OwnedAccountEvent{
+				Type:          change.eventType,
+				Account:       ownedAccountProto(change.pubkey, change.account, !req.ExcludeData),
+				TotalAccounts: uint32(change.total),
+				Resync:        change.resync,
+			}
+			if closure := change.closure; closure != nil {
+				event.Closure = &proto.AccountClosure{
+					Reason:            closure.reason,
+					ReclaimedLamports: closure.reclaimed,
+					PreviousLamports:  closure.previousLamports,
+					PreviousDataSize:  uint64(closure.previousDataSize),
+				}
+				if closure.newOwner != nil {
+					event.Closure.NewOwner = closure.newOwner.String()
+				}
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// parseOwner parses the owner and commitment of an owned accounts request
+func parseOwner(owner, commitment string) (solana.PublicKey, rpc.CommitmentType, error) {
+	key, err := solana.PublicKeyFromBase58(owner)
+	if err != nil {
+		return solana.PublicKey{}, "", status.Errorf(codes.InvalidArgument, "invalid owner: %v", err)
+	}
+	parsed, err := parseCommitment(commitment)
+	if err != nil {
+		return solana.PublicKey{}, "", err
+	}
+	return key, parsed, nil
+}
+
+func ownedAccountProto(pubkey solana.PublicKey, account ownedAccount, withData bool) *proto.OwnedAccount {
+	converted := &proto.OwnedAccount{
+		Pubkey:     pubkey.String(),
+		Lamports:   account.lamports,
+		Executable: account.executable,
+		RentEpoch:  account.rentEpoch,
+		Slot:       account.slot,
+	}
+	if withData {
+		converted.Data = account.data
+	}
+	return converted
+}
+
+// useOwnedSet returns the set of an owner's accounts at a commitment once it
+// is loaded, starting to load it if no call is using it. The returned function
+// must be called when the caller is done with the set.
+func (s *BenchmarkService) useOwnedSet(ctx context.Context, owner solana.PublicKey, commitment rpc.CommitmentType) (*ownedSet, func(), error) {
+	registry := s.ownedAccounts
+	topic := ownedTopic(owner, commitment)
+
+	registry.mu.Lock()
+	set := registry.sets[topic]
+	if set == nil {
+		set = &ownedSet{topic: topic, ready: make(chan struct{})}
+		registry.sets[topic] = set
+		go s.runOwnedSet(set)
+	}
+	set.users++
+	registry.mu.Unlock()
+
+	release := func() {
+		registry.mu.Lock()
+		defer registry.mu.Unlock()
+		set.users--
+		if set.users == 0 {
+			set.idleSince = time.Now()
+		}
+	}
+	select {
+	case <-ctx.Done():
+		release()
+		return nil, nil, status.FromContextError(ctx.Err()).Err()
+	case <-set.ready:
+	}
+	if set.err != nil {
+		release()
+		return nil, nil, set.err
+	}
+	return set, release, nil
+}
+
+// expired removes a set that has gone unused for ownedSetLinger, reporting
+// whether it did
+func (r *ownedSets) expired(set *ownedSet) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if set.users > 0 || time.Since(set.idleSince) < ownedSetLinger {
+		return false
+	}
+	delete(r.sets, set.topic)
+	return true
+}
+
+// fail removes a set that could not be loaded, so the next call retries, and
+// wakes the calls waiting for it
+func (r *ownedSets) fail(set *ownedSet, err error) {
+	r.mu.Lock()
+	delete(r.sets, set.topic)
+	r.mu.Unlock()
+	set.err = err
+	close(set.ready)
+}
+
+// runOwnedSet loads a set and keeps it current until it expires
+func (s *BenchmarkService) runOwnedSet(set *ownedSet) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	owner, commitment := set.topic.Pubkey, set.topic.Commitment
+
+	// Subscribe before loading the set, so no change in between is missed
+	var feed *programFeed
+	if s.wsAvailable() {
+		feed = &programFeed{}
+		if err := s.subscribeProgram(ctx, feed, owner, commitment); err != nil {
+			log.Printf("Program subscription to %s for its owned account set failed: %v", owner, err)
+			feed = nil
+		} else {
+			defer feed.close()
+		}
+	}
+	if feed == nil {
+		if s.wsFallbackPoll == 0 {
+			s.ownedAccounts.fail(set, status.Error(codes.Unavailable, "no websocket upstream is available"))
+			return
+		}
+		set.pollInterval = s.wsFallbackPoll
+	}
+
+	accounts, slot, err := s.readOwnedAccounts(ctx, owner, commitment)
+	if err != nil {
+		s.ownedAccounts.fail(set, err)
+		return
+	}
+	set.accounts, set.slot = accounts, slot
+	close(set.ready)
+
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if s.ownedAccounts.expired(set) {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	if feed == nil {
+		s.pollOwnedSet(ctx, set)
+		return
+	}
+	s.followOwnedSet(ctx, set, feed)
+}
+
+// followOwnedSet applies programSubscribe notifications to a set until ctx
+// is done, sweeping it for closed accounts alongside. When the subscription
+// fails, it resubscribes and rereads the set.
+func (s *BenchmarkService) followOwnedSet(ctx context.Context, set *ownedSet, feed *programFeed) {
+	// Closing the feed unblocks Recv once the set expires
+	stop := context.AfterFunc(ctx, feed.close)
+	defer stop()
+	go s.sweepOwnedSet(ctx, set)
+	owner, commitment := set.topic.Pubkey, set.topic.Commitment
+
+	for {
+		result, err := feed.subscription().Recv()
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil && result == nil {
+			err = errors.New("subscription closed")
+		}
+		if err != nil {
+			backoff := upstream.Backoff{Max: maxResubscribeDelay}
+			for {
+				log.Printf("Program subscription to %s for its owned account set failed, resubscribing in %v: %v", owner, backoff.Delay(), err)
+				if backoff.Wait(ctx) != nil {
+					return
+				}
+				if err = s.subscribeProgram(ctx, feed, owner, commitment); err == nil {
+					break
+				}
+			}
+			s.resyncOwnedSet(ctx, set)
+			continue
+		}
+
+		s.updateOwnedSet(ctx, set, func() []ownedChange {
+			return set.apply(result.Value.Pubkey, result.Value.Account, result.Context.Slot, false)
+		})
+	}
+}
+
+// sweepOwnedSet looks for the accounts that left a set every
+// ownedSweepInterval until ctx is done. programSubscribe only notifies of the
+// accounts the program owns after a change, so an account that is deleted or
+// assigned to another program leaves without a notification.
+func (s *BenchmarkService) sweepOwnedSet(ctx context.Context, set *ownedSet) {
+	ticker := time.NewTicker(ownedSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		pubkeys, err := s.programAccountKeys(ctx, set.topic.Pubkey, set.topic.Commitment)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Failed to sweep the owned account set of %s: %v", set.topic.Pubkey, err)
+			}
+			continue
+		}
+		s.closeOwnedAccounts(ctx, set, set.missing(pubkeys), false)
+	}
+}
+
+// updateOwnedSet makes changes to a set and publishes them to its feeds
+func (s *BenchmarkService) updateOwnedSet(ctx context.Context, set *ownedSet, update func() []ownedChange) {
+	set.publishMu.Lock()
+	defer set.publishMu.Unlock()
+	for _, change := range update() {
+		s.ownedAccounts.changes.Publish(ctx, change, set.topic)
+	}
+}
+
+// pollOwnedSet rereads a set every poll interval until ctx is done
+func (s *BenchmarkService) pollOwnedSet(ctx context.Context, set *ownedSet) {
+	ticker := time.NewTicker(set.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.resyncOwnedSet(ctx, set)
+		}
+	}
+}
+
+// resyncOwnedSet rereads a set and publishes the changes found. Failures are
+// logged and left to the next resync.
+func (s *BenchmarkService) resyncOwnedSet(ctx context.Context, set *ownedSet) {
+	accounts, slot, err := s.readOwnedAccounts(ctx, set.topic.Pubkey, set.topic.Commitment)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Failed to reread the owned account set of %s: %v", set.topic.Pubkey, err)
+		}
+		return
+	}
+	var missing []solana.PublicKey
+	s.updateOwnedSet(ctx, set, func() []ownedChange {
+		var changes []ownedChange
+		changes, missing = set.resync(accounts, slot)
+		return changes
+	})
+	s.closeOwnedAccounts(ctx, set, missing, true)
+}
+
+// closeOwnedAccounts reads the accounts that are missing from a reread of a
+// set, so that those that left it are closed with how they closed. Failures
+// are logged and left to the next reread.
+func (s *BenchmarkService) closeOwnedAccounts(ctx context.Context, set *ownedSet, pubkeys []solana.PublicKey, resync bool) {
+	for start := 0; start < len(pubkeys); start += maxProgramAccountsChunk {
+		end := min(start+maxProgramAccountsChunk, len(pubkeys))
+		release, err := s.scheduler.acquire(ctx, streamClasses[proto.StreamQoS_STREAM_QOS_BULK])
+		if err != nil {
+			return
+		}
+		result, err := s.solanaClient.GetMultipleAccountsWithOpts(ctx, pubkeys[start:end], &rpc.GetMultipleAccountsOpts{
+			Commitment: set.topic.Commitment,
+		})
+		release()
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Failed to read the closed accounts of %s: %v", set.topic.Pubkey, err)
+			}
+			return
+		}
+		s.updateOwnedSet(ctx, set, func() []ownedChange {
+			var changes []ownedChange
+			for i, account := range result.Value {
+				changes = append(changes, set.apply(pubkeys[start+i], account, result.Context.Slot, resync)...)
+			}
+			return changes
+		})
+	}
+}
+
+// readOwnedAccounts reads every account of an owner, with the newest slot the
+// reads were served at
+func (s *BenchmarkService) readOwnedAccounts(ctx context.Context, owner solana.PublicKey, commitment rpc.CommitmentType) (map[solana.PublicKey]ownedAccount, uint64, error) {
+	pubkeys, err := s.programAccountKeys(ctx, owner, commitment)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(pubkeys) > maxOwnedAccounts {
+		return nil, 0, status.Errorf(codes.ResourceExhausted, "owner has %d accounts, more than the %d a set may hold", len(pubkeys), maxOwnedAccounts)
+	}
+
+	accounts := make(map[solana.PublicKey]ownedAccount, len(pubkeys))
+	var slot uint64
+	for start := 0; start < len(pubkeys); start += maxProgramAccountsChunk {
+		end := min(start+maxProgramAccountsChunk, len(pubkeys))
+
+		release, err := s.scheduler.acquire(ctx, streamClasses[proto.StreamQoS_STREAM_QOS_BULK])
+		if err != nil {
+			return nil, 0, err
+		}
+		result, err := s.solanaClient.GetMultipleAccountsWithOpts(ctx, pubkeys[start:end], &rpc.GetMultipleAccountsOpts{
+			Commitment: commitment,
+		})
+		release()
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "failed to get owned accounts page: %v", err)
+		}
+		slot = max(slot, result.Context.Slot)
+		for i, account := range result.Value {
+			// Closed or reassigned after the set was resolved
+			if account == nil || !account.Owner.Equals(owner) {
+				continue
+			}
+			accounts[pubkeys[start+i]] = newOwnedAccount(account, result.Context.Slot)
+		}
+	}
+	return accounts, slot, nil
+}
+
+// keys returns the pubkeys of the set after the given one, ordered by their
+// bytes. The set must be locked.
+func (s *ownedSet) keys(after *solana.PublicKey) []solana.PublicKey {
+	keys := make([]solana.PublicKey, 0, len(s.accounts))
+	for key := range s.accounts {
+		if after == nil || bytes.Compare(key[:], after[:]) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+	return keys
+}
+
+// missing returns the accounts of the set that a list of its accounts, read
+// after they were added, does not have
+func (s *ownedSet) missing(pubkeys []solana.PublicKey) []solana.PublicKey {
+	present := make(map[solana.PublicKey]bool, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		present[pubkey] = true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var missing []solana.PublicKey
+	for pubkey := range s.accounts {
+		if !present[pubkey] {
+			missing = append(missing, pubkey)
+		}
+	}
+	return missing
+}
+
+// apply applies an account's state at a slot, nil if it was deleted,
+// returning the change it made if any. States older than the one the set
+// holds are stale and ignored. An account leaves the set when it is drained
+// or assigned to another program.
+func (s *ownedSet) apply(pubkey solana.PublicKey, account *rpc.Account, slot uint64, resync bool) []ownedChange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	previous, known := s.accounts[pubkey]
+	if known && slot < previous.slot {
+		return nil
+	}
+	s.slot = max(s.slot, slot)
+
+	if account == nil || account.Lamports == 0 || !account.Owner.Equals(s.topic.Pubkey) {
+		if !known {
+			return nil
+		}
+		delete(s.accounts, pubkey)
+		state := ownedAccount{slot: slot}
+		if account != nil {
+			state = newOwnedAccount(account, slot)
+		}
+		change := s.change(proto.OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_CLOSED, pubkey, state, resync)
+		change.closure = newOwnedClosure(previous, account)
+		return []ownedChange{change}
+	}
+
+	state := newOwnedAccount(account, slot)
+	switch {
+	case !known:
+		s.accounts[pubkey] = state
+		return []ownedChange{s.change(proto.OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_CREATED, pubkey, state, resync)}
+	case previous.equal(state):
+		s.accounts[pubkey] = state
+		return nil
+	default:
+		s.accounts[pubkey] = state
+		return []ownedChange{s.change(proto.OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_UPDATED, pubkey, state, resync)}
+	}
+}
+
+// resync applies accounts reread at slot to the set, returning the changes it
+// made and the accounts the reread is missing, which are left for the caller
+// to close. Accounts the set holds a newer state of than the reread are kept.
+func (s *ownedSet) resync(accounts map[solana.PublicKey]ownedAccount, slot uint64) ([]ownedChange, []solana.PublicKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slot = max(s.slot, slot)
+
+	var changes []ownedChange
+	var missing []solana.PublicKey
+	for pubkey, previous := range s.accounts {
+		if _, ok := accounts[pubkey]; !ok && previous.slot <= slot {
+			missing = append(missing, pubkey)
+		}
+	}
+	for pubkey, state := range accounts {
+		previous, known := s.accounts[pubkey]
+		switch {
+		case !known:
+			s.accounts[pubkey] = state
+			changes = append(changes, s.change(proto.OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_CREATED, pubkey, state, true))
+		case previous.slot > slot || previous.equal(state):
+		default:
+			s.accounts[pubkey] = state
+			changes = append(changes, s.change(proto.OwnedAccountEventType_OWNED_ACCOUNT_EVENT_TYPE_UPDATED, pubkey, state, true))
+		}
+	}
+	return changes, missing
+}
+
+// change numbers a change made to the set. The set must be locked.
+func (s *ownedSet) change(eventType proto.OwnedAccountEventType, pubkey solana.PublicKey, account ownedAccount, resync bool) ownedChange {
+	s.seq++
+	return ownedChange{
+		seq:       s.seq,
+		eventType: eventType,
+		pubkey:    pubkey,
+		account:   account,
+		total:     len(s.accounts),
+		resync:    resync,
+	}
+}
+
+// equal reports whether two states of an account hold the same contents
+func (a ownedAccount) equal(b ownedAccount) bool {
+	return a.lamports == b.lamports && a.executable == b.executable && a.rentEpoch == b.rentEpoch && bytes.Equal(a.data, b.data)
+}
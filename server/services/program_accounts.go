@@ -0,0 +1,527 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxProgramAccountsChunk is the largest chunk a snapshot can be paged in,
+	// matching the getMultipleAccounts per-call limit
+	maxProgramAccountsChunk = 100
+	// maxGapSignatures bounds the transactions replayed to repair a gap in
+	// live updates; larger gaps are repaired by rereading every account
+	maxGapSignatures = 500
+	// maxResubscribeDelay caps the backoff between upstream reconnects of a
+	// live stream
+	maxResubscribeDelay = 30 * time.Second
+	// maxResubscribeOutage is how long a live stream waits for the upstream to
+	// come back before failing
+	maxResubscribeOutage = 5 * time.Minute
+)
+
+// StreamProgramAccountsSnapshot streams every account owned by a program.
+// The account set is resolved with a data-less getProgramAccounts call and then
+// paged through getMultipleAccounts, so each chunk carries full account data
+// without the server holding the whole program state in memory. When
+// continue_live is set, the stream then carries programSubscribe updates, or
+// the changes found by polling when no WebSocket upstream is available.
+func (s *BenchmarkService) StreamProgramAccountsSnapshot(req *proto.ProgramAccountsSnapshotRequest, stream proto.BenchmarkService_StreamProgramAccountsSnapshotServer) error {
+	programID, err := solana.PublicKeyFromBase58(req.ProgramId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid program id: %v", err)
+	}
+
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return err
+	}
+
+	chunkSize := maxProgramAccountsChunk
+	if req.ChunkSize > 0 && req.ChunkSize < maxProgramAccountsChunk {
+		chunkSize = int(req.ChunkSize)
+	}
+	class, err := parseStreamQoS(req.Qos)
+	if err != nil {
+		return err
+	}
+	batch, err := parseBatchOptions(req.BatchMaxMessages, req.BatchMaxWaitMs)
+	if err != nil {
+		return err
+	}
+	if req.BatchMaxMessages == 0 {
+		batch = class.batch
+	}
+	requestedPoll, err := parsePollInterval(req.PollIntervalMs)
+	if err != nil {
+		return err
+	}
+	if _, ok := proto.PollChangeDetection_name[int32(req.ChangeDetection)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown change detection: %v", req.ChangeDetection)
+	}
+	if req.ContinueLive {
+		if err := s.requireFeature(features.WebSocketStreams); err != nil {
+			return err
+		}
+	}
+
+	ctx := stream.Context()
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+
+	// Subscribe before taking the snapshot so no change between the snapshot
+	// and the start of live delivery is missed; notifications queue up in the
+	// subscription until the snapshot has been sent.
+	var feed *programFeed
+	var pollInterval time.Duration
+	if req.ContinueLive {
+		var cause error
+		if s.wsAvailable() {
+			feed = &programFeed{}
+			if cause = s.subscribeProgram(ctx, feed, programID, commitment); cause == nil {
+				defer feed.close()
+			} else {
+				feed = nil
+			}
+		}
+		if feed == nil {
+			if pollInterval, err = s.wsFallback("Program stream of "+programID.String(), requestedPoll, cause); err != nil {
+				return err
+			}
+		}
+	}
+	// A polled stream finds changes against the snapshot
+	var known map[solana.PublicKey]polledAccount
+	if pollInterval > 0 {
+		known = make(map[solana.PublicKey]polledAccount)
+	}
+	hashChanges := req.ChangeDetection == proto.PollChangeDetection_POLL_CHANGE_DETECTION_DATA_HASH
+
+	// Resolve the account set without data
+	pubkeys, err := s.programAccountKeys(ctx, programID, commitment)
+	if err != nil {
+		return err
+	}
+	total := uint32(len(pubkeys))
+
+	// Page through the account data
+	var sent uint32
+	var snapshotSlot uint64
+	for start := 0; start < len(pubkeys); start += chunkSize {
+		end := start + chunkSize
+		if end > len(pubkeys) {
+			end = len(pubkeys)
+		}
+
+		release, err := s.scheduler.acquire(ctx, class)
+		if err != nil {
+			return err
+		}
+		result, err := s.solanaClient.GetMultipleAccountsWithOpts(ctx, pubkeys[start:end], &rpc.GetMultipleAccountsOpts{
+			Commitment: commitment,
+		})
+		release()
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get program accounts page: %v", err)
+		}
+		if result.Context.Slot > snapshotSlot {
+			snapshotSlot = result.Context.Slot
+		}
+
+		chunk := &proto.ProgramAccountsChunk{TotalAccounts: total}
+		for i, account := range result.Value {
+			// The account was closed after the set was resolved
+			if account == nil {
+				continue
+			}
+			if known != nil {
+				var state polledAccount
+				if hashChanges {
+					state = newPolledAccount(account)
+				}
+				known[pubkeys[start+i]] = state
+			}
+			chunk.Accounts = append(chunk.Accounts, &proto.AccountUpdate{
+				Pubkey:    pubkeys[start+i].String(),
+				Data:      account.Data.GetBinary(),
+				Owner:     account.Owner.String(),
+				Lamports:  account.Lamports,
+				Slot:      result.Context.Slot,
+				Timestamp: uint64(time.Now().Unix()),
+				Snapshot:  true,
+			})
+		}
+		sent += uint32(end - start)
+		chunk.SentAccounts = sent
+		chunk.SnapshotComplete = sent == total
+
+		if err := stream.Send(chunk); err != nil {
+			return status.Errorf(codes.Internal, "failed to send program accounts chunk: %v", err)
+		}
+	}
+
+	// An empty program still gets an explicit completion marker
+	if total == 0 {
+		if err := stream.Send(&proto.ProgramAccountsChunk{SnapshotComplete: true}); err != nil {
+			return status.Errorf(codes.Internal, "failed to send program accounts chunk: %v", err)
+		}
+	}
+
+	if !req.ContinueLive {
+		return nil
+	}
+	live := &programLiveStream{
+		programID:       programID,
+		commitment:      commitment,
+		feed:            feed,
+		total:           total,
+		lastSlot:        snapshotSlot,
+		class:           class,
+		pollInterval:    pollInterval,
+		changeDetection: req.ChangeDetection,
+		stream:          stream,
+	}
+	live.batch = newBatcher(batch, func(updates []*proto.AccountUpdate) error {
+		return live.send(updates, false)
+	})
+	defer live.batch.stop()
+	if feed == nil {
+		return s.pollProgramAccounts(ctx, live, known)
+	}
+	return s.forwardProgramUpdates(ctx, live)
+}
+
+// programFeed is a programSubscribe subscription and its connection, which
+// are replaced when the stream resubscribes
+type programFeed struct {
+	mu       sync.Mutex
+	wsClient *ws.Client
+	sub      *ws.ProgramSubscription
+}
+
+// subscription returns the current subscription
+func (f *programFeed) subscription() *ws.ProgramSubscription {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.sub
+}
+
+// close unsubscribes before closing the connection, which unblocks Recv
+func (f *programFeed) close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.sub != nil {
+		f.sub.Unsubscribe()
+		f.wsClient.Close()
+	}
+}
+
+// subscribeProgram connects and subscribes to a program, replacing the
+// feed's previous subscription
+func (s *BenchmarkService) subscribeProgram(ctx context.Context, feed *programFeed, programID solana.PublicKey, commitment rpc.CommitmentType) error {
+	feed.close()
+
+	wsClient, err := s.endpoint.ConnectWS(ctx)
+	if err != nil {
+		return err
+	}
+	sub, err := wsClient.ProgramSubscribe(programID, commitment)
+	if err != nil {
+		wsClient.Close()
+		return err
+	}
+
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+	feed.wsClient, feed.sub = wsClient, sub
+	return nil
+}
+
+// programLiveStream is the state of the live phase of a program stream
+type programLiveStream struct {
+	programID  solana.PublicKey
+	commitment rpc.CommitmentType
+	feed       *programFeed
+	total      uint32
+	// lastSlot is the newest slot delivered, from which a gap is repaired
+	lastSlot uint64
+	// repaired holds the accounts a gap repair read at repairSlot. Queued
+	// notifications of those accounts from before it are stale.
+	repaired   map[solana.PublicKey]bool
+	repairSlot uint64
+	class      streamClass
+	// pollInterval is set when the stream polls instead of subscribing, and
+	// changeDetection is how it finds the updates to send
+	pollInterval    time.Duration
+	changeDetection proto.PollChangeDetection
+	stream          proto.BenchmarkService_StreamProgramAccountsSnapshotServer
+	// batch coalesces live updates into chunks; every chunk is sent through it
+	batch *batcher[*proto.AccountUpdate]
+}
+
+// forwardProgramUpdates relays programSubscribe notifications until the
+// client disconnects. When the upstream subscription fails, it resubscribes
+// and repairs the gap before resuming.
+func (s *BenchmarkService) forwardProgramUpdates(ctx context.Context, live *programLiveStream) error {
+	// Closing the feed unblocks Recv once the client goes away
+	stop := context.AfterFunc(ctx, live.feed.close)
+	defer stop()
+
+	for {
+		result, err := live.feed.subscription().Recv()
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		if err == nil && result == nil {
+			err = errors.New("subscription closed")
+		}
+		if err != nil {
+			if err := s.resubscribeProgram(ctx, live, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		slot := result.Context.Slot
+		pubkey := result.Value.Pubkey
+		if live.repaired != nil {
+			if slot > live.repairSlot {
+				live.repaired = nil
+			} else if live.repaired[pubkey] && slot < live.repairSlot {
+				continue
+			}
+		}
+		if slot > live.lastSlot {
+			live.lastSlot = slot
+		}
+
+		account := result.Value.Account
+		update := &proto.AccountUpdate{
+			Pubkey:    pubkey.String(),
+			Data:      account.Data.GetBinary(),
+			Owner:     account.Owner.String(),
+			Lamports:  account.Lamports,
+			Slot:      slot,
+			Timestamp: uint64(time.Now().Unix()),
+		}
+		if err := live.batch.add(update); err != nil {
+			return err
+		}
+	}
+}
+
+// resubscribeProgram reconnects with backoff after the subscription failed
+// with cause, then repairs the updates missed in between
+func (s *BenchmarkService) resubscribeProgram(ctx context.Context, live *programLiveStream, cause error) error {
+	outage := time.Now()
+	backoff := upstream.Backoff{Max: maxResubscribeDelay}
+	for {
+		log.Printf("Program subscription to %s failed, resubscribing in %v: %v", live.programID, backoff.Delay(), cause)
+		if err := backoff.Wait(ctx); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		cause = s.subscribeProgram(ctx, live.feed, live.programID, live.commitment)
+		if cause == nil {
+			break
+		}
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		if time.Since(outage) > maxResubscribeOutage {
+			return status.Errorf(codes.Unavailable, "program subscription failed for %v: %v", maxResubscribeOutage, cause)
+		}
+	}
+
+	release, err := s.scheduler.acquire(ctx, live.class)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return s.repairProgramGap(ctx, live)
+}
+
+// repairProgramGap sends the current state of the program accounts written
+// since the last delivered slot. The accounts are found by replaying the
+// program's transactions from that slot; if there are too many, every
+// account of the program is reread instead.
+func (s *BenchmarkService) repairProgramGap(ctx context.Context, live *programLiveStream) error {
+	pubkeys, ok, err := s.gapWrittenAccounts(ctx, live.programID, live.commitment, live.lastSlot)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if pubkeys, err = s.programAccountKeys(ctx, live.programID, live.commitment); err != nil {
+			return err
+		}
+	}
+	log.Printf("Repairing gap in program subscription to %s from slot %d: rereading %d accounts", live.programID, live.lastSlot, len(pubkeys))
+
+	live.repaired = make(map[solana.PublicKey]bool, len(pubkeys))
+	var updates []*proto.AccountUpdate
+	for start := 0; start < len(pubkeys); start += maxProgramAccountsChunk {
+		end := start + maxProgramAccountsChunk
+		if end > len(pubkeys) {
+			end = len(pubkeys)
+		}
+
+		result, err := s.solanaClient.GetMultipleAccountsWithOpts(ctx, pubkeys[start:end], &rpc.GetMultipleAccountsOpts{
+			Commitment: live.commitment,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to reread program accounts: %v", err)
+		}
+		if result.Context.Slot > live.repairSlot {
+			live.repairSlot = result.Context.Slot
+		}
+		for i, account := range result.Value {
+			// Closed accounts, and accounts of other programs the transactions wrote
+			if account == nil || !account.Owner.Equals(live.programID) {
+				continue
+			}
+			live.repaired[pubkeys[start+i]] = true
+			updates = append(updates, &proto.AccountUpdate{
+				Pubkey:    pubkeys[start+i].String(),
+				Data:      account.Data.GetBinary(),
+				Owner:     account.Owner.String(),
+				Lamports:  account.Lamports,
+				Slot:      result.Context.Slot,
+				Timestamp: uint64(time.Now().Unix()),
+			})
+		}
+	}
+	if live.repairSlot > live.lastSlot {
+		live.lastSlot = live.repairSlot
+	}
+
+	// Send at least one chunk, so clients learn of the repair even when
+	// nothing changed. Updates batched before the outage go first.
+	return live.batch.flushThen(func() error {
+		for len(updates) > maxProgramAccountsChunk {
+			if err := live.send(updates[:maxProgramAccountsChunk], true); err != nil {
+				return err
+			}
+			updates = updates[maxProgramAccountsChunk:]
+		}
+		return live.send(updates, true)
+	})
+}
+
+// gapWrittenAccounts returns the accounts written by the successful
+// transactions of a program from a slot on. ok is false if there were more
+// than maxGapSignatures transactions.
+func (s *BenchmarkService) gapWrittenAccounts(ctx context.Context, programID solana.PublicKey, commitment rpc.CommitmentType, fromSlot uint64) (pubkeys []solana.PublicKey, ok bool, err error) {
+	if fromSlot == 0 {
+		return nil, false, nil
+	}
+	// Transaction history is not served at processed commitment
+	if commitment == rpc.CommitmentProcessed {
+		commitment = rpc.CommitmentConfirmed
+	}
+
+	var signatures []solana.Signature
+	var before solana.Signature
+	for {
+		limit := 1000
+		page, err := s.solanaClient.GetSignaturesForAddressWithOpts(ctx, programID, &rpc.GetSignaturesForAddressOpts{
+			Limit:      &limit,
+			Before:     before,
+			Commitment: commitment,
+		})
+		if err != nil {
+			return nil, false, status.Errorf(codes.Internal, "failed to get program signatures: %v", err)
+		}
+
+		// The last delivered slot is included, as it may have been delivered in part
+		reached := len(page) < limit
+		for _, entry := range page {
+			if entry.Slot < fromSlot {
+				reached = true
+				break
+			}
+			before = entry.Signature
+			if entry.Err == nil {
+				signatures = append(signatures, entry.Signature)
+			}
+		}
+		if len(signatures) > maxGapSignatures {
+			return nil, false, nil
+		}
+		if reached {
+			break
+		}
+	}
+
+	maxVersion := uint64(0)
+	seen := make(map[solana.PublicKey]bool)
+	for _, signature := range signatures {
+		result, err := s.solanaClient.GetTransaction(ctx, signature, &rpc.GetTransactionOpts{
+			Encoding:                       solana.EncodingBase64,
+			Commitment:                     commitment,
+			MaxSupportedTransactionVersion: &maxVersion,
+		})
+		if err != nil {
+			return nil, false, status.Errorf(codes.Internal, "failed to get transaction %s: %v", signature, err)
+		}
+		tx, err := result.Transaction.GetTransaction()
+		if err != nil {
+			return nil, false, status.Errorf(codes.Internal, "failed to decode transaction %s: %v", signature, err)
+		}
+		for _, key := range writableKeys(tx, result.Meta) {
+			if !seen[key] {
+				seen[key] = true
+				pubkeys = append(pubkeys, key)
+			}
+		}
+	}
+	return pubkeys, true, nil
+}
+
+// programAccountKeys resolves the accounts of a program without their data
+func (s *BenchmarkService) programAccountKeys(ctx context.Context, programID solana.PublicKey, commitment rpc.CommitmentType) ([]solana.PublicKey, error) {
+	zero := uint64(0)
+	keyed, err := s.solanaClient.GetProgramAccountsWithOpts(ctx, programID, &rpc.GetProgramAccountsOpts{
+		Commitment: commitment,
+		DataSlice:  &rpc.DataSlice{Offset: &zero, Length: &zero},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get program accounts: %v", err)
+	}
+
+	pubkeys := make([]solana.PublicKey, 0, len(keyed))
+	for _, account := range keyed {
+		pubkeys = append(pubkeys, account.Pubkey)
+	}
+	return pubkeys, nil
+}
+
+// send sends live updates, marked as a gap repair if they were reread after
+// a resubscription
+func (l *programLiveStream) send(updates []*proto.AccountUpdate, gapRepair bool) error {
+	err := l.stream.Send(&proto.ProgramAccountsChunk{
+		Accounts:         updates,
+		SentAccounts:     l.total,
+		TotalAccounts:    l.total,
+		SnapshotComplete: true,
+		Live:             true,
+		GapRepair:        gapRepair,
+		PollIntervalMs:   uint32(l.pollInterval.Milliseconds()),
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to send program account update: %v", err)
+	}
+	return nil
+}
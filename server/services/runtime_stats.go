@@ -0,0 +1,34 @@
+package services
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+)
+
+// runtimeSnapshot is the process-wide resource usage at a point in time
+type runtimeSnapshot struct {
+	mem runtime.MemStats
+	cpu time.Duration
+}
+
+// takeRuntimeSnapshot records the current allocation, GC and CPU counters
+func takeRuntimeSnapshot() runtimeSnapshot {
+	var snapshot runtimeSnapshot
+	runtime.ReadMemStats(&snapshot.mem)
+	snapshot.cpu = processCPUTime()
+	return snapshot
+}
+
+// since returns the resource usage between the snapshot and now
+func (start runtimeSnapshot) since() *proto.RuntimeStats {
+	end := takeRuntimeSnapshot()
+	return &proto.RuntimeStats{
+		AllocatedBytes: end.mem.TotalAlloc - start.mem.TotalAlloc,
+		Allocations:    end.mem.Mallocs - start.mem.Mallocs,
+		GcCycles:       end.mem.NumGC - start.mem.NumGC,
+		GcPauseMs:      float64(end.mem.PauseTotalNs-start.mem.PauseTotalNs) / float64(time.Millisecond),
+		CpuTimeMs:      float64(end.cpu-start.cpu) / float64(time.Millisecond),
+	}
+}
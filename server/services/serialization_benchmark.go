@@ -0,0 +1,236 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"runtime"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/flatblock"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultSerializationIterations is the number of encodes and decodes per
+	// format when the request does not set one
+	defaultSerializationIterations = 100
+	// maxSerializationIterations caps the iterations a request may ask for
+	maxSerializationIterations = 10000
+)
+
+// BenchmarkSerialization fetches a block with full transactions once, then
+// encodes and decodes it repeatedly as a SerializedBlock, as the getBlock
+// JSON-RPC result, which JSON-RPC clients decode with encoding/json, and as a
+// FlatBuffers block, which readers walk in place instead of decoding. It
+// waits its turn in the benchmark queue, so benchmark runs do not skew it.
+func (s *BenchmarkService) BenchmarkSerialization(ctx context.Context, req *proto.SerializationBenchmarkRequest) (*proto.SerializationBenchmarkResults, error) {
+	if req.Slot == 0 {
+		return nil, status.Error(codes.InvalidArgument, "slot is required")
+	}
+	iterations := defaultSerializationIterations
+	if req.Iterations > 0 {
+		iterations = int(req.Iterations)
+	}
+	if iterations > maxSerializationIterations {
+		return nil, status.Errorf(codes.InvalidArgument, "iterations must not exceed %d", maxSerializationIterations)
+	}
+
+	if err := s.benchmarkQueue.acquire(ctx); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	defer s.benchmarkQueue.release()
+
+	opts, err := blockOpts(proto.BlockDetail_BLOCK_DETAIL_FULL)
+	if err != nil {
+		return nil, err
+	}
+	block, err := s.solanaClient.GetBlockWithOpts(upstream.WithPriority(ctx, upstream.PriorityBenchmark), req.Slot, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get block: %v", err)
+	}
+	message, err := serializedBlock(req.Slot, block)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert block: %v", err)
+	}
+
+	results := &proto.SerializationBenchmarkResults{
+		Slot:         req.Slot,
+		Iterations:   uint32(iterations),
+		Transactions: uint32(len(block.Transactions)),
+	}
+	results.Protobuf, err = measureSerialization(ctx, iterations,
+		func() ([]byte, error) { return protobuf.Marshal(message) },
+		func(data []byte) error { return protobuf.Unmarshal(data, &proto.SerializedBlock{}) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	results.Json, err = measureSerialization(ctx, iterations,
+		func() ([]byte, error) { return json.Marshal(block) },
+		func(data []byte) error { return json.Unmarshal(data, &rpc.GetBlockResult{}) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	results.Flatbuffers, err = measureSerialization(ctx, iterations,
+		func() ([]byte, error) { return flatblock.Encode(message), nil },
+		func(data []byte) error {
+			_, err := flatblock.Walk(data)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// measureSerialization encodes iterations times, then decodes the encoding
+// as many times, each phase starting from a clean heap
+func measureSerialization(ctx context.Context, iterations int, encode func() ([]byte, error), decode func([]byte) error) (*proto.SerializationCost, error) {
+	cost := &proto.SerializationCost{}
+
+	var data []byte
+	runtime.GC()
+	snapshot := takeRuntimeSnapshot()
+	start := time.Now()
+	for i := 0; i < iterations; i++ {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		var err error
+		if data, err = encode(); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode block: %v", err)
+		}
+	}
+	cost.EncodeUs = averageUs(time.Since(start), iterations)
+	cost.EncodeRuntime = snapshot.since()
+	cost.EncodedBytes = uint64(len(data))
+
+	runtime.GC()
+	snapshot = takeRuntimeSnapshot()
+	start = time.Now()
+	for i := 0; i < iterations; i++ {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		if err := decode(data); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decode block: %v", err)
+		}
+	}
+	cost.DecodeUs = averageUs(time.Since(start), iterations)
+	cost.DecodeRuntime = snapshot.since()
+	return cost, nil
+}
+
+// averageUs returns the average of iterations over elapsed in microseconds
+func averageUs(elapsed time.Duration, iterations int) float64 {
+	return float64(elapsed) / float64(iterations) / float64(time.Microsecond)
+}
+
+// serializedBlock converts a block fetched with full, base64-encoded
+// transactions to its protobuf counterpart
+func serializedBlock(slot uint64, block *rpc.GetBlockResult) (*proto.SerializedBlock, error) {
+	message := &proto.SerializedBlock{
+		Slot:              slot,
+		Blockhash:         block.Blockhash[:],
+		PreviousBlockhash: block.PreviousBlockhash[:],
+		ParentSlot:        block.ParentSlot,
+		Transactions:      make([]*proto.SerializedTransaction, 0, len(block.Transactions)),
+		Rewards:           serializedRewards(block.Rewards),
+	}
+	if block.BlockTime != nil {
+		message.BlockTime = int64(*block.BlockTime)
+	}
+	if block.BlockHeight != nil {
+		message.BlockHeight = *block.BlockHeight
+	}
+
+	for _, tx := range block.Transactions {
+		if tx.Transaction == nil {
+			continue
+		}
+		serialized := &proto.SerializedTransaction{Transaction: tx.Transaction.GetBinary()}
+		if meta := tx.Meta; meta != nil {
+			if meta.Err != nil {
+				txErr, err := json.Marshal(meta.Err)
+				if err != nil {
+					return nil, err
+				}
+				serialized.Err = string(txErr)
+			}
+			serialized.Fee = meta.Fee
+			serialized.PreBalances = meta.PreBalances
+			serialized.PostBalances = meta.PostBalances
+			serialized.LogMessages = meta.LogMessages
+			serialized.PreTokenBalances = serializedTokenBalances(meta.PreTokenBalances)
+			serialized.PostTokenBalances = serializedTokenBalances(meta.PostTokenBalances)
+			serialized.LoadedWritable = serializedKeys(meta.LoadedAddresses.Writable)
+			serialized.LoadedReadonly = serializedKeys(meta.LoadedAddresses.ReadOnly)
+			serialized.Rewards = serializedRewards(meta.Rewards)
+			for _, inner := range meta.InnerInstructions {
+				instructions := &proto.SerializedInnerInstructions{Index: uint32(inner.Index)}
+				for _, instruction := range inner.Instructions {
+					accounts := make([]byte, len(instruction.Accounts))
+					for i, account := range instruction.Accounts {
+						accounts[i] = byte(account)
+					}
+					instructions.Instructions = append(instructions.Instructions, &proto.SerializedInstruction{
+						ProgramIdIndex: uint32(instruction.ProgramIDIndex),
+						Accounts:       accounts,
+						Data:           instruction.Data,
+					})
+				}
+				serialized.InnerInstructions = append(serialized.InnerInstructions, instructions)
+			}
+		}
+		message.Transactions = append(message.Transactions, serialized)
+	}
+	return message, nil
+}
+
+func serializedTokenBalances(balances []rpc.TokenBalance) []*proto.SerializedTokenBalance {
+	serialized := make([]*proto.SerializedTokenBalance, 0, len(balances))
+	for _, balance := range balances {
+		token := &proto.SerializedTokenBalance{
+			AccountIndex: uint32(balance.AccountIndex),
+			Mint:         balance.Mint[:],
+		}
+		if balance.Owner != nil {
+			token.Owner = balance.Owner[:]
+		}
+		if balance.UiTokenAmount != nil {
+			token.Amount = balance.UiTokenAmount.Amount
+			token.Decimals = uint32(balance.UiTokenAmount.Decimals)
+		}
+		serialized = append(serialized, token)
+	}
+	return serialized
+}
+
+func serializedRewards(rewards []rpc.BlockReward) []*proto.SerializedReward {
+	serialized := make([]*proto.SerializedReward, 0, len(rewards))
+	for _, reward := range rewards {
+		serialized = append(serialized, &proto.SerializedReward{
+			Pubkey:      reward.Pubkey[:],
+			Lamports:    reward.Lamports,
+			PostBalance: reward.PostBalance,
+			RewardType:  string(reward.RewardType),
+		})
+	}
+	return serialized
+}
+
+func serializedKeys(keys solana.PublicKeySlice) [][]byte {
+	serialized := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		serialized = append(serialized, key[:])
+	}
+	return serialized
+}
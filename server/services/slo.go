@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/slo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sloEventBuffer is the number of latency objective events queued for a
+// stream before it misses them
+const sloEventBuffer = 64
+
+// UseSLOTracker reports the latency objectives a tracker evaluates in
+// GetSLOStatus and StreamSLOEvents
+func (s *BenchmarkService) UseSLOTracker(tracker *slo.Tracker) {
+	s.slos = tracker
+}
+
+// GetSLOStatus returns the state of the latency objectives at their last
+// evaluation, or none if the server has no objectives configured
+func (s *BenchmarkService) GetSLOStatus(ctx context.Context, req *proto.SLOStatusRequest) (*proto.SLOStatusResponse, error) {
+	resp := &proto.SLOStatusResponse{}
+	if s.slos == nil {
+		return resp, nil
+	}
+	for _, st := range s.slos.Statuses() {
+		resp.Objectives = append(resp.Objectives, sloStatus(st))
+	}
+	return resp, nil
+}
+
+// StreamSLOEvents sends an event whenever a latency objective changes
+// between met and violated, after the current state of each if requested.
+// Events are dropped for streams that fall behind.
+func (s *BenchmarkService) StreamSLOEvents(req *proto.SLOEventsRequest, stream proto.BenchmarkService_StreamSLOEventsServer) error {
+	if s.slos == nil {
+		return status.Error(codes.FailedPrecondition, "no latency objectives are configured on this server")
+	}
+
+	ctx := stream.Context()
+	events, unsubscribe := s.slos.Subscribe(sloEventBuffer)
+	defer unsubscribe()
+
+	send := func(eventType proto.SLOEventType, st slo.Status) error {
+		event := &proto.SLOEvent{
+			Type:      eventType,
+			Status:    sloStatus(st),
+			Timestamp: uint64(time.Now().Unix()),
+		}
+		if err := stream.Send(event); err != nil {
+			return status.Errorf(codes.Internal, "failed to send SLO event: %v", err)
+		}
+		return nil
+	}
+
+	if req.IncludeCurrent {
+		for _, st := range s.slos.Statuses() {
+			if err := send(proto.SLOEventType_SLO_EVENT_TYPE_CURRENT, st); err != nil {
+				return err
+			}
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case event := <-events:
+			eventType := proto.SLOEventType_SLO_EVENT_TYPE_RECOVERED
+			if event.Violated {
+				eventType = proto.SLOEventType_SLO_EVENT_TYPE_VIOLATED
+			}
+			if err := send(eventType, event.Status); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sloStatus converts the state of a latency objective to its proto form
+func sloStatus(st slo.Status) *proto.SLOStatus {
+	source := proto.SLOSource_SLO_SOURCE_HANDLER
+	if st.Objective.Source == slo.SourceUpstream {
+		source = proto.SLOSource_SLO_SOURCE_UPSTREAM
+	}
+	return &proto.SLOStatus{
+		Name:              st.Objective.Name,
+		Source:            source,
+		Method:            st.Objective.Method,
+		Percentile:        st.Objective.Percentile,
+		ThresholdMs:       uint64(st.Objective.Threshold.Milliseconds()),
+		WindowMs:          uint64(st.Objective.Window.Milliseconds()),
+		LatencyMs:         float64(st.Latency) / float64(time.Millisecond),
+		Samples:           uint32(st.Samples),
+		Met:               st.Met,
+		SinceUnixMs:       st.Since.UnixMilli(),
+		EvaluatedAtUnixMs: st.EvaluatedAt.UnixMilli(),
+	}
+}
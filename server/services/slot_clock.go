@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// maxSlotClockAge is how long after its last slot notification the slot
+	// clock is live; slots are produced every 400ms or so
+	maxSlotClockAge = 5 * time.Second
+	// serverSlotField is the field responses carry the server's slot in
+	serverSlotField = "server_slot"
+	// serverSlotHeader carries the server's slot in the headers of every call
+	serverSlotHeader = "x-server-slot"
+)
+
+// slotClock returns the latest slot of the WebSocket monitor's slot
+// subscription and when it was received, unless the subscription has gone
+// quiet
+func (s *BenchmarkService) slotClock() (uint64, time.Time, bool) {
+	if s.wsMonitor == nil {
+		return 0, time.Time{}, false
+	}
+	status := s.wsMonitor.Status()
+	if status.LastSlot == 0 || time.Since(status.LastNotification) > maxSlotClockAge {
+		return 0, time.Time{}, false
+	}
+	return status.LastSlot, status.LastNotification, true
+}
+
+// GetCurrentSlot returns the slot clock's slot, or the upstream's processed
+// slot while the clock is not live
+func (s *BenchmarkService) GetCurrentSlot(ctx context.Context, req *proto.CurrentSlotRequest) (*proto.CurrentSlot, error) {
+	if slot, at, ok := s.slotClock(); ok {
+		return &proto.CurrentSlot{Slot: slot, ObservedAtUnixMs: at.UnixMilli(), FromSlotClock: true}, nil
+	}
+	slot, err := s.solanaClient.GetSlot(ctx, rpc.CommitmentProcessed)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get slot: %v", err)
+	}
+	return &proto.CurrentSlot{Slot: slot, ObservedAtUnixMs: time.Now().UnixMilli()}, nil
+}
+
+// StampServerSlot sets the slot clock's slot in the headers of unary calls
+// and in the server_slot field of their responses, so clients can tell how
+// fresh a response is without asking for the slot themselves
+func (s *BenchmarkService) StampServerSlot(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if slot, _, ok := s.slotClock(); ok {
+		grpc.SetHeader(ctx, metadata.Pairs(serverSlotHeader, strconv.FormatUint(slot, 10)))
+	}
+	resp, err := handler(ctx, req)
+	if msg, ok := resp.(protobuf.Message); ok && err == nil {
+		s.setServerSlot(msg)
+	}
+	return resp, err
+}
+
+// StampServerSlotStreams sets the slot clock's slot in the headers of streams
+// and in the server_slot field of every message they send
+func (s *BenchmarkService) StampServerSlotStreams(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if slot, _, ok := s.slotClock(); ok {
+		ss.SetHeader(metadata.Pairs(serverSlotHeader, strconv.FormatUint(slot, 10)))
+	}
+	return handler(srv, &slotStampedStream{ServerStream: ss, service: s})
+}
+
+// setServerSlot sets the server_slot field of msg, if it has one
+func (s *BenchmarkService) setServerSlot(msg protobuf.Message) {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(serverSlotField)
+	if fd == nil || fd.Kind() != protoreflect.Uint64Kind {
+		return
+	}
+	if slot, _, ok := s.slotClock(); ok {
+		m.Set(fd, protoreflect.ValueOfUint64(slot))
+	}
+}
+
+type slotStampedStream struct {
+	grpc.ServerStream
+	service *BenchmarkService
+}
+
+func (s *slotStampedStream) SendMsg(m interface{}) error {
+	if msg, ok := m.(protobuf.Message); ok {
+		s.service.setServerSlot(msg)
+	}
+	return s.ServerStream.SendMsg(m)
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultMsPerSlot is the target slot time, used when the upstream has no
+	// performance samples
+	defaultMsPerSlot = 400
+	// performanceSamples is the number of recent samples, taken about a
+	// minute apart, slot timing is averaged over
+	performanceSamples = 60
+	// maxSlotCalibrations bounds the block time lookups correcting an estimate
+	maxSlotCalibrations = 4
+	// minSlotsPerEpoch is the length of the first epoch of a warmup schedule
+	minSlotsPerEpoch = 32
+)
+
+// GetBlockTime returns the estimated production time of a block
+func (s *BenchmarkService) GetBlockTime(ctx context.Context, req *proto.BlockTimeRequest) (*proto.BlockTimeResponse, error) {
+	startTime := time.Now()
+
+	blockTime, err := s.solanaClient.GetBlockTime(ctx, req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get block time: %v", err)
+	}
+	if blockTime == nil {
+		return nil, status.Errorf(codes.NotFound, "no block time for slot %d", req.Slot)
+	}
+
+	return &proto.BlockTimeResponse{
+		Slot:           req.Slot,
+		BlockTime:      int64(*blockTime),
+		ResponseTimeMs: uint64(time.Since(startTime).Milliseconds()),
+	}, nil
+}
+
+// EstimateSlotAtTime extrapolates from the latest finalized slot at the recent
+// average slot time. For a past time the estimate is then corrected against
+// the block times of the estimated slots, since slot times drift over longer
+// spans.
+func (s *BenchmarkService) EstimateSlotAtTime(ctx context.Context, req *proto.SlotAtTimeRequest) (*proto.SlotAtTimeResponse, error) {
+	if req.UnixTime <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "unix time must be positive")
+	}
+	startTime := time.Now()
+
+	refSlot, err := s.solanaClient.GetSlot(ctx, rpc.CommitmentFinalized)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get slot: %v", err)
+	}
+	refTime, err := s.solanaClient.GetBlockTime(ctx, refSlot)
+	if err != nil || refTime == nil {
+		return nil, status.Errorf(codes.Internal, "failed to get block time of slot %d: %v", refSlot, err)
+	}
+	msPerSlot, err := s.recentMsPerSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := s.solanaClient.GetEpochSchedule(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get epoch schedule: %v", err)
+	}
+
+	resp := &proto.SlotAtTimeResponse{
+		MsPerSlot:     msPerSlot,
+		ReferenceSlot: refSlot,
+		ReferenceTime: int64(*refTime),
+		Future:        req.UnixTime > int64(*refTime),
+	}
+	resp.Slot = offsetSlot(refSlot, req.UnixTime-int64(*refTime), msPerSlot)
+
+	// Block times are recorded to the second, so stop once within one
+	for i := 0; !resp.Future && i < maxSlotCalibrations; i++ {
+		blockTime, err := s.solanaClient.GetBlockTime(ctx, resp.Slot)
+		if err != nil || blockTime == nil {
+			// Skipped slots and pruned ledgers have no block time
+			break
+		}
+		resp.Calibrated = true
+		drift := req.UnixTime - int64(*blockTime)
+		if drift == 0 {
+			break
+		}
+		resp.Slot = offsetSlot(resp.Slot, drift, msPerSlot)
+	}
+
+	resp.Epoch, resp.SlotIndex, resp.SlotsInEpoch = epochOf(schedule, resp.Slot)
+	resp.ResponseTimeMs = uint64(time.Since(startTime).Milliseconds())
+	return resp, nil
+}
+
+// recentMsPerSlot averages the slot time over the upstream's recent
+// performance samples
+func (s *BenchmarkService) recentMsPerSlot(ctx context.Context) (float64, error) {
+	limit := uint(performanceSamples)
+	samples, err := s.solanaClient.GetRecentPerformanceSamples(ctx, &limit)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get performance samples: %v", err)
+	}
+
+	var seconds, slots uint64
+	for _, sample := range samples {
+		seconds += uint64(sample.SamplePeriodSecs)
+		slots += sample.NumSlots
+	}
+	if slots == 0 || seconds == 0 {
+		return defaultMsPerSlot, nil
+	}
+	return float64(seconds*1000) / float64(slots), nil
+}
+
+// offsetSlot moves a slot by the slots produced in a number of seconds,
+// clamped to the range of slots. It is computed in floating point, since the
+// seconds in milliseconds may not fit an int64.
+func offsetSlot(slot uint64, seconds int64, msPerSlot float64) uint64 {
+	offset := float64(seconds) * 1000 / msPerSlot
+	switch {
+	case math.IsNaN(offset):
+		return slot
+	case offset < 0:
+		if -offset >= float64(slot) {
+			return 0
+		}
+		return slot - uint64(-offset)
+	case offset >= math.MaxUint64:
+		return math.MaxUint64
+	}
+	sum, carry := bits.Add64(slot, uint64(offset), 0)
+	if carry != 0 {
+		return math.MaxUint64
+	}
+	return sum
+}
+
+// epochOf returns the epoch of a slot, its index in the epoch and the length
+// of the epoch. Under warmup, epochs start at minSlotsPerEpoch slots and double
+// until the first normal epoch.
+func epochOf(schedule *rpc.GetEpochScheduleResult, slot uint64) (epoch, index, length uint64) {
+	if slot >= schedule.FirstNormalSlot {
+		normal := slot - schedule.FirstNormalSlot
+		return schedule.FirstNormalEpoch + normal/schedule.SlotsPerEpoch, normal % schedule.SlotsPerEpoch, schedule.SlotsPerEpoch
+	}
+
+	// Epoch n of the warmup starts at minSlotsPerEpoch * (2^n - 1)
+	epoch = uint64(bits.Len64(slot/minSlotsPerEpoch+1)) - 1
+	length = minSlotsPerEpoch << epoch
+	return epoch, slot - (length - minSlotsPerEpoch), length
+}
+
+// epochFirstSlot returns the first slot of an epoch, the inverse of epochOf
+func epochFirstSlot(schedule *rpc.GetEpochScheduleResult, epoch uint64) uint64 {
+	if epoch >= schedule.FirstNormalEpoch {
+		return schedule.FirstNormalSlot + (epoch-schedule.FirstNormalEpoch)*schedule.SlotsPerEpoch
+	}
+	return minSlotsPerEpoch * (1<<epoch - 1)
+}
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"math"
+	"testing"
+)
+
+// TestOffsetSlotClamps expects offsets past either end of the slot range to
+// stop there rather than wrap around
+func TestOffsetSlotClamps(t *testing.T) {
+	tests := []struct {
+		slot    uint64
+		seconds int64
+		want    uint64
+	}{
+		{100, 10, 125},
+		{100, -10, 75},
+		{100, -100, 0},
+		{100, math.MinInt64, 0},
+		{100, math.MaxInt64, math.MaxUint64},
+		{math.MaxUint64 - 5, 10, math.MaxUint64},
+	}
+	for _, test := range tests {
+		if got := offsetSlot(test.slot, test.seconds, 400); got != test.want {
+			t.Errorf("offsetSlot(%d, %d, 400) = %d, want %d", test.slot, test.seconds, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRewardEpochs caps the epochs of one GetInflationReward request, as the
+// upstream reads the first block of each
+const maxRewardEpochs = 10
+
+// stakeActivationStates maps getStakeActivation states to their proto form
+var stakeActivationStates = map[rpc.ActivationStateType]proto.StakeActivationState{
+	rpc.ActivationStateActive:       proto.StakeActivationState_STAKE_ACTIVATION_STATE_ACTIVE,
+	rpc.ActivationStateInactive:     proto.StakeActivationState_STAKE_ACTIVATION_STATE_INACTIVE,
+	rpc.ActivationStateActivating:   proto.StakeActivationState_STAKE_ACTIVATION_STATE_ACTIVATING,
+	rpc.ActivationStateDeactivating: proto.StakeActivationState_STAKE_ACTIVATION_STATE_DEACTIVATING,
+}
+
+// GetStakeActivation passes getStakeActivation through. Upstreams that
+// removed the method, as Agave 2.0 did, fail the call as unimplemented.
+func (s *BenchmarkService) GetStakeActivation(ctx context.Context, req *proto.StakeActivationRequest) (*proto.StakeActivationResponse, error) {
+	pubkey, err := solana.PublicKeyFromBase58(req.Pubkey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pubkey: %v", err)
+	}
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	var epoch *uint64
+	if req.Epoch != 0 {
+		epoch = &req.Epoch
+	}
+
+	startTime := time.Now()
+	activation, err := s.solanaClient.GetStakeActivation(ctx, pubkey, commitment, epoch)
+	if err != nil {
+		var rpcErr *jsonrpc.RPCError
+		if errors.As(err, &rpcErr) && rpcErr.Code == jsonrpcMethodNotFound {
+			return nil, status.Error(codes.Unimplemented, "the upstream does not support getStakeActivation")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get stake activation: %v", err)
+	}
+
+	return &proto.StakeActivationResponse{
+		Pubkey:           req.Pubkey,
+		State:            stakeActivationStates[activation.State],
+		ActiveLamports:   activation.Active,
+		InactiveLamports: activation.Inactive,
+		ResponseTimeMs:   uint64(time.Since(startTime).Milliseconds()),
+	}, nil
+}
+
+// GetInflationReward fetches the rewards of every requested epoch with one
+// batch of getInflationReward calls
+func (s *BenchmarkService) GetInflationReward(ctx context.Context, req *proto.InflationRewardRequest) (*proto.InflationRewardResponse, error) {
+	if len(req.Addresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one address is required")
+	}
+	if len(req.Addresses) > maxRewardAddresses {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d addresses may be requested", maxRewardAddresses)
+	}
+	addresses, err := parsePubkeys(req.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	first, last := req.FirstEpoch, req.LastEpoch
+	if first == 0 {
+		if last != 0 {
+			return nil, status.Error(codes.InvalidArgument, "last_epoch requires first_epoch")
+		}
+		info, err := s.solanaClient.GetEpochInfo(ctx, commitment)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get epoch info: %v", err)
+		}
+		if info.Epoch == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "the cluster has no previous epoch")
+		}
+		first = info.Epoch - 1
+	}
+	if last == 0 {
+		last = first
+	}
+	if last < first {
+		return nil, status.Errorf(codes.InvalidArgument, "last_epoch %d is before first_epoch %d", last, first)
+	}
+	if last-first+1 > maxRewardEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d epochs may be requested", maxRewardEpochs)
+	}
+
+	requests := make(jsonrpc.RPCRequests, 0, last-first+1)
+	for epoch := first; epoch <= last; epoch++ {
+		requests = append(requests, &jsonrpc.RPCRequest{
+			Method: "getInflationReward",
+			Params: []interface{}{addresses, rpc.M{"epoch": epoch, "commitment": commitment}},
+		})
+	}
+	responses, err := s.solanaClient.RPCCallBatch(ctx, requests)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get inflation rewards: %v", err)
+	}
+	if len(responses) != len(requests) {
+		return nil, status.Errorf(codes.Internal, "failed to get inflation rewards: %d responses to %d requests", len(responses), len(requests))
+	}
+
+	resp := &proto.InflationRewardResponse{Epochs: make([]*proto.EpochRewards, len(requests))}
+	for _, response := range responses {
+		// Batch responses may arrive in any order; their IDs are the indexes
+		// of their requests
+		index := response.ID
+		if index < 0 || index >= len(requests) || resp.Epochs[index] != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get inflation rewards: unexpected response ID %d", response.ID)
+		}
+		epoch := first + uint64(index)
+		if response.Error != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get inflation rewards of epoch %d: %v", epoch, response.Error)
+		}
+		var results []*rpc.GetInflationRewardResult
+		if err := json.Unmarshal(response.Result, &results); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decode inflation rewards of epoch %d: %v", epoch, err)
+		}
+
+		rewards := &proto.EpochRewards{Epoch: epoch, Rewards: inflationRewards(addresses, epoch, results)}
+		for _, reward := range rewards.Rewards {
+			rewards.TotalLamports += reward.AmountLamports
+		}
+		resp.Epochs[index] = rewards
+	}
+	resp.ResponseTimeMs = uint64(time.Since(startTime).Milliseconds())
+	return resp, nil
+}
+
+// inflationRewards decodes the getInflationReward results of addresses, which
+// are null for addresses without a reward
+func inflationRewards(addresses []solana.PublicKey, epoch uint64, results []*rpc.GetInflationRewardResult) []*proto.InflationReward {
+	rewards := make([]*proto.InflationReward, len(addresses))
+	for i, address := range addresses {
+		reward := &proto.InflationReward{Address: address.String(), Epoch: epoch}
+		if i < len(results) && results[i] != nil {
+			result := results[i]
+			reward.Credited = true
+			reward.AmountLamports = result.Amount
+			reward.PostBalanceLamports = result.PostBalance
+			reward.EffectiveSlot = result.EffectiveSlot
+			if result.Commission != nil {
+				reward.Commission = uint32(*result.Commission)
+			}
+		}
+		rewards[i] = reward
+	}
+	return rewards
+}
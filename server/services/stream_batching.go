@@ -0,0 +1,146 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxBatchMessages is the most updates a stream may coalesce into one message
+	maxBatchMessages = 1000
+	// defaultBatchWait is how long an update is held for its batch to fill
+	// when batching is requested without a wait
+	defaultBatchWait = 100 * time.Millisecond
+	// maxBatchWait caps how long an update may be held
+	maxBatchWait = 10 * time.Second
+)
+
+// batchOptions controls how a stream coalesces updates into messages. A
+// maxMessages of 1 sends every update as soon as it arrives.
+type batchOptions struct {
+	maxMessages int
+	maxWait     time.Duration
+}
+
+// parseBatchOptions validates the batching fields of a stream request.
+// Batching is off unless maxMessages is above 1.
+func parseBatchOptions(maxMessages, maxWaitMs uint32) (batchOptions, error) {
+	if maxMessages > maxBatchMessages {
+		return batchOptions{}, status.Errorf(codes.InvalidArgument, "at most %d updates may be batched per message", maxBatchMessages)
+	}
+	maxWait := time.Duration(maxWaitMs) * time.Millisecond
+	if maxWait > maxBatchWait {
+		return batchOptions{}, status.Errorf(codes.InvalidArgument, "batch wait may be at most %v", maxBatchWait)
+	}
+	if maxMessages <= 1 {
+		return batchOptions{maxMessages: 1}, nil
+	}
+	if maxWait == 0 {
+		maxWait = defaultBatchWait
+	}
+	return batchOptions{maxMessages: int(maxMessages), maxWait: maxWait}, nil
+}
+
+// rejectBatching fails an account stream request setting the batching
+// fields, which only StreamAccountUpdateBatches honors
+func rejectBatching(req *proto.AccountStreamRequest) error {
+	if req.BatchMaxMessages != 0 || req.BatchMaxWaitMs != 0 {
+		return status.Error(codes.InvalidArgument, "batching is only supported by StreamAccountUpdateBatches")
+	}
+	return nil
+}
+
+// batcher coalesces the updates of a stream, sending a batch once it holds
+// maxMessages updates or its first update has waited maxWait. Sends are
+// serialized, so other messages of the stream must be sent through flushThen.
+type batcher[T any] struct {
+	opts batchOptions
+	send func([]T) error
+
+	mu      sync.Mutex
+	pending []T
+	timer   *time.Timer
+	// generation identifies the current batch, so a timer firing after its
+	// batch was sent does not cut the next one short
+	generation uint64
+	// err is the failure of a send made by the timer, returned by the next call
+	err error
+}
+
+// newBatcher creates a batcher sending batches with send
+func newBatcher[T any](opts batchOptions, send func([]T) error) *batcher[T] {
+	return &batcher[T]{opts: opts, send: send}
+}
+
+// add queues an update, sending the batch if it is full
+func (b *batcher[T]) add(update T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.err != nil {
+		return b.err
+	}
+
+	b.pending = append(b.pending, update)
+	if len(b.pending) >= b.opts.maxMessages {
+		return b.flushLocked()
+	}
+	if len(b.pending) == 1 {
+		generation := b.generation
+		b.timer = time.AfterFunc(b.opts.maxWait, func() { b.expire(generation) })
+	}
+	return nil
+}
+
+// flushThen sends the pending batch and then calls fn, with no batch sent in
+// between
+func (b *batcher[T]) flushThen(fn func() error) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.err != nil {
+		return b.err
+	}
+	if err := b.flushLocked(); err != nil {
+		return err
+	}
+	return fn()
+}
+
+// stop discards the pending batch once the stream has ended
+func (b *batcher[T]) stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.timer != nil {
+		b.timer.Stop()
+	}
+	b.pending = nil
+	b.generation++
+}
+
+// expire sends a batch whose first update has waited maxWait
+func (b *batcher[T]) expire(generation uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if generation != b.generation || b.err != nil {
+		return
+	}
+	b.err = b.flushLocked()
+}
+
+// flushLocked sends the pending batch, if any. b.mu must be held.
+func (b *batcher[T]) flushLocked() error {
+	if len(b.pending) == 0 {
+		return nil
+	}
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch := b.pending
+	b.pending = nil
+	b.generation++
+	return b.send(batch)
+}
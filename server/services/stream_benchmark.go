@@ -0,0 +1,422 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"github.com/i-tozer/solana-grpc-exploration/server/stats"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultStreamBenchmarkDuration is how long a stream benchmark subscribes
+	// when the request does not set a duration
+	defaultStreamBenchmarkDuration = 30 * time.Second
+	// maxStreamBenchmarkDuration caps the duration a request may ask for
+	maxStreamBenchmarkDuration = 5 * time.Minute
+	// streamBenchmarkGrace is how close to the start or end of a run a slot
+	// may be first delivered and not count as dropped by the other
+	// transport, which may not have subscribed or caught up yet
+	streamBenchmarkGrace = 5 * time.Second
+)
+
+// streamArrival is an update received for a slot
+type streamArrival struct {
+	slot uint64
+	at   time.Time
+}
+
+// streamTransport collects the updates one transport delivers
+type streamTransport struct {
+	method   string
+	mu       sync.Mutex
+	arrivals []streamArrival
+	err      error
+}
+
+func (t *streamTransport) record(slot uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.arrivals = append(t.arrivals, streamArrival{slot: slot, at: time.Now()})
+}
+
+// fail records why the transport stopped, unless the run was already over
+func (t *streamTransport) fail(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.err = err
+}
+
+// firstArrivals returns when each slot was first delivered
+func (t *streamTransport) firstArrivals() map[uint64]time.Time {
+	first := make(map[uint64]time.Time)
+	for _, arrival := range t.arrivals {
+		if _, ok := first[arrival.slot]; !ok {
+			first[arrival.slot] = arrival.at
+		}
+	}
+	return first
+}
+
+// processedSlots records when the reference slot subscription saw each slot
+// processed
+type processedSlots struct {
+	mu sync.Mutex
+	at map[uint64]time.Time
+}
+
+func (p *processedSlots) observe(slot uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.at[slot]; !ok {
+		p.at[slot] = time.Now()
+	}
+}
+
+func (p *processedSlots) get(slot uint64) (time.Time, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	at, ok := p.at[slot]
+	return at, ok
+}
+
+// streamBenchmarkCategory is one kind of update, subscribed to on both
+// transports
+type streamBenchmarkCategory struct {
+	kind          proto.StreamBenchmarkKind
+	grpc, websock *streamTransport
+}
+
+// StreamBenchmark subscribes to the requested kinds of update through the
+// service's own streams, served on a loopback gRPC server so the transport is
+// exercised, and through one WebSocket connection to the upstream, all for
+// the same duration. A slot subscription on a second connection is the
+// reference clock delivery latency is measured against. It waits its turn in
+// the benchmark queue, so benchmark runs do not skew it.
+func (s *BenchmarkService) StreamBenchmark(ctx context.Context, req *proto.StreamBenchmarkRequest) (*proto.StreamBenchmarkResults, error) {
+	if err := s.requireFeature(features.WebSocketStreams); err != nil {
+		return nil, err
+	}
+	if !s.wsAvailable() {
+		return nil, status.Error(codes.FailedPrecondition, "stream benchmarks need a WebSocket upstream")
+	}
+
+	kinds := req.Kinds
+	if len(kinds) == 0 {
+		if req.Pubkey != "" {
+			kinds = append(kinds, proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_ACCOUNT)
+		}
+		kinds = append(kinds, proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_SLOT, proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_BLOCK)
+	}
+	var pubkey solana.PublicKey
+	for _, kind := range kinds {
+		switch kind {
+		case proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_ACCOUNT:
+			var err error
+			if pubkey, err = solana.PublicKeyFromBase58(req.Pubkey); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid pubkey: %v", err)
+			}
+		case proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_SLOT, proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_BLOCK:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown stream benchmark kind %v", kind)
+		}
+	}
+
+	commitment := rpc.CommitmentConfirmed
+	if req.Commitment != "" {
+		var err error
+		if commitment, err = parseCommitment(req.Commitment); err != nil {
+			return nil, err
+		}
+		if commitment == rpc.CommitmentProcessed {
+			return nil, status.Error(codes.InvalidArgument, "stream benchmarks support confirmed or finalized commitment")
+		}
+	}
+
+	duration := defaultStreamBenchmarkDuration
+	if req.DurationSeconds > 0 {
+		duration = time.Duration(req.DurationSeconds) * time.Second
+	}
+	if duration > maxStreamBenchmarkDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "duration must not exceed %v", maxStreamBenchmarkDuration)
+	}
+
+	if err := s.benchmarkQueue.acquire(ctx); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	defer s.benchmarkQueue.release()
+
+	// Serve this service's streams on loopback
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to listen: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	proto.RegisterBenchmarkServiceServer(grpcServer, s)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to connect: %v", err)
+	}
+	defer conn.Close()
+	client := proto.NewBenchmarkServiceClient(conn)
+
+	refClient, err := s.endpoint.ConnectWS(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to connect to the WebSocket upstream: %v", err)
+	}
+	defer refClient.Close()
+	wsClient, err := s.endpoint.ConnectWS(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to connect to the WebSocket upstream: %v", err)
+	}
+	defer wsClient.Close()
+
+	runCtx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+	processed := &processedSlots{at: make(map[uint64]time.Time)}
+	refSub, err := refClient.SlotSubscribe()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to subscribe to slots: %v", err)
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		followWS(runCtx, refSub.Unsubscribe, func() (uint64, bool, error) {
+			result, err := refSub.Recv()
+			if err != nil || result == nil {
+				return 0, false, err
+			}
+			return result.Slot, true, nil
+		}, processed.observe)
+	}()
+
+	commitmentName := string(commitment)
+	var categories []*streamBenchmarkCategory
+	start := time.Now()
+	for _, kind := range kinds {
+		category := &streamBenchmarkCategory{kind: kind, grpc: &streamTransport{}, websock: &streamTransport{}}
+		categories = append(categories, category)
+		var grpcRun, wsRun func(*streamTransport)
+
+		switch kind {
+		case proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_ACCOUNT, proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_SLOT:
+			// Slots are followed through the Clock sysvar, which changes
+			// every slot, at processed commitment as slotSubscribe notifies
+			account, accountCommitment := pubkey, commitmentName
+			if kind == proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_SLOT {
+				account, accountCommitment = solana.SysVarClockPubkey, string(rpc.CommitmentProcessed)
+			}
+			category.grpc.method = "StreamAccountUpdates"
+			grpcRun = func(t *streamTransport) {
+				stream, err := client.StreamAccountUpdates(runCtx, &proto.AccountStreamRequest{Pubkeys: []string{account.String()}, Commitment: accountCommitment})
+				if err != nil {
+					t.fail(runCtx, err)
+					return
+				}
+				for {
+					update, err := stream.Recv()
+					if err != nil {
+						t.fail(runCtx, err)
+						return
+					}
+					t.record(update.Slot)
+				}
+			}
+		case proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_BLOCK:
+			category.grpc.method = "StreamBlocks"
+			grpcRun = func(t *streamTransport) {
+				stream, err := client.StreamBlocks(runCtx, &proto.BlockStreamRequest{Commitment: commitmentName})
+				if err != nil {
+					t.fail(runCtx, err)
+					return
+				}
+				for {
+					update, err := stream.Recv()
+					if err != nil {
+						t.fail(runCtx, err)
+						return
+					}
+					// Skipped slots and status changes carry no block
+					if !update.Skipped && !update.StatusChanged && update.Reorg == nil {
+						t.record(update.Slot)
+					}
+				}
+			}
+		}
+
+		switch kind {
+		case proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_ACCOUNT:
+			category.websock.method = "accountSubscribe"
+			sub, err := wsClient.AccountSubscribe(pubkey, commitment)
+			if err != nil {
+				category.websock.fail(runCtx, err)
+				break
+			}
+			wsRun = func(t *streamTransport) {
+				followWS(runCtx, sub.Unsubscribe, func() (uint64, bool, error) {
+					result, err := sub.Recv()
+					if err != nil || result == nil {
+						return 0, false, err
+					}
+					return result.Context.Slot, true, nil
+				}, t.record)
+			}
+		case proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_SLOT:
+			category.websock.method = "slotSubscribe"
+			sub, err := wsClient.SlotSubscribe()
+			if err != nil {
+				category.websock.fail(runCtx, err)
+				break
+			}
+			wsRun = func(t *streamTransport) {
+				followWS(runCtx, sub.Unsubscribe, func() (uint64, bool, error) {
+					result, err := sub.Recv()
+					if err != nil || result == nil {
+						return 0, false, err
+					}
+					return result.Slot, true, nil
+				}, t.record)
+			}
+		case proto.StreamBenchmarkKind_STREAM_BENCHMARK_KIND_BLOCK:
+			category.websock.method = "blockSubscribe"
+			rewards := false
+			sub, err := wsClient.BlockSubscribe(ws.NewBlockSubscribeFilterAll(), &ws.BlockSubscribeOpts{
+				Commitment:         commitment,
+				TransactionDetails: rpc.TransactionDetailsNone,
+				Rewards:            &rewards,
+			})
+			if err != nil {
+				category.websock.fail(runCtx, err)
+				break
+			}
+			wsRun = func(t *streamTransport) {
+				followWS(runCtx, sub.Unsubscribe, func() (uint64, bool, error) {
+					result, err := sub.Recv()
+					if err != nil || result == nil {
+						return 0, false, err
+					}
+					if result.Value.Block == nil {
+						return 0, false, fmt.Errorf("block notification without a block: %v", result.Value.Err)
+					}
+					return result.Value.Slot, true, nil
+				}, t.record)
+			}
+		}
+
+		for _, run := range []struct {
+			transport *streamTransport
+			run       func(*streamTransport)
+		}{{category.grpc, grpcRun}, {category.websock, wsRun}} {
+			if run.run == nil {
+				continue
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				run.run(run.transport)
+			}()
+		}
+	}
+
+	<-runCtx.Done()
+	cancel()
+	wg.Wait()
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	end := time.Now()
+
+	results := &proto.StreamBenchmarkResults{
+		DurationSeconds: uint32(duration / time.Second),
+		Commitment:      commitmentName,
+	}
+	for _, category := range categories {
+		grpcFirst, wsFirst := category.grpc.firstArrivals(), category.websock.firstArrivals()
+		results.Categories = append(results.Categories, &proto.StreamBenchmarkCategory{
+			Kind:      category.kind,
+			Grpc:      category.grpc.result(processed, wsFirst, grpcFirst, start, end),
+			Websocket: category.websock.result(processed, grpcFirst, wsFirst, start, end),
+		})
+	}
+	return results, nil
+}
+
+// followWS passes the slot of every notification recv returns to record
+// until it fails or the run ends, which unsubscribes to unblock it
+func followWS(ctx context.Context, unsubscribe func(), recv func() (uint64, bool, error), record func(uint64)) {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		unsubscribe()
+	}()
+
+	for {
+		slot, ok, err := recv()
+		if err != nil || !ok {
+			return
+		}
+		record(slot)
+	}
+}
+
+// result summarizes what the transport delivered over a run from start to
+// end. A slot counts as dropped when the other transport delivered it first
+// between streamBenchmarkGrace after the start and before the end, and this
+// transport never did.
+func (t *streamTransport) result(processed *processedSlots, other, own map[uint64]time.Time, start, end time.Time) *proto.StreamBenchmarkTransport {
+	result := &proto.StreamBenchmarkTransport{
+		Method:            t.method,
+		Messages:          uint64(len(t.arrivals)),
+		MessagesPerSecond: float64(len(t.arrivals)) / end.Sub(start).Seconds(),
+	}
+	if t.err != nil {
+		result.Error = t.err.Error()
+	}
+
+	var latencies []float64
+	for _, arrival := range t.arrivals {
+		if at, ok := processed.get(arrival.slot); ok {
+			latencies = append(latencies, float64(arrival.at.Sub(at).Microseconds())/1000)
+		}
+	}
+	if len(latencies) > 0 {
+		summary := stats.Summarize(latencies)
+		result.LatencySamples = uint64(len(latencies))
+		result.AvgLatencyMs = summary.Mean
+		result.P50LatencyMs = summary.P50
+		result.P99LatencyMs = summary.P99
+		result.MaxLatencyMs = summary.Max
+	}
+
+	for slot, at := range other {
+		if at.Before(start.Add(streamBenchmarkGrace)) || at.After(end.Add(-streamBenchmarkGrace)) {
+			continue
+		}
+		if _, ok := own[slot]; !ok {
+			result.Dropped++
+		}
+	}
+	return result
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// StreamLimits caps the streams open at once. Zero means unlimited.
+type StreamLimits struct {
+	// MaxStreams caps the streams of the whole server
+	MaxStreams int
+	// MaxPerPeer caps the streams of one client IP address, for requests
+	// without a configured API key
+	MaxPerPeer int
+}
+
+// DefaultStreamLimits apply unless configured otherwise
+var DefaultStreamLimits = StreamLimits{
+	MaxStreams: 1000,
+	MaxPerPeer: 50,
+}
+
+// streamLimiter counts open streams against the server-wide limit and the
+// limit of the client's API key or, without a configured key, its address
+type streamLimiter struct {
+	limits StreamLimits
+	keys   map[string]int
+
+	mu     sync.Mutex
+	total  int
+	active map[string]int
+}
+
+func newStreamLimiter(limits StreamLimits, keys map[string]int) *streamLimiter {
+	return &streamLimiter{
+		limits: limits,
+		keys:   keys,
+		active: make(map[string]int),
+	}
+}
+
+// client returns who a stream is counted against and their limit: a
+// configured API key, or the peer's IP address
+func (l *streamLimiter) client(ctx context.Context) (string, int) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range md.Get(apiKeyHeader) {
+		if limit, ok := l.keys[key]; ok {
+			return "key:" + key, limit
+		}
+	}
+
+	address := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		address = p.Addr.String()
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			address = host
+		}
+	}
+	return "peer:" + address, l.limits.MaxPerPeer
+}
+
+// admit counts a new stream, rejecting it if a limit is reached. It returns
+// the function to call once the stream ends.
+func (l *streamLimiter) admit(ctx context.Context) (func(), error) {
+	client, limit := l.client(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limits.MaxStreams > 0 && l.total >= l.limits.MaxStreams {
+		return nil, status.Errorf(codes.ResourceExhausted, "the server has %d streams open, the limit is %d", l.total, l.limits.MaxStreams)
+	}
+	if limit > 0 && l.active[client] >= limit {
+		return nil, status.Errorf(codes.ResourceExhausted, "%d streams already open for this client, the limit is %d", l.active[client], limit)
+	}
+	l.total++
+	l.active[client]++
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.total--
+		if l.active[client]--; l.active[client] == 0 {
+			delete(l.active, client)
+		}
+	}, nil
+}
+
+// open returns the number of streams open
+func (l *streamLimiter) open() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.total
+}
+
+// SetStreamLimits sets the caps on open streams, with per-key caps for
+// requests carrying one of the given API keys in the x-api-key metadata. It
+// must be called before the service is serving requests.
+func (s *BenchmarkService) SetStreamLimits(limits StreamLimits, keys map[string]int) {
+	s.streamLimits = newStreamLimiter(limits, keys)
+}
+
+// LimitStreams is a stream server interceptor enforcing the stream limits on
+// every streaming call of the server, rejecting calls over a limit with
+// RESOURCE_EXHAUSTED
+func (s *BenchmarkService) LimitStreams(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	release, err := s.streamLimits.admit(ss.Context())
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler(srv, ss)
+}
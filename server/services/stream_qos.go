@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxConcurrentBulkCalls bounds the upstream work bulk streams do at once
+	maxConcurrentBulkCalls = 2
+	// maxBulkYield is the longest bulk work yields to realtime work, so a
+	// steady realtime load such as the block feed cannot starve it
+	maxBulkYield = time.Second
+)
+
+// streamClass is what a stream's QoS class maps to
+type streamClass struct {
+	bulk bool
+	// queueScale multiplies the default size of the stream's hub queues
+	queueScale int
+	// batch is the batching of streams that do not set their own
+	batch batchOptions
+}
+
+// streamClasses maps the QoS classes of stream requests. Bulk streams queue
+// more, so they survive falling behind, and batch by default, while
+// realtime streams send every update at once and fail fast when they lag.
+var streamClasses = map[proto.StreamQoS]streamClass{
+	proto.StreamQoS_STREAM_QOS_REALTIME: {
+		queueScale: 1,
+		batch:      batchOptions{maxMessages: 1},
+	},
+	proto.StreamQoS_STREAM_QOS_BULK: {
+		bulk:       true,
+		queueScale: 4,
+		batch:      batchOptions{maxMessages: maxProgramAccountsChunk, maxWait: time.Second},
+	},
+}
+
+// parseStreamQoS resolves the QoS class of a stream request
+func parseStreamQoS(qos proto.StreamQoS) (streamClass, error) {
+	class, ok := streamClasses[qos]
+	if !ok {
+		return streamClass{}, status.Errorf(codes.InvalidArgument, "unknown QoS class %v", qos)
+	}
+	return class, nil
+}
+
+// upstreamScheduler prioritizes the upstream work of realtime streams. Bulk
+// work waits while any realtime work is in flight, up to maxBulkYield, and at
+// most maxConcurrentBulkCalls bulk calls run at once, so a backfill cannot
+// take the upstream capacity a latency-critical stream needs, nor be starved
+// by realtime work that never lets up.
+type upstreamScheduler struct {
+	mu       sync.Mutex
+	realtime int
+	bulk     int
+	// wake is closed and replaced whenever work finishes
+	wake chan struct{}
+}
+
+func newUpstreamScheduler() *upstreamScheduler {
+	return &upstreamScheduler{wake: make(chan struct{})}
+}
+
+// acquire waits until a stream of the given class may call the upstream and
+// returns the function to call once it is done. Realtime work never waits.
+func (u *upstreamScheduler) acquire(ctx context.Context, class streamClass) (release func(), err error) {
+	u.mu.Lock()
+	if !class.bulk {
+		u.realtime++
+		u.mu.Unlock()
+		return func() { u.done(&u.realtime) }, nil
+	}
+
+	// Once it has yielded for maxBulkYield, the work only waits for a bulk slot
+	aged := time.NewTimer(maxBulkYield)
+	defer aged.Stop()
+	yielding := true
+	for (yielding && u.realtime > 0) || u.bulk >= maxConcurrentBulkCalls {
+		wake := u.wake
+		u.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-wake:
+		case <-aged.C:
+			yielding = false
+		}
+		u.mu.Lock()
+	}
+	u.bulk++
+	u.mu.Unlock()
+	return func() { u.done(&u.bulk) }, nil
+}
+
+// done records that a call counted in active finished and wakes the waiters
+func (u *upstreamScheduler) done(active *int) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	*active--
+	close(u.wake)
+	u.wake = make(chan struct{})
+}
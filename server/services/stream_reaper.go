@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// reapCheckInterval is how often open streams are checked for reaping
+	reapCheckInterval = time.Second
+	// reapedTrailer is the trailer naming why a stream was reaped: stalled or idle
+	reapedTrailer = "x-stream-reaped"
+)
+
+// StreamReaping sets when streams are cancelled to reclaim their resources.
+// Zero disables a check.
+type StreamReaping struct {
+	// StalledSend reaps a stream whose client has not read a message for this
+	// long, leaving a send blocked
+	StalledSend time.Duration
+	// Idle reaps a stream that has neither sent nor received a message for
+	// this long, typically because its filters match nothing
+	Idle time.Duration
+}
+
+// DefaultStreamReaping applies unless configured otherwise
+var DefaultStreamReaping = StreamReaping{
+	StalledSend: time.Minute,
+	Idle:        30 * time.Minute,
+}
+
+// streamReaper counts the streams reaped by each check
+type streamReaper struct {
+	StreamReaping
+	stalled atomic.Uint64
+	idle    atomic.Uint64
+}
+
+// watchedStream tracks when a stream last moved a message and whether a send
+// is blocked
+type watchedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+
+	mu           sync.Mutex
+	lastActivity time.Time
+	sendingSince time.Time
+	// reaped fails any send or receive once the stream has been reaped
+	reaped error
+}
+
+func (w *watchedStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *watchedStream) SendMsg(m interface{}) error {
+	w.mu.Lock()
+	if w.reaped != nil {
+		w.mu.Unlock()
+		return w.reaped
+	}
+	w.sendingSince = time.Now()
+	w.mu.Unlock()
+
+	err := w.ServerStream.SendMsg(m)
+
+	w.mu.Lock()
+	w.sendingSince = time.Time{}
+	w.lastActivity = time.Now()
+	w.mu.Unlock()
+	return err
+}
+
+func (w *watchedStream) RecvMsg(m interface{}) error {
+	w.mu.Lock()
+	reaped := w.reaped
+	w.mu.Unlock()
+	if reaped != nil {
+		return reaped
+	}
+
+	err := w.ServerStream.RecvMsg(m)
+	if err == nil {
+		w.mu.Lock()
+		w.lastActivity = time.Now()
+		w.mu.Unlock()
+	}
+	return err
+}
+
+// reapReason returns why the stream should be reaped, or an empty kind if it
+// should not
+func (w *watchedStream) reapReason(reaping StreamReaping) (kind, reason string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if reaping.StalledSend > 0 && !w.sendingSince.IsZero() && time.Since(w.sendingSince) > reaping.StalledSend {
+		return "stalled", fmt.Sprintf("the client has not read a message for %v", reaping.StalledSend)
+	}
+	if reaping.Idle > 0 && w.sendingSince.IsZero() && time.Since(w.lastActivity) > reaping.Idle {
+		return "idle", fmt.Sprintf("no message matched the stream for %v", reaping.Idle)
+	}
+	return "", ""
+}
+
+// reap fails the stream's further sends and receives with err, and reports
+// whether a send is still blocked on the client
+func (w *watchedStream) reap(err error) (sending bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.reaped = err
+	return !w.sendingSince.IsZero()
+}
+
+// SetStreamReaping sets when streams are reaped. It must be called before the
+// service is serving requests.
+func (s *BenchmarkService) SetStreamReaping(reaping StreamReaping) {
+	s.streamReaper = &streamReaper{StreamReaping: reaping}
+}
+
+// ReapStreams is a stream server interceptor cancelling streams that are
+// stalled on a client not reading or idle. A reaped stream ends with
+// DEADLINE_EXCEEDED and the reason in the x-stream-reaped trailer, so the
+// client can tell it apart from a failure and resubscribe.
+func (s *BenchmarkService) ReapStreams(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	reaper := s.streamReaper
+	if reaper.StalledSend <= 0 && reaper.Idle <= 0 {
+		return handler(srv, ss)
+	}
+
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+	watched := &watchedStream{ServerStream: ss, ctx: ctx, lastActivity: time.Now()}
+
+	// The handler runs apart, so a stream blocked in a send can be ended
+	done := make(chan error, 1)
+	go func() { done <- handler(srv, watched) }()
+
+	ticker := time.NewTicker(reapCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-ticker.C:
+			kind, reason := watched.reapReason(reaper.StreamReaping)
+			if kind == "" {
+				continue
+			}
+			if kind == "stalled" {
+				reaper.stalled.Add(1)
+			} else {
+				reaper.idle.Add(1)
+			}
+			log.Printf("Reaping %s stream %s: %s", kind, info.FullMethod, reason)
+			err := status.Errorf(codes.DeadlineExceeded, "stream reaped: %s", reason)
+			sending := watched.reap(err)
+			cancel()
+			// A handler not sending returns on its cancelled context, and is
+			// waited for so nothing uses ss once this returns. A send blocked
+			// on the client only fails once the call has finished, which
+			// needs this to return; the handler can make no further calls.
+			if !sending {
+				<-done
+			}
+			ss.SetTrailer(metadata.Pairs(reapedTrailer, kind))
+			return err
+		}
+	}
+}
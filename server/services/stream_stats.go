@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/hub"
+)
+
+// GetStreamStats returns the number of open streams and the subscribers and
+// queue depths of the block feed topics and, if one is used, the Yellowstone
+// gateway topics
+func (s *BenchmarkService) GetStreamStats(ctx context.Context, req *proto.StreamStatsRequest) (*proto.StreamStatsResponse, error) {
+	response := &proto.StreamStatsResponse{
+		OpenStreams:   uint32(s.streamLimits.open()),
+		MaxStreams:    uint32(s.streamLimits.limits.MaxStreams),
+		ReapedStalled: s.streamReaper.stalled.Load(),
+		ReapedIdle:    s.streamReaper.idle.Load(),
+	}
+	response.Topics = appendTopicStats(response.Topics, "blocks", s.blocks.Stats())
+	response.Topics = appendTopicStats(response.Topics, "owned_accounts", s.ownedAccounts.changes.Stats())
+	if s.gateway != nil {
+		response.Topics = appendTopicStats(response.Topics, "gateway", s.gateway.Stats())
+	}
+	return response, nil
+}
+
+// appendTopicStats appends the proto form of a hub's topic statistics
+func appendTopicStats(topics []*proto.TopicStats, name string, stats []hub.TopicStats) []*proto.TopicStats {
+	for _, stat := range stats {
+		key := string(stat.Topic.Commitment)
+		if stat.Topic.Kind != hub.TopicSlot {
+			key = stat.Topic.Pubkey.String()
+			// Owned account sets are kept per commitment
+			if stat.Topic.Commitment != "" {
+				key += ":" + string(stat.Topic.Commitment)
+			}
+		}
+		topics = append(topics, &proto.TopicStats{
+			Hub:         name,
+			Kind:        stat.Topic.Kind.String(),
+			Key:         key,
+			Subscribers: uint32(stat.Subscribers),
+			Queued:      uint64(stat.Queued),
+			MaxQueued:   uint64(stat.MaxQueued),
+			Capacity:    uint64(stat.Capacity),
+			Published:   stat.Published,
+			Dropped:     stat.Dropped,
+		})
+	}
+	return topics
+}
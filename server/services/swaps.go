@@ -0,0 +1,173 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+)
+
+// swapDecoder recognizes the swap instructions of a DEX program. Amounts are
+// not decoded from the instruction: they are taken from the token transfers
+// the swap makes, which hold the amounts actually exchanged.
+type swapDecoder interface {
+	// name identifies the DEX in swap events
+	name() string
+	// decode returns the pool and trader of a swap instruction, or false if
+	// the instruction is not a swap
+	decode(data []byte, accounts []solana.PublicKey) (pool, trader solana.PublicKey, ok bool)
+}
+
+// swapDecoders maps DEX programs to the decoder of their swap instructions.
+// Supporting another DEX takes a decoder registered here.
+var swapDecoders = map[solana.PublicKey]swapDecoder{
+	solana.MustPublicKeyFromBase58("675kPX9MHTjS2zt1qfr1NYHuzeLXfQM9H24wFSUt1Mp8"): raydiumAMMDecoder{},
+	solana.MustPublicKeyFromBase58("whirLbMiicVdio4qvUfM5KAg6Ct8VwpYzGff3uctyCc"):  whirlpoolDecoder{},
+	solana.MustPublicKeyFromBase58("PhoeNiXZ8ByJGLkxNfZRnkUfjvmuYqLR89jjFHGqdXY"):  phoenixDecoder{},
+}
+
+// raydiumAMMDecoder decodes swaps on the Raydium AMM v4 program, whose last
+// account is the owner of the trader's token accounts
+type raydiumAMMDecoder struct{}
+
+func (raydiumAMMDecoder) name() string { return "raydium" }
+
+func (raydiumAMMDecoder) decode(data []byte, accounts []solana.PublicKey) (solana.PublicKey, solana.PublicKey, bool) {
+	const swapBaseIn, swapBaseOut = 9, 11
+	if len(data) == 0 || (data[0] != swapBaseIn && data[0] != swapBaseOut) || len(accounts) < 17 {
+		return solana.PublicKey{}, solana.PublicKey{}, false
+	}
+	return accounts[1], accounts[len(accounts)-1], true
+}
+
+// whirlpoolDecoder decodes swaps on the Orca Whirlpool program. Its
+// instructions are Anchor instructions, identified by discriminator.
+type whirlpoolDecoder struct{}
+
+var (
+	whirlpoolSwap   = anchorDiscriminator("swap")
+	whirlpoolSwapV2 = anchorDiscriminator("swap_v2")
+)
+
+func (whirlpoolDecoder) name() string { return "orca-whirlpool" }
+
+func (whirlpoolDecoder) decode(data []byte, accounts []solana.PublicKey) (solana.PublicKey, solana.PublicKey, bool) {
+	switch {
+	case bytes.HasPrefix(data, whirlpoolSwap) && len(accounts) >= 3:
+		// token_program, token_authority, whirlpool, ...
+		return accounts[2], accounts[1], true
+	case bytes.HasPrefix(data, whirlpoolSwapV2) && len(accounts) >= 5:
+		// token_program_a, token_program_b, memo_program, token_authority, whirlpool, ...
+		return accounts[4], accounts[3], true
+	}
+	return solana.PublicKey{}, solana.PublicKey{}, false
+}
+
+// phoenixDecoder decodes swaps on the Phoenix order book program
+type phoenixDecoder struct{}
+
+func (phoenixDecoder) name() string { return "phoenix" }
+
+func (phoenixDecoder) decode(data []byte, accounts []solana.PublicKey) (solana.PublicKey, solana.PublicKey, bool) {
+	const swap = 0
+	if len(data) == 0 || data[0] != swap || len(accounts) < 4 {
+		return solana.PublicKey{}, solana.PublicKey{}, false
+	}
+	// phoenix_program, log_authority, market, trader, ...
+	return accounts[2], accounts[3], true
+}
+
+// anchorDiscriminator returns the discriminator of an Anchor instruction
+func anchorDiscriminator(instruction string) []byte {
+	sum := sha256.Sum256([]byte("global:" + instruction))
+	return sum[:8]
+}
+
+// pendingSwap is a decoded swap instruction waiting for its token transfers
+type pendingSwap struct {
+	event     *proto.SwapEvent
+	trader    solana.PublicKey
+	transfers []*proto.TokenTransfer
+}
+
+// swapEvents decodes the swaps among a transaction's executed instructions.
+// Each swap is completed by the first two token transfers executed after it
+// within the same top-level instruction: the one the trader authorized is the
+// input and the other the output.
+func swapEvents(instructions []executedInstruction, keys solana.PublicKeySlice, mints map[uint16]rpc.TokenBalance) []*proto.SwapEvent {
+	var swaps []*proto.SwapEvent
+	var pending *pendingSwap
+	for _, instruction := range instructions {
+		if instruction.topLevel {
+			pending = nil
+		}
+		if swap := decodeSwap(instruction.CompiledInstruction, keys); swap != nil {
+			pending = swap
+			continue
+		}
+		if pending == nil {
+			continue
+		}
+
+		transfer := decodeTokenTransfer(instruction.CompiledInstruction, keys, mints)
+		if transfer == nil {
+			continue
+		}
+		pending.transfers = append(pending.transfers, transfer)
+		if len(pending.transfers) == 2 {
+			swaps = append(swaps, pending.complete())
+			pending = nil
+		}
+	}
+	return swaps
+}
+
+// decodeSwap returns the pending swap for a swap instruction of a supported
+// DEX, or nil for any other instruction
+func decodeSwap(instruction solana.CompiledInstruction, keys solana.PublicKeySlice) *pendingSwap {
+	if int(instruction.ProgramIDIndex) >= len(keys) {
+		return nil
+	}
+	program := keys[instruction.ProgramIDIndex]
+	decoder, ok := swapDecoders[program]
+	if !ok {
+		return nil
+	}
+
+	accounts := make([]solana.PublicKey, 0, len(instruction.Accounts))
+	for _, index := range instruction.Accounts {
+		if int(index) >= len(keys) {
+			return nil
+		}
+		accounts = append(accounts, keys[index])
+	}
+	pool, trader, ok := decoder.decode(instruction.Data, accounts)
+	if !ok {
+		return nil
+	}
+
+	return &pendingSwap{
+		event: &proto.SwapEvent{
+			Dex:       decoder.name(),
+			ProgramId: program.String(),
+			Pool:      pool.String(),
+			Trader:    trader.String(),
+		},
+		trader: trader,
+	}
+}
+
+// complete fills in the swap's amounts from its two token transfers
+func (p *pendingSwap) complete() *proto.SwapEvent {
+	in, out := p.transfers[0], p.transfers[1]
+	if out.Authority == p.trader.String() && in.Authority != p.trader.String() {
+		in, out = out, in
+	}
+	p.event.MintIn = in.Mint
+	p.event.AmountIn = in.Amount
+	p.event.MintOut = out.Mint
+	p.event.AmountOut = out.Amount
+	return p.event
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// stageTiming measures the stages of serving a response, or the stream
+// updates built from the same upstream calls
+type stageTiming struct {
+	start    time.Time
+	upstream *upstream.Timing
+}
+
+// startTiming starts timing a request or a poll. Upstream calls made with the
+// returned context are recorded.
+func startTiming(ctx context.Context) (context.Context, *stageTiming) {
+	ctx, t := upstream.WithTiming(ctx)
+	return ctx, &stageTiming{start: time.Now(), upstream: t}
+}
+
+// breakdown returns the timing of message, a response or update whose
+// building started at built and has just finished. The message is marshaled
+// to time its serialization, as gRPC marshals it only once it is returned;
+// the breakdown itself is left out, so message must not carry one yet.
+func (t *stageTiming) breakdown(built time.Time, message protobuf.Message) *proto.TimingBreakdown {
+	stages := t.upstream.Stages()
+	queue := built.Sub(t.start)
+	if !stages.First.IsZero() {
+		queue = stages.First.Sub(t.start) + built.Sub(stages.Last)
+	}
+	protobuf.Marshal(message)
+	return &proto.TimingBreakdown{
+		UpstreamRpcMs: milliseconds(stages.RPC),
+		DecodeMs:      milliseconds(stages.Decode),
+		SerializeMs:   milliseconds(time.Since(built)),
+		QueueMs:       milliseconds(queue),
+	}
+}
+
+// milliseconds converts a duration to fractional milliseconds
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// tokenMintSize and tokenAccountSize are the sizes of the base states.
+	// Token-2022 pads extended mints to the account size, so the byte after
+	// the base state of an extended account is always its account type.
+	tokenMintSize    = 82
+	tokenAccountSize = 165
+	// tokenMultisigSize is the size of a multisig, which has no extensions
+	tokenMultisigSize = 355
+)
+
+// Token-2022 account types, stored after the base state of extended accounts
+const (
+	tokenAccountTypeMint    = 1
+	tokenAccountTypeAccount = 2
+)
+
+// Token-2022 extension types decoded into structured fields
+const (
+	extensionTransferFeeConfig     = 1
+	extensionTransferFeeAmount     = 2
+	extensionInterestBearingConfig = 10
+	extensionMetadataPointer       = 18
+)
+
+// GetTokenAccount reads and decodes a mint or token account of the SPL Token
+// or Token-2022 program
+func (s *BenchmarkService) GetTokenAccount(ctx context.Context, req *proto.TokenAccountRequest) (*proto.TokenAccountResponse, error) {
+	pubkey, err := solana.PublicKeyFromBase58(req.Pubkey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pubkey: %v", err)
+	}
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+
+	account, err := s.solanaClient.GetAccountInfoWithOpts(ctx, pubkey, &rpc.GetAccountInfoOpts{
+		Encoding:   solana.EncodingBase64,
+		Commitment: commitment,
+	})
+	if errors.Is(err, rpc.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Pubkey)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get account info: %v", err)
+	}
+
+	responseTime := time.Since(startTime).Milliseconds()
+
+	owner := account.Value.Owner
+	if !owner.Equals(solana.TokenProgramID) && !owner.Equals(token2022ProgramID) {
+		return nil, status.Errorf(codes.FailedPrecondition, "account %s is not owned by a token program", req.Pubkey)
+	}
+
+	response := &proto.TokenAccountResponse{
+		Pubkey:         req.Pubkey,
+		ProgramId:      owner.String(),
+		Slot:           account.Context.Slot,
+		ResponseTimeMs: uint64(responseTime),
+	}
+	if err := decodeTokenAccount(account.Value.Data.GetBinary(), owner.Equals(token2022ProgramID), response); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "account %s: %v", req.Pubkey, err)
+	}
+	return response, nil
+}
+
+// decodeTokenAccount decodes the base state and, for Token-2022, the
+// extensions of a mint or token account into the response
+func decodeTokenAccount(data []byte, token2022 bool, response *proto.TokenAccountResponse) error {
+	accountType := 0
+	switch {
+	case len(data) == tokenMintSize:
+		accountType = tokenAccountTypeMint
+	case len(data) == tokenAccountSize:
+		accountType = tokenAccountTypeAccount
+	case len(data) == tokenMultisigSize:
+		return errors.New("multisig accounts are not supported")
+	case token2022 && len(data) > tokenAccountSize:
+		accountType = int(data[tokenAccountSize])
+	default:
+		return fmt.Errorf("unexpected size of %d bytes", len(data))
+	}
+
+	switch accountType {
+	case tokenAccountTypeMint:
+		response.Mint = decodeTokenMint(data[:tokenMintSize])
+	case tokenAccountTypeAccount:
+		response.Account = decodeTokenAccountState(data[:tokenAccountSize])
+	default:
+		return fmt.Errorf("unknown account type %d", accountType)
+	}
+
+	if len(data) > tokenAccountSize {
+		extensions, err := decodeTokenExtensions(data[tokenAccountSize+1:])
+		if err != nil {
+			return err
+		}
+		response.Extensions = extensions
+	}
+	return nil
+}
+
+// decodeTokenMint decodes the base state of a mint
+func decodeTokenMint(data []byte) *proto.TokenMint {
+	// Layout: mint authority COption<Pubkey>, supply u64, decimals u8,
+	// is_initialized bool, freeze authority COption<Pubkey>
+	return &proto.TokenMint{
+		MintAuthority:   optionalPubkey(data[0:36]),
+		Supply:          binary.LittleEndian.Uint64(data[36:44]),
+		Decimals:        uint32(data[44]),
+		IsInitialized:   data[45] != 0,
+		FreezeAuthority: optionalPubkey(data[46:82]),
+	}
+}
+
+// decodeTokenAccountState decodes the base state of a token account
+func decodeTokenAccountState(data []byte) *proto.TokenAccountState {
+	// Layout: mint, owner, amount u64, delegate COption<Pubkey>, state u8,
+	// is_native COption<u64>, delegated amount u64, close authority COption<Pubkey>
+	state := &proto.TokenAccountState{
+		Mint:            solana.PublicKeyFromBytes(data[0:32]).String(),
+		Owner:           solana.PublicKeyFromBytes(data[32:64]).String(),
+		Amount:          binary.LittleEndian.Uint64(data[64:72]),
+		Delegate:        optionalPubkey(data[72:108]),
+		Status:          proto.TokenAccountStatus(data[108]),
+		DelegatedAmount: binary.LittleEndian.Uint64(data[121:129]),
+		CloseAuthority:  optionalPubkey(data[129:165]),
+	}
+	if binary.LittleEndian.Uint32(data[109:113]) == 1 {
+		state.IsNative = true
+		state.NativeReserve = binary.LittleEndian.Uint64(data[113:121])
+	}
+	return state
+}
+
+// decodeTokenExtensions decodes the type-length-value extension entries that
+// follow the account type of a Token-2022 account
+func decodeTokenExtensions(data []byte) (*proto.TokenExtensions, error) {
+	extensions := &proto.TokenExtensions{}
+	for len(data) >= 4 {
+		kind := binary.LittleEndian.Uint16(data[0:2])
+		length := int(binary.LittleEndian.Uint16(data[2:4]))
+		if kind == 0 {
+			// The remaining space is uninitialized
+			break
+		}
+		if len(data) < 4+length {
+			return nil, fmt.Errorf("extension %d overruns the account data", kind)
+		}
+		value := data[4 : 4+length]
+		data = data[4+length:]
+
+		switch {
+		case kind == extensionTransferFeeConfig && length == 108:
+			extensions.TransferFeeConfig = &proto.TransferFeeConfig{
+				ConfigAuthority:           optionalNonZeroPubkey(value[0:32]),
+				WithdrawWithheldAuthority: optionalNonZeroPubkey(value[32:64]),
+				WithheldAmount:            binary.LittleEndian.Uint64(value[64:72]),
+				OlderTransferFee:          decodeTransferFee(value[72:90]),
+				NewerTransferFee:          decodeTransferFee(value[90:108]),
+			}
+		case kind == extensionTransferFeeAmount && length == 8:
+			extensions.TransferFeeAmount = &proto.TransferFeeAmount{
+				WithheldAmount: binary.LittleEndian.Uint64(value),
+			}
+		case kind == extensionInterestBearingConfig && length == 52:
+			extensions.InterestBearingConfig = &proto.InterestBearingConfig{
+				RateAuthority:           optionalNonZeroPubkey(value[0:32]),
+				InitializationTimestamp: int64(binary.LittleEndian.Uint64(value[32:40])),
+				PreUpdateAverageRate:    int32(int16(binary.LittleEndian.Uint16(value[40:42]))),
+				LastUpdateTimestamp:     int64(binary.LittleEndian.Uint64(value[42:50])),
+				CurrentRate:             int32(int16(binary.LittleEndian.Uint16(value[50:52]))),
+			}
+		case kind == extensionMetadataPointer && length == 64:
+			extensions.MetadataPointer = &proto.MetadataPointer{
+				Authority:       optionalNonZeroPubkey(value[0:32]),
+				MetadataAddress: optionalNonZeroPubkey(value[32:64]),
+			}
+		default:
+			extensions.OtherTypes = append(extensions.OtherTypes, uint32(kind))
+		}
+	}
+	return extensions, nil
+}
+
+// decodeTransferFee decodes a transfer fee schedule: epoch u64, maximum fee
+// u64 and basis points u16
+func decodeTransferFee(data []byte) *proto.TransferFee {
+	return &proto.TransferFee{
+		Epoch:       binary.LittleEndian.Uint64(data[0:8]),
+		MaximumFee:  binary.LittleEndian.Uint64(data[8:16]),
+		BasisPoints: uint32(binary.LittleEndian.Uint16(data[16:18])),
+	}
+}
+
+// optionalPubkey decodes a COption<Pubkey>: a u32 tag followed by the key,
+// returning an empty string when the tag is None
+func optionalPubkey(data []byte) string {
+	if binary.LittleEndian.Uint32(data[0:4]) != 1 {
+		return ""
+	}
+	return solana.PublicKeyFromBytes(data[4:36]).String()
+}
+
+// optionalNonZeroPubkey decodes a Token-2022 optional key, which is None when
+// all zeros, returning an empty string for None
+func optionalNonZeroPubkey(data []byte) string {
+	key := solana.PublicKeyFromBytes(data)
+	if key.IsZero() {
+		return ""
+	}
+	return key.String()
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"encoding/binary"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+)
+
+// token2022ProgramID is the SPL Token-2022 program, which shares the
+// instruction layout of the original token program for transfers
+var token2022ProgramID = solana.MustPublicKeyFromBase58("TokenzQdBNbLqP5VEhdkAS6EFLWgKUW6LN1srFmmGpE")
+
+// Token program instruction tags
+const (
+	tokenInstructionTransfer        = 3
+	tokenInstructionTransferChecked = 12
+)
+
+// executedInstruction is an instruction of a transaction in execution order
+type executedInstruction struct {
+	solana.CompiledInstruction
+	// topLevel is set for instructions of the transaction message, as opposed
+	// to inner instructions made through cross-program calls
+	topLevel bool
+}
+
+// executedInstructions returns the instructions of a transaction in execution
+// order, each top-level instruction followed by its inner instructions
+func executedInstructions(tx *solana.Transaction, meta *rpc.TransactionMeta) []executedInstruction {
+	inner := make(map[uint16][]solana.CompiledInstruction, len(meta.InnerInstructions))
+	for _, set := range meta.InnerInstructions {
+		inner[set.Index] = set.Instructions
+	}
+
+	var executed []executedInstruction
+	for i, instruction := range tx.Message.Instructions {
+		executed = append(executed, executedInstruction{CompiledInstruction: instruction, topLevel: true})
+		for _, innerInstruction := range inner[uint16(i)] {
+			executed = append(executed, executedInstruction{CompiledInstruction: innerInstruction})
+		}
+	}
+	return executed
+}
+
+// tokenAccountMints returns the token balance, and so the mint and decimals,
+// of every token account a transaction touched by account index
+func tokenAccountMints(meta *rpc.TransactionMeta) map[uint16]rpc.TokenBalance {
+	mints := make(map[uint16]rpc.TokenBalance)
+	for _, balance := range meta.PreTokenBalances {
+		mints[balance.AccountIndex] = balance
+	}
+	for _, balance := range meta.PostTokenBalances {
+		mints[balance.AccountIndex] = balance
+	}
+	return mints
+}
+
+// tokenTransfers decodes the token transfers among a transaction's executed
+// instructions. Plain transfers do not name their mint, so it is taken from
+// the token balances of the source account.
+func tokenTransfers(instructions []executedInstruction, keys solana.PublicKeySlice, mints map[uint16]rpc.TokenBalance) []*proto.TokenTransfer {
+	var transfers []*proto.TokenTransfer
+	for _, instruction := range instructions {
+		if transfer := decodeTokenTransfer(instruction.CompiledInstruction, keys, mints); transfer != nil {
+			transfers = append(transfers, transfer)
+		}
+	}
+	return transfers
+}
+
+// decodeTokenTransfer decodes a transfer or transferChecked instruction of a
+// token program, returning nil for any other instruction
+func decodeTokenTransfer(instruction solana.CompiledInstruction, keys solana.PublicKeySlice, mints map[uint16]rpc.TokenBalance) *proto.TokenTransfer {
+	if int(instruction.ProgramIDIndex) >= len(keys) {
+		return nil
+	}
+	program := keys[instruction.ProgramIDIndex]
+	if !program.Equals(solana.TokenProgramID) && !program.Equals(token2022ProgramID) {
+		return nil
+	}
+	for _, index := range instruction.Accounts {
+		if int(index) >= len(keys) {
+			return nil
+		}
+	}
+
+	data := instruction.Data
+	accounts := instruction.Accounts
+	switch {
+	case len(data) == 9 && data[0] == tokenInstructionTransfer && len(accounts) >= 3:
+		// Accounts: source, destination, authority
+		transfer := &proto.TokenTransfer{
+			ProgramId:   program.String(),
+			Source:      keys[accounts[0]].String(),
+			Destination: keys[accounts[1]].String(),
+			Authority:   keys[accounts[2]].String(),
+			Amount:      binary.LittleEndian.Uint64(data[1:9]),
+		}
+		if balance, ok := mints[accounts[0]]; ok {
+			transfer.Mint = balance.Mint.String()
+			if balance.UiTokenAmount != nil {
+				transfer.Decimals = uint32(balance.UiTokenAmount.Decimals)
+			}
+		}
+		return transfer
+
+	case len(data) == 10 && data[0] == tokenInstructionTransferChecked && len(accounts) >= 4:
+		// Accounts: source, mint, destination, authority
+		return &proto.TokenTransfer{
+			ProgramId:   program.String(),
+			Source:      keys[accounts[0]].String(),
+			Mint:        keys[accounts[1]].String(),
+			Destination: keys[accounts[2]].String(),
+			Authority:   keys[accounts[3]].String(),
+			Amount:      binary.LittleEndian.Uint64(data[1:9]),
+			Decimals:    uint32(data[9]),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Default compute unit limits, covering the instructions of each kind of
+	// transaction with headroom, so unused units are not paid for at the unit price
+	transferComputeUnits      = 1_000
+	tokenTransferComputeUnits = 20_000
+	// createAccountComputeUnits is added for creating an associated token account
+	createAccountComputeUnits = 40_000
+	// maxComputeUnits is the most a transaction may request
+	maxComputeUnits = 1_400_000
+	// createIdempotent is the associated token account instruction that
+	// succeeds if the account exists already
+	createIdempotent = 1
+)
+
+// BuildTransferTransaction assembles an unsigned SOL transfer with a fresh
+// blockhash and compute budget instructions
+func (s *BenchmarkService) BuildTransferTransaction(ctx context.Context, req *proto.TransferTransactionRequest) (*proto.BuiltTransaction, error) {
+	from, err := solana.PublicKeyFromBase58(req.From)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender: %v", err)
+	}
+	to, err := solana.PublicKeyFromBase58(req.To)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient: %v", err)
+	}
+	feePayer, err := optionalFeePayer(req.FeePayer, from)
+	if err != nil {
+		return nil, err
+	}
+	if req.Lamports == 0 {
+		return nil, status.Error(codes.InvalidArgument, "lamports must be positive")
+	}
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+
+	transfer, err := system.NewTransferInstruction(req.Lamports, from, to).ValidateAndBuild()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to build transfer: %v", err)
+	}
+	return s.buildTransaction(ctx, startTime, feePayer, req.ComputeBudget, transferComputeUnits, commitment, transfer)
+}
+
+// BuildTokenTransferTransaction assembles an unsigned transferChecked between
+// the associated token accounts of the owner and the recipient, optionally
+// creating the recipient's account first
+func (s *BenchmarkService) BuildTokenTransferTransaction(ctx context.Context, req *proto.TokenTransferTransactionRequest) (*proto.BuiltTransaction, error) {
+	owner, err := solana.PublicKeyFromBase58(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner: %v", err)
+	}
+	recipient, err := solana.PublicKeyFromBase58(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient: %v", err)
+	}
+	mint, err := solana.PublicKeyFromBase58(req.Mint)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid mint: %v", err)
+	}
+	feePayer, err := optionalFeePayer(req.FeePayer, owner)
+	if err != nil {
+		return nil, err
+	}
+	if req.Amount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+
+	// transferChecked needs the decimals, and the mint's owner selects the
+	// token program
+	account, err := s.solanaClient.GetAccountInfoWithOpts(ctx, mint, &rpc.GetAccountInfoOpts{
+		Encoding:   solana.EncodingBase64,
+		Commitment: commitment,
+	})
+	if errors.Is(err, rpc.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "mint %s not found", req.Mint)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get mint: %v", err)
+	}
+	tokenProgram := account.Value.Owner
+	if !tokenProgram.Equals(solana.TokenProgramID) && !tokenProgram.Equals(token2022ProgramID) {
+		return nil, status.Errorf(codes.FailedPrecondition, "mint %s is not owned by a token program", req.Mint)
+	}
+	decoded := &proto.TokenAccountResponse{}
+	if err := decodeTokenAccount(account.Value.Data.GetBinary(), tokenProgram.Equals(token2022ProgramID), decoded); err != nil || decoded.Mint == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "account %s is not a mint", req.Mint)
+	}
+
+	source, _, err := associatedTokenAddress(owner, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	destination, _, err := associatedTokenAddress(recipient, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+
+	var instructions []solana.Instruction
+	defaultUnits := uint32(tokenTransferComputeUnits)
+	if req.CreateRecipientAccount {
+		instructions = append(instructions, solana.NewInstruction(
+			solana.SPLAssociatedTokenAccountProgramID,
+			solana.AccountMetaSlice{
+				solana.Meta(feePayer).WRITE().SIGNER(),
+				solana.Meta(destination).WRITE(),
+				solana.Meta(recipient),
+				solana.Meta(mint),
+				solana.Meta(solana.SystemProgramID),
+				solana.Meta(tokenProgram),
+			},
+			[]byte{createIdempotent},
+		))
+		defaultUnits += createAccountComputeUnits
+	}
+
+	transfer, err := token.NewTransferCheckedInstruction(req.Amount, uint8(decoded.Mint.Decimals), source, mint, destination, owner, nil).ValidateAndBuild()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to build transfer: %v", err)
+	}
+	data, err := transfer.Data()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode transfer: %v", err)
+	}
+	// The token package always targets SPL Token; Token-2022 shares the
+	// instruction layout, so only the program ID is replaced
+	instructions = append(instructions, solana.NewInstruction(tokenProgram, transfer.Accounts(), data))
+
+	return s.buildTransaction(ctx, startTime, feePayer, req.ComputeBudget, defaultUnits, commitment, instructions...)
+}
+
+// buildTransaction prepends the compute budget instructions, fetches a fresh
+// blockhash, and serializes the unsigned transaction
+func (s *BenchmarkService) buildTransaction(ctx context.Context, startTime time.Time, feePayer solana.PublicKey, budget *proto.ComputeBudget, defaultUnits uint32, commitment rpc.CommitmentType, instructions ...solana.Instruction) (*proto.BuiltTransaction, error) {
+	units, price := defaultUnits, uint64(0)
+	if budget != nil {
+		if budget.UnitLimit > maxComputeUnits {
+			return nil, status.Errorf(codes.InvalidArgument, "compute unit limit exceeds %d", maxComputeUnits)
+		}
+		if budget.UnitLimit > 0 {
+			units = budget.UnitLimit
+		}
+		price = budget.UnitPriceMicroLamports
+	}
+	prefix := []solana.Instruction{computebudget.NewSetComputeUnitLimitInstruction(units).Build()}
+	if price > 0 {
+		prefix = append(prefix, computebudget.NewSetComputeUnitPriceInstruction(price).Build())
+	}
+	instructions = append(prefix, instructions...)
+
+	blockhash, err := s.solanaClient.GetLatestBlockhash(ctx, commitment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get latest blockhash: %v", err)
+	}
+
+	tx, err := solana.NewTransaction(instructions, blockhash.Value.Blockhash, solana.TransactionPayer(feePayer))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to build transaction: %v", err)
+	}
+	message, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode message: %v", err)
+	}
+	// Each required signature is left zeroed for the signers to fill in
+	tx.Signatures = make([]solana.Signature, tx.Message.Header.NumRequiredSignatures)
+	serialized, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode transaction: %v", err)
+	}
+
+	signers := tx.Message.Signers()
+	response := &proto.BuiltTransaction{
+		Transaction:          serialized,
+		Message:              message,
+		Signers:              make([]string, len(signers)),
+		Blockhash:            blockhash.Value.Blockhash.String(),
+		LastValidBlockHeight: blockhash.Value.LastValidBlockHeight,
+		ComputeUnitLimit:     units,
+	}
+	for i, signer := range signers {
+		response.Signers[i] = signer.String()
+	}
+	response.ResponseTimeMs = uint64(time.Since(startTime).Milliseconds())
+	return response, nil
+}
+
+// optionalFeePayer parses a fee payer, defaulting to fallback if empty
+func optionalFeePayer(feePayer string, fallback solana.PublicKey) (solana.PublicKey, error) {
+	if feePayer == "" {
+		return fallback, nil
+	}
+	pubkey, err := solana.PublicKeyFromBase58(feePayer)
+	if err != nil {
+		return solana.PublicKey{}, status.Errorf(codes.InvalidArgument, "invalid fee payer: %v", err)
+	}
+	return pubkey, nil
+}
+
+// associatedTokenAddress derives the associated token account of a wallet for
+// a mint of either token program, and its bump seed
+func associatedTokenAddress(wallet, mint, tokenProgram solana.PublicKey) (solana.PublicKey, uint8, error) {
+	address, bump, err := solana.FindProgramAddress([][]byte{wallet[:], tokenProgram[:], mint[:]}, solana.SPLAssociatedTokenAccountProgramID)
+	if err != nil {
+		return solana.PublicKey{}, 0, status.Errorf(codes.Internal, "failed to find associated token address: %v", err)
+	}
+	return address, bump, nil
+}
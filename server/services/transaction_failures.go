@@ -0,0 +1,153 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+)
+
+// slippageErrors maps DEX and aggregator programs to the custom errors they
+// fail swaps with when the output is below the minimum accepted or the input
+// above the maximum. Recognizing another program takes its codes here.
+var slippageErrors = map[solana.PublicKey][]uint32{
+	// Raydium AMM v4: ExceededSlippage
+	solana.MustPublicKeyFromBase58("675kPX9MHTjS2zt1qfr1NYHuzeLXfQM9H24wFSUt1Mp8"): {30},
+	// Raydium CLMM: TooLittleOutputReceived, TooMuchInputPaid
+	solana.MustPublicKeyFromBase58("CAMMCzo5YL8w4VFF8KVHrK22GGUsp5VTaW7grrKgrWqK"): {6022, 6023},
+	// Orca Whirlpool: AmountOutBelowMinimum, AmountInAboveMaximum
+	solana.MustPublicKeyFromBase58("whirLbMiicVdio4qvUfM5KAg6Ct8VwpYzGff3uctyCc"): {6036, 6037},
+	// Jupiter v6: SlippageToleranceExceeded
+	solana.MustPublicKeyFromBase58("JUP6LkbZbjS1jKKwapdHNy74zcZ3tLUZoi5QNyVTaV4"): {6001},
+}
+
+// tokenInsufficientFunds is the custom error of both token programs for a
+// transfer exceeding the source balance
+const tokenInsufficientFunds = 1
+
+// failedProgramLog matches the log of a program returning an error. The
+// program that failed first logs first, before the programs that invoked it.
+var failedProgramLog = regexp.MustCompile(`^Program (\w+) failed: `)
+
+// transactionFailure classifies the error of a failed transaction, nil if it
+// succeeded. Errors come as the JSON the upstream returns: a string for
+// transaction errors without detail, or an object keyed by the error name,
+// such as {"InstructionError": [0, {"Custom": 6001}]}.
+func transactionFailure(tx *solana.Transaction, meta *rpc.TransactionMeta) *proto.TransactionFailure {
+	if meta.Err == nil {
+		return nil
+	}
+	failure := &proto.TransactionFailure{InstructionIndex: -1}
+	var detail interface{}
+	switch err := meta.Err.(type) {
+	case string:
+		failure.Error = err
+	case map[string]interface{}:
+		for name, value := range err {
+			failure.Error, detail = name, value
+		}
+	}
+
+	switch failure.Error {
+	case "BlockhashNotFound":
+		failure.Kind = proto.TransactionFailureKind_TRANSACTION_FAILURE_KIND_BLOCKHASH_EXPIRED
+		return failure
+	case "InsufficientFundsForFee", "InsufficientFundsForRent":
+		failure.Kind = proto.TransactionFailureKind_TRANSACTION_FAILURE_KIND_INSUFFICIENT_FUNDS
+		return failure
+	case "InstructionError":
+	default:
+		failure.Kind = proto.TransactionFailureKind_TRANSACTION_FAILURE_KIND_OTHER
+		return failure
+	}
+
+	// An instruction error is the index of the failed instruction and the
+	// error, a string or an object such as {"Custom": 6001}
+	failure.Kind = proto.TransactionFailureKind_TRANSACTION_FAILURE_KIND_INSTRUCTION_ERROR
+	pair, ok := detail.([]interface{})
+	if !ok || len(pair) != 2 {
+		return failure
+	}
+	if index, ok := pair[0].(float64); ok {
+		failure.InstructionIndex = int32(index)
+	}
+	var custom bool
+	switch instructionErr := pair[1].(type) {
+	case string:
+		failure.Error = instructionErr
+	case map[string]interface{}:
+		for name, value := range instructionErr {
+			failure.Error = name
+			if code, ok := value.(float64); ok && name == "Custom" {
+				failure.CustomCode, custom = uint32(code), true
+			}
+		}
+	}
+	program, ok := failedProgram(tx, meta, failure.InstructionIndex)
+	if ok {
+		failure.ProgramId = program.String()
+	}
+
+	switch {
+	case failure.Error == "ComputationalBudgetExceeded":
+		failure.Kind = proto.TransactionFailureKind_TRANSACTION_FAILURE_KIND_COMPUTE_EXHAUSTED
+	case failure.Error == "ProgramFailedToComplete" && exceededComputeMeter(meta.LogMessages):
+		failure.Kind = proto.TransactionFailureKind_TRANSACTION_FAILURE_KIND_COMPUTE_EXHAUSTED
+	case failure.Error == "InsufficientFunds":
+		failure.Kind = proto.TransactionFailureKind_TRANSACTION_FAILURE_KIND_INSUFFICIENT_FUNDS
+	case !custom:
+	case ok && slippageError(program, failure.CustomCode):
+		failure.Kind = proto.TransactionFailureKind_TRANSACTION_FAILURE_KIND_SLIPPAGE
+	case ok && failure.CustomCode == tokenInsufficientFunds && (program.Equals(solana.TokenProgramID) || program.Equals(token2022ProgramID)):
+		failure.Kind = proto.TransactionFailureKind_TRANSACTION_FAILURE_KIND_INSUFFICIENT_FUNDS
+	default:
+		failure.Kind = proto.TransactionFailureKind_TRANSACTION_FAILURE_KIND_CUSTOM_PROGRAM_ERROR
+	}
+	return failure
+}
+
+// failedProgram returns the program that returned an instruction error: the
+// first to log its failure, which may have been invoked by the failed
+// instruction, or else the failed instruction's program
+func failedProgram(tx *solana.Transaction, meta *rpc.TransactionMeta, index int32) (solana.PublicKey, bool) {
+	for _, line := range meta.LogMessages {
+		if match := failedProgramLog.FindStringSubmatch(line); match != nil {
+			if program, err := solana.PublicKeyFromBase58(match[1]); err == nil {
+				return program, true
+			}
+		}
+	}
+	if index < 0 || int(index) >= len(tx.Message.Instructions) {
+		return solana.PublicKey{}, false
+	}
+	keys := transactionKeys(tx, meta)
+	programIndex := int(tx.Message.Instructions[index].ProgramIDIndex)
+	if programIndex >= len(keys) {
+		return solana.PublicKey{}, false
+	}
+	return keys[programIndex], true
+}
+
+// slippageError reports whether a custom error is a slippage error of the
+// program that returned it
+func slippageError(program solana.PublicKey, code uint32) bool {
+	for _, slippage := range slippageErrors[program] {
+		if code == slippage {
+			return true
+		}
+	}
+	return false
+}
+
+// exceededComputeMeter reports whether a transaction's logs show a program
+// running out of compute units, which fails it with ProgramFailedToComplete
+func exceededComputeMeter(logs []string) bool {
+	for _, line := range logs {
+		if strings.Contains(line, "exceeded CUs meter") {
+			return true
+		}
+	}
+	return false
+}
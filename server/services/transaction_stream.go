@@ -0,0 +1,135 @@
+package services
+
+import (
+	"encoding/binary"
+	"log"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/features"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSampleRate bounds the 1-in-N sampling of transaction streams
+const maxSampleRate = 1_000_000
+
+// StreamTransactions streams the transactions of every block at the requested
+// commitment, optionally only those referencing one of the requested accounts
+// and only a 1-in-N sample of them. Each update lists the token transfers the
+// transaction made and the DEX swaps they belong to.
+func (s *BenchmarkService) StreamTransactions(req *proto.TransactionStreamRequest, stream proto.BenchmarkService_StreamTransactionsServer) error {
+	if err := s.requireFeature(features.BlockStreams); err != nil {
+		return err
+	}
+	accounts, err := parsePubkeys(req.Accounts)
+	if err != nil {
+		return err
+	}
+	if len(accounts) > maxTrackedAccounts {
+		return status.Errorf(codes.InvalidArgument, "at most %d accounts may be streamed", maxTrackedAccounts)
+	}
+	watched := make(map[solana.PublicKey]bool, len(accounts))
+	for _, account := range accounts {
+		watched[account] = true
+	}
+	if req.SampleRate > maxSampleRate {
+		return status.Errorf(codes.InvalidArgument, "sample rate may be at most 1 in %d", maxSampleRate)
+	}
+
+	commitment, err := parseCommitment(req.Commitment)
+	if err != nil {
+		return err
+	}
+	if commitment == rpc.CommitmentProcessed {
+		return status.Error(codes.InvalidArgument, "transaction streams support confirmed or finalized commitment")
+	}
+	class, err := parseStreamQoS(req.Qos)
+	if err != nil {
+		return err
+	}
+	mask, err := parseFieldMask(req.FieldMask, &proto.TransactionUpdate{})
+	if err != nil {
+		return err
+	}
+	stream = &maskedTransactionStream{stream, mask}
+
+	ctx := stream.Context()
+	if err := setStreamCompression(ctx, req.Compression); err != nil {
+		return err
+	}
+
+	return s.followBlocks(ctx, commitment, class, func(slot uint64, block *rpc.GetBlockResult, timing *stageTiming) error {
+		for _, txWithMeta := range block.Transactions {
+			meta := txWithMeta.Meta
+			if meta == nil || (meta.Err != nil && !req.IncludeFailed) {
+				continue
+			}
+			tx, err := txWithMeta.GetTransaction()
+			if err != nil {
+				log.Printf("Error decoding transaction: %v", err)
+				continue
+			}
+
+			keys := transactionKeys(tx, meta)
+			if len(watched) > 0 && !referencesAny(keys, watched) {
+				continue
+			}
+			if !sampled(tx, req.SampleRate) {
+				continue
+			}
+
+			built := time.Now()
+			update := transactionUpdate(slot, txWithMeta.Transaction.GetBinary(), tx, meta)
+			update.Timing = timing.breakdown(built, update)
+			if err := stream.Send(update); err != nil {
+				return status.Errorf(codes.Internal, "failed to send transaction update: %v", err)
+			}
+		}
+		return nil
+	})
+}
+
+// transactionUpdate builds the update of a decoded transaction and its
+// serialized form, with the token transfers and swaps it made and why it
+// failed
+func transactionUpdate(slot uint64, raw []byte, tx *solana.Transaction, meta *rpc.TransactionMeta) *proto.TransactionUpdate {
+	keys := transactionKeys(tx, meta)
+	instructions := executedInstructions(tx, meta)
+	mints := tokenAccountMints(meta)
+	update := &proto.TransactionUpdate{
+		Slot:           slot,
+		Transaction:    raw,
+		Success:        meta.Err == nil,
+		Timestamp:      uint64(time.Now().Unix()),
+		TokenTransfers: tokenTransfers(instructions, keys, mints),
+		Swaps:          swapEvents(instructions, keys, mints),
+		Failure:        transactionFailure(tx, meta),
+	}
+	if len(tx.Signatures) > 0 {
+		update.Signature = tx.Signatures[0].String()
+	}
+	return update
+}
+
+// sampled reports whether a transaction is in a 1-in-rate sample. Signatures
+// are uniformly distributed, so their leading bytes select the sample without
+// state, and every stream at the same rate receives the same transactions.
+func sampled(tx *solana.Transaction, rate uint32) bool {
+	if rate <= 1 || len(tx.Signatures) == 0 {
+		return true
+	}
+	return binary.LittleEndian.Uint64(tx.Signatures[0][:8])%uint64(rate) == 0
+}
+
+// referencesAny reports whether any of a transaction's keys is watched
+func referencesAny(keys solana.PublicKeySlice, watched map[solana.PublicKey]bool) bool {
+	for _, key := range keys {
+		if watched[key] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package services
+
+import (
+	"sync"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+)
+
+// accountUpdatePool recycles account update messages on the gateway fan-out
+// path, where every upstream event becomes one message per subscriber. At
+// tens of thousands of messages per second these short-lived structs are a
+// large share of the server's allocations and GC work.
+var accountUpdatePool = sync.Pool{
+	New: func() interface{} { return new(proto.AccountUpdate) },
+}
+
+// newAccountUpdate returns an empty account update from the pool
+func newAccountUpdate() *proto.AccountUpdate {
+	return accountUpdatePool.Get().(*proto.AccountUpdate)
+}
+
+// releaseAccountUpdate returns an update to the pool. The update must not be
+// referenced afterwards; gRPC has serialized a message by the time Send
+// returns, so it is safe to release a sent update.
+func releaseAccountUpdate(update *proto.AccountUpdate) {
+	// Drop the data reference so pooled messages do not pin account buffers
+	update.Reset()
+	accountUpdatePool.Put(update)
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/yellowstone"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// BenchmarkForwardGateway compares the allocations of building each gateway
+// update from accountUpdatePool with allocating it, up to serializing it as
+// gRPC does on Send into a reused buffer
+func BenchmarkForwardGateway(b *testing.B) {
+	event := yellowstone.AccountEvent{
+		Pubkey:   solana.NewWallet().PublicKey(),
+		Owner:    solana.TokenProgramID,
+		Lamports: 2039280,
+		Data:     make([]byte, tokenAccountSize),
+		Slot:     250000000,
+	}
+
+	for _, pooled := range []bool{false, true} {
+		name := "unpooled"
+		if pooled {
+			name = "pooled"
+		}
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			var buf []byte
+			for i := 0; i < b.N; i++ {
+				var update *proto.AccountUpdate
+				if pooled {
+					update = newAccountUpdate()
+				} else {
+					update = new(proto.AccountUpdate)
+				}
+				gatewayUpdate(update, event, uint64(i))
+
+				var err error
+				if buf, err = (protobuf.MarshalOptions{}).MarshalAppend(buf[:0], update); err != nil {
+					b.Fatal(err)
+				}
+				if pooled {
+					releaseAccountUpdate(update)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+)
+
+// UseWSMonitor reports the state of the upstream WebSocket connection
+// tracked by monitor in GetUpstreamHealth
+func (s *BenchmarkService) UseWSMonitor(monitor *upstream.WSMonitor) {
+	s.wsMonitor = monitor
+}
+
+// GetUpstreamHealth returns the state of the upstream connections
+func (s *BenchmarkService) GetUpstreamHealth(ctx context.Context, req *proto.UpstreamHealthRequest) (*proto.UpstreamHealth, error) {
+	health := &proto.UpstreamHealth{Websocket: &proto.WebSocketHealth{}}
+	if s.wsMonitor == nil {
+		return health, nil
+	}
+
+	status := s.wsMonitor.Status()
+	health.Websocket = &proto.WebSocketHealth{
+		State:            wsStates[status.State],
+		StateSinceUnixMs: status.Since.UnixMilli(),
+		Connects:         status.Connects,
+		Disconnects:      status.Disconnects,
+		LastError:        status.LastError,
+		LastSlot:         status.LastSlot,
+		ReconnectDelayMs: uint64(status.ReconnectDelay.Milliseconds()),
+	}
+	if !status.LastNotification.IsZero() {
+		health.Websocket.LastNotificationUnixMs = status.LastNotification.UnixMilli()
+	}
+	return health, nil
+}
+
+// wsStates maps monitor states to their proto form
+var wsStates = map[upstream.WSState]proto.WebSocketState{
+	upstream.WSConnecting:   proto.WebSocketState_WEBSOCKET_STATE_CONNECTING,
+	upstream.WSConnected:    proto.WebSocketState_WEBSOCKET_STATE_CONNECTED,
+	upstream.WSDisconnected: proto.WebSocketState_WEBSOCKET_STATE_DISCONNECTED,
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/mr-tron/base58"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxTransactionSize is the largest serialized transaction the network accepts
+const maxTransactionSize = 1232
+
+// UtilsService implements the address derivations of the UtilsService API.
+// It makes no upstream calls.
+type UtilsService struct {
+	proto.UnimplementedUtilsServiceServer
+}
+
+// NewUtilsService creates a new utils service
+func NewUtilsService() *UtilsService {
+	return &UtilsService{}
+}
+
+// FindProgramAddress derives the PDA of the seeds with the highest valid bump
+func (s *UtilsService) FindProgramAddress(ctx context.Context, req *proto.FindProgramAddressRequest) (*proto.DerivedAddress, error) {
+	programID, err := solana.PublicKeyFromBase58(req.ProgramId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid program ID: %v", err)
+	}
+	// The bump seed is appended, so one seed fewer than the maximum may be given
+	if len(req.Seeds) >= solana.MaxSeeds {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d seeds may be given", solana.MaxSeeds-1)
+	}
+	for i, seed := range req.Seeds {
+		if len(seed) > solana.MaxSeedLength {
+			return nil, status.Errorf(codes.InvalidArgument, "seed %d is longer than %d bytes", i, solana.MaxSeedLength)
+		}
+	}
+
+	address, bump, err := solana.FindProgramAddress(req.Seeds, programID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to find program address: %v", err)
+	}
+	return &proto.DerivedAddress{Address: address.String(), Bump: uint32(bump)}, nil
+}
+
+// CreateWithSeed derives the address of an account created with
+// createAccountWithSeed
+func (s *UtilsService) CreateWithSeed(ctx context.Context, req *proto.CreateWithSeedRequest) (*proto.DerivedAddress, error) {
+	base, err := solana.PublicKeyFromBase58(req.Base)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid base: %v", err)
+	}
+	owner, err := solana.PublicKeyFromBase58(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner: %v", err)
+	}
+
+	address, err := solana.CreateWithSeed(base, req.Seed, owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create address with seed: %v", err)
+	}
+	return &proto.DerivedAddress{Address: address.String()}, nil
+}
+
+// GetAssociatedTokenAddress derives the associated token account of a wallet
+// for a mint of the SPL Token or Token-2022 program
+func (s *UtilsService) GetAssociatedTokenAddress(ctx context.Context, req *proto.AssociatedTokenAddressRequest) (*proto.DerivedAddress, error) {
+	wallet, err := solana.PublicKeyFromBase58(req.Wallet)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid wallet: %v", err)
+	}
+	mint, err := solana.PublicKeyFromBase58(req.Mint)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid mint: %v", err)
+	}
+	tokenProgram := solana.TokenProgramID
+	if req.TokenProgramId != "" {
+		tokenProgram, err = solana.PublicKeyFromBase58(req.TokenProgramId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid token program ID: %v", err)
+		}
+		if !tokenProgram.Equals(solana.TokenProgramID) && !tokenProgram.Equals(token2022ProgramID) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is not a token program", tokenProgram)
+		}
+	}
+
+	address, bump, err := associatedTokenAddress(wallet, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.DerivedAddress{Address: address.String(), Bump: uint32(bump)}, nil
+}
+
+// VerifySignature verifies an ed25519 signature of a message
+func (s *UtilsService) VerifySignature(ctx context.Context, req *proto.VerifySignatureRequest) (*proto.VerifySignatureResponse, error) {
+	pubkey, err := solana.PublicKeyFromBase58(req.Pubkey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pubkey: %v", err)
+	}
+	signature, err := solana.SignatureFromBase58(req.Signature)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid signature: %v", err)
+	}
+	return &proto.VerifySignatureResponse{Valid: signature.Verify(pubkey, req.Message)}, nil
+}
+
+// decodeTransaction decodes a transaction in wire format. solana-go's decoder
+// panics on some malformed input, such as lengths beyond any buffer, so a
+// panic is returned as an error rather than taking the server down.
+func decodeTransaction(raw []byte) (tx *solana.Transaction, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			tx, err = nil, fmt.Errorf("malformed transaction: %v", r)
+		}
+	}()
+	return solana.TransactionFromDecoder(bin.NewBinDecoder(raw))
+}
+
+// DecodeTransaction decodes a serialized transaction and verifies each of its
+// signatures against the message
+func (s *UtilsService) DecodeTransaction(ctx context.Context, req *proto.DecodeTransactionRequest) (*proto.DecodedTransaction, error) {
+	var raw []byte
+	var err error
+	switch req.Encoding {
+	case proto.TransactionEncoding_TRANSACTION_ENCODING_BASE64:
+		raw, err = base64.StdEncoding.DecodeString(req.Transaction)
+	case proto.TransactionEncoding_TRANSACTION_ENCODING_BASE58:
+		raw, err = base58.Decode(req.Transaction)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown encoding: %v", req.Encoding)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %v: %v", req.Encoding, err)
+	}
+	if len(raw) > maxTransactionSize {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction of %d bytes exceeds %d", len(raw), maxTransactionSize)
+	}
+
+	tx, err := decodeTransaction(raw)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode transaction: %v", err)
+	}
+	message, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode message: %v", err)
+	}
+
+	header := tx.Message.Header
+	keys := tx.Message.AccountKeys
+	if int(header.NumRequiredSignatures) > len(keys) {
+		return nil, status.Errorf(codes.InvalidArgument, "%d signatures required of %d accounts", header.NumRequiredSignatures, len(keys))
+	}
+
+	decoded := &proto.DecodedTransaction{
+		Version:                     "legacy",
+		NumRequiredSignatures:       uint32(header.NumRequiredSignatures),
+		NumReadonlySignedAccounts:   uint32(header.NumReadonlySignedAccounts),
+		NumReadonlyUnsignedAccounts: uint32(header.NumReadonlyUnsignedAccounts),
+		RecentBlockhash:             tx.Message.RecentBlockhash.String(),
+		SignaturesValid:             len(tx.Signatures) == int(header.NumRequiredSignatures),
+	}
+	if tx.Message.IsVersioned() {
+		decoded.Version = "0"
+	}
+
+	// Signatures are in the order of the signers, which lead the account keys
+	for i, signature := range tx.Signatures {
+		entry := &proto.DecodedSignature{
+			Signature: signature.String(),
+			Signed:    !signature.IsZero(),
+		}
+		if i < int(header.NumRequiredSignatures) {
+			entry.Signer = keys[i].String()
+			entry.Valid = entry.Signed && signature.Verify(keys[i], message)
+		}
+		decoded.SignaturesValid = decoded.SignaturesValid && entry.Valid
+		decoded.Signatures = append(decoded.Signatures, entry)
+	}
+
+	for i, key := range keys {
+		decoded.AccountKeys = append(decoded.AccountKeys, &proto.DecodedAccountKey{
+			Pubkey:   key.String(),
+			Signer:   i < int(header.NumRequiredSignatures),
+			Writable: staticKeyWritable(header, len(keys), i),
+		})
+	}
+
+	for _, instruction := range tx.Message.Instructions {
+		if int(instruction.ProgramIDIndex) >= len(keys) {
+			return nil, status.Errorf(codes.InvalidArgument, "program index %d is not a static account key", instruction.ProgramIDIndex)
+		}
+		entry := &proto.DecodedInstruction{
+			ProgramId:      keys[instruction.ProgramIDIndex].String(),
+			AccountIndexes: make([]uint32, len(instruction.Accounts)),
+			Data:           instruction.Data,
+		}
+		for i, index := range instruction.Accounts {
+			entry.AccountIndexes[i] = uint32(index)
+		}
+		decoded.Instructions = append(decoded.Instructions, entry)
+	}
+
+	for _, lookup := range tx.Message.AddressTableLookups {
+		entry := &proto.DecodedAddressTableLookup{AccountKey: lookup.AccountKey.String()}
+		for _, index := range lookup.WritableIndexes {
+			entry.WritableIndexes = append(entry.WritableIndexes, uint32(index))
+		}
+		for _, index := range lookup.ReadonlyIndexes {
+			entry.ReadonlyIndexes = append(entry.ReadonlyIndexes, uint32(index))
+		}
+		decoded.AddressTableLookups = append(decoded.AddressTableLookups, entry)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"math/rand"
+	"testing"
+
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestDecodeTransactionMalformed feeds random bytes to DecodeTransaction,
+// some of which make solana-go's decoder panic, and expects each to be
+// rejected as an invalid argument
+func TestDecodeTransactionMalformed(t *testing.T) {
+	s := NewUtilsService()
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		raw := make([]byte, 1+r.Intn(200))
+		r.Read(raw)
+		// Keep the signature count small so decoding reaches the message
+		raw[0] = byte(r.Intn(3))
+
+		_, err := s.DecodeTransaction(context.Background(), &proto.DecodeTransactionRequest{
+			Transaction: base64.StdEncoding.EncodeToString(raw),
+		})
+		if err != nil && status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("input %x: got %v, want InvalidArgument", raw, err)
+		}
+	}
+}
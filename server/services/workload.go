@@ -0,0 +1,25 @@
+package services
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+)
+
+// workloadOrder returns the order an iteration of a benchmark visits its
+// targets in. Without a seed that is the request order. With one, the targets
+// are sorted and then shuffled from the seed and iteration, so the order only
+// depends on the set of targets, and every transport sees the same sequence.
+func workloadOrder[T cmp.Ordered](targets []T, seed uint64, iteration int) []T {
+	if seed == 0 || len(targets) < 2 {
+		return targets
+	}
+
+	ordered := slices.Clone(targets)
+	slices.Sort(ordered)
+	rng := rand.New(rand.NewPCG(seed, uint64(iteration)))
+	rng.Shuffle(len(ordered), func(i, j int) {
+		ordered[i], ordered[j] = ordered[j], ordered[i]
+	})
+	return ordered
+}
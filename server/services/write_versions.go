@@ -0,0 +1,91 @@
+package services
+
+import (
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// writeVersionTracker assigns a monotonic per-pubkey write version to the
+// account states observed by the server. It is shared by all account streams
+// so that every consumer sees the same version for the same state. A pubkey
+// is tracked while a stream that observed it is open.
+type writeVersionTracker struct {
+	mu       sync.Mutex
+	accounts map[solana.PublicKey]*accountVersion
+	// evicted is the highest version of a pubkey no longer tracked. Versions
+	// of pubkeys tracked again continue above it, so they never go back.
+	evicted uint64
+}
+
+type accountVersion struct {
+	slot    uint64
+	version uint64
+	// streams counts the open streams that observed the pubkey
+	streams int
+}
+
+func newWriteVersionTracker() *writeVersionTracker {
+	return &writeVersionTracker{
+		accounts: make(map[solana.PublicKey]*accountVersion),
+	}
+}
+
+// writeVersionScope is the pubkeys one stream observed, which stay tracked
+// until it is released
+type writeVersionScope struct {
+	tracker *writeVersionTracker
+	pubkeys map[solana.PublicKey]struct{}
+}
+
+// scope returns a scope for a stream, which must release it when it ends
+func (t *writeVersionTracker) scope() *writeVersionScope {
+	return &writeVersionScope{tracker: t, pubkeys: make(map[solana.PublicKey]struct{})}
+}
+
+// observe records an account state seen at the given slot and returns its
+// write version. A state from a newer slot bumps the version, a state from the
+// same slot keeps it, and a state older than the latest one observed for the
+// pubkey is rejected (ok is false) so updates are never delivered out of order.
+func (v *writeVersionScope) observe(pubkey solana.PublicKey, slot uint64) (version uint64, ok bool) {
+	t := v.tracker
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current, seen := t.accounts[pubkey]
+	if !seen {
+		current = &accountVersion{version: t.evicted}
+		t.accounts[pubkey] = current
+	}
+	if _, ok := v.pubkeys[pubkey]; !ok {
+		v.pubkeys[pubkey] = struct{}{}
+		current.streams++
+	}
+
+	switch {
+	case !seen || slot > current.slot:
+		current.slot = slot
+		current.version++
+	case slot < current.slot:
+		return 0, false
+	}
+
+	return current.version, true
+}
+
+// release stops tracking the pubkeys no other open stream observed
+func (v *writeVersionScope) release() {
+	t := v.tracker
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for pubkey := range v.pubkeys {
+		current := t.accounts[pubkey]
+		current.streams--
+		if current.streams == 0 {
+			delete(t.accounts, pubkey)
+			t.evicted = max(t.evicted, current.version)
+		}
+	}
+	v.pubkeys = nil
+}
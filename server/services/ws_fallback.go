@@ -0,0 +1,262 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"log"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultWSFallbackPollInterval is how often streams that subscribe to the
+// WebSocket upstream poll the RPC upstream instead when it is unavailable
+const DefaultWSFallbackPollInterval = 2 * time.Second
+
+const (
+	// minPollInterval and maxPollInterval bound the poll interval a client
+	// may request
+	minPollInterval = 250 * time.Millisecond
+	maxPollInterval = time.Minute
+)
+
+// SetWSFallbackPollInterval sets how often streams poll the RPC upstream
+// when the WebSocket upstream is unavailable. 0 disables the fallback, so
+// those streams fail instead.
+func (s *BenchmarkService) SetWSFallbackPollInterval(interval time.Duration) {
+	s.wsFallbackPoll = interval
+}
+
+// wsAvailable reports whether a WebSocket upstream is configured and, when
+// it is monitored, not known to be down
+func (s *BenchmarkService) wsAvailable() bool {
+	if s.endpoint.Transport != nil || s.endpoint.WS == "" {
+		return false
+	}
+	return s.wsMonitor == nil || s.wsMonitor.Status().State != upstream.WSDisconnected
+}
+
+// parsePollInterval validates the poll interval requested for a fallback
+// stream; 0 keeps the server's
+func parsePollInterval(ms uint32) (time.Duration, error) {
+	interval := time.Duration(ms) * time.Millisecond
+	if ms > 0 && (interval < minPollInterval || interval > maxPollInterval) {
+		return 0, status.Errorf(codes.InvalidArgument, "poll interval must be between %v and %v", minPollInterval, maxPollInterval)
+	}
+	return interval, nil
+}
+
+// pollInterval returns how often streams that do not subscribe by design,
+// rather than as a fallback, poll: the fallback interval, or its default when
+// the fallback is disabled
+func (s *BenchmarkService) pollInterval() time.Duration {
+	if s.wsFallbackPoll == 0 {
+		return DefaultWSFallbackPollInterval
+	}
+	return s.wsFallbackPoll
+}
+
+// wsFallback returns the interval a stream polls at when it cannot use the
+// WebSocket upstream, because none is available or connecting failed with
+// cause: the requested one if set, else the server's. It fails with
+// UNAVAILABLE if the server disabled the fallback.
+func (s *BenchmarkService) wsFallback(method string, requested time.Duration, cause error) (time.Duration, error) {
+	if s.wsFallbackPoll == 0 {
+		if cause == nil {
+			return 0, status.Error(codes.Unavailable, "no websocket upstream is available")
+		}
+		return 0, status.Errorf(codes.Unavailable, "failed to connect to websocket endpoint: %v", cause)
+	}
+	interval := s.wsFallbackPoll
+	if requested > 0 {
+		interval = requested
+	}
+	if cause != nil {
+		log.Printf("%s falling back to polling every %v: %v", method, interval, cause)
+	}
+	return interval, nil
+}
+
+// polledAccount is the state of an account a polled stream compares to find
+// changes, with its data hashed rather than held
+type polledAccount struct {
+	lamports uint64
+	owner    solana.PublicKey
+	data     [sha256.Size]byte
+}
+
+func newPolledAccount(account *rpc.Account) polledAccount {
+	return polledAccount{
+		lamports: account.Lamports,
+		owner:    account.Owner,
+		data:     sha256.Sum256(account.Data.GetBinary()),
+	}
+}
+
+// pollProgramAccounts rereads the accounts of a program every poll interval
+// in which the slot advanced, until the client disconnects. It sends the
+// accounts that changed since the previous read, or all of them when
+// detecting changes by slot. Accounts that left the program are sent with no
+// lamports or data.
+func (s *BenchmarkService) pollProgramAccounts(ctx context.Context, live *programLiveStream, known map[solana.PublicKey]polledAccount) error {
+	ticker := time.NewTicker(live.pollInterval)
+	defer ticker.Stop()
+
+	hashChanges := live.changeDetection == proto.PollChangeDetection_POLL_CHANGE_DETECTION_DATA_HASH
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+
+		// getProgramAccounts carries no context slot, so updates are stamped
+		// with the slot read just before it. Nothing changed if it has not
+		// advanced.
+		release, err := s.scheduler.acquire(ctx, live.class)
+		if err != nil {
+			return err
+		}
+		slot, err := s.solanaClient.GetSlot(ctx, live.commitment)
+		var accounts rpc.GetProgramAccountsResult
+		if err == nil && slot > live.lastSlot {
+			accounts, err = s.solanaClient.GetProgramAccountsWithOpts(ctx, live.programID, &rpc.GetProgramAccountsOpts{
+				Commitment: live.commitment,
+			})
+		}
+		release()
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		if err != nil {
+			log.Printf("Polling program %s failed: %v", live.programID, err)
+			continue
+		}
+		if slot <= live.lastSlot {
+			continue
+		}
+
+		var updates []*proto.AccountUpdate
+		current := make(map[solana.PublicKey]polledAccount, len(accounts))
+		for _, keyed := range accounts {
+			// Detecting changes by slot only tracks which accounts exist
+			var state polledAccount
+			if hashChanges {
+				state = newPolledAccount(keyed.Account)
+			}
+			previous, ok := known[keyed.Pubkey]
+			current[keyed.Pubkey] = state
+			if hashChanges && ok && previous == state {
+				continue
+			}
+			updates = append(updates, &proto.AccountUpdate{
+				Pubkey:    keyed.Pubkey.String(),
+				Data:      keyed.Account.Data.GetBinary(),
+				Owner:     keyed.Account.Owner.String(),
+				Lamports:  keyed.Account.Lamports,
+				Slot:      slot,
+				Timestamp: uint64(time.Now().Unix()),
+			})
+		}
+		for pubkey := range known {
+			if _, ok := current[pubkey]; !ok {
+				updates = append(updates, &proto.AccountUpdate{
+					Pubkey:    pubkey.String(),
+					Owner:     solana.SystemProgramID.String(),
+					Slot:      slot,
+					Timestamp: uint64(time.Now().Unix()),
+				})
+			}
+		}
+		known = current
+		live.lastSlot = slot
+
+		for _, update := range updates {
+			if err := live.batch.add(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// pollCommitmentLatency measures commitment latency by polling the slot at
+// each commitment level, until ctx is done. A slot has reached a level once
+// the level's slot is at or past it, so each sample is precise to within the
+// poll interval.
+func (s *BenchmarkService) pollCommitmentLatency(ctx context.Context, stream proto.BenchmarkService_MeasureCommitmentLatencyServer, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// The processed slots polled, oldest first, with when they reached each level
+	type pendingSlot struct {
+		slot uint64
+		seen []time.Time
+	}
+	var pending []*pendingSlot
+	var lastProcessed uint64
+	for {
+		select {
+		case <-ctx.Done():
+			// Reaching the requested duration ends the measurement normally
+			if stream.Context().Err() != nil {
+				return status.FromContextError(stream.Context().Err()).Err()
+			}
+			return nil
+		case <-ticker.C:
+		}
+
+		tips := make([]uint64, len(latencyCommitments))
+		for level, commitment := range latencyCommitments {
+			slot, err := s.solanaClient.GetSlot(ctx, commitment)
+			if ctx.Err() != nil {
+				break
+			}
+			if err != nil {
+				return status.Errorf(codes.Unavailable, "failed to poll slot at %s commitment: %v", commitment, err)
+			}
+			tips[level] = slot
+		}
+		if ctx.Err() != nil {
+			continue
+		}
+		now := time.Now()
+
+		if tips[0] > lastProcessed {
+			seen := make([]time.Time, len(latencyCommitments))
+			seen[0] = now
+			pending = append(pending, &pendingSlot{slot: tips[0], seen: seen})
+			lastProcessed = tips[0]
+		}
+
+		remaining := pending[:0]
+		for _, p := range pending {
+			for level := 1; level < len(latencyCommitments); level++ {
+				if p.seen[level].IsZero() && tips[level] >= p.slot {
+					p.seen[level] = now
+				}
+			}
+			processed, confirmed, finalized := p.seen[0], p.seen[1], p.seen[2]
+			if finalized.IsZero() {
+				remaining = append(remaining, p)
+				continue
+			}
+			err := stream.Send(&proto.CommitmentLatencySample{
+				Slot:                   p.slot,
+				ProcessedToConfirmedMs: uint64(confirmed.Sub(processed).Milliseconds()),
+				ConfirmedToFinalizedMs: uint64(finalized.Sub(confirmed).Milliseconds()),
+				ProcessedToFinalizedMs: uint64(finalized.Sub(processed).Milliseconds()),
+				Timestamp:              uint64(now.Unix()),
+				PollIntervalMs:         uint32(interval.Milliseconds()),
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to send commitment latency sample: %v", err)
+			}
+		}
+		pending = remaining
+	}
+}
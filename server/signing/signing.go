@@ -0,0 +1,172 @@
+// Package signing signs the responses the server sends, so consumers relaying
+// its data can prove which gateway instance produced it.
+//
+// The signature of a unary response covers the SHA-256 hash of the response's
+// deterministic protobuf encoding. A stream's covers a hash chain over its
+// messages: starting from 32 zero bytes, each message extends the chain as
+// SHA-256(chain || SHA-256(message)). Signatures are ed25519 and sent in the
+// call's trailer, base58-encoded with the signer's public key, along with the
+// hex-encoded hash.
+package signing
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/i-tozer/solana-grpc-exploration/server/canonical"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Trailer keys of a signed response
+const (
+	HashKey      = "x-response-sha256"
+	SignatureKey = "x-response-signature"
+	SignerKey    = "x-response-signer"
+	// MessagesKey counts the messages a stream's hash chain covers
+	MessagesKey = "x-response-messages"
+)
+
+// Signer signs responses with an ed25519 key
+type Signer struct {
+	key solana.PrivateKey
+}
+
+// New creates a signer for a key
+func New(key solana.PrivateKey) (*Signer, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid signing key: %d bytes, want %d", len(key), ed25519.PrivateKeySize)
+	}
+	return &Signer{key: key}, nil
+}
+
+// PublicKey returns the key responses are verified with
+func (s *Signer) PublicKey() solana.PublicKey {
+	return s.key.PublicKey()
+}
+
+// Hash returns the SHA-256 hash of a message's canonical encoding
+func Hash(m protobuf.Message) ([32]byte, error) {
+	data, err := canonical.Marshal(m)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(data), nil
+}
+
+// Chain extends a stream's hash chain with the hash of its next message
+func Chain(chain, next [32]byte) [32]byte {
+	return sha256.Sum256(append(chain[:], next[:]...))
+}
+
+// trailer signs a hash and returns the trailer carrying the signature
+func (s *Signer) trailer(hash [32]byte) (metadata.MD, error) {
+	signature, err := s.key.Sign(hash[:])
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Pairs(
+		HashKey, hex.EncodeToString(hash[:]),
+		SignatureKey, signature.String(),
+		SignerKey, s.key.PublicKey().String(),
+	), nil
+}
+
+// UnaryInterceptor signs every successful unary response
+func (s *Signer) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	message, ok := resp.(protobuf.Message)
+	if !ok {
+		return resp, nil
+	}
+	hash, err := Hash(message)
+	if err != nil {
+		return resp, nil
+	}
+	if trailer, err := s.trailer(hash); err == nil {
+		grpc.SetTrailer(ctx, trailer)
+	}
+	return resp, nil
+}
+
+// StreamInterceptor signs the hash chain of every stream's messages once the
+// stream ends, whether or not it ended in an error
+func (s *Signer) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	stream := &signedStream{ServerStream: ss}
+	err := handler(srv, stream)
+
+	stream.mu.Lock()
+	chain, messages := stream.chain, stream.messages
+	stream.mu.Unlock()
+	if trailer, signErr := s.trailer(chain); signErr == nil {
+		trailer.Set(MessagesKey, strconv.FormatUint(messages, 10))
+		ss.SetTrailer(trailer)
+	}
+	return err
+}
+
+// signedStream extends the hash chain with every message sent
+type signedStream struct {
+	grpc.ServerStream
+	mu       sync.Mutex
+	chain    [32]byte
+	messages uint64
+}
+
+func (s *signedStream) SendMsg(m interface{}) error {
+	message, ok := m.(protobuf.Message)
+	if !ok {
+		return s.ServerStream.SendMsg(m)
+	}
+	hash, err := Hash(message)
+	if err != nil {
+		return err
+	}
+	// Hashed and sent under the lock, so the chain follows the send order
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.chain = Chain(s.chain, hash)
+	s.messages++
+	return nil
+}
+
+// Verify checks that a response's trailer carries a signature by signer over
+// hash: the response's hash, or a stream's hash chain
+func Verify(trailer metadata.MD, hash [32]byte, signer solana.PublicKey) error {
+	get := func(key string) string {
+		if values := trailer.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	if get(SignatureKey) == "" {
+		return fmt.Errorf("response is not signed")
+	}
+	if got := get(SignerKey); got != signer.String() {
+		return fmt.Errorf("response is signed by %s, want %s", got, signer)
+	}
+	if got := get(HashKey); got != hex.EncodeToString(hash[:]) {
+		return fmt.Errorf("response hash %s does not match the response received", got)
+	}
+	signature, err := solana.SignatureFromBase58(get(SignatureKey))
+	if err != nil {
+		return fmt.Errorf("invalid response signature: %v", err)
+	}
+	if !signature.Verify(signer, hash[:]) {
+		return fmt.Errorf("response signature does not verify")
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Package slo evaluates latency objectives, such as p99 GetAccountInfo under
+// 150ms over 5 minutes, from the latencies the server records in its own
+// metrics, and reports when they are violated and when they recover.
+package slo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/i-tozer/solana-grpc-exploration/server/metrics"
+	"github.com/i-tozer/solana-grpc-exploration/server/stats"
+)
+
+// Source is the metric an objective is evaluated from
+type Source int
+
+const (
+	// SourceHandler is the latency of the gRPC calls the server serves
+	SourceHandler Source = iota
+	// SourceUpstream is the latency of the JSON-RPC calls it makes upstream
+	SourceUpstream
+)
+
+func (s Source) String() string {
+	if s == SourceUpstream {
+		return "upstream"
+	}
+	return "handler"
+}
+
+// Objective requires a percentile of a method's latency over a trailing
+// window to stay under a threshold
+type Objective struct {
+	Name   string
+	Source Source
+	// Method is a full gRPC method name, or a JSON-RPC method for upstream
+	// objectives
+	Method     string
+	Percentile float64
+	Threshold  time.Duration
+	Window     time.Duration
+}
+
+// Validate checks that the objective can be evaluated
+func (o Objective) Validate() error {
+	switch {
+	case o.Method == "":
+		return fmt.Errorf("objective %q has no method", o.Name)
+	case o.Percentile <= 0 || o.Percentile > 100:
+		return fmt.Errorf("objective %q percentile must be above 0 and at most 100", o.Name)
+	case o.Threshold <= 0:
+		return fmt.Errorf("objective %q threshold must be positive", o.Name)
+	case o.Window <= 0:
+		return fmt.Errorf("objective %q window must be positive", o.Name)
+	}
+	return nil
+}
+
+// Status is the state of an objective at its last evaluation
+type Status struct {
+	Objective Objective
+	// Latency is the percentile over the window, from Samples calls. Without
+	// calls in the window the objective is met.
+	Latency time.Duration
+	Samples int
+	Met     bool
+	// Since is when the objective entered its current state, or was first
+	// evaluated
+	Since       time.Time
+	EvaluatedAt time.Time
+}
+
+// Event reports that an objective was violated, or recovered
+type Event struct {
+	Status
+	// Violated is set when the objective stopped being met, and unset when
+	// it was met again
+	Violated bool
+}
+
+// Tracker evaluates objectives periodically and sends an event to its
+// subscribers whenever one changes between met and violated. Subscribers
+// that fall behind miss events rather than delaying the others.
+type Tracker struct {
+	objectives []Objective
+
+	mu          sync.Mutex
+	statuses    []Status
+	subscribers map[chan Event]bool
+}
+
+// New creates a tracker for objectives, which must be valid, and retains the
+// latencies their windows need. Run starts evaluating them.
+func New(objectives []Objective) *Tracker {
+	for _, objective := range objectives {
+		histograms(objective.Source).Retain(objective.Window)
+	}
+	return &Tracker{
+		objectives:  objectives,
+		subscribers: make(map[chan Event]bool),
+	}
+}
+
+// histograms returns the metric a source reads from
+func histograms(source Source) interface {
+	Retain(time.Duration)
+	Recent(string, time.Time) []time.Duration
+} {
+	if source == SourceUpstream {
+		return metrics.UpstreamLatency
+	}
+	return metrics.HandlerLatency
+}
+
+// Objectives returns the tracked objectives
+func (t *Tracker) Objectives() []Objective {
+	return t.objectives
+}
+
+// Run evaluates the objectives every interval until ctx is done
+func (t *Tracker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		t.evaluate(time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// evaluate evaluates every objective as of now, sending an event for each
+// that changed between met and violated. Objectives start out met, so those
+// violated at the first evaluation are reported too.
+func (t *Tracker) evaluate(now time.Time) {
+	statuses := make([]Status, len(t.objectives))
+	for i, objective := range t.objectives {
+		latencies := histograms(objective.Source).Recent(objective.Method, now.Add(-objective.Window))
+		samples := make([]float64, len(latencies))
+		for j, latency := range latencies {
+			samples[j] = float64(latency)
+		}
+		sort.Float64s(samples)
+
+		latency := time.Duration(stats.Percentile(samples, objective.Percentile))
+		statuses[i] = Status{
+			Objective:   objective,
+			Latency:     latency,
+			Samples:     len(samples),
+			Met:         latency < objective.Threshold,
+			Since:       now,
+			EvaluatedAt: now,
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := range statuses {
+		met := true
+		if t.statuses != nil {
+			met = t.statuses[i].Met
+			if met == statuses[i].Met {
+				statuses[i].Since = t.statuses[i].Since
+			}
+		}
+		if met == statuses[i].Met {
+			continue
+		}
+		event := Event{Status: statuses[i], Violated: !statuses[i].Met}
+		for subscriber := range t.subscribers {
+			select {
+			case subscriber <- event:
+			default:
+			}
+		}
+	}
+	t.statuses = statuses
+}
+
+// Statuses returns the status of every objective at its last evaluation, in
+// configuration order; nil before the first
+func (t *Tracker) Statuses() []Status {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Status(nil), t.statuses...)
+}
+
+// Subscribe returns a channel receiving the tracker's events, queueing up to
+// buffer of them, and the function ending the subscription
+func (t *Tracker) Subscribe(buffer int) (<-chan Event, func()) {
+	events := make(chan Event, buffer)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.subscribers[events] = true
+	return events, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.subscribers, events)
+	}
+}
@@ -0,0 +1,86 @@
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// Trim returns the samples with the given fraction dropped from each tail.
+// The input is not modified.
+func Trim(samples []float64, fraction float64) []float64 {
+	sorted, k := tails(samples, fraction)
+	return sorted[k : len(sorted)-k]
+}
+
+// Winsorize returns the samples with the given fraction in each tail clamped
+// to the nearest sample that was kept. The input is not modified.
+func Winsorize(samples []float64, fraction float64) []float64 {
+	sorted, k := tails(samples, fraction)
+	if k == 0 {
+		return sorted
+	}
+
+	low, high := sorted[k], sorted[len(sorted)-k-1]
+	for i := 0; i < k; i++ {
+		sorted[i] = low
+		sorted[len(sorted)-1-i] = high
+	}
+	return sorted
+}
+
+// Summary holds the mean, minimum, maximum, nearest-rank percentiles and
+// sample standard deviation of a sample set
+type Summary struct {
+	Mean   float64
+	Min    float64
+	Max    float64
+	P50    float64
+	P90    float64
+	P95    float64
+	P99    float64
+	StdDev float64
+}
+
+// Summarize returns the summary of the samples. The input is not modified.
+func Summarize(samples []float64) Summary {
+	if len(samples) == 0 {
+		return Summary{}
+	}
+
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+	summary := Summary{
+		Min: sorted[0],
+		Max: sorted[len(sorted)-1],
+		P50: Percentile(sorted, 50),
+		P90: Percentile(sorted, 90),
+		P95: Percentile(sorted, 95),
+		P99: Percentile(sorted, 99),
+	}
+	for _, s := range sorted {
+		summary.Mean += s
+	}
+	summary.Mean /= float64(len(sorted))
+	// A single sample has no spread to estimate
+	if len(sorted) > 1 {
+		_, variance := meanVariance(sorted)
+		summary.StdDev = math.Sqrt(variance)
+	}
+	return summary
+}
+
+// tails returns a sorted copy of the samples and the number of samples in
+// each tail, always keeping at least one sample
+func tails(samples []float64, fraction float64) ([]float64, int) {
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+
+	k := int(float64(len(sorted)) * fraction)
+	if 2*k >= len(sorted) {
+		k = (len(sorted) - 1) / 2
+	}
+	if k < 0 {
+		k = 0
+	}
+	return sorted, k
+}
@@ -0,0 +1,14 @@
+package stats
+
+import "math"
+
+// Percentile returns the nearest-rank p-th percentile, for p from 0 to 100,
+// of samples sorted in ascending order
+func Percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	index := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	index = min(max(index, 0), len(sorted)-1)
+	return sorted[index]
+}
@@ -0,0 +1,182 @@
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// Alpha is the significance level used for tests and confidence intervals
+const Alpha = 0.05
+
+// ErrTooFewSamples is returned when a sample set is too small to test
+var ErrTooFewSamples = errors.New("at least two samples per set are required")
+
+// TTest is the result of Welch's unequal-variances t-test comparing the means
+// of two sample sets
+type TTest struct {
+	// MeanDifference is the mean of b minus the mean of a
+	MeanDifference float64
+	// CILow and CIHigh bound the (1 - Alpha) confidence interval of MeanDifference
+	CILow  float64
+	CIHigh float64
+	T      float64
+	DF     float64
+	// PValue is the two-sided p-value for a difference in means
+	PValue float64
+}
+
+// Significant reports whether the difference in means is significant at Alpha
+func (t TTest) Significant() bool {
+	return t.PValue < Alpha
+}
+
+// WelchTTest compares the means of two sample sets without assuming equal variances
+func WelchTTest(a, b []float64) (TTest, error) {
+	if len(a) < 2 || len(b) < 2 {
+		return TTest{}, ErrTooFewSamples
+	}
+
+	meanA, varA := meanVariance(a)
+	meanB, varB := meanVariance(b)
+	seA := varA / float64(len(a))
+	seB := varB / float64(len(b))
+	se := math.Sqrt(seA + seB)
+	diff := meanB - meanA
+
+	// Identical constant samples leave no variance to test against
+	if se == 0 {
+		p := 1.0
+		if diff != 0 {
+			p = 0
+		}
+		return TTest{MeanDifference: diff, CILow: diff, CIHigh: diff, PValue: p}, nil
+	}
+
+	t := diff / se
+	df := (seA + seB) * (seA + seB) / (seA*seA/float64(len(a)-1) + seB*seB/float64(len(b)-1))
+	margin := studentTQuantile(df) * se
+
+	return TTest{
+		MeanDifference: diff,
+		CILow:          diff - margin,
+		CIHigh:         diff + margin,
+		T:              t,
+		DF:             df,
+		PValue:         studentTTwoSided(t, df),
+	}, nil
+}
+
+// meanVariance returns the mean and unbiased sample variance. A single
+// sample has no variance.
+func meanVariance(samples []float64) (mean, variance float64) {
+	for _, s := range samples {
+		mean += s
+	}
+	mean /= float64(len(samples))
+	if len(samples) < 2 {
+		return mean, 0
+	}
+
+	for _, s := range samples {
+		variance += (s - mean) * (s - mean)
+	}
+	variance /= float64(len(samples) - 1)
+	return mean, variance
+}
+
+// studentTTwoSided returns P(|T| >= |t|) for Student's t with df degrees of freedom
+func studentTTwoSided(t, df float64) float64 {
+	return regularizedBeta(df/(df+t*t), df/2, 0.5)
+}
+
+// studentTQuantile returns the critical value t such that P(|T| >= t) = Alpha
+func studentTQuantile(df float64) float64 {
+	low, high := 0.0, 1.0
+	for studentTTwoSided(high, df) > Alpha {
+		high *= 2
+	}
+	for i := 0; i < 100; i++ {
+		mid := (low + high) / 2
+		if studentTTwoSided(mid, df) > Alpha {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2
+}
+
+// regularizedBeta returns the regularized incomplete beta function I_x(a, b)
+func regularizedBeta(x, a, b float64) float64 {
+	switch {
+	case x <= 0:
+		return 0
+	case x >= 1:
+		return 1
+	}
+
+	lgA, _ := math.Lgamma(a)
+	lgB, _ := math.Lgamma(b)
+	lgAB, _ := math.Lgamma(a + b)
+	front := math.Exp(lgAB - lgA - lgB + a*math.Log(x) + b*math.Log(1-x))
+
+	// The continued fraction converges fastest below the mean of the distribution
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(x, a, b) / a
+	}
+	return 1 - front*betaContinuedFraction(1-x, b, a)/b
+}
+
+// betaContinuedFraction evaluates the continued fraction for the incomplete
+// beta function using the modified Lentz method
+func betaContinuedFraction(x, a, b float64) float64 {
+	const (
+		maxIterations = 200
+		epsilon       = 1e-14
+		tiny          = 1e-300
+	)
+
+	c := 1.0
+	d := 1 - (a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIterations; m++ {
+		fm := float64(m)
+
+		// Even step
+		num := fm * (b - fm) * x / ((a + 2*fm - 1) * (a + 2*fm))
+		d = 1 + num*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + num/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		// Odd step
+		num = -(a + fm) * (a + b + fm) * x / ((a + 2*fm) * (a + 2*fm + 1))
+		d = 1 + num*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + num/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return h
+}
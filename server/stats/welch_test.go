@@ -0,0 +1,149 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWelchTTest(t *testing.T) {
+	// Expected p-values are closed forms of Student's t at the degrees of
+	// freedom each case works out to
+	tests := []struct {
+		name     string
+		a, b     []float64
+		wantT    float64
+		wantDF   float64
+		wantP    float64
+		wantCrit float64 // t quantile at 1 - Alpha/2
+	}{
+		{
+			// Equal sizes and variances reduce to Student's test, with
+			// df = 2 + 2 - 2
+			name:     "df 2",
+			a:        []float64{0, 2},
+			b:        []float64{2, 4},
+			wantT:    math.Sqrt2,
+			wantDF:   2,
+			wantP:    1 - math.Sqrt2/math.Sqrt(2+2),
+			wantCrit: 4.302652729911275,
+		},
+		{
+			// All the variance is in a, so df = len(a) - 1
+			name:     "df 1",
+			a:        []float64{0, 2},
+			b:        []float64{3, 3, 3},
+			wantT:    2,
+			wantDF:   1,
+			wantP:    1 - 2/math.Pi*math.Atan(2),
+			wantCrit: 12.706204736174705,
+		},
+		{
+			name:     "df 4",
+			a:        []float64{0, 1, 2},
+			b:        []float64{3, 4, 5},
+			wantT:    3 / math.Sqrt(2.0/3),
+			wantDF:   4,
+			wantP:    studentT4TwoSided(3 / math.Sqrt(2.0/3)),
+			wantCrit: 2.7764451051977987,
+		},
+		{
+			name:     "negative difference",
+			a:        []float64{3, 4, 5},
+			b:        []float64{0, 1, 2},
+			wantT:    -3 / math.Sqrt(2.0/3),
+			wantDF:   4,
+			wantP:    studentT4TwoSided(3 / math.Sqrt(2.0/3)),
+			wantCrit: 2.7764451051977987,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WelchTTest(tt.a, tt.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			meanA, _ := meanVariance(tt.a)
+			meanB, _ := meanVariance(tt.b)
+			se := (meanB - meanA) / tt.wantT
+			for _, check := range []struct {
+				field     string
+				got, want float64
+			}{
+				{"T", got.T, tt.wantT},
+				{"DF", got.DF, tt.wantDF},
+				{"PValue", got.PValue, tt.wantP},
+				{"MeanDifference", got.MeanDifference, meanB - meanA},
+				{"CILow", got.CILow, meanB - meanA - tt.wantCrit*se},
+				{"CIHigh", got.CIHigh, meanB - meanA + tt.wantCrit*se},
+			} {
+				if math.Abs(check.got-check.want) > 1e-9 {
+					t.Errorf("%s = %.12f, want %.12f", check.field, check.got, check.want)
+				}
+			}
+		})
+	}
+}
+
+// studentT4TwoSided is P(|T| >= t) for Student's t with 4 degrees of freedom
+func studentT4TwoSided(t float64) float64 {
+	return 1 - t*(t*t+6)/math.Pow(t*t+4, 1.5)
+}
+
+func TestWelchTTestConstantSamples(t *testing.T) {
+	same, err := WelchTTest([]float64{5, 5, 5}, []float64{5, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if same.PValue != 1 || same.Significant() {
+		t.Errorf("identical constant samples: p = %v, want 1", same.PValue)
+	}
+
+	different, err := WelchTTest([]float64{5, 5, 5}, []float64{6, 6})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if different.PValue != 0 || different.MeanDifference != 1 || !different.Significant() {
+		t.Errorf("different constant samples: p = %v, difference %v; want 0, 1", different.PValue, different.MeanDifference)
+	}
+}
+
+func TestWelchTTestTooFewSamples(t *testing.T) {
+	for _, sets := range [][2][]float64{
+		{{1}, {1, 2}},
+		{{1, 2}, {1}},
+		{nil, {1, 2}},
+	} {
+		if _, err := WelchTTest(sets[0], sets[1]); err != ErrTooFewSamples {
+			t.Errorf("WelchTTest(%v, %v) error = %v, want ErrTooFewSamples", sets[0], sets[1], err)
+		}
+	}
+}
+
+func TestMeanVariance(t *testing.T) {
+	tests := []struct {
+		name           string
+		samples        []float64
+		mean, variance float64
+	}{
+		{"one sample", []float64{4}, 4, 0},
+		{"constant", []float64{3, 3, 3, 3}, 3, 0},
+		{"unbiased", []float64{2, 4, 4, 4, 5, 5, 7, 9}, 5, 32.0 / 7},
+		{"two samples", []float64{1, 3}, 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mean, variance := meanVariance(tt.samples)
+			if math.Abs(mean-tt.mean) > 1e-12 || math.Abs(variance-tt.variance) > 1e-12 {
+				t.Errorf("meanVariance(%v) = %v, %v; want %v, %v", tt.samples, mean, variance, tt.mean, tt.variance)
+			}
+		})
+	}
+
+	// Samples of equal variance give equal variances whatever their means
+	_, a := meanVariance([]float64{1, 2, 3, 4})
+	_, b := meanVariance([]float64{101, 102, 103, 104})
+	if math.Abs(a-b) > 1e-12 {
+		t.Errorf("variances of shifted samples differ: %v and %v", a, b)
+	}
+}
@@ -0,0 +1,170 @@
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+)
+
+// Supported auth providers
+const (
+	// ProviderHelius passes the token as the api-key query parameter
+	ProviderHelius = "helius"
+	// ProviderTriton passes the token in the x-token header
+	ProviderTriton = "triton"
+	// ProviderQuickNode appends the token to the endpoint path
+	ProviderQuickNode = "quicknode"
+	// ProviderHeader passes the token in a custom header
+	ProviderHeader = "header"
+	// ProviderQuery passes the token in a custom query parameter
+	ProviderQuery = "query"
+)
+
+// Auth describes how to authenticate with an upstream provider. The token is
+// read from the environment variable named by TokenEnv when set, so secrets
+// need not be stored in the config file or passed on the command line.
+type Auth struct {
+	Provider   string `json:"provider"`
+	Token      string `json:"token,omitempty"`
+	TokenEnv   string `json:"token_env,omitempty"`
+	Header     string `json:"header,omitempty"`
+	QueryParam string `json:"query_param,omitempty"`
+}
+
+// Endpoint is an upstream Solana node with its authentication applied
+type Endpoint struct {
+	RPC    string
+	WS     string
+	Header http.Header
+	// Transport, when set, serves JSON-RPC calls in place of the RPC URL.
+	// It is used to run the server against an in-memory backend.
+	Transport rpc.JSONRPCClient
+	// Timeouts, when set, bounds every JSON-RPC call to the endpoint
+	Timeouts *Timeouts
+	// Pool, when set, bounds the JSON-RPC calls in flight to the endpoint
+	// across all of its clients
+	Pool *Pool
+}
+
+// NewEndpoint applies auth to the RPC and WebSocket endpoints of an upstream
+func NewEndpoint(rpcEndpoint, wsEndpoint string, auth *Auth) (Endpoint, error) {
+	endpoint := Endpoint{RPC: rpcEndpoint, WS: wsEndpoint, Header: http.Header{}}
+	if auth == nil || auth.Provider == "" {
+		return endpoint, nil
+	}
+
+	token, err := auth.token()
+	if err != nil {
+		return Endpoint{}, err
+	}
+
+	switch auth.Provider {
+	case ProviderHelius:
+		return endpoint.withQuery("api-key", token)
+	case ProviderTriton:
+		endpoint.Header.Set("x-token", token)
+	case ProviderQuickNode:
+		return endpoint.withPath(token)
+	case ProviderHeader:
+		if auth.Header == "" {
+			return Endpoint{}, fmt.Errorf("auth provider %q requires header", auth.Provider)
+		}
+		endpoint.Header.Set(auth.Header, token)
+	case ProviderQuery:
+		if auth.QueryParam == "" {
+			return Endpoint{}, fmt.Errorf("auth provider %q requires query_param", auth.Provider)
+		}
+		return endpoint.withQuery(auth.QueryParam, token)
+	default:
+		return Endpoint{}, fmt.Errorf("unknown auth provider %q", auth.Provider)
+	}
+
+	return endpoint, nil
+}
+
+// NewRPCClient creates a JSON-RPC client for the endpoint
+func (e Endpoint) NewRPCClient() *rpc.Client {
+	transport := e.Transport
+	if transport == nil {
+		headers := make(map[string]string, len(e.Header))
+		for key := range e.Header {
+			headers[key] = e.Header.Get(key)
+		}
+		transport = jsonrpc.NewClientWithOpts(e.RPC, &jsonrpc.RPCClientOpts{
+			HTTPClient:    newHTTPClient(),
+			CustomHeaders: headers,
+		})
+	}
+
+	if e.Timeouts != nil {
+		transport = &timeoutClient{next: transport, timeouts: *e.Timeouts}
+	}
+	transport = &metricsClient{next: transport}
+	// Time spent queued for a slot counts towards the call, not the upstream
+	if e.Pool != nil {
+		transport = &poolClient{next: transport, pool: e.Pool, endpoint: e.RPC}
+	}
+	// Calls made with a timed context are recorded for timing breakdowns
+	return rpc.NewWithCustomRPCClient(&timingClient{next: transport})
+}
+
+// ConnectWS opens a WebSocket connection to the endpoint
+func (e Endpoint) ConnectWS(ctx context.Context) (*ws.Client, error) {
+	return ws.ConnectWithOptions(ctx, e.WS, &ws.Options{
+		HttpHeader:       e.Header,
+		HandshakeTimeout: ws.DefaultHandshakeTimeout,
+	})
+}
+
+func (a *Auth) token() (string, error) {
+	if a.TokenEnv != "" {
+		token := os.Getenv(a.TokenEnv)
+		if token == "" {
+			return "", fmt.Errorf("auth token environment variable %s is not set", a.TokenEnv)
+		}
+		return token, nil
+	}
+	if a.Token == "" {
+		return "", fmt.Errorf("auth provider %q requires token or token_env", a.Provider)
+	}
+	return a.Token, nil
+}
+
+// withQuery adds a query parameter to both endpoints
+func (e Endpoint) withQuery(key, value string) (Endpoint, error) {
+	return e.editURLs(func(u *url.URL) {
+		q := u.Query()
+		q.Set(key, value)
+		u.RawQuery = q.Encode()
+	})
+}
+
+// withPath appends a path segment to both endpoints
+func (e Endpoint) withPath(segment string) (Endpoint, error) {
+	return e.editURLs(func(u *url.URL) {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + segment + "/"
+	})
+}
+
+func (e Endpoint) editURLs(edit func(*url.URL)) (Endpoint, error) {
+	for _, raw := range []*string{&e.RPC, &e.WS} {
+		// Upstreams without WebSocket support have no WS URL
+		if *raw == "" {
+			continue
+		}
+		u, err := url.Parse(*raw)
+		if err != nil {
+			return Endpoint{}, fmt.Errorf("invalid endpoint %q: %v", *raw, err)
+		}
+		edit(u)
+		*raw = u.String()
+	}
+	return e, nil
+}
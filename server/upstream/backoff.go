@@ -0,0 +1,91 @@
+package upstream
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// defaultInitialBackoff is the first delay of a Backoff without one
+	defaultInitialBackoff = time.Second
+	// defaultMaxBackoff caps the delays of a Backoff without a cap
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// Backoff spaces out the attempts to reach an upstream: each delay doubles
+// the previous one up to Max. The zero value starts at a second and caps at
+// 30 seconds.
+type Backoff struct {
+	// Initial is the first delay
+	Initial time.Duration
+	// Max caps the delays
+	Max time.Duration
+
+	delay time.Duration
+}
+
+// Delay returns the delay the next Wait waits for
+func (b *Backoff) Delay() time.Duration {
+	if b.delay == 0 {
+		b.delay = b.Initial
+		if b.delay == 0 {
+			b.delay = defaultInitialBackoff
+		}
+	}
+	return b.delay
+}
+
+// Next returns the current delay and doubles it for the next attempt, for
+// callers that poll rather than wait in between
+func (b *Backoff) Next() time.Duration {
+	delay := b.Delay()
+	b.delay = min(2*delay, b.max())
+	return delay
+}
+
+// Wait waits for the current delay and doubles it for the next attempt. It
+// returns early with the context's error when ctx is done.
+func (b *Backoff) Wait(ctx context.Context) error {
+	timer := time.NewTimer(b.Next())
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+	return nil
+}
+
+// Reset starts the delays over from Initial
+func (b *Backoff) Reset() {
+	b.delay = 0
+}
+
+func (b *Backoff) max() time.Duration {
+	if b.Max == 0 {
+		return defaultMaxBackoff
+	}
+	return b.Max
+}
+
+// Reconnect runs connect until ctx is done, running it again after a delay
+// from backoff whenever it returns. A connection that stayed up longer than
+// the longest delay resets the backoff. failed is called with the error of
+// each failed connection and the delay before the next one, to report it.
+func Reconnect(ctx context.Context, backoff *Backoff, connect func(context.Context) error, failed func(err error, delay time.Duration)) {
+	for {
+		start := time.Now()
+		err := connect(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if time.Since(start) > backoff.max() {
+			backoff.Reset()
+		}
+		failed(err, backoff.Delay())
+		if backoff.Wait(ctx) != nil {
+			return
+		}
+	}
+}
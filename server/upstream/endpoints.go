@@ -0,0 +1,32 @@
+package upstream
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+)
+
+// WSEndpoint derives the Solana WebSocket endpoint from an HTTP RPC endpoint.
+// The scheme is switched to ws/wss and, following the solana-test-validator
+// convention, an explicit port is incremented by one (8899 -> 8900).
+func WSEndpoint(rpcEndpoint string) string {
+	u, err := url.Parse(rpcEndpoint)
+	if err != nil {
+		return rpcEndpoint
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+
+	if port := u.Port(); port != "" {
+		if p, err := strconv.Atoi(port); err == nil {
+			u.Host = net.JoinHostPort(u.Hostname(), strconv.Itoa(p+1))
+		}
+	}
+
+	return u.String()
+}
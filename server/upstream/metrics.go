@@ -0,0 +1,39 @@
+package upstream
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/i-tozer/solana-grpc-exploration/server/metrics"
+)
+
+// metricsClient records the latency of every upstream call by JSON-RPC
+// method and the tenant of the call it serves; batches are recorded as
+// "batch"
+type metricsClient struct {
+	next rpc.JSONRPCClient
+}
+
+func (c *metricsClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	start := time.Now()
+	err := c.next.CallForInto(ctx, out, method, params)
+	metrics.UpstreamLatency.Observe(method, metrics.Tenant(ctx), time.Since(start))
+	return err
+}
+
+func (c *metricsClient) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	start := time.Now()
+	err := c.next.CallWithCallback(ctx, method, params, callback)
+	metrics.UpstreamLatency.Observe(method, metrics.Tenant(ctx), time.Since(start))
+	return err
+}
+
+func (c *metricsClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	start := time.Now()
+	responses, err := c.next.CallBatch(ctx, requests)
+	metrics.UpstreamLatency.Observe("batch", metrics.Tenant(ctx), time.Since(start))
+	return responses, err
+}
@@ -0,0 +1,236 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// ErrPoolTimeout fails an upstream call that waited longer than the pool's
+// queue timeout for a free slot
+var ErrPoolTimeout = errors.New("timed out waiting for a free upstream call slot")
+
+// Priority orders the calls queued for pool slots
+type Priority int
+
+const (
+	// PriorityInteractive is the default, for calls serving a client waiting
+	// on the response
+	PriorityInteractive Priority = iota
+	// PriorityBenchmark calls only take a slot no interactive call is queued
+	// for, so benchmark runs cannot starve other users of the upstream
+	PriorityBenchmark
+)
+
+type priorityKey struct{}
+
+// WithPriority returns a context whose upstream calls queue with priority
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+func priorityOf(ctx context.Context) Priority {
+	priority, _ := ctx.Value(priorityKey{}).(Priority)
+	return priority
+}
+
+// PoolLimits bounds the JSON-RPC calls in flight upstream, so a burst of gRPC
+// clients queues at the server instead of tripping the provider's rate limits
+type PoolLimits struct {
+	// MaxConcurrent bounds calls in flight to all endpoints; zero is unlimited
+	MaxConcurrent int
+	// MaxPerEndpoint bounds calls in flight to one endpoint; zero is unlimited
+	MaxPerEndpoint int
+	// QueueTimeout is how long a call waits for a slot before failing; zero
+	// waits as long as the caller's context allows
+	QueueTimeout time.Duration
+}
+
+// DefaultPoolLimits leave room above the connections the HTTP client keeps
+// per host, so the limits only bite on bursts
+var DefaultPoolLimits = PoolLimits{
+	MaxConcurrent:  64,
+	MaxPerEndpoint: 32,
+	QueueTimeout:   5 * time.Second,
+}
+
+// Pool holds the call slots shared by every client of the endpoints it is set
+// on. Excess calls queue by priority, then in arrival order.
+type Pool struct {
+	limits PoolLimits
+	global *slots
+
+	mu        sync.Mutex
+	endpoints map[string]*slots
+}
+
+// NewPool creates a pool with the given limits
+func NewPool(limits PoolLimits) *Pool {
+	return &Pool{
+		limits:    limits,
+		global:    newSlots(limits.MaxConcurrent),
+		endpoints: make(map[string]*slots),
+	}
+}
+
+// acquire waits for a slot for a call to endpoint and returns its release
+func (p *Pool) acquire(ctx context.Context, endpoint string) (func(), error) {
+	wait := ctx
+	if p.limits.QueueTimeout > 0 {
+		var cancel context.CancelFunc
+		wait, cancel = context.WithTimeout(ctx, p.limits.QueueTimeout)
+		defer cancel()
+	}
+
+	// Endpoint slots are taken before the global one, so a call queued on a
+	// busy endpoint does not hold a slot calls to other endpoints could use
+	levels := []*slots{p.endpointSlots(endpoint), p.global}
+	priority := priorityOf(ctx)
+	taken := 0
+	release := func() {
+		for _, level := range levels[:taken] {
+			level.release()
+		}
+	}
+	for _, level := range levels {
+		if !level.acquire(wait, priority) {
+			release()
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, ErrPoolTimeout
+		}
+		taken++
+	}
+	return release, nil
+}
+
+func (p *Pool) endpointSlots(endpoint string) *slots {
+	if p.limits.MaxPerEndpoint <= 0 {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	level, ok := p.endpoints[endpoint]
+	if !ok {
+		level = newSlots(p.limits.MaxPerEndpoint)
+		p.endpoints[endpoint] = level
+	}
+	return level
+}
+
+// slots is a counting semaphore that hands freed slots to its waiters by
+// priority, then in arrival order. A nil slots is unlimited.
+type slots struct {
+	mu      sync.Mutex
+	free    int
+	waiting [PriorityBenchmark + 1][]chan struct{}
+}
+
+func newSlots(n int) *slots {
+	if n <= 0 {
+		return nil
+	}
+	return &slots{free: n}
+}
+
+// acquire waits for a slot until ctx is done and reports whether it got one
+func (s *slots) acquire(ctx context.Context, priority Priority) bool {
+	if s == nil {
+		return true
+	}
+	s.mu.Lock()
+	if s.free > 0 {
+		s.free--
+		s.mu.Unlock()
+		return true
+	}
+	ready := make(chan struct{})
+	s.waiting[priority] = append(s.waiting[priority], ready)
+	s.mu.Unlock()
+
+	select {
+	case <-ready:
+		return true
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-ready:
+		// The slot was handed over as the wait ended; pass it on
+		s.handOff()
+	default:
+		queue := s.waiting[priority]
+		for i, waiter := range queue {
+			if waiter == ready {
+				s.waiting[priority] = append(queue[:i:i], queue[i+1:]...)
+				break
+			}
+		}
+	}
+	return false
+}
+
+func (s *slots) release() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handOff()
+}
+
+// handOff gives a freed slot to the first waiter of the highest priority
+// queued, or returns it to the pool. The caller holds mu.
+func (s *slots) handOff() {
+	for priority, queue := range s.waiting {
+		if len(queue) > 0 {
+			close(queue[0])
+			s.waiting[priority] = queue[1:]
+			return
+		}
+	}
+	s.free++
+}
+
+// poolClient holds a pool slot for the duration of every call; a batch takes
+// one slot, as it is a single upstream request
+type poolClient struct {
+	next     rpc.JSONRPCClient
+	pool     *Pool
+	endpoint string
+}
+
+func (c *poolClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	release, err := c.pool.acquire(ctx, c.endpoint)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return c.next.CallForInto(ctx, out, method, params)
+}
+
+func (c *poolClient) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	release, err := c.pool.acquire(ctx, c.endpoint)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return c.next.CallWithCallback(ctx, method, params, callback)
+}
+
+func (c *poolClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	release, err := c.pool.acquire(ctx, c.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return c.next.CallBatch(ctx, requests)
+}
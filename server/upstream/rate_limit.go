@@ -0,0 +1,19 @@
+package upstream
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// IsRateLimited reports whether an upstream call failed because the provider
+// is rate limiting, as an HTTP 429 or a JSON-RPC error carrying that code
+func IsRateLimited(err error) bool {
+	var httpErr *jsonrpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.Code == http.StatusTooManyRequests {
+		return true
+	}
+	var rpcErr *jsonrpc.RPCError
+	return errors.As(err, &rpcErr) && rpcErr.Code == http.StatusTooManyRequests
+}
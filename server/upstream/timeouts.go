@@ -0,0 +1,101 @@
+package upstream
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/klauspost/compress/gzhttp"
+)
+
+// Timeouts bounds upstream JSON-RPC calls, so a hung upstream cannot hold a
+// handler or its stream forever
+type Timeouts struct {
+	// Default bounds calls to methods without an entry in Methods; zero
+	// leaves them bounded only by the caller's deadline
+	Default time.Duration
+	// Methods bounds calls per JSON-RPC method, such as getBlock
+	Methods map[string]time.Duration
+	// Margin is kept free before the caller's deadline, so that a handler
+	// can still report an upstream timeout before its own deadline expires
+	Margin time.Duration
+}
+
+// DefaultTimeouts allow the heavier methods more time than point lookups
+var DefaultTimeouts = Timeouts{
+	Default: 10 * time.Second,
+	Methods: map[string]time.Duration{
+		"getBlock":           30 * time.Second,
+		"getProgramAccounts": 60 * time.Second,
+	},
+	Margin: 200 * time.Millisecond,
+}
+
+// timeout returns the bound for a call to method
+func (t Timeouts) timeout(method string) time.Duration {
+	if timeout, ok := t.Methods[method]; ok {
+		return timeout
+	}
+	return t.Default
+}
+
+// bound derives the context of an upstream call from the caller's: the method
+// timeout, shortened to end Margin before the caller's deadline
+func (t Timeouts) bound(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if deadline, ok := ctx.Deadline(); ok {
+		remaining := time.Until(deadline) - t.Margin
+		if timeout <= 0 || remaining < timeout {
+			// A deadline too close to leave the margin fails the call at once
+			timeout = remaining
+			if timeout <= 0 {
+				timeout = time.Nanosecond
+			}
+		}
+	}
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// timeoutClient bounds every call made through a JSON-RPC client
+type timeoutClient struct {
+	next     rpc.JSONRPCClient
+	timeouts Timeouts
+}
+
+func (c *timeoutClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	ctx, cancel := c.timeouts.bound(ctx, c.timeouts.timeout(method))
+	defer cancel()
+	return c.next.CallForInto(ctx, out, method, params)
+}
+
+func (c *timeoutClient) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	ctx, cancel := c.timeouts.bound(ctx, c.timeouts.timeout(method))
+	defer cancel()
+	return c.next.CallWithCallback(ctx, method, params, callback)
+}
+
+// CallBatch bounds a batch by the longest timeout of its methods
+func (c *timeoutClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	timeout := time.Duration(0)
+	for _, request := range requests {
+		if t := c.timeouts.timeout(request.Method); t > timeout {
+			timeout = t
+		}
+	}
+	ctx, cancel := c.timeouts.bound(ctx, timeout)
+	defer cancel()
+	return c.next.CallBatch(ctx, requests)
+}
+
+// newHTTPClient returns an HTTP client with the connection pooling solana-go
+// uses by default, which asks for compressed responses and decompresses them
+func newHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxConnsPerHost = 9
+	transport.MaxIdleConnsPerHost = 9
+	return &http.Client{Transport: gzhttp.Transport(transport)}
+}
@@ -0,0 +1,110 @@
+package upstream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+)
+
+// Timing accumulates the time spent in the upstream calls made with a
+// context returned by WithTiming, splitting round trips from decoding
+type Timing struct {
+	mu     sync.Mutex
+	stages Stages
+}
+
+// Stages is a snapshot of a Timing
+type Stages struct {
+	// First and Last are when the first call started and the last one ended;
+	// both are zero if no call was made
+	First, Last time.Time
+	// RPC is the time spent in round trips, excluding decoding their results
+	RPC time.Duration
+	// Decode is the time spent decoding results
+	Decode time.Duration
+}
+
+type timingKey struct{}
+
+// WithTiming returns a context whose upstream calls are recorded in the
+// returned Timing
+func WithTiming(ctx context.Context) (context.Context, *Timing) {
+	t := &Timing{}
+	return context.WithValue(ctx, timingKey{}, t), t
+}
+
+// Stages returns the time recorded so far
+func (t *Timing) Stages() Stages {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stages
+}
+
+func (t *Timing) add(start, decoded, end time.Time, decode bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stages.First.IsZero() || start.Before(t.stages.First) {
+		t.stages.First = start
+	}
+	if end.After(t.stages.Last) {
+		t.stages.Last = end
+	}
+	if decode {
+		t.stages.RPC += decoded.Sub(start)
+		t.stages.Decode += end.Sub(decoded)
+	} else {
+		t.stages.RPC += end.Sub(start)
+	}
+}
+
+// timingClient records calls made with a timed context. Results are fetched
+// raw and decoded here, so decoding can be timed apart from the round trip.
+type timingClient struct {
+	next rpc.JSONRPCClient
+}
+
+func (c *timingClient) CallForInto(ctx context.Context, out interface{}, method string, params []interface{}) error {
+	t, _ := ctx.Value(timingKey{}).(*Timing)
+	if t == nil {
+		return c.next.CallForInto(ctx, out, method, params)
+	}
+
+	start := time.Now()
+	var raw json.RawMessage
+	err := c.next.CallForInto(ctx, &raw, method, params)
+	decoded := time.Now()
+	if err != nil {
+		t.add(start, decoded, decoded, false)
+		return err
+	}
+	err = json.Unmarshal(raw, out)
+	t.add(start, decoded, time.Now(), true)
+	return err
+}
+
+func (c *timingClient) CallWithCallback(ctx context.Context, method string, params []interface{}, callback func(*http.Request, *http.Response) error) error {
+	t, _ := ctx.Value(timingKey{}).(*Timing)
+	start := time.Now()
+	err := c.next.CallWithCallback(ctx, method, params, callback)
+	if t != nil {
+		t.add(start, start, time.Now(), false)
+	}
+	return err
+}
+
+// CallBatch counts decoding towards the round trip, as the batch's results
+// are decoded by the caller
+func (c *timingClient) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	t, _ := ctx.Value(timingKey{}).(*Timing)
+	start := time.Now()
+	responses, err := c.next.CallBatch(ctx, requests)
+	if t != nil {
+		t.add(start, start, time.Now(), false)
+	}
+	return responses, err
+}
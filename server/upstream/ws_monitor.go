@@ -0,0 +1,178 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc/ws"
+)
+
+// wsStallTimeout is how long a connection may go without a slot notification,
+// which arrive every slot, before it is considered dead
+const wsStallTimeout = 30 * time.Second
+
+// WSState is the state of a monitored WebSocket connection
+type WSState int
+
+const (
+	WSConnecting WSState = iota
+	WSConnected
+	WSDisconnected
+)
+
+// WSStatus is a snapshot of a WSMonitor
+type WSStatus struct {
+	State WSState
+	// Since is when the connection entered its state
+	Since time.Time
+	// Connects and Disconnects count the connections made and lost
+	Connects, Disconnects uint64
+	// LastError is why the last connection attempt or connection failed
+	LastError string
+	// LastSlot is the slot of the last notification, received at LastNotification
+	LastSlot         uint64
+	LastNotification time.Time
+	// ReconnectDelay is the backoff before the next attempt while disconnected
+	ReconnectDelay time.Duration
+}
+
+// WSMonitor keeps a slot subscription open to the upstream WebSocket
+// endpoint, reconnecting with backoff, to track whether the streams that
+// depend on the endpoint can be served
+type WSMonitor struct {
+	endpoint Endpoint
+	mu       sync.Mutex
+	status   WSStatus
+}
+
+// NewWSMonitor creates a monitor for an endpoint. Run starts monitoring.
+func NewWSMonitor(endpoint Endpoint) *WSMonitor {
+	return &WSMonitor{
+		endpoint: endpoint,
+		status:   WSStatus{State: WSConnecting, Since: time.Now()},
+	}
+}
+
+// Status returns the current state of the connection
+func (m *WSMonitor) Status() WSStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status
+}
+
+// Run monitors the connection until ctx is done
+func (m *WSMonitor) Run(ctx context.Context) {
+	Reconnect(ctx, &Backoff{}, func(ctx context.Context) error {
+		m.setState(WSConnecting)
+		return m.watch(ctx)
+	}, func(err error, delay time.Duration) {
+		m.disconnected(err, delay)
+		log.Printf("Upstream WebSocket disconnected, reconnecting in %v: %v", delay, err)
+	})
+}
+
+// watch connects and receives slot notifications until the connection fails,
+// stalls, or ctx is done
+func (m *WSMonitor) watch(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wsClient, err := m.endpoint.ConnectWS(ctx)
+	if err != nil {
+		return err
+	}
+	defer wsClient.Close()
+
+	sub, err := wsClient.SlotSubscribe()
+	if err != nil {
+		return err
+	}
+	// Unsubscribing before the client closes unblocks the receiver
+	defer sub.Unsubscribe()
+
+	m.connected()
+	log.Printf("Upstream WebSocket connected")
+
+	slots := make(chan uint64)
+	errs := make(chan error, 1)
+	go receiveSlots(ctx, sub, slots, errs)
+
+	stall := time.NewTimer(wsStallTimeout)
+	defer stall.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errs:
+			return err
+		case <-stall.C:
+			return fmt.Errorf("no slot notification for %v", wsStallTimeout)
+		case slot := <-slots:
+			m.notified(slot)
+			if !stall.Stop() {
+				<-stall.C
+			}
+			stall.Reset(wsStallTimeout)
+		}
+	}
+}
+
+// receiveSlots forwards the slots of a subscription until it fails
+func receiveSlots(ctx context.Context, sub *ws.SlotSubscription, slots chan<- uint64, errs chan<- error) {
+	for {
+		result, err := sub.Recv()
+		if err == nil && result == nil {
+			err = errors.New("subscription closed")
+		}
+		if err != nil {
+			errs <- err
+			return
+		}
+		select {
+		case slots <- result.Slot:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (m *WSMonitor) setState(state WSState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.status.State = state
+	m.status.Since = time.Now()
+}
+
+func (m *WSMonitor) connected() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.status.State = WSConnected
+	m.status.Since = time.Now()
+	m.status.Connects++
+	m.status.ReconnectDelay = 0
+}
+
+func (m *WSMonitor) disconnected(err error, delay time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.status.State == WSConnected {
+		m.status.Disconnects++
+	}
+	m.status.State = WSDisconnected
+	m.status.Since = time.Now()
+	m.status.ReconnectDelay = delay
+	if err != nil {
+		m.status.LastError = err.Error()
+	}
+}
+
+func (m *WSMonitor) notified(slot uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.status.LastSlot = slot
+	m.status.LastNotification = time.Now()
+}
@@ -0,0 +1,107 @@
+package yellowstone
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto/geyser"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Adapter serves the Yellowstone geyser.Geyser API from the server's own
+// upstream, so existing Yellowstone client tooling can connect unchanged
+type Adapter struct {
+	geyser.UnimplementedGeyserServer
+	solanaClient *rpc.Client
+	endpoint     upstream.Endpoint
+	writeVersion atomic.Uint64
+}
+
+// NewAdapter creates a Yellowstone adapter for an upstream endpoint
+func NewAdapter(endpoint upstream.Endpoint) *Adapter {
+	return &Adapter{
+		solanaClient: endpoint.NewRPCClient(),
+		endpoint:     endpoint,
+	}
+}
+
+// Ping echoes the ping count
+func (a *Adapter) Ping(ctx context.Context, req *geyser.PingRequest) (*geyser.PongResponse, error) {
+	return &geyser.PongResponse{Count: req.Count}, nil
+}
+
+// GetLatestBlockhash returns the latest blockhash at the requested commitment
+func (a *Adapter) GetLatestBlockhash(ctx context.Context, req *geyser.GetLatestBlockhashRequest) (*geyser.GetLatestBlockhashResponse, error) {
+	result, err := a.solanaClient.GetLatestBlockhash(ctx, commitment(req.Commitment))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get latest blockhash: %v", err)
+	}
+
+	return &geyser.GetLatestBlockhashResponse{
+		Slot:                 result.Context.Slot,
+		Blockhash:            result.Value.Blockhash.String(),
+		LastValidBlockHeight: result.Value.LastValidBlockHeight,
+	}, nil
+}
+
+// GetBlockHeight returns the block height at the requested commitment
+func (a *Adapter) GetBlockHeight(ctx context.Context, req *geyser.GetBlockHeightRequest) (*geyser.GetBlockHeightResponse, error) {
+	height, err := a.solanaClient.GetBlockHeight(ctx, commitment(req.Commitment))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get block height: %v", err)
+	}
+	return &geyser.GetBlockHeightResponse{BlockHeight: height}, nil
+}
+
+// GetSlot returns the slot at the requested commitment
+func (a *Adapter) GetSlot(ctx context.Context, req *geyser.GetSlotRequest) (*geyser.GetSlotResponse, error) {
+	slot, err := a.solanaClient.GetSlot(ctx, commitment(req.Commitment))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get slot: %v", err)
+	}
+	return &geyser.GetSlotResponse{Slot: slot}, nil
+}
+
+// IsBlockhashValid reports whether a blockhash is still valid
+func (a *Adapter) IsBlockhashValid(ctx context.Context, req *geyser.IsBlockhashValidRequest) (*geyser.IsBlockhashValidResponse, error) {
+	blockhash, err := solana.HashFromBase58(req.Blockhash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blockhash: %v", err)
+	}
+
+	result, err := a.solanaClient.IsBlockhashValid(ctx, blockhash, commitment(req.Commitment))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check blockhash: %v", err)
+	}
+	return &geyser.IsBlockhashValidResponse{Slot: result.Context.Slot, Valid: result.Value}, nil
+}
+
+// GetVersion returns the upstream node version
+func (a *Adapter) GetVersion(ctx context.Context, req *geyser.GetVersionRequest) (*geyser.GetVersionResponse, error) {
+	version, err := a.solanaClient.GetVersion(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get version: %v", err)
+	}
+	return &geyser.GetVersionResponse{Version: version.SolanaCore}, nil
+}
+
+// commitment maps a Yellowstone commitment level to an RPC commitment,
+// defaulting to processed like Yellowstone does
+func commitment(level *geyser.CommitmentLevel) rpc.CommitmentType {
+	if level == nil {
+		return rpc.CommitmentProcessed
+	}
+
+	switch *level {
+	case geyser.CommitmentLevel_CONFIRMED:
+		return rpc.CommitmentConfirmed
+	case geyser.CommitmentLevel_FINALIZED:
+		return rpc.CommitmentFinalized
+	default:
+		return rpc.CommitmentProcessed
+	}
+}
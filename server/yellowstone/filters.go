@@ -0,0 +1,243 @@
+package yellowstone
+
+import (
+	"bytes"
+	"encoding/base64"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto/geyser"
+	"github.com/mr-tron/base58"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// tokenAccountSize is the size of an SPL token account
+	tokenAccountSize = 165
+	// tokenAccountStateOffset is the offset of the account state in an SPL token account
+	tokenAccountStateOffset = 108
+	// maxAccountSize is the most data a Solana account can hold
+	maxAccountSize = 10 * 1024 * 1024
+)
+
+// subscription is a validated Yellowstone subscribe request
+type subscription struct {
+	commitment rpc.CommitmentType
+	accounts   map[string]*accountFilter
+	slots      map[string]*geyser.SubscribeRequestFilterSlots
+	blocksMeta []string
+	dataSlices []*geyser.SubscribeRequestAccountsDataSlice
+}
+
+// accountFilter is a named account filter. An account matches when it is one
+// of the listed accounts (if any), is owned by one of the listed owners (if
+// any) and passes every data filter.
+type accountFilter struct {
+	accounts map[solana.PublicKey]bool
+	owners   map[solana.PublicKey]bool
+	filters  []*geyser.SubscribeRequestFilterAccountsFilter
+	memcmp   []memcmpFilter
+}
+
+type memcmpFilter struct {
+	offset uint64
+	bytes  []byte
+}
+
+// newSubscription validates a subscribe request. Update kinds the adapter
+// cannot produce are rejected rather than silently ignored.
+func newSubscription(req *geyser.SubscribeRequest) (*subscription, error) {
+	switch {
+	case len(req.Transactions) > 0, len(req.TransactionsStatus) > 0:
+		return nil, status.Error(codes.Unimplemented, "transaction subscriptions are not supported by this adapter")
+	case len(req.Blocks) > 0:
+		return nil, status.Error(codes.Unimplemented, "block subscriptions are not supported by this adapter; use blocks_meta")
+	case len(req.Entry) > 0:
+		return nil, status.Error(codes.Unimplemented, "entry subscriptions are not supported by this adapter")
+	case req.FromSlot != nil:
+		return nil, status.Error(codes.Unimplemented, "from_slot replay is not supported by this adapter")
+	}
+
+	sub := &subscription{
+		commitment: commitment(req.Commitment),
+		accounts:   make(map[string]*accountFilter, len(req.Accounts)),
+		slots:      req.Slots,
+		dataSlices: req.AccountsDataSlice,
+	}
+	for name := range req.BlocksMeta {
+		sub.blocksMeta = append(sub.blocksMeta, name)
+	}
+
+	for name, reqFilter := range req.Accounts {
+		filter, err := newAccountFilter(name, reqFilter)
+		if err != nil {
+			return nil, err
+		}
+		sub.accounts[name] = filter
+	}
+
+	return sub, nil
+}
+
+func newAccountFilter(name string, req *geyser.SubscribeRequestFilterAccounts) (*accountFilter, error) {
+	if len(req.Account) == 0 && len(req.Owner) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "account filter %s must list accounts or owners", name)
+	}
+	if req.NonemptyTxnSignature != nil {
+		return nil, status.Errorf(codes.Unimplemented, "account filter %s: nonempty_txn_signature is not supported", name)
+	}
+
+	filter := &accountFilter{
+		accounts: make(map[solana.PublicKey]bool, len(req.Account)),
+		owners:   make(map[solana.PublicKey]bool, len(req.Owner)),
+		filters:  req.Filters,
+	}
+	for _, account := range req.Account {
+		pubkey, err := solana.PublicKeyFromBase58(account)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "account filter %s: invalid account: %v", name, err)
+		}
+		filter.accounts[pubkey] = true
+	}
+	for _, owner := range req.Owner {
+		pubkey, err := solana.PublicKeyFromBase58(owner)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "account filter %s: invalid owner: %v", name, err)
+		}
+		filter.owners[pubkey] = true
+	}
+
+	for _, f := range req.Filters {
+		memcmp := f.GetMemcmp()
+		if memcmp == nil {
+			continue
+		}
+		var data []byte
+		switch d := memcmp.Data.(type) {
+		case *geyser.SubscribeRequestFilterAccountsFilterMemcmp_Bytes:
+			data = d.Bytes
+		case *geyser.SubscribeRequestFilterAccountsFilterMemcmp_Base58:
+			decoded, err := base58.Decode(d.Base58)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "account filter %s: invalid memcmp base58: %v", name, err)
+			}
+			data = decoded
+		case *geyser.SubscribeRequestFilterAccountsFilterMemcmp_Base64:
+			decoded, err := base64.StdEncoding.DecodeString(d.Base64)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "account filter %s: invalid memcmp base64: %v", name, err)
+			}
+			data = decoded
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "account filter %s: memcmp requires data", name)
+		}
+		if memcmp.Offset > maxAccountSize || len(data) > maxAccountSize {
+			return nil, status.Errorf(codes.InvalidArgument, "account filter %s: memcmp offset %d and %d bytes exceed the maximum account size", name, memcmp.Offset, len(data))
+		}
+		filter.memcmp = append(filter.memcmp, memcmpFilter{offset: memcmp.Offset, bytes: data})
+	}
+
+	return filter, nil
+}
+
+// matches reports whether an account passes the filter
+func (f *accountFilter) matches(pubkey solana.PublicKey, account *rpc.Account) bool {
+	if len(f.accounts) > 0 && !f.accounts[pubkey] {
+		return false
+	}
+	if len(f.owners) > 0 && !f.owners[account.Owner] {
+		return false
+	}
+
+	data := accountData(account)
+	for _, m := range f.memcmp {
+		if m.offset > uint64(len(data)) {
+			return false
+		}
+		end := m.offset + uint64(len(m.bytes))
+		if end > uint64(len(data)) || !bytes.Equal(data[m.offset:end], m.bytes) {
+			return false
+		}
+	}
+
+	for _, filter := range f.filters {
+		switch kind := filter.Filter.(type) {
+		case *geyser.SubscribeRequestFilterAccountsFilter_Datasize:
+			if uint64(len(data)) != kind.Datasize {
+				return false
+			}
+		case *geyser.SubscribeRequestFilterAccountsFilter_TokenAccountState:
+			// An initialized or frozen SPL token account
+			isTokenAccount := len(data) == tokenAccountSize && data[tokenAccountStateOffset] != 0
+			if isTokenAccount != kind.TokenAccountState {
+				return false
+			}
+		case *geyser.SubscribeRequestFilterAccountsFilter_Lamports:
+			if !matchesLamports(account.Lamports, kind.Lamports) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// rpcFilters returns the filters the upstream can apply server-side
+func (f *accountFilter) rpcFilters() []rpc.RPCFilter {
+	var filters []rpc.RPCFilter
+	for _, m := range f.memcmp {
+		filters = append(filters, rpc.RPCFilter{
+			Memcmp: &rpc.RPCFilterMemcmp{Offset: m.offset, Bytes: solana.Base58(m.bytes)},
+		})
+	}
+	for _, filter := range f.filters {
+		if size, ok := filter.Filter.(*geyser.SubscribeRequestFilterAccountsFilter_Datasize); ok {
+			filters = append(filters, rpc.RPCFilter{DataSize: size.Datasize})
+		}
+	}
+	return filters
+}
+
+func matchesLamports(lamports uint64, cmp *geyser.SubscribeRequestFilterAccountsFilterLamports) bool {
+	switch c := cmp.Cmp.(type) {
+	case *geyser.SubscribeRequestFilterAccountsFilterLamports_Eq:
+		return lamports == c.Eq
+	case *geyser.SubscribeRequestFilterAccountsFilterLamports_Ne:
+		return lamports != c.Ne
+	case *geyser.SubscribeRequestFilterAccountsFilterLamports_Lt:
+		return lamports < c.Lt
+	case *geyser.SubscribeRequestFilterAccountsFilterLamports_Gt:
+		return lamports > c.Gt
+	default:
+		return true
+	}
+}
+
+// sliceData applies the requested data slices, concatenating them as Yellowstone does
+func sliceData(data []byte, slices []*geyser.SubscribeRequestAccountsDataSlice) []byte {
+	if len(slices) == 0 {
+		return data
+	}
+
+	var out []byte
+	for _, slice := range slices {
+		start := slice.Offset
+		if start > uint64(len(data)) {
+			continue
+		}
+		end := start + slice.Length
+		if end > uint64(len(data)) {
+			end = uint64(len(data))
+		}
+		out = append(out, data[start:end]...)
+	}
+	return out
+}
+
+func accountData(account *rpc.Account) []byte {
+	if account.Data == nil {
+		return nil
+	}
+	return account.Data.GetBinary()
+}
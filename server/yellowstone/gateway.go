@@ -0,0 +1,281 @@
+package yellowstone
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/i-tozer/solana-grpc-exploration/proto/geyser"
+	"github.com/i-tozer/solana-grpc-exploration/server/hub"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// DefaultSubscriberQueue is the number of account events queued per
+	// subscriber by default. Subscribers that fall further behind are dropped.
+	DefaultSubscriberQueue = 1000
+	// maxGatewayMessageSize is the largest update accepted from the upstream
+	maxGatewayMessageSize = 64 * 1024 * 1024
+
+	// Names of the upstream filters carrying the union of subscriber interests
+	gatewayAccountsFilter = "accounts"
+	gatewayOwnersFilter   = "owners"
+)
+
+// AccountEvent is an account update received from the upstream Yellowstone
+// subscription
+type AccountEvent struct {
+	Pubkey   solana.PublicKey
+	Owner    solana.PublicKey
+	Lamports uint64
+	Data     []byte
+	Slot     uint64
+}
+
+// Gateway multiplexes many account subscribers onto a single upstream
+// Yellowstone subscription. Subscribers subscribe to account and program
+// topics of a hub, the upstream filters are the union of the subscribed
+// topics, and updates are published on the topics of their account and owner.
+type Gateway struct {
+	conn       *grpc.ClientConn
+	client     geyser.GeyserClient
+	token      string
+	commitment rpc.CommitmentType
+
+	hub     *hub.Hub[AccountEvent]
+	changed chan struct{}
+}
+
+// NewGateway connects to a Yellowstone endpoint such as https://host:443.
+// The token, if set, is sent as the x-token header.
+func NewGateway(endpoint, token string, commitment rpc.CommitmentType) (*Gateway, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid yellowstone endpoint: %v", err)
+	}
+
+	var creds credentials.TransportCredentials
+	port := u.Port()
+	switch u.Scheme {
+	case "https":
+		creds = credentials.NewTLS(&tls.Config{})
+		if port == "" {
+			port = "443"
+		}
+	case "http":
+		creds = insecure.NewCredentials()
+		if port == "" {
+			port = "80"
+		}
+	default:
+		return nil, fmt.Errorf("yellowstone endpoint must be an http or https URL: %s", endpoint)
+	}
+
+	conn, err := grpc.Dial(net.JoinHostPort(u.Hostname(), port),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxGatewayMessageSize)),
+		// Reuse receive buffers across upstream updates. Unmarshalling copies
+		// account data out of the buffer, so events never alias it.
+		grpc.WithRecvBufferPool(grpc.NewSharedBufferPool()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to yellowstone endpoint: %v", err)
+	}
+
+	g := &Gateway{
+		conn:       conn,
+		client:     geyser.NewGeyserClient(conn),
+		token:      token,
+		commitment: commitment,
+		changed:    make(chan struct{}, 1),
+	}
+	// The filters are resent whenever a topic gains its first subscriber or
+	// loses its last
+	g.hub = hub.New[AccountEvent](DefaultSubscriberQueue, func(hub.Topic) func() {
+		g.notifyChanged()
+		return g.notifyChanged
+	})
+	return g, nil
+}
+
+// Commitment returns the commitment level of the upstream subscription
+func (g *Gateway) Commitment() rpc.CommitmentType {
+	return g.commitment
+}
+
+// Close closes the upstream connection
+func (g *Gateway) Close() error {
+	return g.conn.Close()
+}
+
+// Subscribe subscribes to updates to the given accounts and to every account
+// owned by the given owners, queueing up to queue events. The subscription's
+// channel is closed when the subscriber falls further behind.
+func (g *Gateway) Subscribe(accounts, owners []solana.PublicKey, queue int) *hub.Subscription[AccountEvent] {
+	topics := make([]hub.Topic, 0, len(accounts)+len(owners))
+	for _, account := range accounts {
+		topics = append(topics, hub.AccountTopic(account))
+	}
+	for _, owner := range owners {
+		topics = append(topics, hub.ProgramTopic(owner))
+	}
+	return g.hub.SubscribeQueue(queue, topics...)
+}
+
+// Stats returns the subscribers and queue depths of the gateway's topics
+func (g *Gateway) Stats() []hub.TopicStats {
+	return g.hub.Stats()
+}
+
+// Run maintains the upstream subscription until ctx is done, reconnecting
+// with backoff when the stream fails
+func (g *Gateway) Run(ctx context.Context) {
+	upstream.Reconnect(ctx, &upstream.Backoff{}, g.stream, func(err error, delay time.Duration) {
+		log.Printf("Yellowstone upstream stream failed, reconnecting in %v: %v", delay, err)
+	})
+}
+
+// stream runs one upstream subscribe stream, resending the filters whenever
+// the subscriber set changes
+func (g *Gateway) stream(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if g.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-token", g.token)
+	}
+	stream, err := g.client.Subscribe(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Send the current filters and every later change. Only this goroutine
+	// sends on the stream.
+	sendErrs := make(chan error, 1)
+	pings := make(chan int32, 1)
+	go func() {
+		if err := stream.Send(g.request()); err != nil {
+			sendErrs <- err
+			return
+		}
+		for {
+			var req *geyser.SubscribeRequest
+			select {
+			case <-ctx.Done():
+				return
+			case <-g.changed:
+				req = g.request()
+			case id := <-pings:
+				// Answering pings keeps load balancers from closing idle streams
+				req = &geyser.SubscribeRequest{Ping: &geyser.SubscribeRequestPing{Id: id}}
+			}
+			if err := stream.Send(req); err != nil {
+				sendErrs <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			select {
+			case sendErr := <-sendErrs:
+				return sendErr
+			default:
+				return err
+			}
+		}
+
+		switch u := update.UpdateOneof.(type) {
+		case *geyser.SubscribeUpdate_Ping:
+			select {
+			case pings <- 1:
+			default:
+			}
+		case *geyser.SubscribeUpdate_Account:
+			event, ok := accountEvent(u.Account)
+			if ok {
+				g.hub.Publish(ctx, event, hub.AccountTopic(event.Pubkey), hub.ProgramTopic(event.Owner))
+			}
+		}
+	}
+}
+
+// request builds the upstream subscribe request from the subscribed account
+// and program topics
+func (g *Gateway) request() *geyser.SubscribeRequest {
+	var accounts, owners []solana.PublicKey
+	for _, topic := range g.hub.Topics() {
+		switch topic.Kind {
+		case hub.TopicAccount:
+			accounts = append(accounts, topic.Pubkey)
+		case hub.TopicProgram:
+			owners = append(owners, topic.Pubkey)
+		}
+	}
+
+	level := geyser.CommitmentLevel_FINALIZED
+	switch g.commitment {
+	case rpc.CommitmentProcessed:
+		level = geyser.CommitmentLevel_PROCESSED
+	case rpc.CommitmentConfirmed:
+		level = geyser.CommitmentLevel_CONFIRMED
+	}
+
+	// Accounts and owners go in separate filters, since a single Yellowstone
+	// filter requires an account to match both lists
+	req := &geyser.SubscribeRequest{
+		Accounts:   make(map[string]*geyser.SubscribeRequestFilterAccounts),
+		Commitment: &level,
+	}
+	if len(accounts) > 0 {
+		filter := &geyser.SubscribeRequestFilterAccounts{}
+		for _, account := range accounts {
+			filter.Account = append(filter.Account, account.String())
+		}
+		req.Accounts[gatewayAccountsFilter] = filter
+	}
+	if len(owners) > 0 {
+		filter := &geyser.SubscribeRequestFilterAccounts{}
+		for _, owner := range owners {
+			filter.Owner = append(filter.Owner, owner.String())
+		}
+		req.Accounts[gatewayOwnersFilter] = filter
+	}
+	return req
+}
+
+// notifyChanged asks the upstream stream to resend its filters
+func (g *Gateway) notifyChanged() {
+	select {
+	case g.changed <- struct{}{}:
+	default:
+	}
+}
+
+// accountEvent converts an upstream account update
+func accountEvent(update *geyser.SubscribeUpdateAccount) (AccountEvent, bool) {
+	info := update.GetAccount()
+	if info == nil || len(info.Pubkey) != solana.PublicKeyLength || len(info.Owner) != solana.PublicKeyLength {
+		return AccountEvent{}, false
+	}
+
+	return AccountEvent{
+		Pubkey:   solana.PublicKeyFromBytes(info.Pubkey),
+		Owner:    solana.PublicKeyFromBytes(info.Owner),
+		Lamports: info.Lamports,
+		Data:     info.Data,
+		Slot:     update.Slot,
+	}, true
+}
@@ -0,0 +1,590 @@
+package yellowstone
+
+import (
+	"context"
+	"io"
+	"log"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+	"github.com/i-tozer/solana-grpc-exploration/proto/geyser"
+	"github.com/i-tozer/solana-grpc-exploration/server/upstream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// pingInterval is how often keepalive pings are sent on subscribe streams
+	pingInterval = 15 * time.Second
+	// updateBuffer is the number of updates buffered between the upstream
+	// subscriptions and the client stream
+	updateBuffer = 1000
+	// blockMetaPollInterval is how often new blocks are polled for blocks_meta filters
+	blockMetaPollInterval = 1 * time.Second
+	// maxResubscribeOutage is how long a subscription waits for the upstream
+	// to come back before failing
+	maxResubscribeOutage = 5 * time.Minute
+	// maxRereadAccounts is the getMultipleAccounts limit per request
+	maxRereadAccounts = 100
+)
+
+// Subscribe implements the Yellowstone bidirectional subscribe stream. Every
+// request carrying filters replaces the active subscription, while a request
+// carrying only a ping is answered with a pong and leaves it unchanged.
+func (a *Adapter) Subscribe(stream geyser.Geyser_SubscribeServer) error {
+	ctx := stream.Context()
+
+	// Receive requests until the client goes away
+	requests := make(chan *geyser.SubscribeRequest)
+	recvErrs := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErrs <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	updates := make(chan *geyser.SubscribeUpdate, updateBuffer)
+	sourceErrs := make(chan error, 1)
+	var cancelSources context.CancelFunc = func() {}
+	defer func() { cancelSources() }()
+
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	for {
+		var update *geyser.SubscribeUpdate
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case err := <-recvErrs:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case err := <-sourceErrs:
+			return err
+		case <-ping.C:
+			update = &geyser.SubscribeUpdate{
+				UpdateOneof: &geyser.SubscribeUpdate_Ping{Ping: &geyser.SubscribeUpdatePing{}},
+			}
+		case update = <-updates:
+		case req := <-requests:
+			if req.Ping != nil {
+				update = &geyser.SubscribeUpdate{
+					UpdateOneof: &geyser.SubscribeUpdate_Pong{Pong: &geyser.SubscribeUpdatePong{Id: req.Ping.Id}},
+				}
+				if isPingOnly(req) {
+					break
+				}
+			}
+
+			sub, err := newSubscription(req)
+			if err != nil {
+				return err
+			}
+			cancelSources()
+			sourceCtx, cancel := context.WithCancel(ctx)
+			cancelSources = cancel
+			if err := a.startSources(sourceCtx, sub, updates, sourceErrs); err != nil {
+				return err
+			}
+		}
+
+		if update == nil {
+			continue
+		}
+		if err := stream.Send(update); err != nil {
+			return status.Errorf(codes.Internal, "failed to send update: %v", err)
+		}
+	}
+}
+
+// startSources opens the upstream subscriptions a Yellowstone subscription
+// needs. They run until ctx is cancelled, and are resubscribed when they
+// fail; failed receives the error once the upstream has been gone for
+// maxResubscribeOutage.
+func (a *Adapter) startSources(ctx context.Context, sub *subscription, updates chan<- *geyser.SubscribeUpdate, failed chan<- error) error {
+	if len(sub.blocksMeta) > 0 {
+		go a.pollBlocksMeta(ctx, sub, updates)
+	}
+
+	if len(sub.accounts) == 0 && len(sub.slots) == 0 {
+		return nil
+	}
+
+	wsCtx, cancel := context.WithCancel(ctx)
+	errs := make(chan error, 1)
+	if err := a.subscribeWS(wsCtx, sub, updates, errs); err != nil {
+		cancel()
+		return err
+	}
+	go a.resubscribeOnFailure(ctx, sub, updates, errs, cancel, failed)
+	return nil
+}
+
+// resubscribeOnFailure waits for the WebSocket subscriptions to fail, then
+// reopens them with backoff and rereads the watched accounts, so the client
+// has their state as of the new subscriptions. Slot updates missed in
+// between are not replayed.
+func (a *Adapter) resubscribeOnFailure(ctx context.Context, sub *subscription, updates chan<- *geyser.SubscribeUpdate, errs chan error, cancelWS context.CancelFunc, failed chan<- error) {
+	for {
+		var cause error
+		select {
+		case <-ctx.Done():
+			cancelWS()
+			return
+		case cause = <-errs:
+		}
+		cancelWS()
+
+		outage := time.Now()
+		var backoff upstream.Backoff
+		for {
+			log.Printf("Yellowstone upstream subscription failed, resubscribing in %v: %v", backoff.Delay(), cause)
+			if backoff.Wait(ctx) != nil {
+				return
+			}
+
+			wsCtx, cancel := context.WithCancel(ctx)
+			errs = make(chan error, 1)
+			cause = a.subscribeWS(wsCtx, sub, updates, errs)
+			if cause == nil {
+				cancelWS = cancel
+				break
+			}
+			cancel()
+			if ctx.Err() != nil {
+				return
+			}
+			if time.Since(outage) > maxResubscribeOutage {
+				reportError(ctx, failed, status.Errorf(codes.Unavailable, "upstream subscription failed for %v: %v", maxResubscribeOutage, cause))
+				return
+			}
+		}
+
+		if err := a.rereadAccounts(ctx, sub, updates); err != nil {
+			log.Printf("Error rereading accounts after resubscribing: %v", err)
+		}
+	}
+}
+
+// rereadAccounts sends the current state of the accounts a subscription
+// watches, explicitly or by owner
+func (a *Adapter) rereadAccounts(ctx context.Context, sub *subscription, updates chan<- *geyser.SubscribeUpdate) error {
+	watched := make(map[solana.PublicKey]bool)
+	owners := make(map[solana.PublicKey][]*accountFilter)
+	for _, filter := range sub.accounts {
+		if len(filter.accounts) > 0 {
+			for pubkey := range filter.accounts {
+				watched[pubkey] = true
+			}
+			continue
+		}
+		for owner := range filter.owners {
+			owners[owner] = append(owners[owner], filter)
+		}
+	}
+
+	pubkeys := make([]solana.PublicKey, 0, len(watched))
+	for pubkey := range watched {
+		pubkeys = append(pubkeys, pubkey)
+	}
+	for start := 0; start < len(pubkeys); start += maxRereadAccounts {
+		end := min(start+maxRereadAccounts, len(pubkeys))
+		result, err := a.solanaClient.GetMultipleAccountsWithOpts(ctx, pubkeys[start:end], &rpc.GetMultipleAccountsOpts{
+			Commitment: sub.commitment,
+			Encoding:   solana.EncodingBase64,
+		})
+		if err != nil {
+			return err
+		}
+		for i, account := range result.Value {
+			if account == nil {
+				continue
+			}
+			pubkey := pubkeys[start+i]
+			filters := matchingFilters(sub, pubkey, account, func(f *accountFilter) bool { return len(f.accounts) > 0 })
+			if len(filters) > 0 && !send(ctx, updates, a.accountUpdate(sub, filters, pubkey, account, result.Context.Slot)) {
+				return nil
+			}
+		}
+	}
+
+	for owner, filters := range owners {
+		// getProgramAccounts returns no slot, so the accounts are reported as
+		// of the slot before the read
+		slot, err := a.solanaClient.GetSlot(ctx, sub.commitment)
+		if err != nil {
+			return err
+		}
+		opts := &rpc.GetProgramAccountsOpts{Commitment: sub.commitment, Encoding: solana.EncodingBase64}
+		if len(filters) == 1 {
+			opts.Filters = filters[0].rpcFilters()
+		}
+		accounts, err := a.solanaClient.GetProgramAccountsWithOpts(ctx, owner, opts)
+		if err != nil {
+			return err
+		}
+		for _, keyed := range accounts {
+			names := matchingFilters(sub, keyed.Pubkey, keyed.Account, func(f *accountFilter) bool { return len(f.accounts) == 0 })
+			if len(names) > 0 && !send(ctx, updates, a.accountUpdate(sub, names, keyed.Pubkey, keyed.Account, slot)) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// subscribeWS opens the WebSocket subscriptions of a Yellowstone
+// subscription, which report their first failure to errs. They run until ctx
+// is cancelled.
+func (a *Adapter) subscribeWS(ctx context.Context, sub *subscription, updates chan<- *geyser.SubscribeUpdate, errs chan<- error) error {
+	wsClient, err := a.endpoint.ConnectWS(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to connect to websocket endpoint: %v", err)
+	}
+
+	// Subscriptions must be closed before the connection, since closing the
+	// connection does not unblock pending receives
+	var unsubscribes []func()
+	closeAll := func() {
+		for _, unsubscribe := range unsubscribes {
+			unsubscribe()
+		}
+		wsClient.Close()
+	}
+	started := false
+	defer func() {
+		if started {
+			go func() {
+				<-ctx.Done()
+				closeAll()
+			}()
+		} else {
+			closeAll()
+		}
+	}()
+
+	// Accounts listed explicitly are watched individually, while filters that
+	// only name owners are served by one program subscription per owner
+	watched := make(map[solana.PublicKey]bool)
+	ownerFilters := make(map[solana.PublicKey][]*accountFilter)
+	for _, filter := range sub.accounts {
+		if len(filter.accounts) > 0 {
+			for pubkey := range filter.accounts {
+				watched[pubkey] = true
+			}
+			continue
+		}
+		for owner := range filter.owners {
+			ownerFilters[owner] = append(ownerFilters[owner], filter)
+		}
+	}
+
+	for pubkey := range watched {
+		accountSub, err := wsClient.AccountSubscribe(pubkey, sub.commitment)
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "failed to subscribe to account %s: %v", pubkey, err)
+		}
+		unsubscribes = append(unsubscribes, accountSub.Unsubscribe)
+		go a.forwardAccount(ctx, sub, pubkey, accountSub, updates, errs)
+	}
+
+	for owner, filters := range ownerFilters {
+		// Data filters can be applied upstream when a single filter uses the owner
+		var rpcFilters []rpc.RPCFilter
+		if len(filters) == 1 {
+			rpcFilters = filters[0].rpcFilters()
+		}
+		programSub, err := wsClient.ProgramSubscribeWithOpts(owner, sub.commitment, solana.EncodingBase64, rpcFilters)
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "failed to subscribe to owner %s: %v", owner, err)
+		}
+		unsubscribes = append(unsubscribes, programSub.Unsubscribe)
+		go a.forwardProgram(ctx, sub, programSub, updates, errs)
+	}
+
+	if len(sub.slots) > 0 {
+		slotsSub, err := wsClient.SlotsUpdatesSubscribe()
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "failed to subscribe to slot updates: %v", err)
+		}
+		unsubscribes = append(unsubscribes, slotsSub.Unsubscribe)
+		go forwardSlots(ctx, sub, slotsSub, updates, errs)
+	}
+
+	started = true
+	return nil
+}
+
+// forwardAccount relays notifications for an explicitly listed account
+func (a *Adapter) forwardAccount(ctx context.Context, sub *subscription, pubkey solana.PublicKey, accountSub *ws.AccountSubscription, updates chan<- *geyser.SubscribeUpdate, errs chan<- error) {
+	for {
+		result, err := accountSub.Recv()
+		if err != nil {
+			reportError(ctx, errs, err)
+			return
+		}
+		if result == nil {
+			return
+		}
+
+		account := &result.Value.Account
+		filters := matchingFilters(sub, pubkey, account, func(f *accountFilter) bool { return len(f.accounts) > 0 })
+		if len(filters) == 0 {
+			continue
+		}
+		if !send(ctx, updates, a.accountUpdate(sub, filters, pubkey, account, result.Context.Slot)) {
+			return
+		}
+	}
+}
+
+// forwardProgram relays notifications for accounts of an owner program
+func (a *Adapter) forwardProgram(ctx context.Context, sub *subscription, programSub *ws.ProgramSubscription, updates chan<- *geyser.SubscribeUpdate, errs chan<- error) {
+	for {
+		result, err := programSub.Recv()
+		if err != nil {
+			reportError(ctx, errs, err)
+			return
+		}
+		if result == nil {
+			return
+		}
+
+		pubkey, account := result.Value.Pubkey, result.Value.Account
+		filters := matchingFilters(sub, pubkey, account, func(f *accountFilter) bool { return len(f.accounts) == 0 })
+		if len(filters) == 0 {
+			continue
+		}
+		if !send(ctx, updates, a.accountUpdate(sub, filters, pubkey, account, result.Context.Slot)) {
+			return
+		}
+	}
+}
+
+// forwardSlots relays slot status updates to the slot filters that accept them
+func forwardSlots(ctx context.Context, sub *subscription, slotsSub *ws.SlotsUpdatesSubscription, updates chan<- *geyser.SubscribeUpdate, errs chan<- error) {
+	for {
+		result, err := slotsSub.Recv()
+		if err != nil {
+			reportError(ctx, errs, err)
+			return
+		}
+		if result == nil {
+			return
+		}
+
+		slotStatus, ok := slotStatuses[result.Type]
+		if !ok {
+			continue
+		}
+
+		// Statuses between processed and dead are only sent on request
+		interslot := slotStatus == geyser.SlotStatus_SLOT_FIRST_SHRED_RECEIVED ||
+			slotStatus == geyser.SlotStatus_SLOT_COMPLETED ||
+			slotStatus == geyser.SlotStatus_SLOT_CREATED_BANK
+
+		var filters []string
+		for name, filter := range sub.slots {
+			if interslot && !filter.GetInterslotUpdates() {
+				continue
+			}
+			if filter.GetFilterByCommitment() && slotStatus != commitmentSlotStatus[sub.commitment] {
+				continue
+			}
+			filters = append(filters, name)
+		}
+		if len(filters) == 0 {
+			continue
+		}
+
+		slot := &geyser.SubscribeUpdateSlot{Slot: result.Slot, Status: slotStatus}
+		if result.Parent != 0 {
+			parent := result.Parent
+			slot.Parent = &parent
+		}
+		if slotStatus == geyser.SlotStatus_SLOT_DEAD {
+			deadError := "slot marked dead by upstream"
+			slot.DeadError = &deadError
+		}
+
+		update := &geyser.SubscribeUpdate{
+			Filters:     filters,
+			UpdateOneof: &geyser.SubscribeUpdate_Slot{Slot: slot},
+		}
+		if !send(ctx, updates, update) {
+			return
+		}
+	}
+}
+
+// pollBlocksMeta polls for new blocks and emits their metadata. Blocks are not
+// available at processed commitment, so processed subscriptions use confirmed.
+func (a *Adapter) pollBlocksMeta(ctx context.Context, sub *subscription, updates chan<- *geyser.SubscribeUpdate) {
+	commitment := sub.commitment
+	if commitment == rpc.CommitmentProcessed {
+		commitment = rpc.CommitmentConfirmed
+	}
+
+	var next uint64
+	for {
+		tip, err := a.solanaClient.GetSlot(ctx, commitment)
+		if err != nil {
+			log.Printf("Error getting slot: %v", err)
+		} else if next == 0 {
+			// Start from the current tip
+			next = tip
+		}
+
+		if err == nil && tip >= next {
+			slots, err := a.solanaClient.GetBlocks(ctx, next, &tip, commitment)
+			if err != nil {
+				log.Printf("Error getting blocks: %v", err)
+				slots = nil
+			}
+			for _, slot := range slots {
+				meta, err := a.blockMeta(ctx, slot, commitment)
+				if err != nil {
+					log.Printf("Error getting block %d: %v", slot, err)
+					break
+				}
+				update := &geyser.SubscribeUpdate{
+					Filters:     sub.blocksMeta,
+					UpdateOneof: &geyser.SubscribeUpdate_BlockMeta{BlockMeta: meta},
+				}
+				if !send(ctx, updates, update) {
+					return
+				}
+				next = slot + 1
+			}
+			if err == nil && len(slots) == 0 {
+				next = tip + 1
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(blockMetaPollInterval):
+		}
+	}
+}
+
+// blockMeta reads the metadata of a block, counting its transactions from the
+// signature list
+func (a *Adapter) blockMeta(ctx context.Context, slot uint64, commitment rpc.CommitmentType) (*geyser.SubscribeUpdateBlockMeta, error) {
+	rewards := false
+	block, err := a.solanaClient.GetBlockWithOpts(ctx, slot, &rpc.GetBlockOpts{
+		TransactionDetails: rpc.TransactionDetailsSignatures,
+		Rewards:            &rewards,
+		Commitment:         commitment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &geyser.SubscribeUpdateBlockMeta{
+		Slot:                     slot,
+		Blockhash:                block.Blockhash.String(),
+		ParentSlot:               block.ParentSlot,
+		ParentBlockhash:          block.PreviousBlockhash.String(),
+		ExecutedTransactionCount: uint64(len(block.Signatures)),
+	}
+	if block.BlockTime != nil {
+		meta.BlockTime = &geyser.UnixTimestamp{Timestamp: int64(*block.BlockTime)}
+	}
+	if block.BlockHeight != nil {
+		meta.BlockHeight = &geyser.BlockHeight{BlockHeight: *block.BlockHeight}
+	}
+	return meta, nil
+}
+
+// accountUpdate builds a Yellowstone account update, assigning the next write version
+func (a *Adapter) accountUpdate(sub *subscription, filters []string, pubkey solana.PublicKey, account *rpc.Account, slot uint64) *geyser.SubscribeUpdate {
+	return &geyser.SubscribeUpdate{
+		Filters: filters,
+		UpdateOneof: &geyser.SubscribeUpdate_Account{Account: &geyser.SubscribeUpdateAccount{
+			Account: &geyser.SubscribeUpdateAccountInfo{
+				Pubkey:       pubkey.Bytes(),
+				Lamports:     account.Lamports,
+				Owner:        account.Owner.Bytes(),
+				Executable:   account.Executable,
+				RentEpoch:    account.RentEpoch,
+				Data:         sliceData(accountData(account), sub.dataSlices),
+				WriteVersion: a.writeVersion.Add(1),
+			},
+			Slot: slot,
+		}},
+	}
+}
+
+// slotStatuses maps upstream slot update types to Yellowstone slot statuses
+var slotStatuses = map[ws.SlotsUpdatesType]geyser.SlotStatus{
+	ws.SlotsUpdatesFirstShredReceived:     geyser.SlotStatus_SLOT_FIRST_SHRED_RECEIVED,
+	ws.SlotsUpdatesCompleted:              geyser.SlotStatus_SLOT_COMPLETED,
+	ws.SlotsUpdatesCreatedBank:            geyser.SlotStatus_SLOT_CREATED_BANK,
+	ws.SlotsUpdatesFrozen:                 geyser.SlotStatus_SLOT_PROCESSED,
+	ws.SlotsUpdatesDead:                   geyser.SlotStatus_SLOT_DEAD,
+	ws.SlotsUpdatesOptimisticConfirmation: geyser.SlotStatus_SLOT_CONFIRMED,
+	ws.SlotsUpdatesRoot:                   geyser.SlotStatus_SLOT_FINALIZED,
+}
+
+// commitmentSlotStatus maps a commitment to the slot status that reaches it
+var commitmentSlotStatus = map[rpc.CommitmentType]geyser.SlotStatus{
+	rpc.CommitmentProcessed: geyser.SlotStatus_SLOT_PROCESSED,
+	rpc.CommitmentConfirmed: geyser.SlotStatus_SLOT_CONFIRMED,
+	rpc.CommitmentFinalized: geyser.SlotStatus_SLOT_FINALIZED,
+}
+
+// matchingFilters returns the names of the account filters that accept an
+// account, considering only the filters served by the calling source
+func matchingFilters(sub *subscription, pubkey solana.PublicKey, account *rpc.Account, served func(*accountFilter) bool) []string {
+	var names []string
+	for name, filter := range sub.accounts {
+		if served(filter) && filter.matches(pubkey, account) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// isPingOnly reports whether a request carries nothing but a ping
+func isPingOnly(req *geyser.SubscribeRequest) bool {
+	return len(req.Accounts) == 0 && len(req.Slots) == 0 && len(req.Transactions) == 0 &&
+		len(req.TransactionsStatus) == 0 && len(req.Blocks) == 0 && len(req.BlocksMeta) == 0 &&
+		len(req.Entry) == 0 && len(req.AccountsDataSlice) == 0 && req.Commitment == nil && req.FromSlot == nil
+}
+
+// send delivers an update unless the subscription has been replaced or closed
+func send(ctx context.Context, updates chan<- *geyser.SubscribeUpdate, update *geyser.SubscribeUpdate) bool {
+	select {
+	case updates <- update:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// reportError surfaces an upstream failure unless the subscription has already
+// been replaced or closed
+func reportError(ctx context.Context, errs chan<- error, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	select {
+	case errs <- err:
+	default:
+	}
+}
@@ -0,0 +1,241 @@
+package testing
+
+import (
+	"github.com/gagliardetto/solana-go"
+	"github.com/i-tozer/solana-grpc-exploration/server/mock"
+)
+
+// AccountBuilder builds account fixtures
+type AccountBuilder struct {
+	pubkey  solana.PublicKey
+	account mock.Account
+}
+
+// NewAccount starts an account fixture with a fresh pubkey, owned by the
+// system program
+func NewAccount() *AccountBuilder {
+	return &AccountBuilder{
+		pubkey:  solana.NewWallet().PublicKey(),
+		account: mock.Account{Owner: solana.SystemProgramID},
+	}
+}
+
+// Pubkey sets the account address
+func (b *AccountBuilder) Pubkey(pubkey solana.PublicKey) *AccountBuilder {
+	b.pubkey = pubkey
+	return b
+}
+
+// Owner sets the owner program
+func (b *AccountBuilder) Owner(owner solana.PublicKey) *AccountBuilder {
+	b.account.Owner = owner
+	return b
+}
+
+// Lamports sets the balance
+func (b *AccountBuilder) Lamports(lamports uint64) *AccountBuilder {
+	b.account.Lamports = lamports
+	return b
+}
+
+// Data sets the account data
+func (b *AccountBuilder) Data(data []byte) *AccountBuilder {
+	b.account.Data = data
+	return b
+}
+
+// Executable marks the account as a program
+func (b *AccountBuilder) Executable() *AccountBuilder {
+	b.account.Executable = true
+	return b
+}
+
+// Build returns the account address and state
+func (b *AccountBuilder) Build() (solana.PublicKey, *mock.Account) {
+	account := b.account
+	return b.pubkey, &account
+}
+
+// Store builds the account, stores it in the backend and returns its address
+func (b *AccountBuilder) Store(backend *mock.Backend) solana.PublicKey {
+	pubkey, account := b.Build()
+	backend.SetAccount(pubkey, account)
+	return pubkey
+}
+
+// TransactionBuilder builds transaction fixtures. Transactions are signed by
+// a fresh fee payer and carry a transfer from it to each added recipient.
+type TransactionBuilder struct {
+	payer      solana.PrivateKey
+	recipients []solana.PublicKey
+	// fallback is the recipient of a transaction given none
+	fallback  solana.PublicKey
+	blockhash solana.Hash
+	slot      uint64
+	blockTime *int64
+	fee       uint64
+	err       interface{}
+}
+
+// NewTransaction starts a transaction fixture with a fresh fee payer
+func NewTransaction() *TransactionBuilder {
+	return &TransactionBuilder{
+		payer:    solana.NewWallet().PrivateKey,
+		fallback: solana.NewWallet().PublicKey(),
+		fee:      5000,
+	}
+}
+
+// Transfer adds a transfer instruction to the recipient
+func (b *TransactionBuilder) Transfer(recipient solana.PublicKey) *TransactionBuilder {
+	b.recipients = append(b.recipients, recipient)
+	return b
+}
+
+// Blockhash sets the recent blockhash
+func (b *TransactionBuilder) Blockhash(blockhash solana.Hash) *TransactionBuilder {
+	b.blockhash = blockhash
+	return b
+}
+
+// Slot sets the slot the transaction was processed in
+func (b *TransactionBuilder) Slot(slot uint64) *TransactionBuilder {
+	b.slot = slot
+	return b
+}
+
+// BlockTime sets the Unix time the transaction was processed at
+func (b *TransactionBuilder) BlockTime(blockTime int64) *TransactionBuilder {
+	b.blockTime = &blockTime
+	return b
+}
+
+// Failed marks the transaction as failed with an RPC transaction error
+func (b *TransactionBuilder) Failed(err interface{}) *TransactionBuilder {
+	b.err = err
+	return b
+}
+
+// Build signs the transaction and returns it
+func (b *TransactionBuilder) Build() (*mock.Transaction, error) {
+	// A transaction needs at least one instruction
+	recipients := b.recipients
+	if len(recipients) == 0 {
+		recipients = []solana.PublicKey{b.fallback}
+	}
+
+	instructions := make([]solana.Instruction, 0, len(recipients))
+	for _, recipient := range recipients {
+		instructions = append(instructions, solana.NewInstruction(
+			solana.SystemProgramID,
+			solana.AccountMetaSlice{
+				solana.Meta(b.payer.PublicKey()).WRITE().SIGNER(),
+				solana.Meta(recipient).WRITE(),
+			},
+			// System program transfer of zero lamports
+			[]byte{2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		))
+	}
+
+	tx, err := solana.NewTransaction(instructions, b.blockhash, solana.TransactionPayer(b.payer.PublicKey()))
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
+		if key.Equals(b.payer.PublicKey()) {
+			return &b.payer
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &mock.Transaction{
+		Slot:        b.slot,
+		BlockTime:   b.blockTime,
+		Transaction: tx,
+		Fee:         b.fee,
+		Err:         b.err,
+	}, nil
+}
+
+// Store builds the transaction, stores it in the backend and returns it
+func (b *TransactionBuilder) Store(backend *mock.Backend) (*mock.Transaction, error) {
+	tx, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	backend.AddTransaction(tx)
+	return tx, nil
+}
+
+// BlockBuilder builds block fixtures
+type BlockBuilder struct {
+	block mock.Block
+}
+
+// NewBlock starts a block fixture at a slot, with its parent at the previous
+// slot and fresh blockhashes
+func NewBlock(slot uint64) *BlockBuilder {
+	parent := uint64(0)
+	if slot > 0 {
+		parent = slot - 1
+	}
+	return &BlockBuilder{block: mock.Block{
+		Slot:              slot,
+		ParentSlot:        parent,
+		Blockhash:         solana.Hash(solana.NewWallet().PublicKey()),
+		PreviousBlockhash: solana.Hash(solana.NewWallet().PublicKey()),
+	}}
+}
+
+// Parent sets the parent slot and blockhash, linking the block to another
+func (b *BlockBuilder) Parent(slot uint64, blockhash solana.Hash) *BlockBuilder {
+	b.block.ParentSlot = slot
+	b.block.PreviousBlockhash = blockhash
+	return b
+}
+
+// Blockhash sets the block's blockhash
+func (b *BlockBuilder) Blockhash(blockhash solana.Hash) *BlockBuilder {
+	b.block.Blockhash = blockhash
+	return b
+}
+
+// BlockTime sets the block's Unix time
+func (b *BlockBuilder) BlockTime(blockTime int64) *BlockBuilder {
+	b.block.BlockTime = &blockTime
+	return b
+}
+
+// BlockHeight sets the block height
+func (b *BlockBuilder) BlockHeight(height uint64) *BlockBuilder {
+	b.block.BlockHeight = &height
+	return b
+}
+
+// Transactions adds transactions to the block
+func (b *BlockBuilder) Transactions(txs ...*mock.Transaction) *BlockBuilder {
+	b.block.Transactions = append(b.block.Transactions, txs...)
+	return b
+}
+
+// Build returns the block, stamping its transactions with the block's slot
+// and time
+func (b *BlockBuilder) Build() *mock.Block {
+	block := b.block
+	for _, tx := range block.Transactions {
+		tx.Slot = block.Slot
+		tx.BlockTime = block.BlockTime
+	}
+	return &block
+}
+
+// Store builds the block, stores it and its transactions in the backend and
+// returns it
+func (b *BlockBuilder) Store(backend *mock.Backend) *mock.Block {
+	block := b.Build()
+	backend.AddBlock(block)
+	return block
+}
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"crypto/ed25519"
+	"math/rand/v2"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Generator creates fixtures whose keys, hashes and signatures are derived
+// from a seed instead of fresh randomness, so two runs that generate the same
+// fixtures in the same order get bit-for-bit identical chain state. A
+// Generator is not safe for concurrent use.
+type Generator struct {
+	rng *rand.Rand
+}
+
+// NewGenerator returns a generator seeded with seed
+func NewGenerator(seed uint64) *Generator {
+	return &Generator{rng: rand.New(rand.NewPCG(seed, 0))}
+}
+
+// bytes fills b from the generator
+func (g *Generator) bytes(b []byte) {
+	for i := range b {
+		b[i] = byte(g.rng.Uint32())
+	}
+}
+
+// PrivateKey returns the next keypair
+func (g *Generator) PrivateKey() solana.PrivateKey {
+	seed := make([]byte, ed25519.SeedSize)
+	g.bytes(seed)
+	return solana.PrivateKey(ed25519.NewKeyFromSeed(seed))
+}
+
+// Pubkey returns the next account address
+func (g *Generator) Pubkey() solana.PublicKey {
+	return g.PrivateKey().PublicKey()
+}
+
+// Hash returns the next blockhash
+func (g *Generator) Hash() solana.Hash {
+	var hash solana.Hash
+	g.bytes(hash[:])
+	return hash
+}
+
+// Uint64n returns the next number in [0, n)
+func (g *Generator) Uint64n(n uint64) uint64 {
+	return g.rng.Uint64N(n)
+}
+
+// Account starts an account fixture like NewAccount, with a generated pubkey
+func (g *Generator) Account() *AccountBuilder {
+	return NewAccount().Pubkey(g.Pubkey())
+}
+
+// Transaction starts a transaction fixture like NewTransaction, with a
+// generated fee payer. Signing is deterministic, so its signature is too.
+func (g *Generator) Transaction() *TransactionBuilder {
+	b := NewTransaction()
+	b.payer = g.PrivateKey()
+	b.fallback = g.Pubkey()
+	return b
+}
+
+// Block starts a block fixture like NewBlock, with generated blockhashes
+func (g *Generator) Block(slot uint64) *BlockBuilder {
+	b := NewBlock(slot)
+	b.block.Blockhash = g.Hash()
+	b.block.PreviousBlockhash = g.Hash()
+	return b
+}
@@ -0,0 +1,147 @@
+package testing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/i-tozer/solana-grpc-exploration/proto"
+	harness "github.com/i-tozer/solana-grpc-exploration/testing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newServer(t *testing.T) *harness.Server {
+	t.Helper()
+	server, err := harness.NewServer()
+	if err != nil {
+		t.Fatalf("starting server: %v", err)
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGetAccountInfo(t *testing.T) {
+	server := newServer(t)
+	pubkey := harness.NewAccount().
+		Owner(solana.TokenProgramID).
+		Lamports(2039280).
+		Data([]byte{1, 2, 3}).
+		Store(server.Backend)
+
+	resp, err := server.Client.GetAccountInfo(context.Background(), &proto.AccountInfoRequest{
+		Pubkey:         pubkey.String(),
+		EncodingBinary: true,
+	})
+	if err != nil {
+		t.Fatalf("GetAccountInfo: %v", err)
+	}
+	if resp.Pubkey != pubkey.String() || resp.Owner != solana.TokenProgramID.String() || resp.Lamports != 2039280 {
+		t.Errorf("got account %s owned by %s with %d lamports", resp.Pubkey, resp.Owner, resp.Lamports)
+	}
+	if string(resp.Data) != "\x01\x02\x03" {
+		t.Errorf("got data %x, want 010203", resp.Data)
+	}
+}
+
+func TestGetTransactionAndBlock(t *testing.T) {
+	server := newServer(t)
+	tx, err := harness.NewTransaction().Transfer(solana.NewWallet().PublicKey()).Build()
+	if err != nil {
+		t.Fatalf("building transaction: %v", err)
+	}
+	failed, err := harness.NewTransaction().Failed(map[string]interface{}{"InstructionError": []interface{}{0, "InvalidArgument"}}).Build()
+	if err != nil {
+		t.Fatalf("building transaction: %v", err)
+	}
+	block := harness.NewBlock(1000).BlockTime(1700000000).Transactions(tx, failed).Store(server.Backend)
+	server.Backend.SetSlot(1000)
+
+	for _, want := range []struct {
+		tx      *solana.Transaction
+		success bool
+	}{
+		{tx.Transaction, true},
+		{failed.Transaction, false},
+	} {
+		signature := want.tx.Signatures[0].String()
+		resp, err := server.Client.GetTransaction(context.Background(), &proto.TransactionRequest{Signature: signature})
+		if err != nil {
+			t.Fatalf("GetTransaction %s: %v", signature, err)
+		}
+		if resp.Slot != 1000 || resp.Success != want.success {
+			t.Errorf("transaction %s: got slot %d, success %t; want 1000, %t", signature, resp.Slot, resp.Success, want.success)
+		}
+	}
+
+	resp, err := server.Client.GetBlock(context.Background(), &proto.BlockRequest{Slot: 1000})
+	if err != nil {
+		t.Fatalf("GetBlock: %v", err)
+	}
+	if resp.Blockhash != block.Blockhash.String() || resp.ParentSlot != 999 || len(resp.Transactions) != 2 {
+		t.Errorf("got block %s with parent %d and %d transactions", resp.Blockhash, resp.ParentSlot, len(resp.Transactions))
+	}
+}
+
+func TestAckStreamResumeCatchesUp(t *testing.T) {
+	server := newServer(t)
+	server.Backend.SetSlot(100)
+	pubkey := harness.NewAccount().Lamports(1000).Store(server.Backend)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := server.Client.StreamAccountUpdatesWithAck(ctx)
+	if err != nil {
+		t.Fatalf("StreamAccountUpdatesWithAck: %v", err)
+	}
+	err = stream.Send(&proto.AccountAckStreamRequest{
+		Subscription: &proto.AccountStreamRequest{Pubkeys: []string{pubkey.String()}},
+	})
+	if err != nil {
+		t.Fatalf("sending subscription: %v", err)
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("receiving first update: %v", err)
+	}
+	cancel()
+
+	// Change the account while the client is away
+	harness.NewAccount().Pubkey(pubkey).Lamports(2000).Store(server.Backend)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var resumed proto.BenchmarkService_StreamAccountUpdatesWithAckClient
+	var update *proto.AckedAccountUpdate
+	for {
+		resumed, err = server.Client.StreamAccountUpdatesWithAck(ctx)
+		if err != nil {
+			t.Fatalf("StreamAccountUpdatesWithAck: %v", err)
+		}
+		err = resumed.Send(&proto.AccountAckStreamRequest{SessionId: first.SessionId, AckCursor: first.Cursor})
+		if err != nil {
+			t.Fatalf("resuming session: %v", err)
+		}
+		update, err = resumed.Recv()
+		// The session is resumable once the server notices the client left
+		if status.Code(err) != codes.FailedPrecondition {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// After redelivering what was not acknowledged, the resumed session
+	// sends the account's current state
+	for ; err == nil; update, err = resumed.Recv() {
+		if update.SessionId != first.SessionId || update.Cursor <= first.Cursor {
+			t.Fatalf("got session %s cursor %d after resuming %s at cursor %d", update.SessionId, update.Cursor, first.SessionId, first.Cursor)
+		}
+		if update.Update.Snapshot {
+			if update.Update.Lamports != 2000 {
+				t.Errorf("got snapshot with %d lamports, want 2000", update.Update.Lamports)
+			}
+			return
+		}
+	}
+	t.Fatalf("no snapshot after resuming: %v", err)
+}